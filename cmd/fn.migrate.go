@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ezbuy/redis-orm/fs"
+	"github.com/ezbuy/redis-orm/parser"
+	"github.com/spf13/viper"
+)
+
+func GenerateMigration() {
+	inputDir, err := filepath.Abs(viper.GetString("migrate_input"))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	outputDir, err := filepath.Abs(viper.GetString("output"))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	driver := viper.GetString("migrate_driver")
+	snapshot := viper.GetString("migrate_snapshot")
+	//! the snapshot and the diff are of the mysql DDL only
+	if strings.ToLower(driver) != "mysql" {
+		fmt.Println("failed: migrate supports the mysql driver only, not", driver)
+		os.Exit(1)
+	}
+
+	news, err := readYamlTables(driver, inputDir)
+	if err != nil {
+		fmt.Println("failed: ", err)
+		os.Exit(1)
+	}
+
+	olds := []*parser.Table{}
+	if snapshot != "" {
+		stat, err := os.Stat(snapshot)
+		if err != nil {
+			fmt.Println("failed: ", err)
+			os.Exit(1)
+		}
+		if stat.IsDir() {
+			olds, err = readYamlTables(driver, snapshot)
+		} else {
+			olds, err = readDDLTables(driver, snapshot)
+		}
+		if err != nil {
+			fmt.Println("failed: ", err)
+			os.Exit(1)
+		}
+	}
+
+	migration, err := parser.DiffTables(driver, olds, news)
+	if err != nil {
+		fmt.Println("failed: ", err)
+		os.Exit(1)
+	}
+	for _, review := range migration.Reviews {
+		fmt.Println("review => ", review)
+	}
+
+	driver = strings.ToLower(driver)
+	files := map[string]string{
+		"gen.snapshot." + driver + ".sql": snapshotScript(news),
+	}
+	if !migration.IsEmpty() {
+		files["gen.migrate.up."+driver+".sql"] = migration.Script(true)
+		files["gen.migrate.down."+driver+".sql"] = migration.Script(false)
+	} else {
+		fmt.Println("schema not changed")
+	}
+	for name, content := range files {
+		file := filepath.Join(outputDir, name)
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			fmt.Println("failed: ", err)
+			os.Exit(1)
+		}
+		fmt.Println("generate migration => ", file)
+	}
+}
+
+func readYamlTables(driver, inputDir string) ([]*parser.Table, error) {
+	yamls, err := fs.GetDirectoryFilesBySuffix(inputDir, ".yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	tables := []*parser.Table{}
+	for _, yaml := range yamls {
+		objs, err := parser.ReadYaml("script", yaml)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			if obj.DbTable == "" || !obj.DbContains(driver) {
+				continue
+			}
+			table, err := obj.SQLTable(driver)
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, table)
+		}
+	}
+	return tables, nil
+}
+
+func snapshotScript(tables []*parser.Table) string {
+	stmts := []string{}
+	for _, table := range tables {
+		stmts = append(stmts, table.MySQLCreate()...)
+	}
+	return strings.Join(stmts, "\n") + "\n"
+}
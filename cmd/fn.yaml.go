@@ -2,17 +2,101 @@ package cmd
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/parser"
 	"github.com/spf13/viper"
 )
 
 func GenerateYaml() {
+	outputDir, err := filepath.Abs(viper.GetString("output"))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	input := viper.GetString("yaml_input")
 	model := viper.GetString("yaml_model")
+	driver := viper.GetString("yaml_driver")
+	database := viper.GetString("yaml_database")
+
+	var tables []*parser.Table
+	switch {
+	case input != "":
+		tables, err = readDDLTables(driver, input)
+	case database != "":
+		tables, err = readDatabaseTables(driver, database)
+	default:
+		tables = []*parser.Table{sampleTable(model)}
+	}
+	if err != nil {
+		fmt.Println("failed: ", err)
+		os.Exit(1)
+	}
+
+	for _, table := range tables {
+		if model != "" &&
+			strings.ToLower(table.ModelName()) != strings.ToLower(model) &&
+			strings.ToLower(table.Name) != strings.ToLower(model) {
+			continue
+		}
+
+		file := filepath.Join(outputDir, table.FileName())
+		if err := ioutil.WriteFile(file, table.Yaml(driver, database), 0644); err != nil {
+			fmt.Println("failed: ", err)
+			os.Exit(1)
+		}
+		fmt.Println("generate yaml => ", file)
+	}
+}
+
+func readDDLTables(driver, input string) ([]*parser.Table, error) {
+	data, err := ioutil.ReadFile(input)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(driver) {
+	case "mysql":
+		return parser.ParseMySQLDDL(string(data))
+	}
+	return nil, fmt.Errorf("unsupport db driver: %s", driver)
+}
 
-	fmt.Println("generate yaml => ", model)
+func readDatabaseTables(driver, database string) ([]*parser.Table, error) {
+	store, err := orm.NewDBStore(driver,
+		viper.GetString("yaml_host"),
+		viper.GetInt("yaml_port"),
+		database,
+		viper.GetString("yaml_username"),
+		viper.GetString("yaml_password"))
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
 
-	s := make([]string, 6)
-	fmt.Println("s =>", strings.Join(s, ","))
+	switch strings.ToLower(driver) {
+	case "mysql":
+		return parser.ReadMySQLTables(store.DB, database)
+	}
+	return nil, fmt.Errorf("unsupport db driver: %s", driver)
+}
 
+func sampleTable(model string) *parser.Table {
+	if model == "" {
+		model = "Sample"
+	}
+	table := parser.NewTable(parser.Camel2Name(model))
+	table.Columns = []*parser.Column{
+		{Name: "id", Type: "int", AutoIncrement: true},
+		{Name: "name", Type: "varchar", Args: []string{"32"}},
+		{Name: "created_at", Type: "datetime"},
+		{Name: "updated_at", Type: "datetime"},
+	}
+	table.PrimaryKey = []string{"id"}
+	table.Indexes = append(table.Indexes, &parser.TableIndex{Columns: []string{"name"}})
+	return table
 }
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "generate migration scripts by diffing yaml files against the schema snapshot",
+	Long:  "generate migration scripts by diffing yaml files against the schema snapshot, only the mysql schemas are supported",
+	Run: func(cmd *cobra.Command, args []string) {
+		GenerateMigration()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(migrateCmd)
+
+	migrateCmd.PersistentFlags().StringP("input", "i", ".", "directory of yaml files")
+	migrateCmd.PersistentFlags().StringP("snapshot", "s", "", "previous schema, a DDL script file or a directory of yaml files")
+	migrateCmd.PersistentFlags().StringP("driver", "d", "mysql", "database type, only mysql is supported")
+	viper.BindPFlag("migrate_input", migrateCmd.PersistentFlags().Lookup("input"))
+	viper.BindPFlag("migrate_snapshot", migrateCmd.PersistentFlags().Lookup("snapshot"))
+	viper.BindPFlag("migrate_driver", migrateCmd.PersistentFlags().Lookup("driver"))
+}
@@ -31,6 +31,7 @@ var yamlCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(yamlCmd)
 
+	yamlCmd.PersistentFlags().StringP("input", "i", "", "DDL script file of CREATE TABLE statements, instead of the database")
 	yamlCmd.PersistentFlags().StringP("model", "m", "", "sample yaml file's model name")
 	yamlCmd.PersistentFlags().StringP("driver", "D", "mysql", "database driver name, like: mysql, mssql etc")
 	yamlCmd.PersistentFlags().StringP("database", "d", "", "database name")
@@ -39,6 +40,7 @@ func init() {
 	yamlCmd.PersistentFlags().StringP("username", "u", "root", "database username")
 	yamlCmd.PersistentFlags().StringP("password", "p", "", "database password")
 
+	viper.BindPFlag("yaml_input", yamlCmd.PersistentFlags().Lookup("input"))
 	viper.BindPFlag("yaml_model", yamlCmd.PersistentFlags().Lookup("model"))
 	viper.BindPFlag("yaml_driver", yamlCmd.PersistentFlags().Lookup("driver"))
 	viper.BindPFlag("yaml_host", yamlCmd.PersistentFlags().Lookup("host"))
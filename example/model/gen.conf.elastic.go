@@ -1,10 +1,13 @@
 package model
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/ezbuy/redis-orm/orm"
 	elastic "gopkg.in/olivere/elastic.v2"
 )
 
@@ -92,3 +95,50 @@ func (e *ESClient) PutMappingService(index string) *elastic.PutMappingService {
 
 	return e.Client.PutMapping().Index(index)
 }
+
+func (e *ESClient) GetService(index string) *elastic.GetService {
+	if index == "" {
+		index = e.IndexName
+	}
+
+	return e.Client.Get().Index(index)
+}
+
+func (e *ESClient) DeleteService(index string) *elastic.DeleteService {
+	if index == "" {
+		index = e.IndexName
+	}
+
+	return e.Client.Delete().Index(index)
+}
+
+func (e *ESClient) BulkService(index string) *elastic.BulkService {
+	if index == "" {
+		index = e.IndexName
+	}
+
+	return e.Client.Bulk().Index(index)
+}
+
+// elasticNotFound reports the 404 of a missing document.
+func elasticNotFound(err error) bool {
+	var e *elastic.Error
+	return errors.As(err, &e) && e.Status == http.StatusNotFound
+}
+
+// elasticBulkError collects the failed items of a bulk request into an
+// orm.MultiError, a missing document is an orm.NotFoundError.
+func elasticBulkError(object string, res *elastic.BulkResponse) error {
+	var multi orm.MultiError
+	for _, item := range res.Failed() {
+		if item.Status == http.StatusNotFound {
+			multi = append(multi, &orm.NotFoundError{Object: object, Key: item.Id})
+			continue
+		}
+		multi = append(multi, fmt.Errorf("key:%v,status:%d,err:%v", item.Id, item.Status, item.Error))
+	}
+	if len(multi) == 0 {
+		return nil
+	}
+	return multi
+}
@@ -22,6 +22,14 @@ type MySQLConfig struct {
 	Database        string
 	PoolSize        int
 	ConnMaxLifeTime time.Duration
+	Replicas        []MySQLReplicaConfig
+}
+
+// MySQLReplicaConfig is a read replica sharing the database and
+// account of the primary.
+type MySQLReplicaConfig struct {
+	Host string
+	Port int
 }
 
 func MySQLSetup(cf *MySQLConfig) {
@@ -40,6 +48,15 @@ func MySQL() *orm.DBStore {
 		if err != nil {
 			panic(err)
 		}
+		for _, replica := range _mysql_cfg.Replicas {
+			if err = _mysql_store.AddReplica(replica.Host,
+				replica.Port,
+				_mysql_cfg.Database,
+				_mysql_cfg.UserName,
+				_mysql_cfg.Password); err != nil {
+				panic(err)
+			}
+		}
 		_mysql_store.SetConnMaxLifetime(time.Hour)
 		if _mysql_cfg.ConnMaxLifeTime > 0 {
 			_mysql_store.SetConnMaxLifetime(_mysql_cfg.ConnMaxLifeTime)
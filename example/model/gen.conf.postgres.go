@@ -0,0 +1,50 @@
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+var (
+	_postgres_store *orm.DBStore
+	_postgres_cfg   PostgresConfig
+	_postgres_once  sync.Once
+)
+
+type PostgresConfig struct {
+	Host            string
+	Port            int
+	UserName        string
+	Password        string
+	Database        string
+	PoolSize        int
+	ConnMaxLifeTime time.Duration
+}
+
+func PostgresSetup(cf *PostgresConfig) {
+	_postgres_cfg = *cf
+}
+
+func Postgres() *orm.DBStore {
+	var err error
+	_postgres_once.Do(func() {
+		_postgres_store, err = orm.NewDBStore("postgres",
+			_postgres_cfg.Host,
+			_postgres_cfg.Port,
+			_postgres_cfg.Database,
+			_postgres_cfg.UserName,
+			_postgres_cfg.Password)
+		if err != nil {
+			panic(err)
+		}
+		_postgres_store.SetConnMaxLifetime(time.Hour)
+		if _postgres_cfg.ConnMaxLifeTime > 0 {
+			_postgres_store.SetConnMaxLifetime(_postgres_cfg.ConnMaxLifeTime)
+		}
+		_postgres_store.SetMaxIdleConns(_postgres_cfg.PoolSize)
+		_postgres_store.SetMaxOpenConns(_postgres_cfg.PoolSize)
+	})
+	return _postgres_store
+}
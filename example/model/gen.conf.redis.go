@@ -51,6 +51,10 @@ func Redis() *orm.RedisStore {
 
 // 处理error，把一个error变成error数组
 func SplitError(err error) []error {
+	var multi orm.MultiError
+	if errors.As(err, &multi) {
+		return multi
+	}
 	ss := strings.Split(err.Error(), ERROR_SPLIT)
 	result := make([]error, len(ss))
 	for i, s := range ss {
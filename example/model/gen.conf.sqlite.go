@@ -0,0 +1,45 @@
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+var (
+	_sqlite_store *orm.DBStore
+	_sqlite_cfg   SQLiteConfig
+	_sqlite_once  sync.Once
+)
+
+type SQLiteConfig struct {
+	Database        string // file path or ":memory:"
+	PoolSize        int
+	ConnMaxLifeTime time.Duration
+}
+
+func SQLiteSetup(cf *SQLiteConfig) {
+	_sqlite_cfg = *cf
+}
+
+func SQLite() *orm.DBStore {
+	var err error
+	_sqlite_once.Do(func() {
+		_sqlite_store, err = orm.NewDBStore("sqlite", "", 0,
+			_sqlite_cfg.Database, "", "")
+		if err != nil {
+			panic(err)
+		}
+		//! pool is left to the defaults unless configured, in-memory database
+		//! is dropped with its connection
+		if _sqlite_cfg.ConnMaxLifeTime > 0 {
+			_sqlite_store.SetConnMaxLifetime(_sqlite_cfg.ConnMaxLifeTime)
+		}
+		if _sqlite_cfg.PoolSize > 0 {
+			_sqlite_store.SetMaxIdleConns(_sqlite_cfg.PoolSize)
+			_sqlite_store.SetMaxOpenConns(_sqlite_cfg.PoolSize)
+		}
+	})
+	return _sqlite_store
+}
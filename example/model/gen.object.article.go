@@ -0,0 +1,1441 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+var (
+	_ context.Context
+	_ sql.DB
+	_ time.Time
+	_ fmt.Formatter
+	_ strings.Reader
+	_ orm.VSet
+	_ validator.Validate
+	_ sqlbuilder.Builder
+)
+
+type Article struct {
+	Id          int64     `db:"id"`
+	AuthorId    int32     `db:"author_id"`
+	Slug        string    `db:"slug"`
+	Title       string    `db:"title"`
+	Content     string    `db:"content"`
+	Published   bool      `db:"published"`
+	Rating      float64   `db:"rating"`
+	Hits        int32     `db:"hits"`
+	PublishedAt time.Time `db:"published_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+	dirty       orm.Dirty
+}
+
+var ArticleColumns = struct {
+	Id          string
+	AuthorId    string
+	Slug        string
+	Title       string
+	Content     string
+	Published   string
+	Rating      string
+	Hits        string
+	PublishedAt string
+	UpdatedAt   string
+}{
+	"id",
+	"author_id",
+	"slug",
+	"title",
+	"content",
+	"published",
+	"rating",
+	"hits",
+	"published_at",
+	"updated_at",
+}
+
+type _ArticleMgr struct {
+}
+
+var ArticleMgr *_ArticleMgr
+
+func (m *_ArticleMgr) NewArticle() *Article {
+	return &Article{}
+}
+
+//! object function
+
+func (obj *Article) GetNameSpace() string {
+	return "model"
+}
+
+func (obj *Article) GetClassName() string {
+	return "Article"
+}
+
+func (obj *Article) GetTableName() string {
+	return "articles"
+}
+
+func (obj *Article) GetColumns() []string {
+	columns := []string{
+		"articles.id",
+		"articles.author_id",
+		"articles.slug",
+		"articles.title",
+		"articles.content",
+		"articles.published",
+		"articles.rating",
+		"articles.hits",
+		"articles.published_at",
+		"articles.updated_at",
+	}
+	return columns
+}
+
+func (obj *Article) GetNoneIncrementColumns() []string {
+	columns := []string{
+		"author_id",
+		"slug",
+		"title",
+		"content",
+		"published",
+		"rating",
+		"hits",
+		"published_at",
+		"updated_at",
+	}
+	return columns
+}
+
+func (obj *Article) GetPrimaryKey() PrimaryKey {
+	pk := ArticleMgr.NewPrimaryKey()
+	pk.Id = obj.Id
+	return pk
+}
+
+func (obj *Article) Validate() error {
+	validate := validator.New()
+	return validate.Struct(obj)
+}
+
+//! primary key
+
+type IdOfArticlePK struct {
+	Id int64
+}
+
+func (m *_ArticleMgr) NewPrimaryKey() *IdOfArticlePK {
+	return &IdOfArticlePK{}
+}
+
+func (u *IdOfArticlePK) Key() string {
+	strs := []string{
+		"Id",
+		fmt.Sprint(u.Id),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfArticlePK) Parse(key string) error {
+	arr := strings.Split(key, ":")
+	if len(arr)%2 != 0 {
+		return fmt.Errorf("key (%s) format error", key)
+	}
+	kv := map[string]string{}
+	for i := 0; i < len(arr)/2; i++ {
+		kv[arr[2*i]] = arr[2*i+1]
+	}
+	vId, ok := kv["Id"]
+	if !ok {
+		return fmt.Errorf("key (%s) without (Id) field", key)
+	}
+	if err := orm.StringScan(vId, &(u.Id)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *IdOfArticlePK) SQLFormat() string {
+	conditions := []string{
+		"id = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *IdOfArticlePK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Id,
+	}
+}
+
+func (u *IdOfArticlePK) Columns() []string {
+	return []string{
+		"id",
+	}
+}
+
+//! uniques
+
+type SlugOfArticleUK struct {
+	Slug string
+}
+
+func (u *SlugOfArticleUK) Key() string {
+	strs := []string{
+		"Slug",
+		fmt.Sprint(u.Slug),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *SlugOfArticleUK) SQLFormat(limit bool) string {
+	conditions := []string{
+		"slug = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *SlugOfArticleUK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Slug,
+	}
+}
+
+func (u *SlugOfArticleUK) SQLLimit() int {
+	return 1
+}
+
+func (u *SlugOfArticleUK) Limit(n int) {
+}
+
+func (u *SlugOfArticleUK) Offset(n int) {
+}
+
+func (u *SlugOfArticleUK) UKRelation(store *orm.RedisStore) UniqueRelation {
+	return nil
+}
+
+//! indexes
+
+type AuthorIdOfArticleIDX struct {
+	AuthorId int32
+	offset   int
+	limit    int
+}
+
+func (u *AuthorIdOfArticleIDX) Key() string {
+	strs := []string{
+		"AuthorId",
+		fmt.Sprint(u.AuthorId),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *AuthorIdOfArticleIDX) SQLFormat(limit bool) string {
+	conditions := []string{
+		"author_id = ?",
+	}
+	if limit {
+		return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.PostgresOffsetLimit(u.offset, u.limit))
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *AuthorIdOfArticleIDX) SQLParams() []interface{} {
+	return []interface{}{
+		u.AuthorId,
+	}
+}
+
+func (u *AuthorIdOfArticleIDX) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *AuthorIdOfArticleIDX) Limit(n int) {
+	u.limit = n
+}
+
+func (u *AuthorIdOfArticleIDX) Offset(n int) {
+	u.offset = n
+}
+
+func (u *AuthorIdOfArticleIDX) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *AuthorIdOfArticleIDX) IDXRelation(store *orm.RedisStore) IndexRelation {
+	return nil
+}
+
+//! ranges
+
+type IdOfArticleRNG struct {
+	IdBegin      int64
+	IdEnd        int64
+	offset       int
+	limit        int
+	includeBegin bool
+	includeEnd   bool
+	revert       bool
+}
+
+func (u *IdOfArticleRNG) Key() string {
+	strs := []string{
+		"Id",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfArticleRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *IdOfArticleRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *IdOfArticleRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("id %s ?", u.beginOp()))
+		}
+		if u.IdEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("id %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("id", u.revert), orm.PostgresOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("id", u.revert))
+}
+
+func (u *IdOfArticleRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			params = append(params, u.IdBegin)
+		}
+		if u.IdEnd != -1 {
+			params = append(params, u.IdEnd)
+		}
+	}
+	return params
+}
+
+func (u *IdOfArticleRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *IdOfArticleRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *IdOfArticleRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *IdOfArticleRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *IdOfArticleRNG) Begin() int64 {
+	start := u.IdBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *IdOfArticleRNG) End() int64 {
+	stop := u.IdEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *IdOfArticleRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *IdOfArticleRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *IdOfArticleRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *IdOfArticleRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return nil
+}
+
+type HitsOfArticleRNG struct {
+	HitsBegin    int64
+	HitsEnd      int64
+	offset       int
+	limit        int
+	includeBegin bool
+	includeEnd   bool
+	revert       bool
+}
+
+func (u *HitsOfArticleRNG) Key() string {
+	strs := []string{
+		"Hits",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *HitsOfArticleRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *HitsOfArticleRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *HitsOfArticleRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.HitsBegin != u.HitsEnd {
+		if u.HitsBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("hits %s ?", u.beginOp()))
+		}
+		if u.HitsEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("hits %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("hits", u.revert), orm.PostgresOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("hits", u.revert))
+}
+
+func (u *HitsOfArticleRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.HitsBegin != u.HitsEnd {
+		if u.HitsBegin != -1 {
+			params = append(params, u.HitsBegin)
+		}
+		if u.HitsEnd != -1 {
+			params = append(params, u.HitsEnd)
+		}
+	}
+	return params
+}
+
+func (u *HitsOfArticleRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *HitsOfArticleRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *HitsOfArticleRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *HitsOfArticleRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *HitsOfArticleRNG) Begin() int64 {
+	start := u.HitsBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *HitsOfArticleRNG) End() int64 {
+	stop := u.HitsEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *HitsOfArticleRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *HitsOfArticleRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *HitsOfArticleRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *HitsOfArticleRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return nil
+}
+
+type _ArticleDBMgr struct {
+	db   orm.DB
+	from string
+}
+
+func (m *_ArticleMgr) DB(db orm.DB) *_ArticleDBMgr {
+	return ArticleDBMgr(db)
+}
+
+func ArticleDBMgr(db orm.DB) *_ArticleDBMgr {
+	if db == nil {
+		panic(fmt.Errorf("ArticleDBMgr init need db"))
+	}
+	return &_ArticleDBMgr{db: db, from: "articles"}
+}
+
+func (m *_ArticleDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*Article, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_ArticleDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	conditions := []string{where, orderby, limit}
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
+}
+
+func (m *_ArticleDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Article, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_ArticleDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
+		strings.Join(obj.GetColumns(), ","),
+		m.from,
+		orm.SQLWhere(conditions),
+		orderby,
+		orm.PostgresOffsetLimit(offset, limit))
+
+	return m.FetchBySQLCtx(ctx, q, args...)
+}
+
+func (m *_ArticleDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_ArticleDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
+}
+
+func (m *_ArticleDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_ArticleDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
+}
+
+func (m *_ArticleDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Article, err error) {
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_ArticleDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*Article, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Article fetch error: %w", err)
+	}
+	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_ArticleDBMgr) scan(rows *sql.Rows) (results []*Article, err error) {
+
+	var Rating sql.NullFloat64
+	var PublishedAt string
+	var UpdatedAt string
+
+	for rows.Next() {
+		var result Article
+		err = rows.Scan(&(result.Id), &(result.AuthorId), &(result.Slug), &(result.Title), &(result.Content), &(result.Published), &Rating, &(result.Hits), &PublishedAt, &UpdatedAt)
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		result.Rating = Rating.Float64
+
+		result.PublishedAt = orm.PostgresTimeParse(PublishedAt)
+		result.UpdatedAt = orm.PostgresLocalTimeParse(UpdatedAt)
+
+		results = append(results, &result)
+	}
+	if err = rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Article fetch result error: %w", err)
+	}
+	return
+}
+func (m *_ArticleDBMgr) Exist(pk PrimaryKey) (bool, error) {
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_ArticleDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
+	if err != nil {
+		return false, err
+	}
+	return (c != 0), nil
+}
+
+// Deprecated: Use FetchByPrimaryKey instead.
+func (m *_ArticleDBMgr) Fetch(pk PrimaryKey) (*Article, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_ArticleDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Article", Key: pk.Key()}
+}
+
+// primary key
+func (m *_ArticleDBMgr) FetchByPrimaryKey(id int64) (*Article, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_ArticleDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int64) (*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	pk := &IdOfArticlePK{
+		Id: id,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Article", Key: pk.Key()}
+}
+
+func (m *_ArticleDBMgr) FetchByPrimaryKeys(ids []int64) ([]*Article, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), ids)
+}
+
+func (m *_ArticleDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, ids []int64) ([]*Article, error) {
+	size := len(ids)
+	if size == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, size)
+	for _, pk := range ids {
+		params = append(params, pk)
+	}
+	obj := ArticleMgr.NewArticle()
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
+		strings.Repeat(",?", size-1))
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// indexes
+
+func (m *_ArticleDBMgr) FindByAuthorId(authorId int32, limit int, offset int) ([]*Article, error) {
+	return m.FindByAuthorIdCtx(context.Background(), authorId, limit, offset)
+}
+
+func (m *_ArticleDBMgr) FindByAuthorIdCtx(ctx context.Context, authorId int32, limit int, offset int) ([]*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	idx := &AuthorIdOfArticleIDX{
+		AuthorId: authorId,
+		limit:    limit,
+		offset:   offset,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_ArticleDBMgr) FindAllByAuthorId(authorId int32) ([]*Article, error) {
+	return m.FindAllByAuthorIdCtx(context.Background(), authorId)
+}
+
+func (m *_ArticleDBMgr) FindAllByAuthorIdCtx(ctx context.Context, authorId int32) ([]*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	idx := &AuthorIdOfArticleIDX{
+		AuthorId: authorId,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_ArticleDBMgr) FindByAuthorIdGroup(items []int32) ([]*Article, error) {
+	return m.FindByAuthorIdGroupCtx(context.Background(), items)
+}
+
+func (m *_ArticleDBMgr) FindByAuthorIdGroupCtx(ctx context.Context, items []int32) ([]*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	if len(items) == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		params = append(params, item)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s where author_id in (?", strings.Join(obj.GetColumns(), ","), m.from) +
+		strings.Repeat(",?", len(items)-1) + ")"
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// uniques
+
+func (m *_ArticleDBMgr) FetchBySlug(slug string) (*Article, error) {
+	return m.FetchBySlugCtx(context.Background(), slug)
+}
+
+func (m *_ArticleDBMgr) FetchBySlugCtx(ctx context.Context, slug string) (*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	uniq := &SlugOfArticleUK{
+		Slug: slug,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, uniq.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, uniq.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Article", Key: uniq.Key()}
+}
+
+func (m *_ArticleDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_ArticleDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Article", Key: unique.Key()}
+}
+
+// Deprecated: Use FetchByXXXUnique instead.
+func (m *_ArticleDBMgr) FindOneFetch(unique Unique) (*Article, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_ArticleDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Article, error) {
+	obj := ArticleMgr.NewArticle()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Article", Key: unique.Key()}
+}
+
+// Deprecated: Use FindByXXXUnique instead.
+func (m *_ArticleDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_ArticleDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_ArticleDBMgr) FindFetch(index Index) (int64, []*Article, error) {
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_ArticleDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Article, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+
+	obj := ArticleMgr.NewArticle()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_ArticleDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_ArticleDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_ArticleDBMgr) RangeFetch(scope Range) (int64, []*Article, error) {
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_ArticleDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Article, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	obj := ArticleMgr.NewArticle()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_ArticleDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_ArticleDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	scope.Revert(true)
+	return m.RangeCtx(ctx, scope)
+}
+
+func (m *_ArticleDBMgr) RangeRevertFetch(scope Range) (int64, []*Article, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_ArticleDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Article, error) {
+	scope.Revert(true)
+	return m.RangeFetchCtx(ctx, scope)
+}
+
+func (m *_ArticleDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+	pk := ArticleMgr.NewPrimaryKey()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Article query limit error: %w", err)
+	}
+	defer rows.Close()
+
+	offset := 0
+
+	for rows.Next() {
+		if limit >= 0 && offset >= limit {
+			break
+		}
+		offset++
+
+		result := ArticleMgr.NewPrimaryKey()
+		err = rows.Scan(&(result.Id))
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Article query limit result error: %w", err)
+	}
+	return
+}
+
+func (m *_ArticleDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(id) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("Article query count error: %w", err)
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		if err = rows.Scan(&count); err != nil {
+			m.db.SetError(err)
+			return 0, err
+		}
+		break
+	}
+	return count, nil
+}
+
+func (obj *Article) SetAuthorId(val int32) *Article {
+	obj.AuthorId = val
+	obj.dirty.Mark(ArticleColumns.AuthorId)
+	return obj
+}
+
+func (obj *Article) SetSlug(val string) *Article {
+	obj.Slug = val
+	obj.dirty.Mark(ArticleColumns.Slug)
+	return obj
+}
+
+func (obj *Article) SetTitle(val string) *Article {
+	obj.Title = val
+	obj.dirty.Mark(ArticleColumns.Title)
+	return obj
+}
+
+func (obj *Article) SetContent(val string) *Article {
+	obj.Content = val
+	obj.dirty.Mark(ArticleColumns.Content)
+	return obj
+}
+
+func (obj *Article) SetPublished(val bool) *Article {
+	obj.Published = val
+	obj.dirty.Mark(ArticleColumns.Published)
+	return obj
+}
+
+func (obj *Article) SetRating(val float64) *Article {
+	obj.Rating = val
+	obj.dirty.Mark(ArticleColumns.Rating)
+	return obj
+}
+
+func (obj *Article) SetHits(val int32) *Article {
+	obj.Hits = val
+	obj.dirty.Mark(ArticleColumns.Hits)
+	return obj
+}
+
+func (obj *Article) SetPublishedAt(val time.Time) *Article {
+	obj.PublishedAt = val
+	obj.dirty.Mark(ArticleColumns.PublishedAt)
+	return obj
+}
+
+func (obj *Article) SetUpdatedAt(val time.Time) *Article {
+	obj.UpdatedAt = val
+	obj.dirty.Mark(ArticleColumns.UpdatedAt)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *Article) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
+func (m *_ArticleDBMgr) BatchCreate(objs []*Article) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_ArticleDBMgr) BatchCreateCtx(ctx context.Context, objs []*Article) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO articles(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_ArticleDBMgr) beforeWrite(obj *Article, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_ArticleDBMgr) hook(obj *Article, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_ArticleDBMgr) batchValues(objs []*Article, withIncrement bool) (string, []interface{}) {
+	size := 9
+	if withIncrement {
+		size = 10
+	}
+	params := make([]string, 0, len(objs))
+	values := make([]interface{}, 0, len(objs)*size)
+	for _, obj := range objs {
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
+		if withIncrement {
+			values = append(values, obj.Id)
+		}
+		values = append(values, obj.AuthorId)
+		values = append(values, obj.Slug)
+		values = append(values, obj.Title)
+		values = append(values, obj.Content)
+		values = append(values, obj.Published)
+		values = append(values, obj.Rating)
+		values = append(values, obj.Hits)
+		values = append(values, orm.PostgresTimeFormat(obj.PublishedAt))
+		values = append(values, orm.TimeToLocalTime(obj.UpdatedAt))
+	}
+	return strings.Join(params, ","), values
+}
+
+// argument example:
+// set:"a=?, b=?"
+// where:"c=? and d=?"
+// params:[]interface{}{"a", "b", "c", "d"}...
+func (m *_ArticleDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_ArticleDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("UPDATE articles SET %s", set)
+	if where != "" {
+		query = fmt.Sprintf("UPDATE articles SET %s WHERE %s", set, where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_ArticleDBMgr) Create(obj *Article) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_ArticleDBMgr) CreateCtx(ctx context.Context, obj *Article) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_ArticleDBMgr) create(ctx context.Context, obj *Article) (int64, error) {
+	params := orm.NewStringSlice(9, "?")
+	q := fmt.Sprintf("INSERT INTO articles(%s) VALUES(%s) RETURNING id",
+		strings.Join(obj.GetNoneIncrementColumns(), ","),
+		strings.Join(params, ","))
+
+	values := make([]interface{}, 0, 10)
+	values = append(values, obj.AuthorId)
+	values = append(values, obj.Slug)
+	values = append(values, obj.Title)
+	values = append(values, obj.Content)
+	values = append(values, obj.Published)
+	values = append(values, obj.Rating)
+	values = append(values, obj.Hits)
+	values = append(values, orm.PostgresTimeFormat(obj.PublishedAt))
+	values = append(values, orm.TimeToLocalTime(obj.UpdatedAt))
+	rows, err := m.db.QueryContext(orm.WithPrimary(ctx), q, values...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var affected int64
+	for rows.Next() {
+		if err = rows.Scan(&(obj.Id)); err != nil {
+			m.db.SetError(err)
+			return 0, err
+		}
+		affected++
+	}
+	if err = rows.Err(); err != nil {
+		m.db.SetError(err)
+		return 0, orm.TranslateError(err)
+	}
+	return affected, nil
+}
+
+func (m *_ArticleDBMgr) Update(obj *Article) (int64, error) {
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_ArticleDBMgr) UpdateCtx(ctx context.Context, obj *Article) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_ArticleDBMgr) UpdateAll(obj *Article) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_ArticleDBMgr) UpdateAllCtx(ctx context.Context, obj *Article) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		ArticleColumns.AuthorId,
+		ArticleColumns.Slug,
+		ArticleColumns.Title,
+		ArticleColumns.Content,
+		ArticleColumns.Published,
+		ArticleColumns.Rating,
+		ArticleColumns.Hits,
+		ArticleColumns.PublishedAt,
+		ArticleColumns.UpdatedAt,
+	)
+}
+
+func (m *_ArticleDBMgr) UpdateFields(obj *Article, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of ArticleColumns.
+func (m *_ArticleDBMgr) UpdateFieldsCtx(ctx context.Context, obj *Article, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_ArticleDBMgr) updateFields(ctx context.Context, obj *Article, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "author_id":
+			set.Add(column, obj.AuthorId)
+		case "slug":
+			set.Add(column, obj.Slug)
+		case "title":
+			set.Add(column, obj.Title)
+		case "content":
+			set.Add(column, obj.Content)
+		case "published":
+			set.Add(column, obj.Published)
+		case "rating":
+			set.Add(column, obj.Rating)
+		case "hits":
+			set.Add(column, obj.Hits)
+		case "published_at":
+			set.Add(column, orm.PostgresTimeFormat(obj.PublishedAt))
+		case "updated_at":
+			set.Add(column, orm.TimeToLocalTime(obj.UpdatedAt))
+		default:
+			return 0, fmt.Errorf("Article has no column %s to update", column)
+		}
+	}
+	sets, values, err := sqlbuilder.Postgres.BuildArgs(set)
+	if err != nil {
+		return 0, err
+	}
+
+	pk := obj.GetPrimaryKey()
+	q := fmt.Sprintf("UPDATE articles SET %s %s", sets, pk.SQLFormat())
+	values = append(values, pk.SQLParams()...)
+
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Clean(columns...)
+	return result.RowsAffected()
+}
+
+func (m *_ArticleDBMgr) Save(obj *Article) (int64, error) {
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+func (m *_ArticleDBMgr) SaveCtx(ctx context.Context, obj *Article) (int64, error) {
+	if obj.Id == 0 {
+		return m.CreateCtx(ctx, obj)
+	}
+	affected, failed, err := m.save(ctx, []*Article{obj})
+	if err != nil {
+		return affected, err
+	}
+	if len(failed) > 0 {
+		return 0, failed[0]
+	}
+	return affected, nil
+}
+
+func (m *_ArticleDBMgr) BatchUpsert(objs []*Article) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_ArticleDBMgr) BatchUpsertCtx(ctx context.Context, objs []*Article) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*Article, 0, len(objs))
+	upserts := make([]*Article, 0, len(objs))
+	for _, obj := range objs {
+		if obj.Id == 0 {
+			creates = append(creates, obj)
+		} else {
+			upserts = append(upserts, obj)
+		}
+	}
+
+	var affected int64
+	if len(creates) > 0 {
+		n, err := m.BatchCreateCtx(ctx, creates)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_ArticleDBMgr) save(ctx context.Context, objs []*Article) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+func (m *_ArticleDBMgr) upsert(ctx context.Context, objs []*Article) ([]*Article, orm.MultiError, error) {
+	columns := []string{
+		"id",
+		"author_id",
+		"slug",
+		"title",
+		"content",
+		"published",
+		"rating",
+		"hits",
+		"published_at",
+		"updated_at",
+	}
+	params, values := m.batchValues(objs, true)
+	updates := []string{
+		"author_id = EXCLUDED.author_id",
+		"slug = EXCLUDED.slug",
+		"title = EXCLUDED.title",
+		"content = EXCLUDED.content",
+		"published = EXCLUDED.published",
+		"rating = EXCLUDED.rating",
+		"hits = EXCLUDED.hits",
+		"published_at = EXCLUDED.published_at",
+		"updated_at = EXCLUDED.updated_at",
+	}
+	action := "UPDATE SET " + strings.Join(updates, ",")
+	//! the rows written are returned, the stale ones are not
+	q := fmt.Sprintf("INSERT INTO articles(%s) VALUES %s ON CONFLICT (id) DO %s RETURNING %s",
+		strings.Join(columns, ","),
+		params,
+		action,
+		strings.Join(columns, ","))
+	rows, err := m.db.QueryContext(orm.WithPrimary(ctx), q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	//! the statement may only fail once its rows are read
+	if _, err := m.scan(rows); err != nil {
+		return nil, nil, orm.TranslateError(err)
+	}
+	return objs, nil, nil
+}
+
+func (m *_ArticleDBMgr) Delete(obj *Article) (int64, error) {
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_ArticleDBMgr) DeleteCtx(ctx context.Context, obj *Article) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.Id)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
+}
+
+func (m *_ArticleDBMgr) DeleteByPrimaryKey(id int64) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_ArticleDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, id int64) (int64, error) {
+	pk := &IdOfArticlePK{
+		Id: id,
+	}
+	q := fmt.Sprintf("DELETE FROM articles %s", pk.SQLFormat())
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_ArticleDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_ArticleDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM articles")
+	if where != "" {
+		query = fmt.Sprintf("DELETE FROM articles WHERE %s", where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
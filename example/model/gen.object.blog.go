@@ -1,35 +1,42 @@
 package model
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
 	"gopkg.in/go-playground/validator.v9"
 	elastic "gopkg.in/olivere/elastic.v2"
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
 	_ orm.VSet
 	_ validator.Validate
+	_ sqlbuilder.Builder
 )
 
 type Blog struct {
-	Id        int32     `db:"id" json:"id"`
-	UserId    int32     `db:"user_id" json:"user_id"`
-	Title     string    `db:"title" json:"title"`
-	Content   string    `db:"content" json:"content"`
-	Status    int32     `db:"status" json:"status"`
-	Readed    int32     `db:"readed" json:"readed"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	Id        int32      `db:"id" json:"id"`
+	UserId    int32      `db:"user_id" json:"user_id"`
+	Title     string     `db:"title" json:"title"`
+	Content   string     `db:"content" json:"content"`
+	Status    BlogStatus `db:"status" json:"status"`
+	Readed    int32      `db:"readed" json:"readed"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at" json:"updated_at"`
+	dirty     orm.Dirty
 }
 
 var BlogColumns = struct {
@@ -52,6 +59,91 @@ var BlogColumns = struct {
 	"updated_at",
 }
 
+// BlogStatus is the enum of Blog.Status, stored as its value string.
+type BlogStatus int32
+
+const (
+	BlogStatusDraft BlogStatus = iota
+	BlogStatusPublished
+	BlogStatusArchived
+)
+
+var _BlogStatusValues = []string{
+	"draft",
+	"published",
+	"archived",
+}
+
+func ParseBlogStatus(s string) (BlogStatus, error) {
+	for i, value := range _BlogStatusValues {
+		if value == s {
+			return BlogStatus(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid BlogStatus value: %s", s)
+}
+
+func (e BlogStatus) IsValid() bool {
+	return e >= 0 && int(e) < len(_BlogStatusValues)
+}
+
+func (e BlogStatus) String() string {
+	if !e.IsValid() {
+		return fmt.Sprintf("BlogStatus(%d)", int32(e))
+	}
+	return _BlogStatusValues[e]
+}
+
+func (e BlogStatus) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid BlogStatus: %d", int32(e))
+	}
+	return []byte(_BlogStatusValues[e]), nil
+}
+
+func (e *BlogStatus) UnmarshalText(b []byte) error {
+	v, err := ParseBlogStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+func (e BlogStatus) MarshalJSON() ([]byte, error) {
+	b, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+func (e *BlogStatus) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+func (e BlogStatus) Value() (driver.Value, error) {
+	b, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (e *BlogStatus) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	}
+	return fmt.Errorf("can't scan %T into BlogStatus", src)
+}
+
 type _BlogMgr struct {
 }
 
@@ -189,7 +281,7 @@ func (u *IdUserIdOfBlogPK) Columns() []string {
 //! indexes
 
 type StatusOfBlogIDX struct {
-	Status int32
+	Status BlogStatus
 	offset int
 	limit  int
 }
@@ -382,7 +474,8 @@ func (u *IdUserIdOfBlogRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
 }
 
 type _BlogDBMgr struct {
-	db orm.DB
+	db   orm.DB
+	from string
 }
 
 func (m *_BlogMgr) DB(db orm.DB) *_BlogDBMgr {
@@ -393,41 +486,67 @@ func BlogDBMgr(db orm.DB) *_BlogDBMgr {
 	if db == nil {
 		panic(fmt.Errorf("BlogDBMgr init need db"))
 	}
-	return &_BlogDBMgr{db: db}
+	return &_BlogDBMgr{db: db, from: "blogs"}
 }
 
 func (m *_BlogDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*Blog, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_BlogDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*Blog, error) {
 	obj := BlogMgr.NewBlog()
 	conditions := []string{where, orderby, limit}
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), strings.Join(conditions, " "))
-	return m.FetchBySQL(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
 }
 
 func (m *_BlogDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Blog, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_BlogDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Blog, error) {
 	obj := BlogMgr.NewBlog()
-	q := fmt.Sprintf("SELECT %s FROM blogs %s %s %s",
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
 		strings.Join(obj.GetColumns(), ","),
+		m.from,
 		orm.SQLWhere(conditions),
 		orderby,
 		orm.SQLOffsetLimit(offset, limit))
 
-	return m.FetchBySQL(q, args...)
+	return m.FetchBySQLCtx(ctx, q, args...)
 }
 
 func (m *_BlogDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
-	return m.queryCount(where, args...)
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_BlogDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
 }
 
 func (m *_BlogDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
-	return m.queryCount(orm.SQLWhere(conditions), args...)
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_BlogDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
 }
 
 func (m *_BlogDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Blog, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_BlogDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*Blog, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("Blog fetch error: %v", err)
+		return nil, fmt.Errorf("Blog fetch error: %w", err)
 	}
 	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_BlogDBMgr) scan(rows *sql.Rows) (results []*Blog, err error) {
 
 	var CreatedAt string
 	var UpdatedAt string
@@ -447,12 +566,16 @@ func (m *_BlogDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Blog,
 	}
 	if err = rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("Blog fetch result error: %v", err)
+		return nil, fmt.Errorf("Blog fetch result error: %w", err)
 	}
 	return
 }
 func (m *_BlogDBMgr) Exist(pk PrimaryKey) (bool, error) {
-	c, err := m.queryCount(pk.SQLFormat(), pk.SQLParams()...)
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_BlogDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
 	if err != nil {
 		return false, err
 	}
@@ -461,40 +584,52 @@ func (m *_BlogDBMgr) Exist(pk PrimaryKey) (bool, error) {
 
 // Deprecated: Use FetchByPrimaryKey instead.
 func (m *_BlogDBMgr) Fetch(pk PrimaryKey) (*Blog, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_BlogDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Blog, error) {
 	obj := BlogMgr.NewBlog()
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("Blog fetch record not found")
+	return nil, &orm.NotFoundError{Object: "Blog", Key: pk.Key()}
 }
 
 // primary key
 func (m *_BlogDBMgr) FetchByPrimaryKey(id int32, userId int32) (*Blog, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id, userId)
+}
+
+func (m *_BlogDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int32, userId int32) (*Blog, error) {
 	obj := BlogMgr.NewBlog()
 	pk := &IdUserIdOfBlogPK{
 		Id:     id,
 		UserId: userId,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("Blog fetch record not found")
+	return nil, &orm.NotFoundError{Object: "Blog", Key: pk.Key()}
 }
 
 // indexes
 
-func (m *_BlogDBMgr) FindByStatus(status int32, limit int, offset int) ([]*Blog, error) {
+func (m *_BlogDBMgr) FindByStatus(status BlogStatus, limit int, offset int) ([]*Blog, error) {
+	return m.FindByStatusCtx(context.Background(), status, limit, offset)
+}
+
+func (m *_BlogDBMgr) FindByStatusCtx(ctx context.Context, status BlogStatus, limit int, offset int) ([]*Blog, error) {
 	obj := BlogMgr.NewBlog()
 	idx := &StatusOfBlogIDX{
 		Status: status,
@@ -502,21 +637,29 @@ func (m *_BlogDBMgr) FindByStatus(status int32, limit int, offset int) ([]*Blog,
 		offset: offset,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), idx.SQLFormat(true))
-	return m.FetchBySQL(query, idx.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
 }
 
-func (m *_BlogDBMgr) FindAllByStatus(status int32) ([]*Blog, error) {
+func (m *_BlogDBMgr) FindAllByStatus(status BlogStatus) ([]*Blog, error) {
+	return m.FindAllByStatusCtx(context.Background(), status)
+}
+
+func (m *_BlogDBMgr) FindAllByStatusCtx(ctx context.Context, status BlogStatus) ([]*Blog, error) {
 	obj := BlogMgr.NewBlog()
 	idx := &StatusOfBlogIDX{
 		Status: status,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), idx.SQLFormat(true))
-	return m.FetchBySQL(query, idx.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_BlogDBMgr) FindByStatusGroup(items []BlogStatus) ([]*Blog, error) {
+	return m.FindByStatusGroupCtx(context.Background(), items)
 }
 
-func (m *_BlogDBMgr) FindByStatusGroup(items []int32) ([]*Blog, error) {
+func (m *_BlogDBMgr) FindByStatusGroupCtx(ctx context.Context, items []BlogStatus) ([]*Blog, error) {
 	obj := BlogMgr.NewBlog()
 	if len(items) == 0 {
 		return nil, nil
@@ -525,57 +668,73 @@ func (m *_BlogDBMgr) FindByStatusGroup(items []int32) ([]*Blog, error) {
 	for _, item := range items {
 		params = append(params, item)
 	}
-	query := fmt.Sprintf("SELECT %s FROM blogs where `status` in (?", strings.Join(obj.GetColumns(), ",")) +
+	query := fmt.Sprintf("SELECT %s FROM %s where `status` in (?", strings.Join(obj.GetColumns(), ","), m.from) +
 		strings.Repeat(",?", len(items)-1) + ")"
-	return m.FetchBySQL(query, params...)
+	return m.FetchBySQLCtx(ctx, query, params...)
 }
 
 // uniques
 
 func (m *_BlogDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
-	objs, err := m.queryLimit(unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_BlogDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("Blog find record not found")
+	return nil, &orm.NotFoundError{Object: "Blog", Key: unique.Key()}
 }
 
 // Deprecated: Use FetchByXXXUnique instead.
 func (m *_BlogDBMgr) FindOneFetch(unique Unique) (*Blog, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_BlogDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Blog, error) {
 	obj := BlogMgr.NewBlog()
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), unique.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, unique.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("none record")
+	return nil, &orm.NotFoundError{Object: "Blog", Key: unique.Key()}
 }
 
 // Deprecated: Use FindByXXXUnique instead.
 func (m *_BlogDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_BlogDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_BlogDBMgr) FindFetch(index Index) (int64, []*Blog, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_BlogDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Blog, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 
 	obj := BlogMgr.NewBlog()
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), index.SQLFormat(true))
-	results, err := m.FetchBySQL(query, index.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -583,22 +742,30 @@ func (m *_BlogDBMgr) FindFetch(index Index) (int64, []*Blog, error) {
 }
 
 func (m *_BlogDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_BlogDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_BlogDBMgr) RangeFetch(scope Range) (int64, []*Blog, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_BlogDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Blog, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 	obj := BlogMgr.NewBlog()
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(obj.GetColumns(), ","), scope.SQLFormat(true))
-	results, err := m.FetchBySQL(query, scope.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -606,21 +773,29 @@ func (m *_BlogDBMgr) RangeFetch(scope Range) (int64, []*Blog, error) {
 }
 
 func (m *_BlogDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_BlogDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
 	scope.Revert(true)
-	return m.Range(scope)
+	return m.RangeCtx(ctx, scope)
 }
 
 func (m *_BlogDBMgr) RangeRevertFetch(scope Range) (int64, []*Blog, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_BlogDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Blog, error) {
 	scope.Revert(true)
-	return m.RangeFetch(scope)
+	return m.RangeFetchCtx(ctx, scope)
 }
 
-func (m *_BlogDBMgr) queryLimit(where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+func (m *_BlogDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
 	pk := BlogMgr.NewPrimaryKey()
-	query := fmt.Sprintf("SELECT %s FROM blogs %s", strings.Join(pk.Columns(), ","), where)
-	rows, err := m.db.Query(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("Blog query limit error: %v", err)
+		return nil, fmt.Errorf("Blog query limit error: %w", err)
 	}
 	defer rows.Close()
 
@@ -643,16 +818,16 @@ func (m *_BlogDBMgr) queryLimit(where string, limit int, args ...interface{}) (r
 	}
 	if err := rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("Blog query limit result error: %v", err)
+		return nil, fmt.Errorf("Blog query limit result error: %w", err)
 	}
 	return
 }
 
-func (m *_BlogDBMgr) queryCount(where string, args ...interface{}) (int64, error) {
-	query := fmt.Sprintf("SELECT count(`id`) FROM blogs %s", where)
-	rows, err := m.db.Query(query, args...)
+func (m *_BlogDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(`id`) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("Blog query count error: %v", err)
+		return 0, fmt.Errorf("Blog query count error: %w", err)
 	}
 	defer rows.Close()
 
@@ -667,15 +842,110 @@ func (m *_BlogDBMgr) queryCount(where string, args ...interface{}) (int64, error
 	return count, nil
 }
 
+func (obj *Blog) SetTitle(val string) *Blog {
+	obj.Title = val
+	obj.dirty.Mark(BlogColumns.Title)
+	return obj
+}
+
+func (obj *Blog) SetContent(val string) *Blog {
+	obj.Content = val
+	obj.dirty.Mark(BlogColumns.Content)
+	return obj
+}
+
+func (obj *Blog) SetStatus(val BlogStatus) *Blog {
+	obj.Status = val
+	obj.dirty.Mark(BlogColumns.Status)
+	return obj
+}
+
+func (obj *Blog) SetReaded(val int32) *Blog {
+	obj.Readed = val
+	obj.dirty.Mark(BlogColumns.Readed)
+	return obj
+}
+
+func (obj *Blog) SetCreatedAt(val time.Time) *Blog {
+	obj.CreatedAt = val
+	obj.dirty.Mark(BlogColumns.CreatedAt)
+	return obj
+}
+
+func (obj *Blog) SetUpdatedAt(val time.Time) *Blog {
+	obj.UpdatedAt = val
+	obj.dirty.Mark(BlogColumns.UpdatedAt)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *Blog) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
 func (m *_BlogDBMgr) BatchCreate(objs []*Blog) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_BlogDBMgr) BatchCreateCtx(ctx context.Context, objs []*Blog) (int64, error) {
 	if len(objs) == 0 {
 		return 0, nil
 	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO blogs(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_BlogDBMgr) beforeWrite(obj *Blog, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_BlogDBMgr) hook(obj *Blog, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
 
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_BlogDBMgr) batchValues(objs []*Blog, withIncrement bool) (string, []interface{}) {
+	size := 8
+	if withIncrement {
+		size = 8
+	}
 	params := make([]string, 0, len(objs))
-	values := make([]interface{}, 0, len(objs)*8)
+	values := make([]interface{}, 0, len(objs)*size)
 	for _, obj := range objs {
-		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(8, "?"), ",")))
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
 		values = append(values, obj.Id)
 		values = append(values, obj.UserId)
 		values = append(values, obj.Title)
@@ -685,12 +955,7 @@ func (m *_BlogDBMgr) BatchCreate(objs []*Blog) (int64, error) {
 		values = append(values, orm.TimeFormat(obj.CreatedAt))
 		values = append(values, orm.TimeFormat(obj.UpdatedAt))
 	}
-	query := fmt.Sprintf("INSERT INTO blogs(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), strings.Join(params, ","))
-	result, err := m.db.Exec(query, values...)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
+	return strings.Join(params, ","), values
 }
 
 // argument example:
@@ -698,11 +963,15 @@ func (m *_BlogDBMgr) BatchCreate(objs []*Blog) (int64, error) {
 // where:"c=? and d=?"
 // params:[]interface{}{"a", "b", "c", "d"}...
 func (m *_BlogDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_BlogDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("UPDATE blogs SET %s", set)
 	if where != "" {
 		query = fmt.Sprintf("UPDATE blogs SET %s WHERE %s", set, where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -710,6 +979,22 @@ func (m *_BlogDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64,
 }
 
 func (m *_BlogDBMgr) Create(obj *Blog) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_BlogDBMgr) CreateCtx(ctx context.Context, obj *Blog) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_BlogDBMgr) create(ctx context.Context, obj *Blog) (int64, error) {
 	params := orm.NewStringSlice(8, "?")
 	q := fmt.Sprintf("INSERT INTO blogs(%s) VALUES(%s)",
 		strings.Join(obj.GetNoneIncrementColumns(), ","),
@@ -724,7 +1009,7 @@ func (m *_BlogDBMgr) Create(obj *Blog) (int64, error) {
 	values = append(values, obj.Readed)
 	values = append(values, orm.TimeFormat(obj.CreatedAt))
 	values = append(values, orm.TimeFormat(obj.UpdatedAt))
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
@@ -732,55 +1017,279 @@ func (m *_BlogDBMgr) Create(obj *Blog) (int64, error) {
 }
 
 func (m *_BlogDBMgr) Update(obj *Blog) (int64, error) {
-	columns := []string{
-		"`title` = ?",
-		"`content` = ?",
-		"`status` = ?",
-		"`readed` = ?",
-		"`created_at` = ?",
-		"`updated_at` = ?",
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_BlogDBMgr) UpdateCtx(ctx context.Context, obj *Blog) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_BlogDBMgr) UpdateAll(obj *Blog) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_BlogDBMgr) UpdateAllCtx(ctx context.Context, obj *Blog) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		BlogColumns.Title,
+		BlogColumns.Content,
+		BlogColumns.Status,
+		BlogColumns.Readed,
+		BlogColumns.CreatedAt,
+		BlogColumns.UpdatedAt,
+	)
+}
+
+func (m *_BlogDBMgr) UpdateFields(obj *Blog, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of BlogColumns.
+func (m *_BlogDBMgr) UpdateFieldsCtx(ctx context.Context, obj *Blog, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_BlogDBMgr) updateFields(ctx context.Context, obj *Blog, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "title":
+			set.Add(column, obj.Title)
+		case "content":
+			set.Add(column, obj.Content)
+		case "status":
+			set.Add(column, obj.Status)
+		case "readed":
+			set.Add(column, obj.Readed)
+		case "created_at":
+			set.Add(column, orm.TimeFormat(obj.CreatedAt))
+		case "updated_at":
+			set.Add(column, orm.TimeFormat(obj.UpdatedAt))
+		default:
+			return 0, fmt.Errorf("Blog has no column %s to update", column)
+		}
+	}
+	sets, values, err := sqlbuilder.MySQL.BuildArgs(set)
+	if err != nil {
+		return 0, err
 	}
 
 	pk := obj.GetPrimaryKey()
-	q := fmt.Sprintf("UPDATE blogs SET %s %s", strings.Join(columns, ","), pk.SQLFormat())
-	values := make([]interface{}, 0, 8-2)
-	values = append(values, obj.Title)
-	values = append(values, obj.Content)
-	values = append(values, obj.Status)
-	values = append(values, obj.Readed)
-	values = append(values, orm.TimeFormat(obj.CreatedAt))
-	values = append(values, orm.TimeFormat(obj.UpdatedAt))
+	q := fmt.Sprintf("UPDATE blogs SET %s %s", sets, pk.SQLFormat())
 	values = append(values, pk.SQLParams()...)
 
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
+	obj.dirty.Clean(columns...)
 	return result.RowsAffected()
 }
 
 func (m *_BlogDBMgr) Save(obj *Blog) (int64, error) {
-	affected, err := m.Update(obj)
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+func (m *_BlogDBMgr) SaveCtx(ctx context.Context, obj *Blog) (int64, error) {
+	affected, failed, err := m.save(ctx, []*Blog{obj})
 	if err != nil {
 		return affected, err
 	}
-	if affected == 0 {
-		return m.Create(obj)
+	if len(failed) > 0 {
+		return 0, failed[0]
 	}
-	return affected, err
+	return affected, nil
+}
+
+func (m *_BlogDBMgr) BatchUpsert(objs []*Blog) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_BlogDBMgr) BatchUpsertCtx(ctx context.Context, objs []*Blog) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+	upserts := objs
+
+	var affected int64
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_BlogDBMgr) save(ctx context.Context, objs []*Blog) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+func (m *_BlogDBMgr) upsert(ctx context.Context, objs []*Blog) ([]*Blog, orm.MultiError, error) {
+	columns := []string{
+		"`id`",
+		"`user_id`",
+		"`title`",
+		"`content`",
+		"`status`",
+		"`readed`",
+		"`created_at`",
+		"`updated_at`",
+	}
+	params, values := m.batchValues(objs, true)
+	//! ON DUPLICATE KEY fires on any unique key, the columns are only assigned
+	//! when the duplicate is the row of the primary key
+	guard := "`id` = VALUES(`id`) AND `user_id` = VALUES(`user_id`)"
+	updates := make([]string, 0, 8)
+	for _, column := range []string{
+		"`title`",
+		"`content`",
+		"`status`",
+		"`readed`",
+		"`created_at`",
+		"`updated_at`",
+	} {
+		updates = append(updates, fmt.Sprintf("%s = IF(%s, VALUES(%s), %s)", column, guard, column, column))
+	}
+	q := fmt.Sprintf("INSERT INTO blogs(%s) VALUES %s ON DUPLICATE KEY UPDATE %s",
+		strings.Join(columns, ","),
+		params,
+		strings.Join(updates, ","))
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return nil, nil, err
+	}
+	//! mysql counts 1 for each inserted row, 2 for each updated row and 0 for
+	//! each row left as it was, the rows are read back unless the count tells
+	//! that every obj was written
+	if n == 2*int64(len(objs)) || (n == 1 && len(objs) == 1) {
+		return objs, nil, nil
+	}
+	stored, err := m.stored(ctx, objs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var failed orm.MultiError
+	written := make([]*Blog, 0, len(objs))
+	for _, obj := range objs {
+		pk := obj.GetPrimaryKey()
+		row := stored[pk.Key()]
+		if row == nil {
+			//! neither inserted nor the row of its primary key, a unique key
+			//! of obj is taken by another row
+			failed = append(failed, &orm.DuplicateKeyError{Err: fmt.Errorf("Blog %s has the unique key of another row", pk.Key())})
+			continue
+		}
+		written = append(written, obj)
+	}
+	return written, failed, nil
+}
+
+// stored reads the rows of the primary keys of objs from the primary, the
+// soft deleted ones included, by the keys of their primary keys.
+func (m *_BlogDBMgr) stored(ctx context.Context, objs []*Blog) (map[string]*Blog, error) {
+	conditions := make([]string, 0, len(objs))
+	params := make([]interface{}, 0, len(objs)*2)
+	for _, obj := range objs {
+		conditions = append(conditions, "(`id` = ? AND `user_id` = ?)")
+		params = append(params, obj.GetPrimaryKey().SQLParams()...)
+	}
+	query := fmt.Sprintf("SELECT %s FROM blogs WHERE %s", strings.Join(objs[0].GetColumns(), ","), strings.Join(conditions, " OR "))
+	rows, err := m.FetchBySQLCtx(orm.WithPrimary(ctx), query, params...)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*Blog, len(rows))
+	for _, row := range rows {
+		stored[row.GetPrimaryKey().Key()] = row
+	}
+	return stored, nil
 }
 
 func (m *_BlogDBMgr) Delete(obj *Blog) (int64, error) {
-	return m.DeleteByPrimaryKey(obj.Id, obj.UserId)
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_BlogDBMgr) DeleteCtx(ctx context.Context, obj *Blog) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.Id, obj.UserId)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
 }
 
 func (m *_BlogDBMgr) DeleteByPrimaryKey(id int32, userId int32) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), id, userId)
+}
+
+func (m *_BlogDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, id int32, userId int32) (int64, error) {
 	pk := &IdUserIdOfBlogPK{
 		Id:     id,
 		UserId: userId,
 	}
 	q := fmt.Sprintf("DELETE FROM blogs %s", pk.SQLFormat())
-	result, err := m.db.Exec(q, pk.SQLParams()...)
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
 	if err != nil {
 		return 0, err
 	}
@@ -788,11 +1297,14 @@ func (m *_BlogDBMgr) DeleteByPrimaryKey(id int32, userId int32) (int64, error) {
 }
 
 func (m *_BlogDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_BlogDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("DELETE FROM blogs")
 	if where != "" {
 		query = fmt.Sprintf("DELETE FROM blogs WHERE %s", where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -847,3 +1359,78 @@ func (m *_BlogElasticMgr) IndexService() (*elastic.IndexService, error) {
 func (m *_BlogElasticMgr) PutMappingService() *elastic.PutMappingService {
 	return ElasticClient().PutMappingService("ezorm ").Type("blogs")
 }
+
+// Index writes obj as the document of its primary key.
+func (m *_BlogElasticMgr) Index(obj *Blog) error {
+	service, err := m.IndexService()
+	if err != nil {
+		return err
+	}
+	_, err = service.Id(obj.GetPrimaryKey().Key()).BodyJson(obj).Do()
+	return err
+}
+
+// Fetch reads the document of pk, a missing one is an orm.NotFoundError.
+func (m *_BlogElasticMgr) Fetch(pk PrimaryKey) (*Blog, error) {
+	result, err := ElasticClient().GetService("ezorm").Type("blogs").Id(pk.Key()).Do()
+	if elasticNotFound(err) || (err == nil && (!result.Found || result.Source == nil)) {
+		return nil, &orm.NotFoundError{Object: "Blog", Key: pk.Key()}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	obj := BlogMgr.NewBlog()
+	if err := json.Unmarshal(*result.Source, obj); err != nil {
+		return nil, fmt.Errorf("key:%v,err:%w", pk.Key(), err)
+	}
+	return obj, nil
+}
+
+// Delete removes the document of pk, a missing one is an orm.NotFoundError.
+func (m *_BlogElasticMgr) Delete(pk PrimaryKey) error {
+	result, err := ElasticClient().DeleteService("ezorm").Type("blogs").Id(pk.Key()).Do()
+	if elasticNotFound(err) || (err == nil && !result.Found) {
+		return &orm.NotFoundError{Object: "Blog", Key: pk.Key()}
+	}
+	return err
+}
+
+// BulkIndex writes objs in one bulk request, the documents failing are
+// returned as an orm.MultiError.
+func (m *_BlogElasticMgr) BulkIndex(objs []*Blog) error {
+	if len(objs) == 0 {
+		return nil
+	}
+	if _, err := m.IndexService(); err != nil {
+		return err
+	}
+
+	bulk := ElasticClient().BulkService("ezorm").Type("blogs")
+	for _, obj := range objs {
+		bulk.Add(elastic.NewBulkIndexRequest().Id(obj.GetPrimaryKey().Key()).Doc(obj))
+	}
+	result, err := bulk.Do()
+	if err != nil {
+		return err
+	}
+	return elasticBulkError("Blog", result)
+}
+
+// BulkDelete removes the documents of pks in one bulk request, the missing
+// ones are orm.NotFoundErrors of the returned orm.MultiError.
+func (m *_BlogElasticMgr) BulkDelete(pks []PrimaryKey) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	bulk := ElasticClient().BulkService("ezorm").Type("blogs")
+	for _, pk := range pks {
+		bulk.Add(elastic.NewBulkDeleteRequest().Id(pk.Key()))
+	}
+	result, err := bulk.Do()
+	if err != nil {
+		return err
+	}
+	return elasticBulkError("Blog", result)
+}
@@ -0,0 +1,2381 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
+	"gopkg.in/go-playground/validator.v9"
+	redis "gopkg.in/redis.v5"
+)
+
+var (
+	_ context.Context
+	_ sql.DB
+	_ time.Time
+	_ fmt.Formatter
+	_ strings.Reader
+	_ orm.VSet
+	_ validator.Validate
+	_ sqlbuilder.Builder
+)
+
+type Comment struct {
+	Id        int64     `db:"id" json:"id"`
+	BlogId    int32     `db:"blog_id" json:"blog_id"`
+	UserId    int32     `db:"user_id" json:"user_id"`
+	Content   string    `db:"content" json:"content"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	dirty     orm.Dirty
+}
+
+var CommentColumns = struct {
+	Id        string
+	BlogId    string
+	UserId    string
+	Content   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	"id",
+	"blog_id",
+	"user_id",
+	"content",
+	"created_at",
+	"updated_at",
+}
+
+type _CommentMgr struct {
+}
+
+var CommentMgr *_CommentMgr
+
+func (m *_CommentMgr) NewComment() *Comment {
+	return &Comment{}
+}
+
+//! object function
+
+func (obj *Comment) GetNameSpace() string {
+	return "model"
+}
+
+func (obj *Comment) GetClassName() string {
+	return "Comment"
+}
+
+func (obj *Comment) GetTableName() string {
+	return "comments"
+}
+
+func (obj *Comment) GetColumns() []string {
+	columns := []string{
+		"comments.`id`",
+		"comments.`blog_id`",
+		"comments.`user_id`",
+		"comments.`content`",
+		"comments.`created_at`",
+		"comments.`updated_at`",
+	}
+	return columns
+}
+
+func (obj *Comment) GetNoneIncrementColumns() []string {
+	columns := []string{
+		"`blog_id`",
+		"`user_id`",
+		"`content`",
+		"`created_at`",
+		"`updated_at`",
+	}
+	return columns
+}
+
+func (obj *Comment) GetPrimaryKey() PrimaryKey {
+	pk := CommentMgr.NewPrimaryKey()
+	pk.Id = obj.Id
+	return pk
+}
+
+func (obj *Comment) Validate() error {
+	validate := validator.New()
+	return validate.Struct(obj)
+}
+
+// touch stamps the autoupdatetime fields, and the autocreatetime fields
+// still unset when create is set.
+func (obj *Comment) touch(now time.Time, create bool) {
+	if create && obj.CreatedAt.IsZero() {
+		obj.CreatedAt = now
+	}
+	obj.UpdatedAt = now
+}
+func (obj *Comment) GetIndexes() []string {
+	idx := []string{
+		"BlogId",
+	}
+	return idx
+}
+
+func (obj *Comment) GetStoreType() string {
+	return "hash"
+}
+
+func (obj *Comment) GetPrimaryName() string {
+	pk := obj.GetPrimaryKey()
+	return pk.Key()
+}
+
+//! primary key
+
+type IdOfCommentPK struct {
+	Id int64
+}
+
+func (m *_CommentMgr) NewPrimaryKey() *IdOfCommentPK {
+	return &IdOfCommentPK{}
+}
+
+func (u *IdOfCommentPK) Key() string {
+	strs := []string{
+		"Id",
+		fmt.Sprint(u.Id),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfCommentPK) Parse(key string) error {
+	arr := strings.Split(key, ":")
+	if len(arr)%2 != 0 {
+		return fmt.Errorf("key (%s) format error", key)
+	}
+	kv := map[string]string{}
+	for i := 0; i < len(arr)/2; i++ {
+		kv[arr[2*i]] = arr[2*i+1]
+	}
+	vId, ok := kv["Id"]
+	if !ok {
+		return fmt.Errorf("key (%s) without (Id) field", key)
+	}
+	if err := orm.StringScan(vId, &(u.Id)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *IdOfCommentPK) SQLFormat() string {
+	conditions := []string{
+		"`id` = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *IdOfCommentPK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Id,
+	}
+}
+
+func (u *IdOfCommentPK) Columns() []string {
+	return []string{
+		"`id`",
+	}
+}
+
+//! uniques
+
+//! indexes
+
+type BlogIdOfCommentIDX struct {
+	BlogId int32
+	offset int
+	limit  int
+}
+
+func (u *BlogIdOfCommentIDX) Key() string {
+	strs := []string{
+		"BlogId",
+		fmt.Sprint(u.BlogId),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *BlogIdOfCommentIDX) SQLFormat(limit bool) string {
+	conditions := []string{
+		"`blog_id` = ?",
+	}
+	if limit {
+		return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *BlogIdOfCommentIDX) SQLParams() []interface{} {
+	return []interface{}{
+		u.BlogId,
+	}
+}
+
+func (u *BlogIdOfCommentIDX) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *BlogIdOfCommentIDX) Limit(n int) {
+	u.limit = n
+}
+
+func (u *BlogIdOfCommentIDX) Offset(n int) {
+	u.offset = n
+}
+
+func (u *BlogIdOfCommentIDX) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *BlogIdOfCommentIDX) IDXRelation(store *orm.RedisStore) IndexRelation {
+	return BlogIdOfCommentIDXRelationRedisMgr(store)
+}
+
+//! ranges
+
+type IdOfCommentRNG struct {
+	IdBegin      int64
+	IdEnd        int64
+	offset       int
+	limit        int
+	includeBegin bool
+	includeEnd   bool
+	revert       bool
+}
+
+func (u *IdOfCommentRNG) Key() string {
+	strs := []string{
+		"Id",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfCommentRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *IdOfCommentRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *IdOfCommentRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("`id` %s ?", u.beginOp()))
+		}
+		if u.IdEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("`id` %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("`id`", u.revert), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("`id`", u.revert))
+}
+
+func (u *IdOfCommentRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			params = append(params, u.IdBegin)
+		}
+		if u.IdEnd != -1 {
+			params = append(params, u.IdEnd)
+		}
+	}
+	return params
+}
+
+func (u *IdOfCommentRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *IdOfCommentRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *IdOfCommentRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *IdOfCommentRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *IdOfCommentRNG) Begin() int64 {
+	start := u.IdBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *IdOfCommentRNG) End() int64 {
+	stop := u.IdEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *IdOfCommentRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *IdOfCommentRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *IdOfCommentRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *IdOfCommentRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return IdOfCommentRNGRelationRedisMgr(store)
+}
+
+type _CommentDBMgr struct {
+	db   orm.DB
+	from string
+}
+
+func (m *_CommentMgr) DB(db orm.DB) *_CommentDBMgr {
+	return CommentDBMgr(db)
+}
+
+func CommentDBMgr(db orm.DB) *_CommentDBMgr {
+	if db == nil {
+		panic(fmt.Errorf("CommentDBMgr init need db"))
+	}
+	return &_CommentDBMgr{db: db, from: "comments"}
+}
+
+func (m *_CommentDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*Comment, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_CommentDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*Comment, error) {
+	obj := CommentMgr.NewComment()
+	conditions := []string{where, orderby, limit}
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
+}
+
+func (m *_CommentDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Comment, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_CommentDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Comment, error) {
+	obj := CommentMgr.NewComment()
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
+		strings.Join(obj.GetColumns(), ","),
+		m.from,
+		orm.SQLWhere(conditions),
+		orderby,
+		orm.SQLOffsetLimit(offset, limit))
+
+	return m.FetchBySQLCtx(ctx, q, args...)
+}
+
+func (m *_CommentDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_CommentDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
+}
+
+func (m *_CommentDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_CommentDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
+}
+
+func (m *_CommentDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Comment, err error) {
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_CommentDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*Comment, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Comment fetch error: %w", err)
+	}
+	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_CommentDBMgr) scan(rows *sql.Rows) (results []*Comment, err error) {
+
+	var CreatedAt int64
+	var UpdatedAt string
+
+	for rows.Next() {
+		var result Comment
+		err = rows.Scan(&(result.Id), &(result.BlogId), &(result.UserId), &(result.Content), &CreatedAt, &UpdatedAt)
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		result.CreatedAt = time.Unix(CreatedAt, 0)
+		result.UpdatedAt = orm.TimeParseLocalTime(UpdatedAt)
+
+		results = append(results, &result)
+	}
+	if err = rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Comment fetch result error: %w", err)
+	}
+	return
+}
+func (m *_CommentDBMgr) Exist(pk PrimaryKey) (bool, error) {
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_CommentDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
+	if err != nil {
+		return false, err
+	}
+	return (c != 0), nil
+}
+
+// Deprecated: Use FetchByPrimaryKey instead.
+func (m *_CommentDBMgr) Fetch(pk PrimaryKey) (*Comment, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_CommentDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Comment, error) {
+	obj := CommentMgr.NewComment()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Comment", Key: pk.Key()}
+}
+
+// primary key
+func (m *_CommentDBMgr) FetchByPrimaryKey(id int64) (*Comment, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_CommentDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int64) (*Comment, error) {
+	obj := CommentMgr.NewComment()
+	pk := &IdOfCommentPK{
+		Id: id,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Comment", Key: pk.Key()}
+}
+
+func (m *_CommentDBMgr) FetchByPrimaryKeys(ids []int64) ([]*Comment, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), ids)
+}
+
+func (m *_CommentDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, ids []int64) ([]*Comment, error) {
+	size := len(ids)
+	if size == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, size)
+	for _, pk := range ids {
+		params = append(params, pk)
+	}
+	obj := CommentMgr.NewComment()
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `id` IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
+		strings.Repeat(",?", size-1))
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// indexes
+
+func (m *_CommentDBMgr) FindByBlogId(blogId int32, limit int, offset int) ([]*Comment, error) {
+	return m.FindByBlogIdCtx(context.Background(), blogId, limit, offset)
+}
+
+func (m *_CommentDBMgr) FindByBlogIdCtx(ctx context.Context, blogId int32, limit int, offset int) ([]*Comment, error) {
+	obj := CommentMgr.NewComment()
+	idx := &BlogIdOfCommentIDX{
+		BlogId: blogId,
+		limit:  limit,
+		offset: offset,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_CommentDBMgr) FindAllByBlogId(blogId int32) ([]*Comment, error) {
+	return m.FindAllByBlogIdCtx(context.Background(), blogId)
+}
+
+func (m *_CommentDBMgr) FindAllByBlogIdCtx(ctx context.Context, blogId int32) ([]*Comment, error) {
+	obj := CommentMgr.NewComment()
+	idx := &BlogIdOfCommentIDX{
+		BlogId: blogId,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_CommentDBMgr) FindByBlogIdGroup(items []int32) ([]*Comment, error) {
+	return m.FindByBlogIdGroupCtx(context.Background(), items)
+}
+
+func (m *_CommentDBMgr) FindByBlogIdGroupCtx(ctx context.Context, items []int32) ([]*Comment, error) {
+	obj := CommentMgr.NewComment()
+	if len(items) == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		params = append(params, item)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s where `blog_id` in (?", strings.Join(obj.GetColumns(), ","), m.from) +
+		strings.Repeat(",?", len(items)-1) + ")"
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// uniques
+
+func (m *_CommentDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_CommentDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Comment", Key: unique.Key()}
+}
+
+// Deprecated: Use FetchByXXXUnique instead.
+func (m *_CommentDBMgr) FindOneFetch(unique Unique) (*Comment, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_CommentDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Comment, error) {
+	obj := CommentMgr.NewComment()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Comment", Key: unique.Key()}
+}
+
+// Deprecated: Use FindByXXXUnique instead.
+func (m *_CommentDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_CommentDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_CommentDBMgr) FindFetch(index Index) (int64, []*Comment, error) {
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_CommentDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Comment, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+
+	obj := CommentMgr.NewComment()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_CommentDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_CommentDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_CommentDBMgr) RangeFetch(scope Range) (int64, []*Comment, error) {
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_CommentDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Comment, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	obj := CommentMgr.NewComment()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_CommentDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_CommentDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	scope.Revert(true)
+	return m.RangeCtx(ctx, scope)
+}
+
+func (m *_CommentDBMgr) RangeRevertFetch(scope Range) (int64, []*Comment, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_CommentDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Comment, error) {
+	scope.Revert(true)
+	return m.RangeFetchCtx(ctx, scope)
+}
+
+func (m *_CommentDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+	pk := CommentMgr.NewPrimaryKey()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Comment query limit error: %w", err)
+	}
+	defer rows.Close()
+
+	offset := 0
+
+	for rows.Next() {
+		if limit >= 0 && offset >= limit {
+			break
+		}
+		offset++
+
+		result := CommentMgr.NewPrimaryKey()
+		err = rows.Scan(&(result.Id))
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Comment query limit result error: %w", err)
+	}
+	return
+}
+
+func (m *_CommentDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(`id`) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("Comment query count error: %w", err)
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		if err = rows.Scan(&count); err != nil {
+			m.db.SetError(err)
+			return 0, err
+		}
+		break
+	}
+	return count, nil
+}
+
+func (obj *Comment) SetBlogId(val int32) *Comment {
+	obj.BlogId = val
+	obj.dirty.Mark(CommentColumns.BlogId)
+	return obj
+}
+
+func (obj *Comment) SetUserId(val int32) *Comment {
+	obj.UserId = val
+	obj.dirty.Mark(CommentColumns.UserId)
+	return obj
+}
+
+func (obj *Comment) SetContent(val string) *Comment {
+	obj.Content = val
+	obj.dirty.Mark(CommentColumns.Content)
+	return obj
+}
+
+func (obj *Comment) SetCreatedAt(val time.Time) *Comment {
+	obj.CreatedAt = val
+	obj.dirty.Mark(CommentColumns.CreatedAt)
+	return obj
+}
+
+func (obj *Comment) SetUpdatedAt(val time.Time) *Comment {
+	obj.UpdatedAt = val
+	obj.dirty.Mark(CommentColumns.UpdatedAt)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *Comment) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
+func (m *_CommentDBMgr) BatchCreate(objs []*Comment) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_CommentDBMgr) BatchCreateCtx(ctx context.Context, objs []*Comment) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+	now := orm.Now()
+	for _, obj := range objs {
+		obj.touch(now, true)
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO comments(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_CommentDBMgr) beforeWrite(obj *Comment, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_CommentDBMgr) hook(obj *Comment, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_CommentDBMgr) batchValues(objs []*Comment, withIncrement bool) (string, []interface{}) {
+	size := 5
+	if withIncrement {
+		size = 6
+	}
+	params := make([]string, 0, len(objs))
+	values := make([]interface{}, 0, len(objs)*size)
+	for _, obj := range objs {
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
+		if withIncrement {
+			values = append(values, obj.Id)
+		}
+		values = append(values, obj.BlogId)
+		values = append(values, obj.UserId)
+		values = append(values, obj.Content)
+		values = append(values, obj.CreatedAt.Unix())
+		values = append(values, orm.TimeToLocalTime(obj.UpdatedAt))
+	}
+	return strings.Join(params, ","), values
+}
+
+// argument example:
+// set:"a=?, b=?"
+// where:"c=? and d=?"
+// params:[]interface{}{"a", "b", "c", "d"}...
+func (m *_CommentDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_CommentDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("UPDATE comments SET %s", set)
+	if where != "" {
+		query = fmt.Sprintf("UPDATE comments SET %s WHERE %s", set, where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_CommentDBMgr) Create(obj *Comment) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_CommentDBMgr) CreateCtx(ctx context.Context, obj *Comment) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_CommentDBMgr) create(ctx context.Context, obj *Comment) (int64, error) {
+	obj.touch(orm.Now(), true)
+	params := orm.NewStringSlice(5, "?")
+	q := fmt.Sprintf("INSERT INTO comments(%s) VALUES(%s)",
+		strings.Join(obj.GetNoneIncrementColumns(), ","),
+		strings.Join(params, ","))
+
+	values := make([]interface{}, 0, 6)
+	values = append(values, obj.BlogId)
+	values = append(values, obj.UserId)
+	values = append(values, obj.Content)
+	values = append(values, obj.CreatedAt.Unix())
+	values = append(values, orm.TimeToLocalTime(obj.UpdatedAt))
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	lastInsertId, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	obj.Id = int64(lastInsertId)
+	return result.RowsAffected()
+}
+
+func (m *_CommentDBMgr) Update(obj *Comment) (int64, error) {
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_CommentDBMgr) UpdateCtx(ctx context.Context, obj *Comment) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_CommentDBMgr) UpdateAll(obj *Comment) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_CommentDBMgr) UpdateAllCtx(ctx context.Context, obj *Comment) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		CommentColumns.BlogId,
+		CommentColumns.UserId,
+		CommentColumns.Content,
+	)
+}
+
+func (m *_CommentDBMgr) UpdateFields(obj *Comment, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of CommentColumns.
+func (m *_CommentDBMgr) UpdateFieldsCtx(ctx context.Context, obj *Comment, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_CommentDBMgr) updateFields(ctx context.Context, obj *Comment, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "blog_id":
+			set.Add(column, obj.BlogId)
+		case "user_id":
+			set.Add(column, obj.UserId)
+		case "content":
+			set.Add(column, obj.Content)
+		case "created_at":
+			set.Add(column, obj.CreatedAt.Unix())
+		case "updated_at":
+			//! stamped by each update
+		default:
+			return 0, fmt.Errorf("Comment has no column %s to update", column)
+		}
+	}
+	obj.touch(orm.Now(), false)
+	set.Add("updated_at", orm.TimeToLocalTime(obj.UpdatedAt))
+	sets, values, err := sqlbuilder.MySQL.BuildArgs(set)
+	if err != nil {
+		return 0, err
+	}
+
+	pk := obj.GetPrimaryKey()
+	q := fmt.Sprintf("UPDATE comments SET %s %s", sets, pk.SQLFormat())
+	values = append(values, pk.SQLParams()...)
+
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Clean(columns...)
+	return result.RowsAffected()
+}
+
+func (m *_CommentDBMgr) Save(obj *Comment) (int64, error) {
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+// The creation time of obj is the stored one.
+func (m *_CommentDBMgr) SaveCtx(ctx context.Context, obj *Comment) (int64, error) {
+	if obj.Id == 0 {
+		return m.CreateCtx(ctx, obj)
+	}
+	affected, failed, err := m.save(ctx, []*Comment{obj})
+	if err != nil {
+		return affected, err
+	}
+	if len(failed) > 0 {
+		return 0, failed[0]
+	}
+	return affected, nil
+}
+
+func (m *_CommentDBMgr) BatchUpsert(objs []*Comment) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_CommentDBMgr) BatchUpsertCtx(ctx context.Context, objs []*Comment) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*Comment, 0, len(objs))
+	upserts := make([]*Comment, 0, len(objs))
+	for _, obj := range objs {
+		if obj.Id == 0 {
+			creates = append(creates, obj)
+		} else {
+			upserts = append(upserts, obj)
+		}
+	}
+
+	var affected int64
+	if len(creates) > 0 {
+		n, err := m.BatchCreateCtx(ctx, creates)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_CommentDBMgr) save(ctx context.Context, objs []*Comment) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+// The creation times of objs are the stored ones, the update times are
+// stamped.
+func (m *_CommentDBMgr) upsert(ctx context.Context, objs []*Comment) ([]*Comment, orm.MultiError, error) {
+	columns := []string{
+		"`id`",
+		"`blog_id`",
+		"`user_id`",
+		"`content`",
+		"`created_at`",
+		"`updated_at`",
+	}
+	now := orm.Now()
+	for _, obj := range objs {
+		obj.touch(now, true)
+	}
+	params, values := m.batchValues(objs, true)
+	//! ON DUPLICATE KEY fires on any unique key, the columns are only assigned
+	//! when the duplicate is the row of the primary key
+	guard := "`id` = VALUES(`id`)"
+	updates := make([]string, 0, 6)
+	for _, column := range []string{
+		"`blog_id`",
+		"`user_id`",
+		"`content`",
+		"`updated_at`",
+	} {
+		updates = append(updates, fmt.Sprintf("%s = IF(%s, VALUES(%s), %s)", column, guard, column, column))
+	}
+	q := fmt.Sprintf("INSERT INTO comments(%s) VALUES %s ON DUPLICATE KEY UPDATE %s",
+		strings.Join(columns, ","),
+		params,
+		strings.Join(updates, ","))
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return nil, nil, err
+	}
+	//! mysql counts 1 for each inserted row, 2 for each updated row and 0 for
+	//! each row left as it was, the rows are read back unless the count tells
+	//! that every obj was written
+	if n == 1 && len(objs) == 1 {
+		return objs, nil, nil
+	}
+	stored, err := m.stored(ctx, objs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var failed orm.MultiError
+	written := make([]*Comment, 0, len(objs))
+	for _, obj := range objs {
+		pk := obj.GetPrimaryKey()
+		row := stored[pk.Key()]
+		if row == nil {
+			//! neither inserted nor the row of its primary key, a unique key
+			//! of obj is taken by another row
+			failed = append(failed, &orm.DuplicateKeyError{Err: fmt.Errorf("Comment %s has the unique key of another row", pk.Key())})
+			continue
+		}
+		obj.CreatedAt = row.CreatedAt
+		written = append(written, obj)
+	}
+	return written, failed, nil
+}
+
+// stored reads the rows of the primary keys of objs from the primary, the
+// soft deleted ones included, by the keys of their primary keys.
+func (m *_CommentDBMgr) stored(ctx context.Context, objs []*Comment) (map[string]*Comment, error) {
+	conditions := make([]string, 0, len(objs))
+	params := make([]interface{}, 0, len(objs)*1)
+	for _, obj := range objs {
+		conditions = append(conditions, "(`id` = ?)")
+		params = append(params, obj.GetPrimaryKey().SQLParams()...)
+	}
+	query := fmt.Sprintf("SELECT %s FROM comments WHERE %s", strings.Join(objs[0].GetColumns(), ","), strings.Join(conditions, " OR "))
+	rows, err := m.FetchBySQLCtx(orm.WithPrimary(ctx), query, params...)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*Comment, len(rows))
+	for _, row := range rows {
+		stored[row.GetPrimaryKey().Key()] = row
+	}
+	return stored, nil
+}
+
+func (m *_CommentDBMgr) Delete(obj *Comment) (int64, error) {
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_CommentDBMgr) DeleteCtx(ctx context.Context, obj *Comment) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.Id)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
+}
+
+func (m *_CommentDBMgr) DeleteByPrimaryKey(id int64) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_CommentDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, id int64) (int64, error) {
+	pk := &IdOfCommentPK{
+		Id: id,
+	}
+	q := fmt.Sprintf("DELETE FROM comments %s", pk.SQLFormat())
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_CommentDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_CommentDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM comments")
+	if where != "" {
+		query = fmt.Sprintf("DELETE FROM comments WHERE %s", where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+type _CommentRedisMgr struct {
+	*orm.RedisStore
+}
+
+func (m *_CommentMgr) Redis(store *orm.RedisStore) *_CommentRedisMgr {
+	return CommentRedisMgr(store)
+}
+
+func CommentRedisMgr(store *orm.RedisStore) *_CommentRedisMgr {
+	if store == nil {
+		panic(fmt.Errorf("CommentRedisMgr init need redis store"))
+	}
+	return &_CommentRedisMgr{RedisStore: store}
+}
+
+func (m *_CommentRedisMgr) WithContext(ctx context.Context) *_CommentRedisMgr {
+	return &_CommentRedisMgr{RedisStore: m.RedisStore.WithContext(ctx)}
+}
+
+//! pipeline
+type _CommentRedisPipeline struct {
+	*redis.Pipeline
+	Err error
+	ctx context.Context
+}
+
+func (m *_CommentRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_CommentRedisPipeline {
+	if len(pipes) > 0 {
+		return &_CommentRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_CommentRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_CommentRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
+	}
+	return pipe.Pipeline.Exec()
+}
+
+func (m *_CommentRedisMgr) Load(db *_CommentDBMgr) error {
+	if err := m.Clear(); err != nil {
+		return err
+	}
+
+	return m.AddBySQL(db, "SELECT `id`,`blog_id`,`user_id`,`content`,`created_at`,`updated_at` FROM comments")
+
+}
+
+func (m *_CommentRedisMgr) AddBySQL(db *_CommentDBMgr, sql string, args ...interface{}) error {
+	objs, err := db.FetchBySQL(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return m.SaveBatch(objs)
+}
+func (m *_CommentRedisMgr) DelBySQL(db *_CommentDBMgr, sql string, args ...interface{}) error {
+	objs, err := db.FetchBySQL(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objs {
+		if err := m.Delete(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//! redis model read
+func (m *_CommentRedisMgr) FindOne(unique Unique) (PrimaryKey, error) {
+	if relation := unique.UKRelation(m.RedisStore); relation != nil {
+		str, err := relation.FindOne(unique.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		pk := CommentMgr.NewPrimaryKey()
+		if err := pk.Parse(str); err != nil {
+			return nil, err
+		}
+		return pk, nil
+	}
+	return nil, fmt.Errorf("unique none relation.")
+}
+
+func (m *_CommentRedisMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	return m.WithContext(ctx).FindOne(unique)
+}
+
+func (m *_CommentRedisMgr) FindOneFetch(unique Unique) (*Comment, error) {
+	v, err := m.FindOne(unique)
+	if err != nil {
+		return nil, err
+	}
+	return m.Fetch(v)
+}
+
+func (m *_CommentRedisMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Comment, error) {
+	return m.WithContext(ctx).FindOneFetch(unique)
+}
+
+func (m *_CommentRedisMgr) Find(index Index) (int64, []PrimaryKey, error) {
+	if relation := index.IDXRelation(m.RedisStore); relation != nil {
+		strs, err := relation.Find(index.Key())
+		if err != nil {
+			return 0, nil, err
+		}
+		total := int64(len(strs))
+		p1, p2 := index.PositionOffsetLimit(len(strs))
+		strs = strs[p1:p2]
+
+		results := make([]PrimaryKey, 0, len(strs))
+		for _, str := range strs {
+			pk := CommentMgr.NewPrimaryKey()
+			if err := pk.Parse(str); err != nil {
+				total--
+				continue
+			}
+			results = append(results, pk)
+		}
+		return total, results, nil
+	}
+	return 0, nil, fmt.Errorf("index none relation.")
+}
+
+func (m *_CommentRedisMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	return m.WithContext(ctx).Find(index)
+}
+
+func (m *_CommentRedisMgr) FindFetch(index Index) (int64, []*Comment, error) {
+	total, vs, err := m.Find(index)
+	if err != nil {
+		return 0, nil, err
+	}
+	objs, err := m.FetchByPrimaryKeys(vs)
+	return total, objs, err
+}
+
+func (m *_CommentRedisMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Comment, error) {
+	return m.WithContext(ctx).FindFetch(index)
+}
+
+func (m *_CommentRedisMgr) Range(scope Range) (int64, []PrimaryKey, error) {
+	if relation := scope.RNGRelation(m.RedisStore); relation != nil {
+		strs, err := relation.Range(scope.Key(), scope.Begin(), scope.End())
+		if err != nil {
+			return 0, nil, err
+		}
+		total := int64(len(strs))
+		p1, p2 := scope.PositionOffsetLimit(len(strs))
+		strs = strs[p1:p2]
+
+		results := make([]PrimaryKey, 0, len(strs))
+		for _, str := range strs {
+			pk := CommentMgr.NewPrimaryKey()
+			if err := pk.Parse(str); err != nil {
+				total--
+				continue
+			}
+			results = append(results, pk)
+		}
+		return total, results, nil
+	}
+	return 0, nil, fmt.Errorf("range none relation.")
+}
+
+func (m *_CommentRedisMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	return m.WithContext(ctx).Range(scope)
+}
+
+func (m *_CommentRedisMgr) RangeFetch(scope Range) (int64, []*Comment, error) {
+	total, vs, err := m.Range(scope)
+	if err != nil {
+		return 0, nil, err
+	}
+	objs, err := m.FetchByPrimaryKeys(vs)
+	return total, objs, err
+}
+
+func (m *_CommentRedisMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Comment, error) {
+	return m.WithContext(ctx).RangeFetch(scope)
+}
+
+func (m *_CommentRedisMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	if relation := scope.RNGRelation(m.RedisStore); relation != nil {
+		scope.Revert(true)
+		strs, err := relation.RangeRevert(scope.Key(), scope.Begin(), scope.End())
+		if err != nil {
+			return 0, nil, err
+		}
+
+		total := int64(len(strs))
+		p1, p2 := scope.PositionOffsetLimit(len(strs))
+		strs = strs[p1:p2]
+
+		results := make([]PrimaryKey, 0, len(strs))
+		for _, str := range strs {
+			pk := CommentMgr.NewPrimaryKey()
+			if err := pk.Parse(str); err != nil {
+				total--
+				continue
+			}
+			results = append(results, pk)
+		}
+		return total, results, nil
+	}
+	return 0, nil, fmt.Errorf("revert range none relation.")
+}
+
+func (m *_CommentRedisMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	return m.WithContext(ctx).RangeRevert(scope)
+}
+
+func (m *_CommentRedisMgr) RangeRevertFetch(scope Range) (int64, []*Comment, error) {
+	total, vs, err := m.RangeRevert(scope)
+	if err != nil {
+		return 0, nil, err
+	}
+	objs, err := m.FetchByPrimaryKeys(vs)
+	return total, objs, err
+}
+
+func (m *_CommentRedisMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Comment, error) {
+	return m.WithContext(ctx).RangeRevertFetch(scope)
+}
+
+func (m *_CommentRedisMgr) Fetch(pk PrimaryKey) (*Comment, error) {
+	obj := CommentMgr.NewComment()
+
+	pipe := m.BeginPipeline()
+	pipe.Exists(keyOfObject(obj, pk.Key()))
+	pipe.HMGet(keyOfObject(obj, pk.Key()),
+		"Id",
+		"BlogId",
+		"UserId",
+		"Content",
+		"CreatedAt",
+		"UpdatedAt")
+	cmds, err := pipe.Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	if b, err := cmds[0].(*redis.BoolCmd).Result(); err == nil {
+		if !b {
+			return nil, &orm.NotFoundError{Object: "Comment", Key: pk.Key()}
+		}
+	}
+
+	strs, err := cmds[1].(*redis.SliceCmd).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	if strs[0] == nil {
+		missing = append(missing, "Id")
+	} else {
+		if err := orm.StringScan(strs[0].(string), &obj.Id); err != nil {
+			return nil, err
+		}
+	}
+	if strs[1] == nil {
+		missing = append(missing, "BlogId")
+	} else {
+		if err := orm.StringScan(strs[1].(string), &obj.BlogId); err != nil {
+			return nil, err
+		}
+	}
+	if strs[2] == nil {
+		missing = append(missing, "UserId")
+	} else {
+		if err := orm.StringScan(strs[2].(string), &obj.UserId); err != nil {
+			return nil, err
+		}
+	}
+	if strs[3] == nil {
+		missing = append(missing, "Content")
+	} else {
+		if err := orm.StringScan(strs[3].(string), &obj.Content); err != nil {
+			return nil, err
+		}
+	}
+	if strs[4] == nil {
+		missing = append(missing, "CreatedAt")
+	} else {
+		var val4 int64
+		if err := orm.StringScan(strs[4].(string), &val4); err != nil {
+			return nil, err
+		}
+		obj.CreatedAt = time.Unix(val4, 0)
+	}
+	if strs[5] == nil {
+		missing = append(missing, "UpdatedAt")
+	} else {
+		var val5 string
+		if err := orm.StringScan(strs[5].(string), &val5); err != nil {
+			return nil, err
+		}
+		obj.UpdatedAt = orm.TimeParseLocalTime(val5)
+	}
+	if m.ReportSchemaDrift("Comment", pk.Key(), missing) {
+		//! best effort, a failed upgrade is left to the next read
+		pipe := m.BeginPipeline()
+		if err := m.upgrade(pipe, keyOfObject(obj, pk.Key()), obj, missing); err == nil {
+			if _, err := pipe.Exec(); orm.IsNoScript(err) {
+				m.LoadScripts()
+			}
+		}
+	}
+	return obj, nil
+}
+
+func (m *_CommentRedisMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Comment, error) {
+	return m.WithContext(ctx).Fetch(pk)
+}
+
+func (m *_CommentRedisMgr) FetchByPrimaryKeys(pks []PrimaryKey) ([]*Comment, error) {
+	objs := make([]*Comment, 0, len(pks))
+	pipe := m.BeginPipeline()
+	obj := CommentMgr.NewComment()
+	for _, pk := range pks {
+		pipe.Exists(keyOfObject(obj, pk.Key()))
+		pipe.HMGet(keyOfObject(obj, pk.Key()),
+			"Id",
+			"BlogId",
+			"UserId",
+			"Content",
+			"CreatedAt",
+			"UpdatedAt")
+	}
+	cmds, err := pipe.Exec()
+	if err != nil {
+		return nil, err
+	}
+	var errall orm.MultiError
+	var upgrades *_CommentRedisPipeline
+	sv := ""
+	ok := true
+	for i := 0; i < len(pks); i++ {
+		if b, err := cmds[2*i].(*redis.BoolCmd).Result(); err == nil {
+			if !b {
+				errall = append(errall, &orm.NotFoundError{Object: "Comment", Key: pks[i].Key()})
+				continue
+			}
+		}
+
+		strs, err := cmds[2*i+1].(*redis.SliceCmd).Result()
+		if err != nil {
+			errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+			continue
+		}
+
+		obj := CommentMgr.NewComment()
+		var missing []string
+		if strs[0] == nil {
+			missing = append(missing, "Id")
+		} else {
+			sv, ok = strs[0].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[0]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Id); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+		}
+		if strs[1] == nil {
+			missing = append(missing, "BlogId")
+		} else {
+			sv, ok = strs[1].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[1]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.BlogId); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+		}
+		if strs[2] == nil {
+			missing = append(missing, "UserId")
+		} else {
+			sv, ok = strs[2].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[2]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.UserId); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+		}
+		if strs[3] == nil {
+			missing = append(missing, "Content")
+		} else {
+			sv, ok = strs[3].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[3]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Content); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+		}
+		if strs[4] == nil {
+			missing = append(missing, "CreatedAt")
+		} else {
+			var val4 int64
+			sv, ok = strs[4].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[4]))
+				continue
+			}
+			if err := orm.StringScan(sv, &val4); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+			obj.CreatedAt = time.Unix(val4, 0)
+		}
+		if strs[5] == nil {
+			missing = append(missing, "UpdatedAt")
+		} else {
+			var val5 string
+			sv, ok = strs[5].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[5]))
+				continue
+			}
+			if err := orm.StringScan(sv, &val5); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+			obj.UpdatedAt = orm.TimeParseLocalTime(val5)
+		}
+		if m.ReportSchemaDrift("Comment", pks[i].Key(), missing) {
+			if upgrades == nil {
+				upgrades = m.BeginPipeline()
+			}
+			m.upgrade(upgrades, keyOfObject(obj, pks[i].Key()), obj, missing)
+		}
+		objs = append(objs, obj)
+	}
+	if upgrades != nil {
+		//! best effort, a failed upgrade is left to the next read
+		if _, err := upgrades.Exec(); orm.IsNoScript(err) {
+			m.LoadScripts()
+		}
+	}
+	if len(errall) > 0 {
+		return objs, errall
+	}
+	return objs, nil
+}
+
+func (m *_CommentRedisMgr) FetchByPrimaryKeysCtx(ctx context.Context, pks []PrimaryKey) ([]*Comment, error) {
+	return m.WithContext(ctx).FetchByPrimaryKeys(pks)
+}
+
+func (m *_CommentRedisMgr) Create(obj *Comment) error {
+	return m.Save(obj)
+}
+
+func (m *_CommentRedisMgr) CreateCtx(ctx context.Context, obj *Comment) error {
+	return m.WithContext(ctx).Create(obj)
+}
+
+func (m *_CommentRedisMgr) Update(obj *Comment) error {
+	return m.Save(obj)
+}
+
+func (m *_CommentRedisMgr) UpdateCtx(ctx context.Context, obj *Comment) error {
+	return m.WithContext(ctx).Update(obj)
+}
+
+func (m *_CommentRedisMgr) CreateWithExpire(obj *Comment, expire time.Duration) error {
+	return m.SaveWithExpire(obj, expire)
+}
+
+func (m *_CommentRedisMgr) CreateWithExpireCtx(ctx context.Context, obj *Comment, expire time.Duration) error {
+	return m.WithContext(ctx).CreateWithExpire(obj, expire)
+}
+
+func (m *_CommentRedisMgr) UpdateWithExpire(obj *Comment, expire time.Duration) error {
+	return m.SaveWithExpire(obj, expire)
+}
+
+func (m *_CommentRedisMgr) UpdateWithExpireCtx(ctx context.Context, obj *Comment, expire time.Duration) error {
+	return m.WithContext(ctx).UpdateWithExpire(obj, expire)
+}
+
+// Delete removes obj with its index entries. BeforeDelete and AfterDelete
+// of obj are passed a nil orm.DB, redis has no database handle.
+func (m *_CommentRedisMgr) Delete(obj *Comment) error {
+	if err := orm.BeforeDelete(nil, obj); err != nil {
+		return err
+	}
+	pk := obj.GetPrimaryKey()
+	pipe := m.BeginPipeline()
+	if err := m.removeIndexes(pipe, obj); err != nil {
+		return err
+	}
+
+	if err := pipe.Del(keyOfObject(obj, pk.Key())).Err(); err != nil {
+		return err
+	}
+
+	if _, err := pipe.Exec(); err != nil {
+		return err
+	}
+	return orm.AfterDelete(nil, obj)
+}
+
+func (m *_CommentRedisMgr) DeleteCtx(ctx context.Context, obj *Comment) error {
+	return m.WithContext(ctx).Delete(obj)
+}
+
+func (m *_CommentRedisMgr) SaveBatch(objs []*Comment) error {
+	return m.SaveBatchWithExpire(objs, 0)
+}
+
+func (m *_CommentRedisMgr) SaveBatchCtx(ctx context.Context, objs []*Comment) error {
+	return m.WithContext(ctx).SaveBatch(objs)
+}
+
+// Save writes obj over its stored hash, Create and Update are the same
+// write. Only BeforeSave and AfterSave of obj run, as redis cannot tell a
+// create from an update, and they are passed a nil orm.DB.
+func (m *_CommentRedisMgr) Save(obj *Comment) error {
+	return m.SaveWithExpire(obj, 0)
+}
+
+func (m *_CommentRedisMgr) SaveCtx(ctx context.Context, obj *Comment) error {
+	return m.WithContext(ctx).Save(obj)
+}
+
+func (m *_CommentRedisMgr) SaveBatchWithExpire(objs []*Comment, expire time.Duration) error {
+	if len(objs) > 0 {
+		for _, obj := range objs {
+			if err := m.beforeSave(obj); err != nil {
+				return err
+			}
+		}
+		keys := make([]string, 0, len(objs))
+		for _, obj := range objs {
+			keys = append(keys, keyOfObject(obj, obj.GetPrimaryKey().Key()))
+		}
+		var prevs []*Comment
+		err := m.Transaction(keys, func(store redis.Cmdable) (err error) {
+			prevs, err = m.previous(store, objs)
+			return err
+		}, func(p *redis.Pipeline) (err error) {
+			pipe := m.BeginPipeline(p)
+			for i, obj := range objs {
+				if _, err = m.addToPipeline(pipe, prevs[i], obj, expire); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			if err := orm.AfterSave(nil, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *_CommentRedisMgr) SaveBatchWithExpireCtx(ctx context.Context, objs []*Comment, expire time.Duration) error {
+	return m.WithContext(ctx).SaveBatchWithExpire(objs, expire)
+}
+
+func (m *_CommentRedisMgr) SaveWithExpire(obj *Comment, expire time.Duration) error {
+	if obj != nil {
+		if err := m.beforeSave(obj); err != nil {
+			return err
+		}
+		objs := []*Comment{obj}
+		var prevs []*Comment
+		err := m.Transaction([]string{keyOfObject(obj, obj.GetPrimaryKey().Key())}, func(store redis.Cmdable) (err error) {
+			prevs, err = m.previous(store, objs)
+			return err
+		}, func(p *redis.Pipeline) error {
+			_, err := m.addToPipeline(m.BeginPipeline(p), prevs[0], obj, expire)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return orm.AfterSave(nil, obj)
+	}
+	return nil
+}
+
+func (m *_CommentRedisMgr) SaveWithExpireCtx(ctx context.Context, obj *Comment, expire time.Duration) error {
+	return m.WithContext(ctx).SaveWithExpire(obj, expire)
+}
+
+//! beforeSave runs once per save, before the writes which may be retried, the hook gets a nil db
+func (m *_CommentRedisMgr) beforeSave(obj *Comment) error {
+	if err := orm.BeforeSave(nil, obj); err != nil {
+		return err
+	}
+	return nil
+}
+
+//! addToPipeline queues the write of obj, prev is the stored obj read by previous, nil when unknown,
+//! it returns the command of the version script, nil without a version
+func (m *_CommentRedisMgr) addToPipeline(pipe *_CommentRedisPipeline, prev, obj *Comment, expire time.Duration) (*redis.Cmd, error) {
+	key := keyOfObject(obj, obj.GetPrimaryKey().Key())
+	if prev != nil {
+		if err := m.removeStaleIndexes(pipe, prev, obj); err != nil {
+			return nil, err
+		}
+	}
+	fields, err := m.hashFields(obj)
+	if err != nil {
+		return nil, err
+	}
+	//! fields
+	pipe.HMSet(key, fields)
+	if err := m.addIndexes(pipe, obj); err != nil {
+		return nil, err
+	}
+	if expire > 0 {
+		pipe.Expire(key, expire)
+	}
+	return nil, nil
+}
+
+//! hashFields returns the fields of the hash of obj with their values
+func (m *_CommentRedisMgr) hashFields(obj *Comment) (map[string]string, error) {
+	fields := make(map[string]string, 6)
+	fields["Id"] = fmt.Sprint(obj.Id)
+	fields["BlogId"] = fmt.Sprint(obj.BlogId)
+	fields["UserId"] = fmt.Sprint(obj.UserId)
+	fields["Content"] = fmt.Sprint(obj.Content)
+	fields["CreatedAt"] = fmt.Sprint(obj.CreatedAt.Unix())
+	fields["UpdatedAt"] = fmt.Sprint(orm.TimeToLocalTime(obj.UpdatedAt))
+	return fields, nil
+}
+
+//! previous reads the stored values of the fields of the index entries of objs, nil for the objects not stored yet
+func (m *_CommentRedisMgr) previous(store redis.Cmdable, objs []*Comment) ([]*Comment, error) {
+	pipe := store.Pipeline()
+	cmds := make([]*redis.SliceCmd, 0, len(objs))
+	for _, obj := range objs {
+		cmds = append(cmds, pipe.HMGet(keyOfObject(obj, obj.GetPrimaryKey().Key()),
+			"Id",
+			"BlogId"))
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, err
+	}
+
+	prevs := make([]*Comment, len(objs))
+	for i, cmd := range cmds {
+		strs := cmd.Val()
+		prev := CommentMgr.NewComment()
+		stored := false
+		if strs[0] != nil {
+			stored = true
+			if err := orm.StringScan(strs[0].(string), &prev.Id); err != nil {
+				return nil, err
+			}
+		}
+		if strs[1] != nil {
+			stored = true
+			if err := orm.StringScan(strs[1].(string), &prev.BlogId); err != nil {
+				return nil, err
+			}
+		}
+		if stored {
+			prevs[i] = prev
+		}
+	}
+	return prevs, nil
+}
+
+//! removeStaleIndexes queues the removal of the index entries of prev whose keys obj changes
+func (m *_CommentRedisMgr) removeStaleIndexes(pipe *_CommentRedisPipeline, prev, obj *Comment) error {
+	//! uniques
+
+	//! indexes
+	idx_prev_0 := strings.Join([]string{
+		"BlogId",
+		fmt.Sprint(prev.BlogId),
+	}, ":")
+	idx_key_0 := strings.Join([]string{
+		"BlogId",
+		fmt.Sprint(obj.BlogId),
+	}, ":")
+	if idx_prev_0 != idx_key_0 {
+		idx_pip_0 := BlogIdOfCommentIDXRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+		idx_rel_0 := BlogIdOfCommentIDXRelationRedisMgr().NewBlogIdOfCommentIDXRelation(idx_prev_0)
+		idx_rel_0.Value = obj.GetPrimaryKey().Key()
+		if err := idx_pip_0.SetRem(idx_rel_0); err != nil {
+			return err
+		}
+	}
+
+	//! ranges, a changed score alone is rewritten by the add
+	return nil
+}
+
+//! upgrade queues the write of the fields missing from the hash key with their values in obj
+func (m *_CommentRedisMgr) upgrade(pipe *_CommentRedisPipeline, key string, obj *Comment, missing []string) error {
+	fields, err := m.hashFields(obj)
+	if err != nil {
+		return err
+	}
+	pairs := make([]interface{}, 0, len(missing)*2)
+	for _, name := range missing {
+		pairs = append(pairs, name, fields[name])
+	}
+	orm.HSetMissing(pipe.Pipeline, key, pairs...)
+	return nil
+}
+
+func (m *_CommentRedisMgr) addIndexes(pipe *_CommentRedisPipeline, obj *Comment) error {
+	pk := obj.GetPrimaryKey()
+	//! uniques
+
+	//! indexes
+	idx_key_0 := []string{
+		"BlogId",
+		fmt.Sprint(obj.BlogId),
+	}
+	idx_pip_0 := BlogIdOfCommentIDXRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+	idx_rel_0 := BlogIdOfCommentIDXRelationRedisMgr().NewBlogIdOfCommentIDXRelation(strings.Join(idx_key_0, ":"))
+	idx_rel_0.Value = pk.Key()
+	if err := idx_pip_0.SetAdd(idx_rel_0); err != nil {
+		return err
+	}
+
+	//! ranges
+	rg_key_0 := []string{
+		"Id",
+	}
+	rg_pip_0 := IdOfCommentRNGRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+	rg_rel_0 := IdOfCommentRNGRelationRedisMgr().NewIdOfCommentRNGRelation(strings.Join(rg_key_0, ":"))
+	score_rg_0, err := orm.ToFloat64(obj.Id)
+	if err != nil {
+		return err
+	}
+	rg_rel_0.Score = score_rg_0
+	rg_rel_0.Value = pk.Key()
+	if err := rg_pip_0.ZSetAdd(rg_rel_0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *_CommentRedisMgr) removeIndexes(pipe *_CommentRedisPipeline, obj *Comment) error {
+	pk := obj.GetPrimaryKey()
+	//! uniques
+
+	//! indexes
+	idx_key_0 := []string{
+		"BlogId",
+		fmt.Sprint(obj.BlogId),
+	}
+	idx_pip_0 := BlogIdOfCommentIDXRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+	idx_rel_0 := BlogIdOfCommentIDXRelationRedisMgr().NewBlogIdOfCommentIDXRelation(strings.Join(idx_key_0, ":"))
+	idx_rel_0.Value = pk.Key()
+	if err := idx_pip_0.SetRem(idx_rel_0); err != nil {
+		return err
+	}
+
+	//! ranges
+	rg_key_0 := []string{
+		"Id",
+	}
+	rg_pip_0 := IdOfCommentRNGRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+	rg_rel_0 := IdOfCommentRNGRelationRedisMgr().NewIdOfCommentRNGRelation(strings.Join(rg_key_0, ":"))
+	score_rg_0, err := orm.ToFloat64(obj.Id)
+	if err != nil {
+		return err
+	}
+	rg_rel_0.Score = score_rg_0
+	rg_rel_0.Value = pk.Key()
+	if err := rg_pip_0.ZSetRem(rg_rel_0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *_CommentRedisMgr) Clear() error {
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+//! ClearWithProgress deletes the keys of the objects and their relations by SCAN, returning their count
+func (m *_CommentRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress,
+		pairOfClass("Comment", "*"),
+		hashOfClass("Comment", "object", "*"),
+		setOfClass("Comment", "*"),
+		zsetOfClass("Comment", "*"),
+		geoOfClass("Comment", "*"),
+		listOfClass("Comment", "*"),
+	)
+}
+
+func (m *_CommentRedisMgr) ClearWithProgressCtx(ctx context.Context, progress func(*orm.ClearProgress)) (int64, error) {
+	return m.WithContext(ctx).ClearWithProgress(progress)
+}
+
+func (m *_CommentRedisMgr) ClearCtx(ctx context.Context) error {
+	return m.WithContext(ctx).Clear()
+}
+
+//! uniques
+
+//! indexes
+
+//! relation
+type BlogIdOfCommentIDXRelation struct {
+	Key   string `db:"key" json:"key"`
+	Value string `db:"value" json:"value"`
+}
+
+func (relation *BlogIdOfCommentIDXRelation) GetClassName() string {
+	return "BlogIdOfCommentIDXRelation"
+}
+
+func (relation *BlogIdOfCommentIDXRelation) GetIndexes() []string {
+	idx := []string{}
+	return idx
+}
+
+func (relation *BlogIdOfCommentIDXRelation) GetStoreType() string {
+	return "set"
+}
+
+type _BlogIdOfCommentIDXRelationRedisMgr struct {
+	*orm.RedisStore
+}
+
+func BlogIdOfCommentIDXRelationRedisMgr(stores ...*orm.RedisStore) *_BlogIdOfCommentIDXRelationRedisMgr {
+	if len(stores) > 0 {
+		return &_BlogIdOfCommentIDXRelationRedisMgr{stores[0]}
+	}
+	return &_BlogIdOfCommentIDXRelationRedisMgr{_redis_store}
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) WithContext(ctx context.Context) *_BlogIdOfCommentIDXRelationRedisMgr {
+	return &_BlogIdOfCommentIDXRelationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) NewBlogIdOfCommentIDXRelation(key string) *BlogIdOfCommentIDXRelation {
+	return &BlogIdOfCommentIDXRelation{
+		Key: key,
+	}
+}
+
+//! pipeline
+type _BlogIdOfCommentIDXRelationRedisPipeline struct {
+	*redis.Pipeline
+	Err error
+	ctx context.Context
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_BlogIdOfCommentIDXRelationRedisPipeline {
+	if len(pipes) > 0 {
+		return &_BlogIdOfCommentIDXRelationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_BlogIdOfCommentIDXRelationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_BlogIdOfCommentIDXRelationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
+	}
+	return pipe.Pipeline.Exec()
+}
+
+//! redis relation pair
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) SetAdd(relation *BlogIdOfCommentIDXRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
+	return m.SAdd(setOfClass("Comment", "BlogIdOfCommentIDXRelation", relation.Key), relation.Value).Err()
+}
+
+func (pipe *_BlogIdOfCommentIDXRelationRedisPipeline) SetAdd(relation *BlogIdOfCommentIDXRelation) error {
+	return pipe.SAdd(setOfClass("Comment", "BlogIdOfCommentIDXRelation", relation.Key), relation.Value).Err()
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) SetGet(key string) ([]*BlogIdOfCommentIDXRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
+	strs, err := m.SMembers(setOfClass("Comment", "BlogIdOfCommentIDXRelation", key)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	relations := make([]*BlogIdOfCommentIDXRelation, 0, len(strs))
+	for _, str := range strs {
+		relation := m.NewBlogIdOfCommentIDXRelation(key)
+		if err := orm.StringScan(str, &relation.Value); err != nil {
+			return nil, err
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) SetRem(relation *BlogIdOfCommentIDXRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
+	return m.SRem(setOfClass("Comment", "BlogIdOfCommentIDXRelation", relation.Key), relation.Value).Err()
+}
+
+func (pipe *_BlogIdOfCommentIDXRelationRedisPipeline) SetRem(relation *BlogIdOfCommentIDXRelation) error {
+	return pipe.SRem(setOfClass("Comment", "BlogIdOfCommentIDXRelation", relation.Key), relation.Value).Err()
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) SetDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
+	return m.Del(setOfClass("Comment", "BlogIdOfCommentIDXRelation", key)).Err()
+}
+
+func (pipe *_BlogIdOfCommentIDXRelationRedisPipeline) SetDel(key string) error {
+	return pipe.Del(setOfClass("Comment", "BlogIdOfCommentIDXRelation", key)).Err()
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) Find(key string) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
+	return m.SMembers(setOfClass("Comment", "BlogIdOfCommentIDXRelation", key)).Result()
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) Clear() error {
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_BlogIdOfCommentIDXRelationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, setOfClass("Comment", "BlogIdOfCommentIDXRelation", "*"))
+}
+
+//! ranges
+
+//! relation
+type IdOfCommentRNGRelation struct {
+	Key   string  `db:"key" json:"key"`
+	Score float64 `db:"score" json:"score"`
+	Value string  `db:"value" json:"value"`
+}
+
+func (relation *IdOfCommentRNGRelation) GetClassName() string {
+	return "IdOfCommentRNGRelation"
+}
+
+func (relation *IdOfCommentRNGRelation) GetIndexes() []string {
+	idx := []string{}
+	return idx
+}
+
+func (relation *IdOfCommentRNGRelation) GetStoreType() string {
+	return "zset"
+}
+
+type _IdOfCommentRNGRelationRedisMgr struct {
+	*orm.RedisStore
+}
+
+func IdOfCommentRNGRelationRedisMgr(stores ...*orm.RedisStore) *_IdOfCommentRNGRelationRedisMgr {
+	if len(stores) > 0 {
+		return &_IdOfCommentRNGRelationRedisMgr{stores[0]}
+	}
+	return &_IdOfCommentRNGRelationRedisMgr{_redis_store}
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) WithContext(ctx context.Context) *_IdOfCommentRNGRelationRedisMgr {
+	return &_IdOfCommentRNGRelationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) NewIdOfCommentRNGRelation(key string) *IdOfCommentRNGRelation {
+	return &IdOfCommentRNGRelation{
+		Key: key,
+	}
+}
+
+//! pipeline
+type _IdOfCommentRNGRelationRedisPipeline struct {
+	*redis.Pipeline
+	Err error
+	ctx context.Context
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_IdOfCommentRNGRelationRedisPipeline {
+	if len(pipes) > 0 {
+		return &_IdOfCommentRNGRelationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_IdOfCommentRNGRelationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_IdOfCommentRNGRelationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
+	}
+	return pipe.Pipeline.Exec()
+}
+
+//! redis relation zset
+func (m *_IdOfCommentRNGRelationRedisMgr) ZSetAdd(relation *IdOfCommentRNGRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
+	return m.ZAdd(zsetOfClass("Comment", "IdOfCommentRNGRelation", relation.Key), redis.Z{Score: relation.Score, Member: relation.Value}).Err()
+}
+
+func (pipe *_IdOfCommentRNGRelationRedisPipeline) ZSetAdd(relation *IdOfCommentRNGRelation) error {
+	return pipe.ZAdd(zsetOfClass("Comment", "IdOfCommentRNGRelation", relation.Key), redis.Z{Score: relation.Score, Member: relation.Value}).Err()
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) ZSetRange(key string, min, max int64) ([]*IdOfCommentRNGRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
+	strs, err := m.ZRange(zsetOfClass("IdOfCommentRNGRelation", key), min, max).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	relations := make([]*IdOfCommentRNGRelation, 0, len(strs))
+	for _, str := range strs {
+		relation := m.NewIdOfCommentRNGRelation(key)
+		if err := orm.StringScan(str, &relation.Value); err != nil {
+			return nil, err
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) ZSetRevertRange(key string, min, max int64) ([]*IdOfCommentRNGRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
+	strs, err := m.ZRevRange(zsetOfClass("IdOfCommentRNGRelation", key), min, max).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	relations := make([]*IdOfCommentRNGRelation, 0, len(strs))
+	for _, str := range strs {
+		relation := m.NewIdOfCommentRNGRelation(key)
+		if err := orm.StringScan(str, &relation.Value); err != nil {
+			return nil, err
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) ZSetRem(relation *IdOfCommentRNGRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
+	return m.ZRem(zsetOfClass("Comment", "IdOfCommentRNGRelation", relation.Key), relation.Value).Err()
+}
+
+func (pipe *_IdOfCommentRNGRelationRedisPipeline) ZSetRem(relation *IdOfCommentRNGRelation) error {
+	return pipe.ZRem(zsetOfClass("Comment", "IdOfCommentRNGRelation", relation.Key), relation.Value).Err()
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) ZSetDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
+	return m.Del(setOfClass("Comment", "IdOfCommentRNGRelation", key)).Err()
+}
+
+func (pipe *_IdOfCommentRNGRelationRedisPipeline) ZSetDel(key string) error {
+	return pipe.Del(setOfClass("Comment", "IdOfCommentRNGRelation", key)).Err()
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) Range(key string, min, max int64) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
+	return m.ZRange(zsetOfClass("Comment", "IdOfCommentRNGRelation", key), min, max).Result()
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) RangeRevert(key string, min, max int64) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
+	return m.ZRevRange(zsetOfClass("Comment", "IdOfCommentRNGRelation", key), min, max).Result()
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) Clear() error {
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_IdOfCommentRNGRelationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, zsetOfClass("Comment", "IdOfCommentRNGRelation", "*"))
+}
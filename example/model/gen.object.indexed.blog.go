@@ -1,7 +1,9 @@
 package model
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
@@ -13,6 +15,7 @@ import (
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
@@ -353,3 +356,78 @@ func (m *_IndexedBlogElasticMgr) IndexService() (*elastic.IndexService, error) {
 func (m *_IndexedBlogElasticMgr) PutMappingService() *elastic.PutMappingService {
 	return ElasticClient().PutMappingService("ezsearch ").Type("indexed_blog")
 }
+
+// Index writes obj as the document of its primary key.
+func (m *_IndexedBlogElasticMgr) Index(obj *IndexedBlog) error {
+	service, err := m.IndexService()
+	if err != nil {
+		return err
+	}
+	_, err = service.Id(obj.GetPrimaryKey().Key()).BodyJson(obj).Do()
+	return err
+}
+
+// Fetch reads the document of pk, a missing one is an orm.NotFoundError.
+func (m *_IndexedBlogElasticMgr) Fetch(pk PrimaryKey) (*IndexedBlog, error) {
+	result, err := ElasticClient().GetService("ezsearch").Type("indexed_blog").Id(pk.Key()).Do()
+	if elasticNotFound(err) || (err == nil && (!result.Found || result.Source == nil)) {
+		return nil, &orm.NotFoundError{Object: "IndexedBlog", Key: pk.Key()}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	obj := IndexedBlogMgr.NewIndexedBlog()
+	if err := json.Unmarshal(*result.Source, obj); err != nil {
+		return nil, fmt.Errorf("key:%v,err:%w", pk.Key(), err)
+	}
+	return obj, nil
+}
+
+// Delete removes the document of pk, a missing one is an orm.NotFoundError.
+func (m *_IndexedBlogElasticMgr) Delete(pk PrimaryKey) error {
+	result, err := ElasticClient().DeleteService("ezsearch").Type("indexed_blog").Id(pk.Key()).Do()
+	if elasticNotFound(err) || (err == nil && !result.Found) {
+		return &orm.NotFoundError{Object: "IndexedBlog", Key: pk.Key()}
+	}
+	return err
+}
+
+// BulkIndex writes objs in one bulk request, the documents failing are
+// returned as an orm.MultiError.
+func (m *_IndexedBlogElasticMgr) BulkIndex(objs []*IndexedBlog) error {
+	if len(objs) == 0 {
+		return nil
+	}
+	if _, err := m.IndexService(); err != nil {
+		return err
+	}
+
+	bulk := ElasticClient().BulkService("ezsearch").Type("indexed_blog")
+	for _, obj := range objs {
+		bulk.Add(elastic.NewBulkIndexRequest().Id(obj.GetPrimaryKey().Key()).Doc(obj))
+	}
+	result, err := bulk.Do()
+	if err != nil {
+		return err
+	}
+	return elasticBulkError("IndexedBlog", result)
+}
+
+// BulkDelete removes the documents of pks in one bulk request, the missing
+// ones are orm.NotFoundErrors of the returned orm.MultiError.
+func (m *_IndexedBlogElasticMgr) BulkDelete(pks []PrimaryKey) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	bulk := ElasticClient().BulkService("ezsearch").Type("indexed_blog")
+	for _, pk := range pks {
+		bulk.Add(elastic.NewBulkDeleteRequest().Id(pk.Key()))
+	}
+	result, err := bulk.Do()
+	if err != nil {
+		return err
+	}
+	return elasticBulkError("IndexedBlog", result)
+}
@@ -0,0 +1,1690 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+var (
+	_ context.Context
+	_ sql.DB
+	_ time.Time
+	_ fmt.Formatter
+	_ strings.Reader
+	_ orm.VSet
+	_ validator.Validate
+	_ sqlbuilder.Builder
+)
+
+type Note struct {
+	Id          int64                  `db:"id"`
+	OwnerId     UserID                 `db:"owner_id"`
+	Slug        string                 `db:"slug" validate:"required"`
+	Content     string                 `db:"content"`
+	Color       string                 `db:"color"`
+	Stars       int32                  `db:"stars"`
+	Visibility  NoteVisibility         `db:"visibility"`
+	Meta        map[string]interface{} `db:"meta"`
+	Attachments []Attachment           `db:"attachments"`
+	DeletedAt   *time.Time             `db:"deleted_at"`
+	dirty       orm.Dirty
+}
+
+var NoteColumns = struct {
+	Id          string
+	OwnerId     string
+	Slug        string
+	Content     string
+	Color       string
+	Stars       string
+	Visibility  string
+	Meta        string
+	Attachments string
+	DeletedAt   string
+}{
+	"id",
+	"owner_id",
+	"slug",
+	"content",
+	"color",
+	"stars",
+	"visibility",
+	"meta",
+	"attachments",
+	"deleted_at",
+}
+
+// NoteVisibility is the enum of Note.Visibility, stored as its value string.
+type NoteVisibility int32
+
+const (
+	NoteVisibilityPrivate NoteVisibility = iota
+	NoteVisibilityShared
+	NoteVisibilityPublic
+)
+
+var _NoteVisibilityValues = []string{
+	"private",
+	"shared",
+	"public",
+}
+
+func ParseNoteVisibility(s string) (NoteVisibility, error) {
+	for i, value := range _NoteVisibilityValues {
+		if value == s {
+			return NoteVisibility(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid NoteVisibility value: %s", s)
+}
+
+func (e NoteVisibility) IsValid() bool {
+	return e >= 0 && int(e) < len(_NoteVisibilityValues)
+}
+
+func (e NoteVisibility) String() string {
+	if !e.IsValid() {
+		return fmt.Sprintf("NoteVisibility(%d)", int32(e))
+	}
+	return _NoteVisibilityValues[e]
+}
+
+func (e NoteVisibility) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid NoteVisibility: %d", int32(e))
+	}
+	return []byte(_NoteVisibilityValues[e]), nil
+}
+
+func (e *NoteVisibility) UnmarshalText(b []byte) error {
+	v, err := ParseNoteVisibility(string(b))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+func (e NoteVisibility) MarshalJSON() ([]byte, error) {
+	b, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+func (e *NoteVisibility) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+func (e NoteVisibility) Value() (driver.Value, error) {
+	b, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (e *NoteVisibility) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	}
+	return fmt.Errorf("can't scan %T into NoteVisibility", src)
+}
+
+type _NoteMgr struct {
+}
+
+var NoteMgr *_NoteMgr
+
+func (m *_NoteMgr) NewNote() *Note {
+	return &Note{
+		Color: "yellow",
+	}
+}
+
+//! object function
+
+func (obj *Note) GetNameSpace() string {
+	return "model"
+}
+
+func (obj *Note) GetClassName() string {
+	return "Note"
+}
+
+func (obj *Note) GetTableName() string {
+	return "notes"
+}
+
+func (obj *Note) GetColumns() []string {
+	columns := []string{
+		"notes.id",
+		"notes.owner_id",
+		"notes.slug",
+		"notes.content",
+		"notes.color",
+		"notes.stars",
+		"notes.visibility",
+		"notes.meta",
+		"notes.attachments",
+		"notes.deleted_at",
+	}
+	return columns
+}
+
+func (obj *Note) GetNoneIncrementColumns() []string {
+	columns := []string{
+		"owner_id",
+		"slug",
+		"content",
+		"color",
+		"stars",
+		"visibility",
+		"meta",
+		"attachments",
+		"deleted_at",
+	}
+	return columns
+}
+
+func (obj *Note) GetPrimaryKey() PrimaryKey {
+	pk := NoteMgr.NewPrimaryKey()
+	pk.Id = obj.Id
+	return pk
+}
+
+func (obj *Note) Validate() error {
+	validate := validator.New()
+	return validate.Struct(obj)
+}
+
+//! primary key
+
+type IdOfNotePK struct {
+	Id int64
+}
+
+func (m *_NoteMgr) NewPrimaryKey() *IdOfNotePK {
+	return &IdOfNotePK{}
+}
+
+func (u *IdOfNotePK) Key() string {
+	strs := []string{
+		"Id",
+		fmt.Sprint(u.Id),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfNotePK) Parse(key string) error {
+	arr := strings.Split(key, ":")
+	if len(arr)%2 != 0 {
+		return fmt.Errorf("key (%s) format error", key)
+	}
+	kv := map[string]string{}
+	for i := 0; i < len(arr)/2; i++ {
+		kv[arr[2*i]] = arr[2*i+1]
+	}
+	vId, ok := kv["Id"]
+	if !ok {
+		return fmt.Errorf("key (%s) without (Id) field", key)
+	}
+	if err := orm.StringScan(vId, &(u.Id)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *IdOfNotePK) SQLFormat() string {
+	conditions := []string{
+		"id = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *IdOfNotePK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Id,
+	}
+}
+
+func (u *IdOfNotePK) Columns() []string {
+	return []string{
+		"id",
+	}
+}
+
+//! uniques
+
+type SlugOfNoteUK struct {
+	Slug string
+}
+
+func (u *SlugOfNoteUK) Key() string {
+	strs := []string{
+		"Slug",
+		fmt.Sprint(u.Slug),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *SlugOfNoteUK) SQLFormat(limit bool) string {
+	conditions := []string{
+		"slug = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *SlugOfNoteUK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Slug,
+	}
+}
+
+func (u *SlugOfNoteUK) SQLLimit() int {
+	return 1
+}
+
+func (u *SlugOfNoteUK) Limit(n int) {
+}
+
+func (u *SlugOfNoteUK) Offset(n int) {
+}
+
+func (u *SlugOfNoteUK) UKRelation(store *orm.RedisStore) UniqueRelation {
+	return nil
+}
+
+//! indexes
+
+type VisibilityOfNoteIDX struct {
+	Visibility NoteVisibility
+	offset     int
+	limit      int
+}
+
+func (u *VisibilityOfNoteIDX) Key() string {
+	strs := []string{
+		"Visibility",
+		fmt.Sprint(u.Visibility),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *VisibilityOfNoteIDX) SQLFormat(limit bool) string {
+	conditions := []string{
+		"visibility = ?",
+	}
+	if limit {
+		return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *VisibilityOfNoteIDX) SQLParams() []interface{} {
+	return []interface{}{
+		u.Visibility,
+	}
+}
+
+func (u *VisibilityOfNoteIDX) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *VisibilityOfNoteIDX) Limit(n int) {
+	u.limit = n
+}
+
+func (u *VisibilityOfNoteIDX) Offset(n int) {
+	u.offset = n
+}
+
+func (u *VisibilityOfNoteIDX) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *VisibilityOfNoteIDX) IDXRelation(store *orm.RedisStore) IndexRelation {
+	return nil
+}
+
+type OwnerIdOfNoteIDX struct {
+	OwnerId UserID
+	offset  int
+	limit   int
+}
+
+func (u *OwnerIdOfNoteIDX) Key() string {
+	strs := []string{
+		"OwnerId",
+		orm.Sprint(u.OwnerId),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *OwnerIdOfNoteIDX) SQLFormat(limit bool) string {
+	conditions := []string{
+		"owner_id = ?",
+	}
+	if limit {
+		return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *OwnerIdOfNoteIDX) SQLParams() []interface{} {
+	return []interface{}{
+		u.OwnerId,
+	}
+}
+
+func (u *OwnerIdOfNoteIDX) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *OwnerIdOfNoteIDX) Limit(n int) {
+	u.limit = n
+}
+
+func (u *OwnerIdOfNoteIDX) Offset(n int) {
+	u.offset = n
+}
+
+func (u *OwnerIdOfNoteIDX) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *OwnerIdOfNoteIDX) IDXRelation(store *orm.RedisStore) IndexRelation {
+	return nil
+}
+
+//! ranges
+
+type StarsOfNoteRNG struct {
+	StarsBegin   int64
+	StarsEnd     int64
+	offset       int
+	limit        int
+	includeBegin bool
+	includeEnd   bool
+	revert       bool
+}
+
+func (u *StarsOfNoteRNG) Key() string {
+	strs := []string{
+		"Stars",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *StarsOfNoteRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *StarsOfNoteRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *StarsOfNoteRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.StarsBegin != u.StarsEnd {
+		if u.StarsBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("stars %s ?", u.beginOp()))
+		}
+		if u.StarsEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("stars %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("stars", u.revert), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("stars", u.revert))
+}
+
+func (u *StarsOfNoteRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.StarsBegin != u.StarsEnd {
+		if u.StarsBegin != -1 {
+			params = append(params, u.StarsBegin)
+		}
+		if u.StarsEnd != -1 {
+			params = append(params, u.StarsEnd)
+		}
+	}
+	return params
+}
+
+func (u *StarsOfNoteRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *StarsOfNoteRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *StarsOfNoteRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *StarsOfNoteRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *StarsOfNoteRNG) Begin() int64 {
+	start := u.StarsBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *StarsOfNoteRNG) End() int64 {
+	stop := u.StarsEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *StarsOfNoteRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *StarsOfNoteRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *StarsOfNoteRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *StarsOfNoteRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return nil
+}
+
+type IdOfNoteRNG struct {
+	IdBegin      int64
+	IdEnd        int64
+	offset       int
+	limit        int
+	includeBegin bool
+	includeEnd   bool
+	revert       bool
+}
+
+func (u *IdOfNoteRNG) Key() string {
+	strs := []string{
+		"Id",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfNoteRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *IdOfNoteRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *IdOfNoteRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("id %s ?", u.beginOp()))
+		}
+		if u.IdEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("id %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("id", u.revert), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("id", u.revert))
+}
+
+func (u *IdOfNoteRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			params = append(params, u.IdBegin)
+		}
+		if u.IdEnd != -1 {
+			params = append(params, u.IdEnd)
+		}
+	}
+	return params
+}
+
+func (u *IdOfNoteRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *IdOfNoteRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *IdOfNoteRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *IdOfNoteRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *IdOfNoteRNG) Begin() int64 {
+	start := u.IdBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *IdOfNoteRNG) End() int64 {
+	stop := u.IdEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *IdOfNoteRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *IdOfNoteRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *IdOfNoteRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *IdOfNoteRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return nil
+}
+
+type _NoteDBMgr struct {
+	db   orm.DB
+	from string
+}
+
+func (m *_NoteMgr) DB(db orm.DB) *_NoteDBMgr {
+	return NoteDBMgr(db)
+}
+
+func NoteDBMgr(db orm.DB) *_NoteDBMgr {
+	if db == nil {
+		panic(fmt.Errorf("NoteDBMgr init need db"))
+	}
+	return &_NoteDBMgr{db: db, from: "(SELECT * FROM notes WHERE deleted_at IS NULL) notes"}
+}
+
+// WithDeleted returns a manager whose finders also see the soft deleted rows.
+func (m *_NoteDBMgr) WithDeleted() *_NoteDBMgr {
+	return &_NoteDBMgr{db: m.db, from: "notes"}
+}
+
+// OnlyDeleted returns a manager whose finders see the soft deleted rows only.
+func (m *_NoteDBMgr) OnlyDeleted() *_NoteDBMgr {
+	return &_NoteDBMgr{db: m.db, from: "(SELECT * FROM notes WHERE deleted_at IS NOT NULL) notes"}
+}
+
+func (m *_NoteDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*Note, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_NoteDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	conditions := []string{where, orderby, limit}
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
+}
+
+func (m *_NoteDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Note, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_NoteDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
+		strings.Join(obj.GetColumns(), ","),
+		m.from,
+		orm.SQLWhere(conditions),
+		orderby,
+		orm.SQLOffsetLimit(offset, limit))
+
+	return m.FetchBySQLCtx(ctx, q, args...)
+}
+
+func (m *_NoteDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_NoteDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
+}
+
+func (m *_NoteDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_NoteDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
+}
+
+func (m *_NoteDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Note, err error) {
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_NoteDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*Note, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Note fetch error: %w", err)
+	}
+	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_NoteDBMgr) scan(rows *sql.Rows) (results []*Note, err error) {
+
+	var DeletedAt sql.NullInt64
+
+	for rows.Next() {
+		var result Note
+		err = rows.Scan(&(result.Id), &(result.OwnerId), &(result.Slug), &(result.Content), &(result.Color), &(result.Stars), &(result.Visibility), orm.JSON{V: &(result.Meta)}, orm.JSON{V: &(result.Attachments)}, &DeletedAt)
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		if DeletedAt.Valid {
+			DeletedAtValue := DeletedAt.Int64
+			DeletedAtPoint := time.Unix(DeletedAtValue, 0)
+			result.DeletedAt = &DeletedAtPoint
+		} else {
+			result.DeletedAt = nil
+		}
+
+		results = append(results, &result)
+	}
+	if err = rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Note fetch result error: %w", err)
+	}
+	return
+}
+func (m *_NoteDBMgr) Exist(pk PrimaryKey) (bool, error) {
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_NoteDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
+	if err != nil {
+		return false, err
+	}
+	return (c != 0), nil
+}
+
+// Deprecated: Use FetchByPrimaryKey instead.
+func (m *_NoteDBMgr) Fetch(pk PrimaryKey) (*Note, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_NoteDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Note, error) {
+	obj := NoteMgr.NewNote()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Note", Key: pk.Key()}
+}
+
+// primary key
+func (m *_NoteDBMgr) FetchByPrimaryKey(id int64) (*Note, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_NoteDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int64) (*Note, error) {
+	obj := NoteMgr.NewNote()
+	pk := &IdOfNotePK{
+		Id: id,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Note", Key: pk.Key()}
+}
+
+func (m *_NoteDBMgr) FetchByPrimaryKeys(ids []int64) ([]*Note, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), ids)
+}
+
+func (m *_NoteDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, ids []int64) ([]*Note, error) {
+	size := len(ids)
+	if size == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, size)
+	for _, pk := range ids {
+		params = append(params, pk)
+	}
+	obj := NoteMgr.NewNote()
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
+		strings.Repeat(",?", size-1))
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// indexes
+
+func (m *_NoteDBMgr) FindByVisibility(visibility NoteVisibility, limit int, offset int) ([]*Note, error) {
+	return m.FindByVisibilityCtx(context.Background(), visibility, limit, offset)
+}
+
+func (m *_NoteDBMgr) FindByVisibilityCtx(ctx context.Context, visibility NoteVisibility, limit int, offset int) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	idx := &VisibilityOfNoteIDX{
+		Visibility: visibility,
+		limit:      limit,
+		offset:     offset,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_NoteDBMgr) FindAllByVisibility(visibility NoteVisibility) ([]*Note, error) {
+	return m.FindAllByVisibilityCtx(context.Background(), visibility)
+}
+
+func (m *_NoteDBMgr) FindAllByVisibilityCtx(ctx context.Context, visibility NoteVisibility) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	idx := &VisibilityOfNoteIDX{
+		Visibility: visibility,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_NoteDBMgr) FindByVisibilityGroup(items []NoteVisibility) ([]*Note, error) {
+	return m.FindByVisibilityGroupCtx(context.Background(), items)
+}
+
+func (m *_NoteDBMgr) FindByVisibilityGroupCtx(ctx context.Context, items []NoteVisibility) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	if len(items) == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		params = append(params, item)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s where visibility in (?", strings.Join(obj.GetColumns(), ","), m.from) +
+		strings.Repeat(",?", len(items)-1) + ")"
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+func (m *_NoteDBMgr) FindByOwnerId(ownerId UserID, limit int, offset int) ([]*Note, error) {
+	return m.FindByOwnerIdCtx(context.Background(), ownerId, limit, offset)
+}
+
+func (m *_NoteDBMgr) FindByOwnerIdCtx(ctx context.Context, ownerId UserID, limit int, offset int) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	idx := &OwnerIdOfNoteIDX{
+		OwnerId: ownerId,
+		limit:   limit,
+		offset:  offset,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_NoteDBMgr) FindAllByOwnerId(ownerId UserID) ([]*Note, error) {
+	return m.FindAllByOwnerIdCtx(context.Background(), ownerId)
+}
+
+func (m *_NoteDBMgr) FindAllByOwnerIdCtx(ctx context.Context, ownerId UserID) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	idx := &OwnerIdOfNoteIDX{
+		OwnerId: ownerId,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_NoteDBMgr) FindByOwnerIdGroup(items []int32) ([]*Note, error) {
+	return m.FindByOwnerIdGroupCtx(context.Background(), items)
+}
+
+func (m *_NoteDBMgr) FindByOwnerIdGroupCtx(ctx context.Context, items []int32) ([]*Note, error) {
+	obj := NoteMgr.NewNote()
+	if len(items) == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		params = append(params, item)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s where owner_id in (?", strings.Join(obj.GetColumns(), ","), m.from) +
+		strings.Repeat(",?", len(items)-1) + ")"
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// uniques
+
+func (m *_NoteDBMgr) FetchBySlug(slug string) (*Note, error) {
+	return m.FetchBySlugCtx(context.Background(), slug)
+}
+
+func (m *_NoteDBMgr) FetchBySlugCtx(ctx context.Context, slug string) (*Note, error) {
+	obj := NoteMgr.NewNote()
+	uniq := &SlugOfNoteUK{
+		Slug: slug,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, uniq.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, uniq.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Note", Key: uniq.Key()}
+}
+
+func (m *_NoteDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_NoteDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Note", Key: unique.Key()}
+}
+
+// Deprecated: Use FetchByXXXUnique instead.
+func (m *_NoteDBMgr) FindOneFetch(unique Unique) (*Note, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_NoteDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Note, error) {
+	obj := NoteMgr.NewNote()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Note", Key: unique.Key()}
+}
+
+// Deprecated: Use FindByXXXUnique instead.
+func (m *_NoteDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_NoteDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_NoteDBMgr) FindFetch(index Index) (int64, []*Note, error) {
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_NoteDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Note, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+
+	obj := NoteMgr.NewNote()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_NoteDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_NoteDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_NoteDBMgr) RangeFetch(scope Range) (int64, []*Note, error) {
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_NoteDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Note, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	obj := NoteMgr.NewNote()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_NoteDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_NoteDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	scope.Revert(true)
+	return m.RangeCtx(ctx, scope)
+}
+
+func (m *_NoteDBMgr) RangeRevertFetch(scope Range) (int64, []*Note, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_NoteDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Note, error) {
+	scope.Revert(true)
+	return m.RangeFetchCtx(ctx, scope)
+}
+
+func (m *_NoteDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+	pk := NoteMgr.NewPrimaryKey()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Note query limit error: %w", err)
+	}
+	defer rows.Close()
+
+	offset := 0
+
+	for rows.Next() {
+		if limit >= 0 && offset >= limit {
+			break
+		}
+		offset++
+
+		result := NoteMgr.NewPrimaryKey()
+		err = rows.Scan(&(result.Id))
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Note query limit result error: %w", err)
+	}
+	return
+}
+
+func (m *_NoteDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(id) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("Note query count error: %w", err)
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		if err = rows.Scan(&count); err != nil {
+			m.db.SetError(err)
+			return 0, err
+		}
+		break
+	}
+	return count, nil
+}
+
+func (obj *Note) SetOwnerId(val UserID) *Note {
+	obj.OwnerId = val
+	obj.dirty.Mark(NoteColumns.OwnerId)
+	return obj
+}
+
+func (obj *Note) SetSlug(val string) *Note {
+	obj.Slug = val
+	obj.dirty.Mark(NoteColumns.Slug)
+	return obj
+}
+
+func (obj *Note) SetContent(val string) *Note {
+	obj.Content = val
+	obj.dirty.Mark(NoteColumns.Content)
+	return obj
+}
+
+func (obj *Note) SetColor(val string) *Note {
+	obj.Color = val
+	obj.dirty.Mark(NoteColumns.Color)
+	return obj
+}
+
+func (obj *Note) SetStars(val int32) *Note {
+	obj.Stars = val
+	obj.dirty.Mark(NoteColumns.Stars)
+	return obj
+}
+
+func (obj *Note) SetVisibility(val NoteVisibility) *Note {
+	obj.Visibility = val
+	obj.dirty.Mark(NoteColumns.Visibility)
+	return obj
+}
+
+func (obj *Note) SetMeta(val map[string]interface{}) *Note {
+	obj.Meta = val
+	obj.dirty.Mark(NoteColumns.Meta)
+	return obj
+}
+
+func (obj *Note) SetAttachments(val []Attachment) *Note {
+	obj.Attachments = val
+	obj.dirty.Mark(NoteColumns.Attachments)
+	return obj
+}
+
+func (obj *Note) SetDeletedAt(val *time.Time) *Note {
+	obj.DeletedAt = val
+	obj.dirty.Mark(NoteColumns.DeletedAt)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *Note) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
+func (m *_NoteDBMgr) BatchCreate(objs []*Note) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_NoteDBMgr) BatchCreateCtx(ctx context.Context, objs []*Note) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO notes(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save and
+// validates obj, an error aborts the write.
+func (m *_NoteDBMgr) beforeWrite(obj *Note, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	if err := obj.Validate(); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_NoteDBMgr) hook(obj *Note, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_NoteDBMgr) batchValues(objs []*Note, withIncrement bool) (string, []interface{}) {
+	size := 9
+	if withIncrement {
+		size = 10
+	}
+	params := make([]string, 0, len(objs))
+	values := make([]interface{}, 0, len(objs)*size)
+	for _, obj := range objs {
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
+		if withIncrement {
+			values = append(values, obj.Id)
+		}
+		values = append(values, obj.OwnerId)
+		values = append(values, obj.Slug)
+		values = append(values, obj.Content)
+		values = append(values, obj.Color)
+		values = append(values, obj.Stars)
+		values = append(values, obj.Visibility)
+		values = append(values, orm.JSON{V: obj.Meta})
+		values = append(values, orm.JSON{V: obj.Attachments})
+		if obj.DeletedAt == nil {
+			values = append(values, nil)
+		} else {
+			values = append(values, obj.DeletedAt.Unix())
+		}
+	}
+	return strings.Join(params, ","), values
+}
+
+// argument example:
+// set:"a=?, b=?"
+// where:"c=? and d=?"
+// params:[]interface{}{"a", "b", "c", "d"}...
+func (m *_NoteDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_NoteDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("UPDATE notes SET %s", set)
+	if where != "" {
+		query = fmt.Sprintf("UPDATE notes SET %s WHERE %s", set, where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_NoteDBMgr) Create(obj *Note) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_NoteDBMgr) CreateCtx(ctx context.Context, obj *Note) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_NoteDBMgr) create(ctx context.Context, obj *Note) (int64, error) {
+	params := orm.NewStringSlice(9, "?")
+	q := fmt.Sprintf("INSERT INTO notes(%s) VALUES(%s)",
+		strings.Join(obj.GetNoneIncrementColumns(), ","),
+		strings.Join(params, ","))
+
+	values := make([]interface{}, 0, 10)
+	values = append(values, obj.OwnerId)
+	values = append(values, obj.Slug)
+	values = append(values, obj.Content)
+	values = append(values, obj.Color)
+	values = append(values, obj.Stars)
+	values = append(values, obj.Visibility)
+	values = append(values, orm.JSON{V: obj.Meta})
+	values = append(values, orm.JSON{V: obj.Attachments})
+	if obj.DeletedAt == nil {
+		values = append(values, nil)
+	} else {
+		values = append(values, obj.DeletedAt.Unix())
+	}
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	lastInsertId, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	obj.Id = int64(lastInsertId)
+	return result.RowsAffected()
+}
+
+func (m *_NoteDBMgr) Update(obj *Note) (int64, error) {
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_NoteDBMgr) UpdateCtx(ctx context.Context, obj *Note) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_NoteDBMgr) UpdateAll(obj *Note) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_NoteDBMgr) UpdateAllCtx(ctx context.Context, obj *Note) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		NoteColumns.OwnerId,
+		NoteColumns.Slug,
+		NoteColumns.Content,
+		NoteColumns.Color,
+		NoteColumns.Stars,
+		NoteColumns.Visibility,
+		NoteColumns.Meta,
+		NoteColumns.Attachments,
+		NoteColumns.DeletedAt,
+	)
+}
+
+func (m *_NoteDBMgr) UpdateFields(obj *Note, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of NoteColumns.
+func (m *_NoteDBMgr) UpdateFieldsCtx(ctx context.Context, obj *Note, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_NoteDBMgr) updateFields(ctx context.Context, obj *Note, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "owner_id":
+			set.Add(column, obj.OwnerId)
+		case "slug":
+			set.Add(column, obj.Slug)
+		case "content":
+			set.Add(column, obj.Content)
+		case "color":
+			set.Add(column, obj.Color)
+		case "stars":
+			set.Add(column, obj.Stars)
+		case "visibility":
+			set.Add(column, obj.Visibility)
+		case "meta":
+			set.Add(column, orm.JSON{V: obj.Meta})
+		case "attachments":
+			set.Add(column, orm.JSON{V: obj.Attachments})
+		case "deleted_at":
+			if obj.DeletedAt == nil {
+				set.Add(column, nil)
+			} else {
+				set.Add(column, obj.DeletedAt.Unix())
+			}
+		default:
+			return 0, fmt.Errorf("Note has no column %s to update", column)
+		}
+	}
+	sets, values, err := sqlbuilder.SQLite.BuildArgs(set)
+	if err != nil {
+		return 0, err
+	}
+
+	pk := obj.GetPrimaryKey()
+	q := fmt.Sprintf("UPDATE notes SET %s %s", sets, pk.SQLFormat())
+	values = append(values, pk.SQLParams()...)
+
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Clean(columns...)
+	return result.RowsAffected()
+}
+
+func (m *_NoteDBMgr) Save(obj *Note) (int64, error) {
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+func (m *_NoteDBMgr) SaveCtx(ctx context.Context, obj *Note) (int64, error) {
+	if obj.Id == 0 {
+		return m.CreateCtx(ctx, obj)
+	}
+	affected, failed, err := m.save(ctx, []*Note{obj})
+	if err != nil {
+		return affected, err
+	}
+	if len(failed) > 0 {
+		return 0, failed[0]
+	}
+	return affected, nil
+}
+
+func (m *_NoteDBMgr) BatchUpsert(objs []*Note) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_NoteDBMgr) BatchUpsertCtx(ctx context.Context, objs []*Note) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*Note, 0, len(objs))
+	upserts := make([]*Note, 0, len(objs))
+	for _, obj := range objs {
+		if obj.Id == 0 {
+			creates = append(creates, obj)
+		} else {
+			upserts = append(upserts, obj)
+		}
+	}
+
+	var affected int64
+	if len(creates) > 0 {
+		n, err := m.BatchCreateCtx(ctx, creates)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_NoteDBMgr) save(ctx context.Context, objs []*Note) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+func (m *_NoteDBMgr) upsert(ctx context.Context, objs []*Note) ([]*Note, orm.MultiError, error) {
+	columns := []string{
+		"id",
+		"owner_id",
+		"slug",
+		"content",
+		"color",
+		"stars",
+		"visibility",
+		"meta",
+		"attachments",
+		"deleted_at",
+	}
+	params, values := m.batchValues(objs, true)
+	updates := []string{
+		"owner_id = EXCLUDED.owner_id",
+		"slug = EXCLUDED.slug",
+		"content = EXCLUDED.content",
+		"color = EXCLUDED.color",
+		"stars = EXCLUDED.stars",
+		"visibility = EXCLUDED.visibility",
+		"meta = EXCLUDED.meta",
+		"attachments = EXCLUDED.attachments",
+		"deleted_at = EXCLUDED.deleted_at",
+	}
+	action := "UPDATE SET " + strings.Join(updates, ",")
+	//! the rows written are returned, the stale ones are not
+	q := fmt.Sprintf("INSERT INTO notes(%s) VALUES %s ON CONFLICT (id) DO %s RETURNING %s",
+		strings.Join(columns, ","),
+		params,
+		action,
+		strings.Join(columns, ","))
+	rows, err := m.db.QueryContext(orm.WithPrimary(ctx), q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	//! the statement may only fail once its rows are read
+	if _, err := m.scan(rows); err != nil {
+		return nil, nil, orm.TranslateError(err)
+	}
+	return objs, nil, nil
+}
+
+func (m *_NoteDBMgr) Delete(obj *Note) (int64, error) {
+	return m.DeleteCtx(context.Background(), obj)
+}
+
+// DeleteCtx soft deletes obj, its DeletedAt is set to the time of
+// the deletion.
+func (m *_NoteDBMgr) DeleteCtx(ctx context.Context, obj *Note) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	at := orm.Now()
+	n, err := m.softDelete(ctx, obj.GetPrimaryKey(), at)
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		obj.DeletedAt = &at
+	}
+	return n, m.hook(obj, orm.AfterDelete)
+}
+
+func (m *_NoteDBMgr) DeleteByPrimaryKey(id int64) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_NoteDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, id int64) (int64, error) {
+	pk := &IdOfNotePK{
+		Id: id,
+	}
+	return m.softDelete(ctx, pk, orm.Now())
+}
+
+// softDelete marks the row of pk deleted at the given time, a row deleted
+// before keeps its time.
+func (m *_NoteDBMgr) softDelete(ctx context.Context, pk PrimaryKey, at time.Time) (int64, error) {
+	q := fmt.Sprintf("UPDATE notes SET deleted_at = ? %s AND deleted_at IS NULL", pk.SQLFormat())
+	values := make([]interface{}, 0, 1+1)
+	values = append(values, at.Unix())
+	values = append(values, pk.SQLParams()...)
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_NoteDBMgr) Restore(obj *Note) (int64, error) {
+	return m.RestoreCtx(context.Background(), obj)
+}
+
+// RestoreCtx brings the soft deleted obj back to the finders.
+func (m *_NoteDBMgr) RestoreCtx(ctx context.Context, obj *Note) (int64, error) {
+	pk := obj.GetPrimaryKey()
+	q := fmt.Sprintf("UPDATE notes SET deleted_at = NULL %s AND deleted_at IS NOT NULL", pk.SQLFormat())
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
+	if err != nil {
+		return 0, err
+	}
+	obj.DeletedAt = nil
+	return result.RowsAffected()
+}
+
+func (m *_NoteDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+
+// DeleteBySQLCtx removes the matching rows for good, soft deleted or not.
+func (m *_NoteDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM notes")
+	if where != "" {
+		query = fmt.Sprintf("DELETE FROM notes WHERE %s", where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
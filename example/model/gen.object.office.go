@@ -1,22 +1,26 @@
 package model
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
 	"gopkg.in/go-playground/validator.v9"
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
 	_ orm.VSet
 	_ validator.Validate
+	_ sqlbuilder.Builder
 )
 
 type Office struct {
@@ -29,6 +33,7 @@ type Office struct {
 	UpdateBy             string    `db:"update_by"`
 	CreateDate           time.Time `db:"create_date"`
 	UpdateDate           time.Time `db:"update_date"`
+	dirty                orm.Dirty
 }
 
 var OfficeColumns = struct {
@@ -305,7 +310,8 @@ func (u *OfficeIdOfOfficeRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
 }
 
 type _OfficeDBMgr struct {
-	db orm.DB
+	db   orm.DB
+	from string
 }
 
 func (m *_OfficeMgr) DB(db orm.DB) *_OfficeDBMgr {
@@ -316,44 +322,70 @@ func OfficeDBMgr(db orm.DB) *_OfficeDBMgr {
 	if db == nil {
 		panic(fmt.Errorf("OfficeDBMgr init need db"))
 	}
-	return &_OfficeDBMgr{db: db}
+	return &_OfficeDBMgr{db: db, from: "[dbo].[testCRUD]"}
 }
 
 func (m *_OfficeDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*Office, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_OfficeDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*Office, error) {
 	obj := OfficeMgr.NewOffice()
 	conditions := []string{where, orderby, limit}
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(obj.GetColumns(), ","), strings.Join(conditions, " "))
-	return m.FetchBySQL(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
 }
 
 func (m *_OfficeDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Office, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_OfficeDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Office, error) {
 	obj := OfficeMgr.NewOffice()
 	if orderby == "" {
 		orderby = orm.SQLOrderBy("office_id", false)
 	}
-	q := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s %s %s",
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
 		strings.Join(obj.GetColumns(), ","),
+		m.from,
 		orm.SQLWhere(conditions),
 		orderby,
 		orm.MsSQLOffsetLimit(offset, limit))
 
-	return m.FetchBySQL(q, args...)
+	return m.FetchBySQLCtx(ctx, q, args...)
 }
 
 func (m *_OfficeDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
-	return m.queryCount(where, args...)
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_OfficeDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
 }
 
 func (m *_OfficeDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
-	return m.queryCount(orm.SQLWhere(conditions), args...)
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_OfficeDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
 }
 
 func (m *_OfficeDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Office, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_OfficeDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*Office, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("Office fetch error: %v", err)
+		return nil, fmt.Errorf("Office fetch error: %w", err)
 	}
 	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_OfficeDBMgr) scan(rows *sql.Rows) (results []*Office, err error) {
 
 	var CreateDate string
 	var UpdateDate string
@@ -373,12 +405,16 @@ func (m *_OfficeDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Off
 	}
 	if err = rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("Office fetch result error: %v", err)
+		return nil, fmt.Errorf("Office fetch result error: %w", err)
 	}
 	return
 }
 func (m *_OfficeDBMgr) Exist(pk PrimaryKey) (bool, error) {
-	c, err := m.queryCount(pk.SQLFormat(), pk.SQLParams()...)
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_OfficeDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
 	if err != nil {
 		return false, err
 	}
@@ -387,37 +423,49 @@ func (m *_OfficeDBMgr) Exist(pk PrimaryKey) (bool, error) {
 
 // Deprecated: Use FetchByPrimaryKey instead.
 func (m *_OfficeDBMgr) Fetch(pk PrimaryKey) (*Office, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_OfficeDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Office, error) {
 	obj := OfficeMgr.NewOffice()
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("Office fetch record not found")
+	return nil, &orm.NotFoundError{Object: "Office", Key: pk.Key()}
 }
 
 // primary key
 func (m *_OfficeDBMgr) FetchByPrimaryKey(officeId int32) (*Office, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), officeId)
+}
+
+func (m *_OfficeDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, officeId int32) (*Office, error) {
 	obj := OfficeMgr.NewOffice()
 	pk := &OfficeIdOfOfficePK{
 		OfficeId: officeId,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("Office fetch record not found")
+	return nil, &orm.NotFoundError{Object: "Office", Key: pk.Key()}
 }
 
 func (m *_OfficeDBMgr) FetchByPrimaryKeys(officeIds []int32) ([]*Office, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), officeIds)
+}
+
+func (m *_OfficeDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, officeIds []int32) ([]*Office, error) {
 	size := len(officeIds)
 	if size == 0 {
 		return nil, nil
@@ -427,9 +475,9 @@ func (m *_OfficeDBMgr) FetchByPrimaryKeys(officeIds []int32) ([]*Office, error)
 		params = append(params, pk)
 	}
 	obj := OfficeMgr.NewOffice()
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] WHERE office_id IN (?%s)", strings.Join(obj.GetColumns(), ","),
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE office_id IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
 		strings.Repeat(",?", size-1))
-	return m.FetchBySQL(query, params...)
+	return m.FetchBySQLCtx(ctx, query, params...)
 }
 
 // indexes
@@ -437,49 +485,65 @@ func (m *_OfficeDBMgr) FetchByPrimaryKeys(officeIds []int32) ([]*Office, error)
 // uniques
 
 func (m *_OfficeDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
-	objs, err := m.queryLimit(unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_OfficeDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("Office find record not found")
+	return nil, &orm.NotFoundError{Object: "Office", Key: unique.Key()}
 }
 
 // Deprecated: Use FetchByXXXUnique instead.
 func (m *_OfficeDBMgr) FindOneFetch(unique Unique) (*Office, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_OfficeDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Office, error) {
 	obj := OfficeMgr.NewOffice()
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(obj.GetColumns(), ","), unique.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, unique.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("none record")
+	return nil, &orm.NotFoundError{Object: "Office", Key: unique.Key()}
 }
 
 // Deprecated: Use FindByXXXUnique instead.
 func (m *_OfficeDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_OfficeDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_OfficeDBMgr) FindFetch(index Index) (int64, []*Office, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_OfficeDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Office, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 
 	obj := OfficeMgr.NewOffice()
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(obj.GetColumns(), ","), index.SQLFormat(true))
-	results, err := m.FetchBySQL(query, index.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -487,22 +551,30 @@ func (m *_OfficeDBMgr) FindFetch(index Index) (int64, []*Office, error) {
 }
 
 func (m *_OfficeDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_OfficeDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_OfficeDBMgr) RangeFetch(scope Range) (int64, []*Office, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_OfficeDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Office, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 	obj := OfficeMgr.NewOffice()
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(obj.GetColumns(), ","), scope.SQLFormat(true))
-	results, err := m.FetchBySQL(query, scope.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -510,21 +582,29 @@ func (m *_OfficeDBMgr) RangeFetch(scope Range) (int64, []*Office, error) {
 }
 
 func (m *_OfficeDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_OfficeDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
 	scope.Revert(true)
-	return m.Range(scope)
+	return m.RangeCtx(ctx, scope)
 }
 
 func (m *_OfficeDBMgr) RangeRevertFetch(scope Range) (int64, []*Office, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_OfficeDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Office, error) {
 	scope.Revert(true)
-	return m.RangeFetch(scope)
+	return m.RangeFetchCtx(ctx, scope)
 }
 
-func (m *_OfficeDBMgr) queryLimit(where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+func (m *_OfficeDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
 	pk := OfficeMgr.NewPrimaryKey()
-	query := fmt.Sprintf("SELECT %s FROM [dbo].[testCRUD] %s", strings.Join(pk.Columns(), ","), where)
-	rows, err := m.db.Query(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("Office query limit error: %v", err)
+		return nil, fmt.Errorf("Office query limit error: %w", err)
 	}
 	defer rows.Close()
 
@@ -547,16 +627,16 @@ func (m *_OfficeDBMgr) queryLimit(where string, limit int, args ...interface{})
 	}
 	if err := rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("Office query limit result error: %v", err)
+		return nil, fmt.Errorf("Office query limit result error: %w", err)
 	}
 	return
 }
 
-func (m *_OfficeDBMgr) queryCount(where string, args ...interface{}) (int64, error) {
-	query := fmt.Sprintf("SELECT count(office_id) FROM [dbo].[testCRUD] %s", where)
-	rows, err := m.db.Query(query, args...)
+func (m *_OfficeDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(office_id) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("Office query count error: %v", err)
+		return 0, fmt.Errorf("Office query count error: %w", err)
 	}
 	defer rows.Close()
 
@@ -571,15 +651,125 @@ func (m *_OfficeDBMgr) queryCount(where string, args ...interface{}) (int64, err
 	return count, nil
 }
 
+func (obj *Office) SetOfficeArea(val string) *Office {
+	obj.OfficeArea = val
+	obj.dirty.Mark(OfficeColumns.OfficeArea)
+	return obj
+}
+
+func (obj *Office) SetOfficeName(val string) *Office {
+	obj.OfficeName = val
+	obj.dirty.Mark(OfficeColumns.OfficeName)
+	return obj
+}
+
+func (obj *Office) SetSearchOriginCode(val string) *Office {
+	obj.SearchOriginCode = val
+	obj.dirty.Mark(OfficeColumns.SearchOriginCode)
+	return obj
+}
+
+func (obj *Office) SetProcessingOriginCode(val string) *Office {
+	obj.ProcessingOriginCode = val
+	obj.dirty.Mark(OfficeColumns.ProcessingOriginCode)
+	return obj
+}
+
+func (obj *Office) SetCreateBy(val string) *Office {
+	obj.CreateBy = val
+	obj.dirty.Mark(OfficeColumns.CreateBy)
+	return obj
+}
+
+func (obj *Office) SetUpdateBy(val string) *Office {
+	obj.UpdateBy = val
+	obj.dirty.Mark(OfficeColumns.UpdateBy)
+	return obj
+}
+
+func (obj *Office) SetCreateDate(val time.Time) *Office {
+	obj.CreateDate = val
+	obj.dirty.Mark(OfficeColumns.CreateDate)
+	return obj
+}
+
+func (obj *Office) SetUpdateDate(val time.Time) *Office {
+	obj.UpdateDate = val
+	obj.dirty.Mark(OfficeColumns.UpdateDate)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *Office) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
 func (m *_OfficeDBMgr) BatchCreate(objs []*Office) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_OfficeDBMgr) BatchCreateCtx(ctx context.Context, objs []*Office) (int64, error) {
 	if len(objs) == 0 {
 		return 0, nil
 	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO [dbo].[testCRUD](%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_OfficeDBMgr) beforeWrite(obj *Office, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_OfficeDBMgr) hook(obj *Office, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
 
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_OfficeDBMgr) batchValues(objs []*Office, withIncrement bool) (string, []interface{}) {
+	size := 8
+	if withIncrement {
+		size = 9
+	}
 	params := make([]string, 0, len(objs))
-	values := make([]interface{}, 0, len(objs)*8)
+	values := make([]interface{}, 0, len(objs)*size)
 	for _, obj := range objs {
-		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(8, "?"), ",")))
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
+		if withIncrement {
+			values = append(values, obj.OfficeId)
+		}
 		values = append(values, obj.OfficeArea)
 		values = append(values, obj.OfficeName)
 		values = append(values, obj.SearchOriginCode)
@@ -589,12 +779,7 @@ func (m *_OfficeDBMgr) BatchCreate(objs []*Office) (int64, error) {
 		values = append(values, orm.MsSQLTimeFormat(obj.CreateDate))
 		values = append(values, orm.MsSQLTimeFormat(obj.UpdateDate))
 	}
-	query := fmt.Sprintf("INSERT INTO [dbo].[testCRUD](%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), strings.Join(params, ","))
-	result, err := m.db.Exec(query, values...)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
+	return strings.Join(params, ","), values
 }
 
 // argument example:
@@ -602,11 +787,15 @@ func (m *_OfficeDBMgr) BatchCreate(objs []*Office) (int64, error) {
 // where:"c=? and d=?"
 // params:[]interface{}{"a", "b", "c", "d"}...
 func (m *_OfficeDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_OfficeDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("UPDATE [dbo].[testCRUD] SET %s", set)
 	if where != "" {
 		query = fmt.Sprintf("UPDATE [dbo].[testCRUD] SET %s WHERE %s", set, where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -614,8 +803,25 @@ func (m *_OfficeDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int6
 }
 
 func (m *_OfficeDBMgr) Create(obj *Office) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_OfficeDBMgr) CreateCtx(ctx context.Context, obj *Office) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_OfficeDBMgr) create(ctx context.Context, obj *Office) (int64, error) {
 	params := orm.NewStringSlice(8, "?")
-	q := fmt.Sprintf("INSERT INTO [dbo].[testCRUD](%s) VALUES(%s)",
+	//! mssql has no LastInsertId, the identity is output by the insert
+	q := fmt.Sprintf("INSERT INTO [dbo].[testCRUD](%s) OUTPUT INSERTED.office_id VALUES(%s)",
 		strings.Join(obj.GetNoneIncrementColumns(), ","),
 		strings.Join(params, ","))
 
@@ -628,71 +834,290 @@ func (m *_OfficeDBMgr) Create(obj *Office) (int64, error) {
 	values = append(values, obj.UpdateBy)
 	values = append(values, orm.MsSQLTimeFormat(obj.CreateDate))
 	values = append(values, orm.MsSQLTimeFormat(obj.UpdateDate))
-	result, err := m.db.Exec(q, values...)
+	rows, err := m.db.QueryContext(orm.WithPrimary(ctx), q, values...)
 	if err != nil {
 		return 0, err
 	}
-	lastInsertId, err := result.LastInsertId()
-	if err != nil {
-		return 0, err
+	defer rows.Close()
+
+	var affected int64
+	for rows.Next() {
+		if err = rows.Scan(&(obj.OfficeId)); err != nil {
+			m.db.SetError(err)
+			return 0, err
+		}
+		affected++
 	}
-	obj.OfficeId = int32(lastInsertId)
-	return result.RowsAffected()
+	if err = rows.Err(); err != nil {
+		m.db.SetError(err)
+		return 0, orm.TranslateError(err)
+	}
+	return affected, nil
 }
 
 func (m *_OfficeDBMgr) Update(obj *Office) (int64, error) {
-	columns := []string{
-		"office_area = ?",
-		"office_name = ?",
-		"search_origin_code = ?",
-		"processing_origin_code = ?",
-		"create_by = ?",
-		"update_by = ?",
-		"create_date = ?",
-		"update_date = ?",
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_OfficeDBMgr) UpdateCtx(ctx context.Context, obj *Office) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_OfficeDBMgr) UpdateAll(obj *Office) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_OfficeDBMgr) UpdateAllCtx(ctx context.Context, obj *Office) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		OfficeColumns.OfficeArea,
+		OfficeColumns.OfficeName,
+		OfficeColumns.SearchOriginCode,
+		OfficeColumns.ProcessingOriginCode,
+		OfficeColumns.CreateBy,
+		OfficeColumns.UpdateBy,
+		OfficeColumns.CreateDate,
+		OfficeColumns.UpdateDate,
+	)
+}
+
+func (m *_OfficeDBMgr) UpdateFields(obj *Office, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of OfficeColumns.
+func (m *_OfficeDBMgr) UpdateFieldsCtx(ctx context.Context, obj *Office, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_OfficeDBMgr) updateFields(ctx context.Context, obj *Office, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "office_area":
+			set.Add(column, obj.OfficeArea)
+		case "office_name":
+			set.Add(column, obj.OfficeName)
+		case "search_origin_code":
+			set.Add(column, obj.SearchOriginCode)
+		case "processing_origin_code":
+			set.Add(column, obj.ProcessingOriginCode)
+		case "create_by":
+			set.Add(column, obj.CreateBy)
+		case "update_by":
+			set.Add(column, obj.UpdateBy)
+		case "create_date":
+			set.Add(column, orm.MsSQLTimeFormat(obj.CreateDate))
+		case "update_date":
+			set.Add(column, orm.MsSQLTimeFormat(obj.UpdateDate))
+		default:
+			return 0, fmt.Errorf("Office has no column %s to update", column)
+		}
+	}
+	sets, values, err := sqlbuilder.MSSQL.BuildArgs(set)
+	if err != nil {
+		return 0, err
 	}
 
 	pk := obj.GetPrimaryKey()
-	q := fmt.Sprintf("UPDATE [dbo].[testCRUD] SET %s %s", strings.Join(columns, ","), pk.SQLFormat())
-	values := make([]interface{}, 0, 9-1)
-	values = append(values, obj.OfficeArea)
-	values = append(values, obj.OfficeName)
-	values = append(values, obj.SearchOriginCode)
-	values = append(values, obj.ProcessingOriginCode)
-	values = append(values, obj.CreateBy)
-	values = append(values, obj.UpdateBy)
-	values = append(values, orm.MsSQLTimeFormat(obj.CreateDate))
-	values = append(values, orm.MsSQLTimeFormat(obj.UpdateDate))
+	q := fmt.Sprintf("UPDATE [dbo].[testCRUD] SET %s %s", sets, pk.SQLFormat())
 	values = append(values, pk.SQLParams()...)
 
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
+	obj.dirty.Clean(columns...)
 	return result.RowsAffected()
 }
 
 func (m *_OfficeDBMgr) Save(obj *Office) (int64, error) {
-	affected, err := m.Update(obj)
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+func (m *_OfficeDBMgr) SaveCtx(ctx context.Context, obj *Office) (int64, error) {
+	if obj.OfficeId == 0 {
+		return m.CreateCtx(ctx, obj)
+	}
+	affected, failed, err := m.save(ctx, []*Office{obj})
 	if err != nil {
 		return affected, err
 	}
-	if affected == 0 {
-		return m.Create(obj)
+	if len(failed) > 0 {
+		return 0, failed[0]
 	}
-	return affected, err
+	return affected, nil
+}
+
+func (m *_OfficeDBMgr) BatchUpsert(objs []*Office) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_OfficeDBMgr) BatchUpsertCtx(ctx context.Context, objs []*Office) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*Office, 0, len(objs))
+	upserts := make([]*Office, 0, len(objs))
+	for _, obj := range objs {
+		if obj.OfficeId == 0 {
+			creates = append(creates, obj)
+		} else {
+			upserts = append(upserts, obj)
+		}
+	}
+
+	var affected int64
+	if len(creates) > 0 {
+		n, err := m.BatchCreateCtx(ctx, creates)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_OfficeDBMgr) save(ctx context.Context, objs []*Office) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+func (m *_OfficeDBMgr) upsert(ctx context.Context, objs []*Office) ([]*Office, orm.MultiError, error) {
+	columns := []string{
+		"office_id",
+		"office_area",
+		"office_name",
+		"search_origin_code",
+		"processing_origin_code",
+		"create_by",
+		"update_by",
+		"create_date",
+		"update_date",
+	}
+	params, values := m.batchValues(objs, true)
+	sources := make([]string, 0, len(columns))
+	outputs := make([]string, 0, len(columns))
+	for _, column := range columns {
+		sources = append(sources, "s."+column)
+		outputs = append(outputs, "inserted."+column)
+	}
+	updates := []string{
+		"office_area = s.office_area",
+		"office_name = s.office_name",
+		"search_origin_code = s.search_origin_code",
+		"processing_origin_code = s.processing_origin_code",
+		"create_by = s.create_by",
+		"update_by = s.update_by",
+		"create_date = s.create_date",
+		"update_date = s.update_date",
+	}
+	//! the rows written are output, the stale ones are not
+	q := fmt.Sprintf("MERGE INTO [dbo].[testCRUD] WITH (HOLDLOCK) AS t USING (VALUES %s) AS s(%s) ON (t.office_id = s.office_id) WHEN MATCHED THEN UPDATE SET %s WHEN NOT MATCHED THEN INSERT(%s) VALUES(%s) OUTPUT %s;",
+		params,
+		strings.Join(columns, ","),
+		strings.Join(updates, ","),
+		strings.Join(columns, ","),
+		strings.Join(sources, ","),
+		strings.Join(outputs, ","))
+	//! the new rows keep the identities of objs
+	q = "SET IDENTITY_INSERT [dbo].[testCRUD] ON; " + q + " SET IDENTITY_INSERT [dbo].[testCRUD] OFF;"
+	rows, err := m.db.QueryContext(orm.WithPrimary(ctx), q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	//! the statement may only fail once its rows are read
+	if _, err := m.scan(rows); err != nil {
+		return nil, nil, orm.TranslateError(err)
+	}
+	return objs, nil, nil
 }
 
 func (m *_OfficeDBMgr) Delete(obj *Office) (int64, error) {
-	return m.DeleteByPrimaryKey(obj.OfficeId)
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_OfficeDBMgr) DeleteCtx(ctx context.Context, obj *Office) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.OfficeId)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
 }
 
 func (m *_OfficeDBMgr) DeleteByPrimaryKey(officeId int32) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), officeId)
+}
+
+func (m *_OfficeDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, officeId int32) (int64, error) {
 	pk := &OfficeIdOfOfficePK{
 		OfficeId: officeId,
 	}
 	q := fmt.Sprintf("DELETE FROM [dbo].[testCRUD] %s", pk.SQLFormat())
-	result, err := m.db.Exec(q, pk.SQLParams()...)
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
 	if err != nil {
 		return 0, err
 	}
@@ -700,11 +1125,14 @@ func (m *_OfficeDBMgr) DeleteByPrimaryKey(officeId int32) (int64, error) {
 }
 
 func (m *_OfficeDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_OfficeDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("DELETE FROM [dbo].[testCRUD]")
 	if where != "" {
 		query = fmt.Sprintf("DELETE FROM [dbo].[testCRUD] WHERE %s", where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,1528 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+var (
+	_ context.Context
+	_ sql.DB
+	_ time.Time
+	_ fmt.Formatter
+	_ strings.Reader
+	_ orm.VSet
+	_ validator.Validate
+	_ sqlbuilder.Builder
+)
+
+type Todo struct {
+	Id         int64       `db:"id"`
+	OwnerId    int32       `db:"owner_id"`
+	Title      string      `db:"title"`
+	Done       bool        `db:"done"`
+	Priority   int32       `db:"priority"`
+	Remark     string      `db:"remark"`
+	Budget     orm.Decimal `db:"budget"`
+	Attachment []byte      `db:"attachment"`
+	DueAt      time.Time   `db:"due_at"`
+	CreatedAt  time.Time   `db:"created_at"`
+	UpdatedAt  time.Time   `db:"updated_at"`
+	Version    int32       `db:"version"`
+	dirty      orm.Dirty
+}
+
+var TodoColumns = struct {
+	Id         string
+	OwnerId    string
+	Title      string
+	Done       string
+	Priority   string
+	Remark     string
+	Budget     string
+	Attachment string
+	DueAt      string
+	CreatedAt  string
+	UpdatedAt  string
+	Version    string
+}{
+	"id",
+	"owner_id",
+	"title",
+	"done",
+	"priority",
+	"remark",
+	"budget",
+	"attachment",
+	"due_at",
+	"created_at",
+	"updated_at",
+	"version",
+}
+
+type _TodoMgr struct {
+}
+
+var TodoMgr *_TodoMgr
+
+func (m *_TodoMgr) NewTodo() *Todo {
+	return &Todo{}
+}
+
+//! object function
+
+func (obj *Todo) GetNameSpace() string {
+	return "model"
+}
+
+func (obj *Todo) GetClassName() string {
+	return "Todo"
+}
+
+func (obj *Todo) GetTableName() string {
+	return "todos"
+}
+
+func (obj *Todo) GetColumns() []string {
+	columns := []string{
+		"todos.id",
+		"todos.owner_id",
+		"todos.title",
+		"todos.done",
+		"todos.priority",
+		"todos.remark",
+		"todos.budget",
+		"todos.attachment",
+		"todos.due_at",
+		"todos.created_at",
+		"todos.updated_at",
+		"todos.version",
+	}
+	return columns
+}
+
+func (obj *Todo) GetNoneIncrementColumns() []string {
+	columns := []string{
+		"owner_id",
+		"title",
+		"done",
+		"priority",
+		"remark",
+		"budget",
+		"attachment",
+		"due_at",
+		"created_at",
+		"updated_at",
+		"version",
+	}
+	return columns
+}
+
+func (obj *Todo) GetPrimaryKey() PrimaryKey {
+	pk := TodoMgr.NewPrimaryKey()
+	pk.Id = obj.Id
+	return pk
+}
+
+func (obj *Todo) Validate() error {
+	validate := validator.New()
+	return validate.Struct(obj)
+}
+
+// touch stamps the autoupdatetime fields, and the autocreatetime fields
+// still unset when create is set.
+func (obj *Todo) touch(now time.Time, create bool) {
+	if create && obj.CreatedAt.IsZero() {
+		obj.CreatedAt = now
+	}
+	obj.UpdatedAt = now
+}
+
+//! primary key
+
+type IdOfTodoPK struct {
+	Id int64
+}
+
+func (m *_TodoMgr) NewPrimaryKey() *IdOfTodoPK {
+	return &IdOfTodoPK{}
+}
+
+func (u *IdOfTodoPK) Key() string {
+	strs := []string{
+		"Id",
+		fmt.Sprint(u.Id),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfTodoPK) Parse(key string) error {
+	arr := strings.Split(key, ":")
+	if len(arr)%2 != 0 {
+		return fmt.Errorf("key (%s) format error", key)
+	}
+	kv := map[string]string{}
+	for i := 0; i < len(arr)/2; i++ {
+		kv[arr[2*i]] = arr[2*i+1]
+	}
+	vId, ok := kv["Id"]
+	if !ok {
+		return fmt.Errorf("key (%s) without (Id) field", key)
+	}
+	if err := orm.StringScan(vId, &(u.Id)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *IdOfTodoPK) SQLFormat() string {
+	conditions := []string{
+		"id = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *IdOfTodoPK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Id,
+	}
+}
+
+func (u *IdOfTodoPK) Columns() []string {
+	return []string{
+		"id",
+	}
+}
+
+//! uniques
+
+type TitleOfTodoUK struct {
+	Title string
+}
+
+func (u *TitleOfTodoUK) Key() string {
+	strs := []string{
+		"Title",
+		fmt.Sprint(u.Title),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *TitleOfTodoUK) SQLFormat(limit bool) string {
+	conditions := []string{
+		"title = ?",
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *TitleOfTodoUK) SQLParams() []interface{} {
+	return []interface{}{
+		u.Title,
+	}
+}
+
+func (u *TitleOfTodoUK) SQLLimit() int {
+	return 1
+}
+
+func (u *TitleOfTodoUK) Limit(n int) {
+}
+
+func (u *TitleOfTodoUK) Offset(n int) {
+}
+
+func (u *TitleOfTodoUK) UKRelation(store *orm.RedisStore) UniqueRelation {
+	return nil
+}
+
+//! indexes
+
+type OwnerIdOfTodoIDX struct {
+	OwnerId int32
+	offset  int
+	limit   int
+}
+
+func (u *OwnerIdOfTodoIDX) Key() string {
+	strs := []string{
+		"OwnerId",
+		fmt.Sprint(u.OwnerId),
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *OwnerIdOfTodoIDX) SQLFormat(limit bool) string {
+	conditions := []string{
+		"owner_id = ?",
+	}
+	if limit {
+		return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return orm.SQLWhere(conditions)
+}
+
+func (u *OwnerIdOfTodoIDX) SQLParams() []interface{} {
+	return []interface{}{
+		u.OwnerId,
+	}
+}
+
+func (u *OwnerIdOfTodoIDX) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *OwnerIdOfTodoIDX) Limit(n int) {
+	u.limit = n
+}
+
+func (u *OwnerIdOfTodoIDX) Offset(n int) {
+	u.offset = n
+}
+
+func (u *OwnerIdOfTodoIDX) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *OwnerIdOfTodoIDX) IDXRelation(store *orm.RedisStore) IndexRelation {
+	return nil
+}
+
+//! ranges
+
+type PriorityOfTodoRNG struct {
+	PriorityBegin int64
+	PriorityEnd   int64
+	offset        int
+	limit         int
+	includeBegin  bool
+	includeEnd    bool
+	revert        bool
+}
+
+func (u *PriorityOfTodoRNG) Key() string {
+	strs := []string{
+		"Priority",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *PriorityOfTodoRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *PriorityOfTodoRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *PriorityOfTodoRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.PriorityBegin != u.PriorityEnd {
+		if u.PriorityBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("priority %s ?", u.beginOp()))
+		}
+		if u.PriorityEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("priority %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("priority", u.revert), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("priority", u.revert))
+}
+
+func (u *PriorityOfTodoRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.PriorityBegin != u.PriorityEnd {
+		if u.PriorityBegin != -1 {
+			params = append(params, u.PriorityBegin)
+		}
+		if u.PriorityEnd != -1 {
+			params = append(params, u.PriorityEnd)
+		}
+	}
+	return params
+}
+
+func (u *PriorityOfTodoRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *PriorityOfTodoRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *PriorityOfTodoRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *PriorityOfTodoRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *PriorityOfTodoRNG) Begin() int64 {
+	start := u.PriorityBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *PriorityOfTodoRNG) End() int64 {
+	stop := u.PriorityEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *PriorityOfTodoRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *PriorityOfTodoRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *PriorityOfTodoRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *PriorityOfTodoRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return nil
+}
+
+type IdOfTodoRNG struct {
+	IdBegin      int64
+	IdEnd        int64
+	offset       int
+	limit        int
+	includeBegin bool
+	includeEnd   bool
+	revert       bool
+}
+
+func (u *IdOfTodoRNG) Key() string {
+	strs := []string{
+		"Id",
+	}
+	return fmt.Sprintf("%s", strings.Join(strs, ":"))
+}
+
+func (u *IdOfTodoRNG) beginOp() string {
+	if u.includeBegin {
+		return ">="
+	}
+	return ">"
+}
+func (u *IdOfTodoRNG) endOp() string {
+	if u.includeBegin {
+		return "<="
+	}
+	return "<"
+}
+
+func (u *IdOfTodoRNG) SQLFormat(limit bool) string {
+	conditions := []string{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			conditions = append(conditions, fmt.Sprintf("id %s ?", u.beginOp()))
+		}
+		if u.IdEnd != -1 {
+			conditions = append(conditions, fmt.Sprintf("id %s ?", u.endOp()))
+		}
+	}
+	if limit {
+		return fmt.Sprintf("%s %s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("id", u.revert), orm.SQLOffsetLimit(u.offset, u.limit))
+	}
+	return fmt.Sprintf("%s %s", orm.SQLWhere(conditions), orm.SQLOrderBy("id", u.revert))
+}
+
+func (u *IdOfTodoRNG) SQLParams() []interface{} {
+	params := []interface{}{}
+	if u.IdBegin != u.IdEnd {
+		if u.IdBegin != -1 {
+			params = append(params, u.IdBegin)
+		}
+		if u.IdEnd != -1 {
+			params = append(params, u.IdEnd)
+		}
+	}
+	return params
+}
+
+func (u *IdOfTodoRNG) SQLLimit() int {
+	if u.limit > 0 {
+		return u.limit
+	}
+	return -1
+}
+
+func (u *IdOfTodoRNG) Limit(n int) {
+	u.limit = n
+}
+
+func (u *IdOfTodoRNG) Offset(n int) {
+	u.offset = n
+}
+
+func (u *IdOfTodoRNG) PositionOffsetLimit(len int) (int, int) {
+	if u.limit <= 0 {
+		return 0, len
+	}
+	if u.offset+u.limit > len {
+		return u.offset, len
+	}
+	return u.offset, u.limit
+}
+
+func (u *IdOfTodoRNG) Begin() int64 {
+	start := u.IdBegin
+	if start == -1 || start == 0 {
+		start = 0
+	}
+	if start > 0 {
+		if !u.includeBegin {
+			start = start + 1
+		}
+	}
+	return start
+}
+
+func (u *IdOfTodoRNG) End() int64 {
+	stop := u.IdEnd
+	if stop == 0 || stop == -1 {
+		stop = -1
+	}
+	if stop > 0 {
+		if !u.includeBegin {
+			stop = stop - 1
+		}
+	}
+	return stop
+}
+
+func (u *IdOfTodoRNG) Revert(b bool) {
+	u.revert = b
+}
+
+func (u *IdOfTodoRNG) IncludeBegin(f bool) {
+	u.includeBegin = f
+}
+
+func (u *IdOfTodoRNG) IncludeEnd(f bool) {
+	u.includeEnd = f
+}
+
+func (u *IdOfTodoRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
+	return nil
+}
+
+type _TodoDBMgr struct {
+	db   orm.DB
+	from string
+}
+
+func (m *_TodoMgr) DB(db orm.DB) *_TodoDBMgr {
+	return TodoDBMgr(db)
+}
+
+func TodoDBMgr(db orm.DB) *_TodoDBMgr {
+	if db == nil {
+		panic(fmt.Errorf("TodoDBMgr init need db"))
+	}
+	return &_TodoDBMgr{db: db, from: "todos"}
+}
+
+func (m *_TodoDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*Todo, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_TodoDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	conditions := []string{where, orderby, limit}
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
+}
+
+func (m *_TodoDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Todo, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_TodoDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
+		strings.Join(obj.GetColumns(), ","),
+		m.from,
+		orm.SQLWhere(conditions),
+		orderby,
+		orm.SQLOffsetLimit(offset, limit))
+
+	return m.FetchBySQLCtx(ctx, q, args...)
+}
+
+func (m *_TodoDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_TodoDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
+}
+
+func (m *_TodoDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_TodoDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
+}
+
+func (m *_TodoDBMgr) FetchBySQL(q string, args ...interface{}) (results []*Todo, err error) {
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_TodoDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*Todo, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Todo fetch error: %w", err)
+	}
+	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_TodoDBMgr) scan(rows *sql.Rows) (results []*Todo, err error) {
+
+	var Remark sql.NullString
+	var DueAt string
+	var CreatedAt string
+	var UpdatedAt int64
+
+	for rows.Next() {
+		var result Todo
+		err = rows.Scan(&(result.Id), &(result.OwnerId), &(result.Title), &(result.Done), &(result.Priority), &Remark, &(result.Budget), &(result.Attachment), &DueAt, &CreatedAt, &UpdatedAt, &(result.Version))
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		result.Remark = Remark.String
+
+		result.DueAt = orm.SQLiteTimeParse(DueAt)
+		result.CreatedAt = orm.SQLiteLocalTimeParse(CreatedAt)
+		result.UpdatedAt = time.Unix(UpdatedAt, 0)
+
+		results = append(results, &result)
+	}
+	if err = rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Todo fetch result error: %w", err)
+	}
+	return
+}
+func (m *_TodoDBMgr) Exist(pk PrimaryKey) (bool, error) {
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_TodoDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
+	if err != nil {
+		return false, err
+	}
+	return (c != 0), nil
+}
+
+// Deprecated: Use FetchByPrimaryKey instead.
+func (m *_TodoDBMgr) Fetch(pk PrimaryKey) (*Todo, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_TodoDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Todo", Key: pk.Key()}
+}
+
+// primary key
+func (m *_TodoDBMgr) FetchByPrimaryKey(id int64) (*Todo, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_TodoDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int64) (*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	pk := &IdOfTodoPK{
+		Id: id,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Todo", Key: pk.Key()}
+}
+
+func (m *_TodoDBMgr) FetchByPrimaryKeys(ids []int64) ([]*Todo, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), ids)
+}
+
+func (m *_TodoDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, ids []int64) ([]*Todo, error) {
+	size := len(ids)
+	if size == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, size)
+	for _, pk := range ids {
+		params = append(params, pk)
+	}
+	obj := TodoMgr.NewTodo()
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
+		strings.Repeat(",?", size-1))
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// indexes
+
+func (m *_TodoDBMgr) FindByOwnerId(ownerId int32, limit int, offset int) ([]*Todo, error) {
+	return m.FindByOwnerIdCtx(context.Background(), ownerId, limit, offset)
+}
+
+func (m *_TodoDBMgr) FindByOwnerIdCtx(ctx context.Context, ownerId int32, limit int, offset int) ([]*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	idx := &OwnerIdOfTodoIDX{
+		OwnerId: ownerId,
+		limit:   limit,
+		offset:  offset,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_TodoDBMgr) FindAllByOwnerId(ownerId int32) ([]*Todo, error) {
+	return m.FindAllByOwnerIdCtx(context.Background(), ownerId)
+}
+
+func (m *_TodoDBMgr) FindAllByOwnerIdCtx(ctx context.Context, ownerId int32) ([]*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	idx := &OwnerIdOfTodoIDX{
+		OwnerId: ownerId,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
+}
+
+func (m *_TodoDBMgr) FindByOwnerIdGroup(items []int32) ([]*Todo, error) {
+	return m.FindByOwnerIdGroupCtx(context.Background(), items)
+}
+
+func (m *_TodoDBMgr) FindByOwnerIdGroupCtx(ctx context.Context, items []int32) ([]*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	if len(items) == 0 {
+		return nil, nil
+	}
+	params := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		params = append(params, item)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s where owner_id in (?", strings.Join(obj.GetColumns(), ","), m.from) +
+		strings.Repeat(",?", len(items)-1) + ")"
+	return m.FetchBySQLCtx(ctx, query, params...)
+}
+
+// uniques
+
+func (m *_TodoDBMgr) FetchByTitle(title string) (*Todo, error) {
+	return m.FetchByTitleCtx(context.Background(), title)
+}
+
+func (m *_TodoDBMgr) FetchByTitleCtx(ctx context.Context, title string) (*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	uniq := &TitleOfTodoUK{
+		Title: title,
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, uniq.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, uniq.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Todo", Key: uniq.Key()}
+}
+
+func (m *_TodoDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_TodoDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Todo", Key: unique.Key()}
+}
+
+// Deprecated: Use FetchByXXXUnique instead.
+func (m *_TodoDBMgr) FindOneFetch(unique Unique) (*Todo, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_TodoDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*Todo, error) {
+	obj := TodoMgr.NewTodo()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) > 0 {
+		return objs[0], nil
+	}
+	return nil, &orm.NotFoundError{Object: "Todo", Key: unique.Key()}
+}
+
+// Deprecated: Use FindByXXXUnique instead.
+func (m *_TodoDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_TodoDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_TodoDBMgr) FindFetch(index Index) (int64, []*Todo, error) {
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_TodoDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*Todo, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+
+	obj := TodoMgr.NewTodo()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_TodoDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_TodoDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	return total, pks, err
+}
+
+func (m *_TodoDBMgr) RangeFetch(scope Range) (int64, []*Todo, error) {
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_TodoDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*Todo, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	obj := TodoMgr.NewTodo()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
+	if err != nil {
+		return total, nil, err
+	}
+	return total, results, nil
+}
+
+func (m *_TodoDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_TodoDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	scope.Revert(true)
+	return m.RangeCtx(ctx, scope)
+}
+
+func (m *_TodoDBMgr) RangeRevertFetch(scope Range) (int64, []*Todo, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_TodoDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*Todo, error) {
+	scope.Revert(true)
+	return m.RangeFetchCtx(ctx, scope)
+}
+
+func (m *_TodoDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+	pk := TodoMgr.NewPrimaryKey()
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Todo query limit error: %w", err)
+	}
+	defer rows.Close()
+
+	offset := 0
+
+	for rows.Next() {
+		if limit >= 0 && offset >= limit {
+			break
+		}
+		offset++
+
+		result := TodoMgr.NewPrimaryKey()
+		err = rows.Scan(&(result.Id))
+		if err != nil {
+			m.db.SetError(err)
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		m.db.SetError(err)
+		return nil, fmt.Errorf("Todo query limit result error: %w", err)
+	}
+	return
+}
+
+func (m *_TodoDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(id) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("Todo query count error: %w", err)
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		if err = rows.Scan(&count); err != nil {
+			m.db.SetError(err)
+			return 0, err
+		}
+		break
+	}
+	return count, nil
+}
+
+func (obj *Todo) SetOwnerId(val int32) *Todo {
+	obj.OwnerId = val
+	obj.dirty.Mark(TodoColumns.OwnerId)
+	return obj
+}
+
+func (obj *Todo) SetTitle(val string) *Todo {
+	obj.Title = val
+	obj.dirty.Mark(TodoColumns.Title)
+	return obj
+}
+
+func (obj *Todo) SetDone(val bool) *Todo {
+	obj.Done = val
+	obj.dirty.Mark(TodoColumns.Done)
+	return obj
+}
+
+func (obj *Todo) SetPriority(val int32) *Todo {
+	obj.Priority = val
+	obj.dirty.Mark(TodoColumns.Priority)
+	return obj
+}
+
+func (obj *Todo) SetRemark(val string) *Todo {
+	obj.Remark = val
+	obj.dirty.Mark(TodoColumns.Remark)
+	return obj
+}
+
+func (obj *Todo) SetBudget(val orm.Decimal) *Todo {
+	obj.Budget = val
+	obj.dirty.Mark(TodoColumns.Budget)
+	return obj
+}
+
+func (obj *Todo) SetAttachment(val []byte) *Todo {
+	obj.Attachment = val
+	obj.dirty.Mark(TodoColumns.Attachment)
+	return obj
+}
+
+func (obj *Todo) SetDueAt(val time.Time) *Todo {
+	obj.DueAt = val
+	obj.dirty.Mark(TodoColumns.DueAt)
+	return obj
+}
+
+func (obj *Todo) SetCreatedAt(val time.Time) *Todo {
+	obj.CreatedAt = val
+	obj.dirty.Mark(TodoColumns.CreatedAt)
+	return obj
+}
+
+func (obj *Todo) SetUpdatedAt(val time.Time) *Todo {
+	obj.UpdatedAt = val
+	obj.dirty.Mark(TodoColumns.UpdatedAt)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *Todo) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
+func (m *_TodoDBMgr) BatchCreate(objs []*Todo) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_TodoDBMgr) BatchCreateCtx(ctx context.Context, objs []*Todo) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+	now := orm.Now()
+	for _, obj := range objs {
+		obj.touch(now, true)
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO todos(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_TodoDBMgr) beforeWrite(obj *Todo, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_TodoDBMgr) hook(obj *Todo, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_TodoDBMgr) batchValues(objs []*Todo, withIncrement bool) (string, []interface{}) {
+	size := 11
+	if withIncrement {
+		size = 12
+	}
+	params := make([]string, 0, len(objs))
+	values := make([]interface{}, 0, len(objs)*size)
+	for _, obj := range objs {
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
+		if withIncrement {
+			values = append(values, obj.Id)
+		}
+		values = append(values, obj.OwnerId)
+		values = append(values, obj.Title)
+		values = append(values, obj.Done)
+		values = append(values, obj.Priority)
+		values = append(values, obj.Remark)
+		values = append(values, obj.Budget.Round(2))
+		values = append(values, obj.Attachment)
+		values = append(values, orm.SQLiteTimeFormat(obj.DueAt))
+		values = append(values, orm.TimeToLocalTime(obj.CreatedAt))
+		values = append(values, obj.UpdatedAt.Unix())
+		values = append(values, obj.Version)
+	}
+	return strings.Join(params, ","), values
+}
+
+// argument example:
+// set:"a=?, b=?"
+// where:"c=? and d=?"
+// params:[]interface{}{"a", "b", "c", "d"}...
+func (m *_TodoDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_TodoDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("UPDATE todos SET %s", set)
+	if where != "" {
+		query = fmt.Sprintf("UPDATE todos SET %s WHERE %s", set, where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_TodoDBMgr) Create(obj *Todo) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_TodoDBMgr) CreateCtx(ctx context.Context, obj *Todo) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_TodoDBMgr) create(ctx context.Context, obj *Todo) (int64, error) {
+	obj.touch(orm.Now(), true)
+	params := orm.NewStringSlice(11, "?")
+	q := fmt.Sprintf("INSERT INTO todos(%s) VALUES(%s)",
+		strings.Join(obj.GetNoneIncrementColumns(), ","),
+		strings.Join(params, ","))
+
+	values := make([]interface{}, 0, 12)
+	values = append(values, obj.OwnerId)
+	values = append(values, obj.Title)
+	values = append(values, obj.Done)
+	values = append(values, obj.Priority)
+	values = append(values, obj.Remark)
+	values = append(values, obj.Budget.Round(2))
+	values = append(values, obj.Attachment)
+	values = append(values, orm.SQLiteTimeFormat(obj.DueAt))
+	values = append(values, orm.TimeToLocalTime(obj.CreatedAt))
+	values = append(values, obj.UpdatedAt.Unix())
+	values = append(values, obj.Version)
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	lastInsertId, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	obj.Id = int64(lastInsertId)
+	return result.RowsAffected()
+}
+
+func (m *_TodoDBMgr) Update(obj *Todo) (int64, error) {
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_TodoDBMgr) UpdateCtx(ctx context.Context, obj *Todo) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_TodoDBMgr) UpdateAll(obj *Todo) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_TodoDBMgr) UpdateAllCtx(ctx context.Context, obj *Todo) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		TodoColumns.OwnerId,
+		TodoColumns.Title,
+		TodoColumns.Done,
+		TodoColumns.Priority,
+		TodoColumns.Remark,
+		TodoColumns.Budget,
+		TodoColumns.Attachment,
+		TodoColumns.DueAt,
+	)
+}
+
+func (m *_TodoDBMgr) UpdateFields(obj *Todo, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of TodoColumns.
+// The row is only written at the version of obj, which is bumped, and
+// ConflictError is returned when the stored version has moved on.
+func (m *_TodoDBMgr) UpdateFieldsCtx(ctx context.Context, obj *Todo, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_TodoDBMgr) updateFields(ctx context.Context, obj *Todo, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "owner_id":
+			set.Add(column, obj.OwnerId)
+		case "title":
+			set.Add(column, obj.Title)
+		case "done":
+			set.Add(column, obj.Done)
+		case "priority":
+			set.Add(column, obj.Priority)
+		case "remark":
+			set.Add(column, obj.Remark)
+		case "budget":
+			set.Add(column, obj.Budget.Round(2))
+		case "attachment":
+			set.Add(column, obj.Attachment)
+		case "due_at":
+			set.Add(column, orm.SQLiteTimeFormat(obj.DueAt))
+		case "created_at":
+			set.Add(column, orm.TimeToLocalTime(obj.CreatedAt))
+		case "updated_at":
+			//! stamped by each update
+		case "version":
+			//! bumped by each update
+		default:
+			return 0, fmt.Errorf("Todo has no column %s to update", column)
+		}
+	}
+	obj.touch(orm.Now(), false)
+	set.Add("updated_at", obj.UpdatedAt.Unix())
+	set.Add("version", obj.Version+1)
+	sets, values, err := sqlbuilder.SQLite.BuildArgs(set)
+	if err != nil {
+		return 0, err
+	}
+
+	pk := obj.GetPrimaryKey()
+	q := fmt.Sprintf("UPDATE todos SET %s %s AND version = ?", sets, pk.SQLFormat())
+	values = append(values, pk.SQLParams()...)
+	values = append(values, obj.Version)
+
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected == 0 {
+		return 0, &orm.ConflictError{Object: "Todo", Key: pk.Key(), Version: int64(obj.Version)}
+	}
+	obj.Version++
+	obj.dirty.Clean(columns...)
+	return affected, nil
+}
+
+func (m *_TodoDBMgr) Save(obj *Todo) (int64, error) {
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+// The creation time of obj is the stored one.
+// A stored row at another version is not written, ConflictError is returned
+// and the version of obj is kept.
+func (m *_TodoDBMgr) SaveCtx(ctx context.Context, obj *Todo) (int64, error) {
+	if obj.Id == 0 {
+		return m.CreateCtx(ctx, obj)
+	}
+	affected, failed, err := m.save(ctx, []*Todo{obj})
+	if err != nil {
+		return affected, err
+	}
+	if len(failed) > 0 {
+		return 0, failed[0]
+	}
+	return affected, nil
+}
+
+func (m *_TodoDBMgr) BatchUpsert(objs []*Todo) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_TodoDBMgr) BatchUpsertCtx(ctx context.Context, objs []*Todo) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*Todo, 0, len(objs))
+	upserts := make([]*Todo, 0, len(objs))
+	for _, obj := range objs {
+		if obj.Id == 0 {
+			creates = append(creates, obj)
+		} else {
+			upserts = append(upserts, obj)
+		}
+	}
+
+	var affected int64
+	if len(creates) > 0 {
+		n, err := m.BatchCreateCtx(ctx, creates)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_TodoDBMgr) save(ctx context.Context, objs []*Todo) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+// The creation times of objs are the stored ones, the update times are
+// stamped.
+// The rows are only updated at the versions of objs, which are bumped, the
+// objects of the stale rows keep their versions and fail with a ConflictError.
+func (m *_TodoDBMgr) upsert(ctx context.Context, objs []*Todo) ([]*Todo, orm.MultiError, error) {
+	columns := []string{
+		"id",
+		"owner_id",
+		"title",
+		"done",
+		"priority",
+		"remark",
+		"budget",
+		"attachment",
+		"due_at",
+		"created_at",
+		"updated_at",
+		"version",
+	}
+	now := orm.Now()
+	for _, obj := range objs {
+		obj.touch(now, true)
+	}
+	for _, obj := range objs {
+		obj.Version++
+	}
+	params, values := m.batchValues(objs, true)
+	updates := []string{
+		"owner_id = EXCLUDED.owner_id",
+		"title = EXCLUDED.title",
+		"done = EXCLUDED.done",
+		"priority = EXCLUDED.priority",
+		"remark = EXCLUDED.remark",
+		"budget = EXCLUDED.budget",
+		"attachment = EXCLUDED.attachment",
+		"due_at = EXCLUDED.due_at",
+		"updated_at = EXCLUDED.updated_at",
+		"version = EXCLUDED.version",
+	}
+	action := "UPDATE SET " + strings.Join(updates, ",")
+	action += " WHERE todos.version = EXCLUDED.version - 1"
+	//! the rows written are returned, the stale ones are not
+	q := fmt.Sprintf("INSERT INTO todos(%s) VALUES %s ON CONFLICT (id) DO %s RETURNING %s",
+		strings.Join(columns, ","),
+		params,
+		action,
+		strings.Join(columns, ","))
+	rows, err := m.db.QueryContext(orm.WithPrimary(ctx), q, values...)
+	if err != nil {
+		for _, obj := range objs {
+			obj.Version--
+		}
+		return nil, nil, err
+	}
+	defer rows.Close()
+	//! the statement may only fail once its rows are read
+	results, err := m.scan(rows)
+	if err != nil {
+		for _, obj := range objs {
+			obj.Version--
+		}
+		return nil, nil, orm.TranslateError(err)
+	}
+	stored := make(map[string]*Todo, len(results))
+	for _, row := range results {
+		stored[row.GetPrimaryKey().Key()] = row
+	}
+
+	var failed orm.MultiError
+	written := make([]*Todo, 0, len(objs))
+	for _, obj := range objs {
+		pk := obj.GetPrimaryKey()
+		row := stored[pk.Key()]
+		if row == nil {
+			obj.Version--
+			failed = append(failed, &orm.ConflictError{Object: "Todo", Key: pk.Key(), Version: int64(obj.Version)})
+			continue
+		}
+		obj.CreatedAt = row.CreatedAt
+		written = append(written, obj)
+	}
+	return written, failed, nil
+}
+
+func (m *_TodoDBMgr) Delete(obj *Todo) (int64, error) {
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_TodoDBMgr) DeleteCtx(ctx context.Context, obj *Todo) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.Id)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
+}
+
+func (m *_TodoDBMgr) DeleteByPrimaryKey(id int64) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_TodoDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, id int64) (int64, error) {
+	pk := &IdOfTodoPK{
+		Id: id,
+	}
+	q := fmt.Sprintf("DELETE FROM todos %s", pk.SQLFormat())
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (m *_TodoDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_TodoDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM todos")
+	if where != "" {
+		query = fmt.Sprintf("DELETE FROM todos WHERE %s", where)
+	}
+	result, err := m.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -1,27 +1,32 @@
 package model
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
 	"gopkg.in/go-playground/validator.v9"
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
 	_ orm.VSet
 	_ validator.Validate
+	_ sqlbuilder.Builder
 )
 
 type UserBlogs struct {
 	UserId int32 `db:"user_id"`
 	BlogId int32 `db:"blog_id"`
+	dirty  orm.Dirty
 }
 
 var UserBlogsColumns = struct {
@@ -291,7 +296,8 @@ func (u *UserIdBlogIdOfUserBlogsRNG) RNGRelation(store *orm.RedisStore) RangeRel
 }
 
 type _UserBlogsDBMgr struct {
-	db orm.DB
+	db   orm.DB
+	from string
 }
 
 func (m *_UserBlogsMgr) DB(db orm.DB) *_UserBlogsDBMgr {
@@ -302,41 +308,67 @@ func UserBlogsDBMgr(db orm.DB) *_UserBlogsDBMgr {
 	if db == nil {
 		panic(fmt.Errorf("UserBlogsDBMgr init need db"))
 	}
-	return &_UserBlogsDBMgr{db: db}
+	return &_UserBlogsDBMgr{db: db, from: "user_blogs"}
 }
 
 func (m *_UserBlogsDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*UserBlogs, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_UserBlogsDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*UserBlogs, error) {
 	obj := UserBlogsMgr.NewUserBlogs()
 	conditions := []string{where, orderby, limit}
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(obj.GetColumns(), ","), strings.Join(conditions, " "))
-	return m.FetchBySQL(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
 }
 
 func (m *_UserBlogsDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*UserBlogs, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_UserBlogsDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*UserBlogs, error) {
 	obj := UserBlogsMgr.NewUserBlogs()
-	q := fmt.Sprintf("SELECT %s FROM user_blogs %s %s %s",
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
 		strings.Join(obj.GetColumns(), ","),
+		m.from,
 		orm.SQLWhere(conditions),
 		orderby,
 		orm.SQLOffsetLimit(offset, limit))
 
-	return m.FetchBySQL(q, args...)
+	return m.FetchBySQLCtx(ctx, q, args...)
 }
 
 func (m *_UserBlogsDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
-	return m.queryCount(where, args...)
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_UserBlogsDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
 }
 
 func (m *_UserBlogsDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
-	return m.queryCount(orm.SQLWhere(conditions), args...)
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_UserBlogsDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
 }
 
 func (m *_UserBlogsDBMgr) FetchBySQL(q string, args ...interface{}) (results []*UserBlogs, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_UserBlogsDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*UserBlogs, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserBlogs fetch error: %v", err)
+		return nil, fmt.Errorf("UserBlogs fetch error: %w", err)
 	}
 	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_UserBlogsDBMgr) scan(rows *sql.Rows) (results []*UserBlogs, err error) {
 
 	for rows.Next() {
 		var result UserBlogs
@@ -350,12 +382,16 @@ func (m *_UserBlogsDBMgr) FetchBySQL(q string, args ...interface{}) (results []*
 	}
 	if err = rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("UserBlogs fetch result error: %v", err)
+		return nil, fmt.Errorf("UserBlogs fetch result error: %w", err)
 	}
 	return
 }
 func (m *_UserBlogsDBMgr) Exist(pk PrimaryKey) (bool, error) {
-	c, err := m.queryCount(pk.SQLFormat(), pk.SQLParams()...)
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_UserBlogsDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
 	if err != nil {
 		return false, err
 	}
@@ -364,35 +400,43 @@ func (m *_UserBlogsDBMgr) Exist(pk PrimaryKey) (bool, error) {
 
 // Deprecated: Use FetchByPrimaryKey instead.
 func (m *_UserBlogsDBMgr) Fetch(pk PrimaryKey) (*UserBlogs, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_UserBlogsDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*UserBlogs, error) {
 	obj := UserBlogsMgr.NewUserBlogs()
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBlogs fetch record not found")
+	return nil, &orm.NotFoundError{Object: "UserBlogs", Key: pk.Key()}
 }
 
 // primary key
 func (m *_UserBlogsDBMgr) FetchByPrimaryKey(userId int32, blogId int32) (*UserBlogs, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), userId, blogId)
+}
+
+func (m *_UserBlogsDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, userId int32, blogId int32) (*UserBlogs, error) {
 	obj := UserBlogsMgr.NewUserBlogs()
 	pk := &UserIdBlogIdOfUserBlogsPK{
 		UserId: userId,
 		BlogId: blogId,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBlogs fetch record not found")
+	return nil, &orm.NotFoundError{Object: "UserBlogs", Key: pk.Key()}
 }
 
 // indexes
@@ -400,49 +444,65 @@ func (m *_UserBlogsDBMgr) FetchByPrimaryKey(userId int32, blogId int32) (*UserBl
 // uniques
 
 func (m *_UserBlogsDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
-	objs, err := m.queryLimit(unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_UserBlogsDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBlogs find record not found")
+	return nil, &orm.NotFoundError{Object: "UserBlogs", Key: unique.Key()}
 }
 
 // Deprecated: Use FetchByXXXUnique instead.
 func (m *_UserBlogsDBMgr) FindOneFetch(unique Unique) (*UserBlogs, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_UserBlogsDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*UserBlogs, error) {
 	obj := UserBlogsMgr.NewUserBlogs()
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(obj.GetColumns(), ","), unique.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, unique.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("none record")
+	return nil, &orm.NotFoundError{Object: "UserBlogs", Key: unique.Key()}
 }
 
 // Deprecated: Use FindByXXXUnique instead.
 func (m *_UserBlogsDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_UserBlogsDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_UserBlogsDBMgr) FindFetch(index Index) (int64, []*UserBlogs, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_UserBlogsDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*UserBlogs, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 
 	obj := UserBlogsMgr.NewUserBlogs()
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(obj.GetColumns(), ","), index.SQLFormat(true))
-	results, err := m.FetchBySQL(query, index.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -450,22 +510,30 @@ func (m *_UserBlogsDBMgr) FindFetch(index Index) (int64, []*UserBlogs, error) {
 }
 
 func (m *_UserBlogsDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_UserBlogsDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_UserBlogsDBMgr) RangeFetch(scope Range) (int64, []*UserBlogs, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_UserBlogsDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*UserBlogs, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 	obj := UserBlogsMgr.NewUserBlogs()
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(obj.GetColumns(), ","), scope.SQLFormat(true))
-	results, err := m.FetchBySQL(query, scope.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -473,21 +541,29 @@ func (m *_UserBlogsDBMgr) RangeFetch(scope Range) (int64, []*UserBlogs, error) {
 }
 
 func (m *_UserBlogsDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_UserBlogsDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
 	scope.Revert(true)
-	return m.Range(scope)
+	return m.RangeCtx(ctx, scope)
 }
 
 func (m *_UserBlogsDBMgr) RangeRevertFetch(scope Range) (int64, []*UserBlogs, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_UserBlogsDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*UserBlogs, error) {
 	scope.Revert(true)
-	return m.RangeFetch(scope)
+	return m.RangeFetchCtx(ctx, scope)
 }
 
-func (m *_UserBlogsDBMgr) queryLimit(where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+func (m *_UserBlogsDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
 	pk := UserBlogsMgr.NewPrimaryKey()
-	query := fmt.Sprintf("SELECT %s FROM user_blogs %s", strings.Join(pk.Columns(), ","), where)
-	rows, err := m.db.Query(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserBlogs query limit error: %v", err)
+		return nil, fmt.Errorf("UserBlogs query limit error: %w", err)
 	}
 	defer rows.Close()
 
@@ -510,16 +586,16 @@ func (m *_UserBlogsDBMgr) queryLimit(where string, limit int, args ...interface{
 	}
 	if err := rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("UserBlogs query limit result error: %v", err)
+		return nil, fmt.Errorf("UserBlogs query limit result error: %w", err)
 	}
 	return
 }
 
-func (m *_UserBlogsDBMgr) queryCount(where string, args ...interface{}) (int64, error) {
-	query := fmt.Sprintf("SELECT count(`user_id`) FROM user_blogs %s", where)
-	rows, err := m.db.Query(query, args...)
+func (m *_UserBlogsDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(`user_id`) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("UserBlogs query count error: %v", err)
+		return 0, fmt.Errorf("UserBlogs query count error: %w", err)
 	}
 	defer rows.Close()
 
@@ -534,24 +610,78 @@ func (m *_UserBlogsDBMgr) queryCount(where string, args ...interface{}) (int64,
 	return count, nil
 }
 
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *UserBlogs) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
 func (m *_UserBlogsDBMgr) BatchCreate(objs []*UserBlogs) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_UserBlogsDBMgr) BatchCreateCtx(ctx context.Context, objs []*UserBlogs) (int64, error) {
 	if len(objs) == 0 {
 		return 0, nil
 	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
 
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO user_blogs(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_UserBlogsDBMgr) beforeWrite(obj *UserBlogs, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_UserBlogsDBMgr) hook(obj *UserBlogs, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_UserBlogsDBMgr) batchValues(objs []*UserBlogs, withIncrement bool) (string, []interface{}) {
+	size := 2
+	if withIncrement {
+		size = 2
+	}
 	params := make([]string, 0, len(objs))
-	values := make([]interface{}, 0, len(objs)*2)
+	values := make([]interface{}, 0, len(objs)*size)
 	for _, obj := range objs {
-		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(2, "?"), ",")))
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
 		values = append(values, obj.UserId)
 		values = append(values, obj.BlogId)
 	}
-	query := fmt.Sprintf("INSERT INTO user_blogs(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), strings.Join(params, ","))
-	result, err := m.db.Exec(query, values...)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
+	return strings.Join(params, ","), values
 }
 
 // argument example:
@@ -559,11 +689,15 @@ func (m *_UserBlogsDBMgr) BatchCreate(objs []*UserBlogs) (int64, error) {
 // where:"c=? and d=?"
 // params:[]interface{}{"a", "b", "c", "d"}...
 func (m *_UserBlogsDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_UserBlogsDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("UPDATE user_blogs SET %s", set)
 	if where != "" {
 		query = fmt.Sprintf("UPDATE user_blogs SET %s WHERE %s", set, where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -571,6 +705,22 @@ func (m *_UserBlogsDBMgr) UpdateBySQL(set, where string, args ...interface{}) (i
 }
 
 func (m *_UserBlogsDBMgr) Create(obj *UserBlogs) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_UserBlogsDBMgr) CreateCtx(ctx context.Context, obj *UserBlogs) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_UserBlogsDBMgr) create(ctx context.Context, obj *UserBlogs) (int64, error) {
 	params := orm.NewStringSlice(2, "?")
 	q := fmt.Sprintf("INSERT INTO user_blogs(%s) VALUES(%s)",
 		strings.Join(obj.GetNoneIncrementColumns(), ","),
@@ -579,7 +729,7 @@ func (m *_UserBlogsDBMgr) Create(obj *UserBlogs) (int64, error) {
 	values := make([]interface{}, 0, 2)
 	values = append(values, obj.UserId)
 	values = append(values, obj.BlogId)
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
@@ -587,42 +737,250 @@ func (m *_UserBlogsDBMgr) Create(obj *UserBlogs) (int64, error) {
 }
 
 func (m *_UserBlogsDBMgr) Update(obj *UserBlogs) (int64, error) {
-	columns := []string{}
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_UserBlogsDBMgr) UpdateCtx(ctx context.Context, obj *UserBlogs) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
+	}
+	return m.UpdateAllCtx(ctx, obj)
+}
+
+func (m *_UserBlogsDBMgr) UpdateAll(obj *UserBlogs) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_UserBlogsDBMgr) UpdateAllCtx(ctx context.Context, obj *UserBlogs) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj)
+}
+
+func (m *_UserBlogsDBMgr) UpdateFields(obj *UserBlogs, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of UserBlogsColumns.
+func (m *_UserBlogsDBMgr) UpdateFieldsCtx(ctx context.Context, obj *UserBlogs, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_UserBlogsDBMgr) updateFields(ctx context.Context, obj *UserBlogs, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		default:
+			return 0, fmt.Errorf("UserBlogs has no column %s to update", column)
+		}
+	}
+	sets, values, err := sqlbuilder.MySQL.BuildArgs(set)
+	if err != nil {
+		return 0, err
+	}
 
 	pk := obj.GetPrimaryKey()
-	q := fmt.Sprintf("UPDATE user_blogs SET %s %s", strings.Join(columns, ","), pk.SQLFormat())
-	values := make([]interface{}, 0, 2-2)
+	q := fmt.Sprintf("UPDATE user_blogs SET %s %s", sets, pk.SQLFormat())
 	values = append(values, pk.SQLParams()...)
 
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
+	obj.dirty.Clean(columns...)
 	return result.RowsAffected()
 }
 
 func (m *_UserBlogsDBMgr) Save(obj *UserBlogs) (int64, error) {
-	affected, err := m.Update(obj)
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+func (m *_UserBlogsDBMgr) SaveCtx(ctx context.Context, obj *UserBlogs) (int64, error) {
+	affected, failed, err := m.save(ctx, []*UserBlogs{obj})
 	if err != nil {
 		return affected, err
 	}
-	if affected == 0 {
-		return m.Create(obj)
+	if len(failed) > 0 {
+		return 0, failed[0]
+	}
+	return affected, nil
+}
+
+func (m *_UserBlogsDBMgr) BatchUpsert(objs []*UserBlogs) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_UserBlogsDBMgr) BatchUpsertCtx(ctx context.Context, objs []*UserBlogs) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+	upserts := objs
+
+	var affected int64
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_UserBlogsDBMgr) save(ctx context.Context, objs []*UserBlogs) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
 	}
-	return affected, err
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+func (m *_UserBlogsDBMgr) upsert(ctx context.Context, objs []*UserBlogs) ([]*UserBlogs, orm.MultiError, error) {
+	columns := []string{
+		"`user_id`",
+		"`blog_id`",
+	}
+	params, values := m.batchValues(objs, true)
+	//! ON DUPLICATE KEY fires on any unique key, the columns are only assigned
+	//! when the duplicate is the row of the primary key
+	guard := "`user_id` = VALUES(`user_id`) AND `blog_id` = VALUES(`blog_id`)"
+	updates := make([]string, 0, 2)
+	for _, column := range []string{
+		"`user_id`",
+		"`blog_id`",
+	} {
+		updates = append(updates, fmt.Sprintf("%s = IF(%s, VALUES(%s), %s)", column, guard, column, column))
+	}
+	q := fmt.Sprintf("INSERT INTO user_blogs(%s) VALUES %s ON DUPLICATE KEY UPDATE %s",
+		strings.Join(columns, ","),
+		params,
+		strings.Join(updates, ","))
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return nil, nil, err
+	}
+	//! mysql counts 1 for each inserted row, 2 for each updated row and 0 for
+	//! each row left as it was, the rows are read back unless the count tells
+	//! that every obj was written
+	if n == 2*int64(len(objs)) || (n == 1 && len(objs) == 1) {
+		return objs, nil, nil
+	}
+	stored, err := m.stored(ctx, objs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var failed orm.MultiError
+	written := make([]*UserBlogs, 0, len(objs))
+	for _, obj := range objs {
+		pk := obj.GetPrimaryKey()
+		row := stored[pk.Key()]
+		if row == nil {
+			//! neither inserted nor the row of its primary key, a unique key
+			//! of obj is taken by another row
+			failed = append(failed, &orm.DuplicateKeyError{Err: fmt.Errorf("UserBlogs %s has the unique key of another row", pk.Key())})
+			continue
+		}
+		written = append(written, obj)
+	}
+	return written, failed, nil
+}
+
+// stored reads the rows of the primary keys of objs from the primary, the
+// soft deleted ones included, by the keys of their primary keys.
+func (m *_UserBlogsDBMgr) stored(ctx context.Context, objs []*UserBlogs) (map[string]*UserBlogs, error) {
+	conditions := make([]string, 0, len(objs))
+	params := make([]interface{}, 0, len(objs)*2)
+	for _, obj := range objs {
+		conditions = append(conditions, "(`user_id` = ? AND `blog_id` = ?)")
+		params = append(params, obj.GetPrimaryKey().SQLParams()...)
+	}
+	query := fmt.Sprintf("SELECT %s FROM user_blogs WHERE %s", strings.Join(objs[0].GetColumns(), ","), strings.Join(conditions, " OR "))
+	rows, err := m.FetchBySQLCtx(orm.WithPrimary(ctx), query, params...)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*UserBlogs, len(rows))
+	for _, row := range rows {
+		stored[row.GetPrimaryKey().Key()] = row
+	}
+	return stored, nil
 }
 
 func (m *_UserBlogsDBMgr) Delete(obj *UserBlogs) (int64, error) {
-	return m.DeleteByPrimaryKey(obj.UserId, obj.BlogId)
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_UserBlogsDBMgr) DeleteCtx(ctx context.Context, obj *UserBlogs) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.UserId, obj.BlogId)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
 }
 
 func (m *_UserBlogsDBMgr) DeleteByPrimaryKey(userId int32, blogId int32) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), userId, blogId)
+}
+
+func (m *_UserBlogsDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, userId int32, blogId int32) (int64, error) {
 	pk := &UserIdBlogIdOfUserBlogsPK{
 		UserId: userId,
 		BlogId: blogId,
 	}
 	q := fmt.Sprintf("DELETE FROM user_blogs %s", pk.SQLFormat())
-	result, err := m.db.Exec(q, pk.SQLParams()...)
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
 	if err != nil {
 		return 0, err
 	}
@@ -630,11 +988,14 @@ func (m *_UserBlogsDBMgr) DeleteByPrimaryKey(userId int32, blogId int32) (int64,
 }
 
 func (m *_UserBlogsDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_UserBlogsDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("DELETE FROM user_blogs")
 	if where != "" {
 		query = fmt.Sprintf("DELETE FROM user_blogs WHERE %s", where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -1,24 +1,27 @@
 package model
 
 import (
+	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
 	"gopkg.in/go-playground/validator.v9"
 	redis "gopkg.in/redis.v5"
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
 	_ orm.VSet
 	_ validator.Validate
+	_ sqlbuilder.Builder
 )
 
 type User struct {
@@ -36,6 +39,7 @@ type User struct {
 	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
 	DeletedAt   *time.Time `db:"deleted_at" json:"deleted_at"`
+	dirty       orm.Dirty
 }
 
 var UserColumns = struct {
@@ -577,7 +581,8 @@ func (u *AgeOfUserRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
 }
 
 type _UserDBMgr struct {
-	db orm.DB
+	db   orm.DB
+	from string
 }
 
 func (m *_UserMgr) DB(db orm.DB) *_UserDBMgr {
@@ -588,41 +593,67 @@ func UserDBMgr(db orm.DB) *_UserDBMgr {
 	if db == nil {
 		panic(fmt.Errorf("UserDBMgr init need db"))
 	}
-	return &_UserDBMgr{db: db}
+	return &_UserDBMgr{db: db, from: "users"}
 }
 
 func (m *_UserDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*User, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_UserDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*User, error) {
 	obj := UserMgr.NewUser()
 	conditions := []string{where, orderby, limit}
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), strings.Join(conditions, " "))
-	return m.FetchBySQL(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
 }
 
 func (m *_UserDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*User, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_UserDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*User, error) {
 	obj := UserMgr.NewUser()
-	q := fmt.Sprintf("SELECT %s FROM users %s %s %s",
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
 		strings.Join(obj.GetColumns(), ","),
+		m.from,
 		orm.SQLWhere(conditions),
 		orderby,
 		orm.SQLOffsetLimit(offset, limit))
 
-	return m.FetchBySQL(q, args...)
+	return m.FetchBySQLCtx(ctx, q, args...)
 }
 
 func (m *_UserDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
-	return m.queryCount(where, args...)
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_UserDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
 }
 
 func (m *_UserDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
-	return m.queryCount(orm.SQLWhere(conditions), args...)
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_UserDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
 }
 
 func (m *_UserDBMgr) FetchBySQL(q string, args ...interface{}) (results []*User, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_UserDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*User, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("User fetch error: %v", err)
+		return nil, fmt.Errorf("User fetch error: %w", err)
 	}
 	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_UserDBMgr) scan(rows *sql.Rows) (results []*User, err error) {
 
 	var Description sql.NullString
 	var HeadUrl sql.NullString
@@ -657,12 +688,16 @@ func (m *_UserDBMgr) FetchBySQL(q string, args ...interface{}) (results []*User,
 	}
 	if err = rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("User fetch result error: %v", err)
+		return nil, fmt.Errorf("User fetch result error: %w", err)
 	}
 	return
 }
 func (m *_UserDBMgr) Exist(pk PrimaryKey) (bool, error) {
-	c, err := m.queryCount(pk.SQLFormat(), pk.SQLParams()...)
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_UserDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
 	if err != nil {
 		return false, err
 	}
@@ -671,37 +706,49 @@ func (m *_UserDBMgr) Exist(pk PrimaryKey) (bool, error) {
 
 // Deprecated: Use FetchByPrimaryKey instead.
 func (m *_UserDBMgr) Fetch(pk PrimaryKey) (*User, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_UserDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*User, error) {
 	obj := UserMgr.NewUser()
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("User fetch record not found")
+	return nil, &orm.NotFoundError{Object: "User", Key: pk.Key()}
 }
 
 // primary key
 func (m *_UserDBMgr) FetchByPrimaryKey(id int32) (*User, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_UserDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int32) (*User, error) {
 	obj := UserMgr.NewUser()
 	pk := &IdOfUserPK{
 		Id: id,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("User fetch record not found")
+	return nil, &orm.NotFoundError{Object: "User", Key: pk.Key()}
 }
 
 func (m *_UserDBMgr) FetchByPrimaryKeys(ids []int32) ([]*User, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), ids)
+}
+
+func (m *_UserDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, ids []int32) ([]*User, error) {
 	size := len(ids)
 	if size == 0 {
 		return nil, nil
@@ -711,14 +758,18 @@ func (m *_UserDBMgr) FetchByPrimaryKeys(ids []int32) ([]*User, error) {
 		params = append(params, pk)
 	}
 	obj := UserMgr.NewUser()
-	query := fmt.Sprintf("SELECT %s FROM users WHERE `id` IN (?%s)", strings.Join(obj.GetColumns(), ","),
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `id` IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
 		strings.Repeat(",?", size-1))
-	return m.FetchBySQL(query, params...)
+	return m.FetchBySQLCtx(ctx, query, params...)
 }
 
 // indexes
 
 func (m *_UserDBMgr) FindBySex(sex bool, limit int, offset int) ([]*User, error) {
+	return m.FindBySexCtx(context.Background(), sex, limit, offset)
+}
+
+func (m *_UserDBMgr) FindBySexCtx(ctx context.Context, sex bool, limit int, offset int) ([]*User, error) {
 	obj := UserMgr.NewUser()
 	idx := &SexOfUserIDX{
 		Sex:    sex,
@@ -726,21 +777,29 @@ func (m *_UserDBMgr) FindBySex(sex bool, limit int, offset int) ([]*User, error)
 		offset: offset,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), idx.SQLFormat(true))
-	return m.FetchBySQL(query, idx.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
 }
 
 func (m *_UserDBMgr) FindAllBySex(sex bool) ([]*User, error) {
+	return m.FindAllBySexCtx(context.Background(), sex)
+}
+
+func (m *_UserDBMgr) FindAllBySexCtx(ctx context.Context, sex bool) ([]*User, error) {
 	obj := UserMgr.NewUser()
 	idx := &SexOfUserIDX{
 		Sex: sex,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), idx.SQLFormat(true))
-	return m.FetchBySQL(query, idx.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
 }
 
 func (m *_UserDBMgr) FindBySexGroup(items []bool) ([]*User, error) {
+	return m.FindBySexGroupCtx(context.Background(), items)
+}
+
+func (m *_UserDBMgr) FindBySexGroupCtx(ctx context.Context, items []bool) ([]*User, error) {
 	obj := UserMgr.NewUser()
 	if len(items) == 0 {
 		return nil, nil
@@ -749,75 +808,95 @@ func (m *_UserDBMgr) FindBySexGroup(items []bool) ([]*User, error) {
 	for _, item := range items {
 		params = append(params, item)
 	}
-	query := fmt.Sprintf("SELECT %s FROM users where `sex` in (?", strings.Join(obj.GetColumns(), ",")) +
+	query := fmt.Sprintf("SELECT %s FROM %s where `sex` in (?", strings.Join(obj.GetColumns(), ","), m.from) +
 		strings.Repeat(",?", len(items)-1) + ")"
-	return m.FetchBySQL(query, params...)
+	return m.FetchBySQLCtx(ctx, query, params...)
 }
 
 // uniques
 
 func (m *_UserDBMgr) FetchByMailboxPassword(mailbox string, password string) (*User, error) {
+	return m.FetchByMailboxPasswordCtx(context.Background(), mailbox, password)
+}
+
+func (m *_UserDBMgr) FetchByMailboxPasswordCtx(ctx context.Context, mailbox string, password string) (*User, error) {
 	obj := UserMgr.NewUser()
 	uniq := &MailboxPasswordOfUserUK{
 		Mailbox:  mailbox,
 		Password: password,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), uniq.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, uniq.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, uniq.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, uniq.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("User fetch record not found")
+	return nil, &orm.NotFoundError{Object: "User", Key: uniq.Key()}
 }
 
 func (m *_UserDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
-	objs, err := m.queryLimit(unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_UserDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("User find record not found")
+	return nil, &orm.NotFoundError{Object: "User", Key: unique.Key()}
 }
 
 // Deprecated: Use FetchByXXXUnique instead.
 func (m *_UserDBMgr) FindOneFetch(unique Unique) (*User, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_UserDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*User, error) {
 	obj := UserMgr.NewUser()
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), unique.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, unique.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("none record")
+	return nil, &orm.NotFoundError{Object: "User", Key: unique.Key()}
 }
 
 // Deprecated: Use FindByXXXUnique instead.
 func (m *_UserDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_UserDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_UserDBMgr) FindFetch(index Index) (int64, []*User, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_UserDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*User, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 
 	obj := UserMgr.NewUser()
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), index.SQLFormat(true))
-	results, err := m.FetchBySQL(query, index.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -825,22 +904,30 @@ func (m *_UserDBMgr) FindFetch(index Index) (int64, []*User, error) {
 }
 
 func (m *_UserDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_UserDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_UserDBMgr) RangeFetch(scope Range) (int64, []*User, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_UserDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*User, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 	obj := UserMgr.NewUser()
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(obj.GetColumns(), ","), scope.SQLFormat(true))
-	results, err := m.FetchBySQL(query, scope.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -848,21 +935,29 @@ func (m *_UserDBMgr) RangeFetch(scope Range) (int64, []*User, error) {
 }
 
 func (m *_UserDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_UserDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
 	scope.Revert(true)
-	return m.Range(scope)
+	return m.RangeCtx(ctx, scope)
 }
 
 func (m *_UserDBMgr) RangeRevertFetch(scope Range) (int64, []*User, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_UserDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*User, error) {
 	scope.Revert(true)
-	return m.RangeFetch(scope)
+	return m.RangeFetchCtx(ctx, scope)
 }
 
-func (m *_UserDBMgr) queryLimit(where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+func (m *_UserDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
 	pk := UserMgr.NewPrimaryKey()
-	query := fmt.Sprintf("SELECT %s FROM users %s", strings.Join(pk.Columns(), ","), where)
-	rows, err := m.db.Query(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("User query limit error: %v", err)
+		return nil, fmt.Errorf("User query limit error: %w", err)
 	}
 	defer rows.Close()
 
@@ -885,16 +980,16 @@ func (m *_UserDBMgr) queryLimit(where string, limit int, args ...interface{}) (r
 	}
 	if err := rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("User query limit result error: %v", err)
+		return nil, fmt.Errorf("User query limit result error: %w", err)
 	}
 	return
 }
 
-func (m *_UserDBMgr) queryCount(where string, args ...interface{}) (int64, error) {
-	query := fmt.Sprintf("SELECT count(`id`) FROM users %s", where)
-	rows, err := m.db.Query(query, args...)
+func (m *_UserDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(`id`) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("User query count error: %v", err)
+		return 0, fmt.Errorf("User query count error: %w", err)
 	}
 	defer rows.Close()
 
@@ -909,15 +1004,155 @@ func (m *_UserDBMgr) queryCount(where string, args ...interface{}) (int64, error
 	return count, nil
 }
 
+func (obj *User) SetName(val string) *User {
+	obj.Name = val
+	obj.dirty.Mark(UserColumns.Name)
+	return obj
+}
+
+func (obj *User) SetMailbox(val string) *User {
+	obj.Mailbox = val
+	obj.dirty.Mark(UserColumns.Mailbox)
+	return obj
+}
+
+func (obj *User) SetSex(val bool) *User {
+	obj.Sex = val
+	obj.dirty.Mark(UserColumns.Sex)
+	return obj
+}
+
+func (obj *User) SetAge(val int32) *User {
+	obj.Age = val
+	obj.dirty.Mark(UserColumns.Age)
+	return obj
+}
+
+func (obj *User) SetLongitude(val float64) *User {
+	obj.Longitude = val
+	obj.dirty.Mark(UserColumns.Longitude)
+	return obj
+}
+
+func (obj *User) SetLatitude(val float64) *User {
+	obj.Latitude = val
+	obj.dirty.Mark(UserColumns.Latitude)
+	return obj
+}
+
+func (obj *User) SetDescription(val string) *User {
+	obj.Description = val
+	obj.dirty.Mark(UserColumns.Description)
+	return obj
+}
+
+func (obj *User) SetPassword(val string) *User {
+	obj.Password = val
+	obj.dirty.Mark(UserColumns.Password)
+	return obj
+}
+
+func (obj *User) SetHeadUrl(val string) *User {
+	obj.HeadUrl = val
+	obj.dirty.Mark(UserColumns.HeadUrl)
+	return obj
+}
+
+func (obj *User) SetStatus(val int32) *User {
+	obj.Status = val
+	obj.dirty.Mark(UserColumns.Status)
+	return obj
+}
+
+func (obj *User) SetCreatedAt(val time.Time) *User {
+	obj.CreatedAt = val
+	obj.dirty.Mark(UserColumns.CreatedAt)
+	return obj
+}
+
+func (obj *User) SetUpdatedAt(val time.Time) *User {
+	obj.UpdatedAt = val
+	obj.dirty.Mark(UserColumns.UpdatedAt)
+	return obj
+}
+
+func (obj *User) SetDeletedAt(val *time.Time) *User {
+	obj.DeletedAt = val
+	obj.dirty.Mark(UserColumns.DeletedAt)
+	return obj
+}
+
+// DirtyColumns returns the columns changed through the Set mutators and not
+// written since.
+func (obj *User) DirtyColumns() []string {
+	return obj.dirty.Columns()
+}
+
 func (m *_UserDBMgr) BatchCreate(objs []*User) (int64, error) {
+	return m.BatchCreateCtx(context.Background(), objs)
+}
+
+func (m *_UserDBMgr) BatchCreateCtx(ctx context.Context, objs []*User) (int64, error) {
 	if len(objs) == 0 {
 		return 0, nil
 	}
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+			return 0, err
+		}
+	}
+
+	params, values := m.batchValues(objs, false)
+	query := fmt.Sprintf("INSERT INTO users(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), params)
+	result, err := m.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterCreate); err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// beforeWrite runs the Before hooks of obj for a create, an update or a save, an error aborts the write.
+func (m *_UserDBMgr) beforeWrite(obj *User, callback orm.Callback) error {
+	if err := m.hook(obj, callback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// hook runs the callback of obj, an error marks the transaction of m for
+// rollback.
+func (m *_UserDBMgr) hook(obj *User, callback orm.Callback) error {
+	if err := callback(m.db, obj); err != nil {
+		m.db.SetError(err)
+		return err
+	}
+	return nil
+}
 
+// batchValues renders the multi-row VALUES of objs, the auto increment
+// column is only included when withIncrement is set.
+func (m *_UserDBMgr) batchValues(objs []*User, withIncrement bool) (string, []interface{}) {
+	size := 13
+	if withIncrement {
+		size = 14
+	}
 	params := make([]string, 0, len(objs))
-	values := make([]interface{}, 0, len(objs)*13)
+	values := make([]interface{}, 0, len(objs)*size)
 	for _, obj := range objs {
-		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(13, "?"), ",")))
+		params = append(params, fmt.Sprintf("(%s)", strings.Join(orm.NewStringSlice(size, "?"), ",")))
+		if withIncrement {
+			values = append(values, obj.Id)
+		}
 		values = append(values, obj.Name)
 		values = append(values, obj.Mailbox)
 		values = append(values, obj.Sex)
@@ -936,12 +1171,7 @@ func (m *_UserDBMgr) BatchCreate(objs []*User) (int64, error) {
 			values = append(values, obj.DeletedAt.Unix())
 		}
 	}
-	query := fmt.Sprintf("INSERT INTO users(%s) VALUES %s", strings.Join(objs[0].GetNoneIncrementColumns(), ","), strings.Join(params, ","))
-	result, err := m.db.Exec(query, values...)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
+	return strings.Join(params, ","), values
 }
 
 // argument example:
@@ -949,11 +1179,15 @@ func (m *_UserDBMgr) BatchCreate(objs []*User) (int64, error) {
 // where:"c=? and d=?"
 // params:[]interface{}{"a", "b", "c", "d"}...
 func (m *_UserDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64, error) {
+	return m.UpdateBySQLCtx(context.Background(), set, where, args...)
+}
+
+func (m *_UserDBMgr) UpdateBySQLCtx(ctx context.Context, set, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("UPDATE users SET %s", set)
 	if where != "" {
 		query = fmt.Sprintf("UPDATE users SET %s WHERE %s", set, where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -961,6 +1195,22 @@ func (m *_UserDBMgr) UpdateBySQL(set, where string, args ...interface{}) (int64,
 }
 
 func (m *_UserDBMgr) Create(obj *User) (int64, error) {
+	return m.CreateCtx(context.Background(), obj)
+}
+
+func (m *_UserDBMgr) CreateCtx(ctx context.Context, obj *User) (int64, error) {
+	if err := m.beforeWrite(obj, orm.BeforeCreate); err != nil {
+		return 0, err
+	}
+	affected, err := m.create(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	obj.dirty.Reset()
+	return affected, m.hook(obj, orm.AfterCreate)
+}
+
+func (m *_UserDBMgr) create(ctx context.Context, obj *User) (int64, error) {
 	params := orm.NewStringSlice(13, "?")
 	q := fmt.Sprintf("INSERT INTO users(%s) VALUES(%s)",
 		strings.Join(obj.GetNoneIncrementColumns(), ","),
@@ -984,7 +1234,7 @@ func (m *_UserDBMgr) Create(obj *User) (int64, error) {
 	} else {
 		values = append(values, obj.DeletedAt.Unix())
 	}
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
@@ -997,72 +1247,335 @@ func (m *_UserDBMgr) Create(obj *User) (int64, error) {
 }
 
 func (m *_UserDBMgr) Update(obj *User) (int64, error) {
-	columns := []string{
-		"`name` = ?",
-		"`mailbox` = ?",
-		"`sex` = ?",
-		"`age` = ?",
-		"`longitude` = ?",
-		"`latitude` = ?",
-		"`description` = ?",
-		"`password` = ?",
-		"`head_url` = ?",
-		"`status` = ?",
-		"`created_at` = ?",
-		"`updated_at` = ?",
-		"`deleted_at` = ?",
+	return m.UpdateCtx(context.Background(), obj)
+}
+
+// UpdateCtx writes the columns changed through the Set mutators of obj, so
+// the writers of other columns keep their changes, or all the columns when
+// none was changed that way. The fields assigned directly beside the Set
+// mutators are only written by UpdateAllCtx.
+func (m *_UserDBMgr) UpdateCtx(ctx context.Context, obj *User) (int64, error) {
+	if dirty := obj.dirty.Columns(); len(dirty) > 0 {
+		return m.UpdateFieldsCtx(ctx, obj, dirty...)
 	}
+	return m.UpdateAllCtx(ctx, obj)
+}
 
-	pk := obj.GetPrimaryKey()
-	q := fmt.Sprintf("UPDATE users SET %s %s", strings.Join(columns, ","), pk.SQLFormat())
-	values := make([]interface{}, 0, 14-1)
-	values = append(values, obj.Name)
-	values = append(values, obj.Mailbox)
-	values = append(values, obj.Sex)
-	values = append(values, obj.Age)
-	values = append(values, obj.Longitude)
-	values = append(values, obj.Latitude)
-	values = append(values, obj.Description)
-	values = append(values, obj.Password)
-	values = append(values, orm.Encode(obj.HeadUrl))
-	values = append(values, obj.Status)
-	values = append(values, obj.CreatedAt.Unix())
-	values = append(values, obj.UpdatedAt.Unix())
-	if obj.DeletedAt == nil {
-		values = append(values, nil)
-	} else {
-		values = append(values, obj.DeletedAt.Unix())
+func (m *_UserDBMgr) UpdateAll(obj *User) (int64, error) {
+	return m.UpdateAllCtx(context.Background(), obj)
+}
+
+// UpdateAllCtx writes all the columns of obj, changed through the Set
+// mutators or not.
+func (m *_UserDBMgr) UpdateAllCtx(ctx context.Context, obj *User) (int64, error) {
+	return m.UpdateFieldsCtx(ctx, obj,
+		UserColumns.Name,
+		UserColumns.Mailbox,
+		UserColumns.Sex,
+		UserColumns.Age,
+		UserColumns.Longitude,
+		UserColumns.Latitude,
+		UserColumns.Description,
+		UserColumns.Password,
+		UserColumns.HeadUrl,
+		UserColumns.Status,
+		UserColumns.CreatedAt,
+		UserColumns.UpdatedAt,
+		UserColumns.DeletedAt,
+	)
+}
+
+func (m *_UserDBMgr) UpdateFields(obj *User, columns ...string) (int64, error) {
+	return m.UpdateFieldsCtx(context.Background(), obj, columns...)
+}
+
+// UpdateFieldsCtx writes only the given columns of obj, the names are the
+// ones of UserColumns.
+func (m *_UserDBMgr) UpdateFieldsCtx(ctx context.Context, obj *User, columns ...string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	if err := m.beforeWrite(obj, orm.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	affected, err := m.updateFields(ctx, obj, columns)
+	if err != nil {
+		return 0, err
+	}
+	return affected, m.hook(obj, orm.AfterUpdate)
+}
+
+func (m *_UserDBMgr) updateFields(ctx context.Context, obj *User, columns []string) (int64, error) {
+
+	set := sqlbuilder.Set()
+	for _, column := range columns {
+		switch column {
+		case "name":
+			set.Add(column, obj.Name)
+		case "mailbox":
+			set.Add(column, obj.Mailbox)
+		case "sex":
+			set.Add(column, obj.Sex)
+		case "age":
+			set.Add(column, obj.Age)
+		case "longitude":
+			set.Add(column, obj.Longitude)
+		case "latitude":
+			set.Add(column, obj.Latitude)
+		case "description":
+			set.Add(column, obj.Description)
+		case "password":
+			set.Add(column, obj.Password)
+		case "head_url":
+			set.Add(column, orm.Encode(obj.HeadUrl))
+		case "status":
+			set.Add(column, obj.Status)
+		case "created_at":
+			set.Add(column, obj.CreatedAt.Unix())
+		case "updated_at":
+			set.Add(column, obj.UpdatedAt.Unix())
+		case "deleted_at":
+			if obj.DeletedAt == nil {
+				set.Add(column, nil)
+			} else {
+				set.Add(column, obj.DeletedAt.Unix())
+			}
+		default:
+			return 0, fmt.Errorf("User has no column %s to update", column)
+		}
 	}
+	sets, values, err := sqlbuilder.MySQL.BuildArgs(set)
+	if err != nil {
+		return 0, err
+	}
+
+	pk := obj.GetPrimaryKey()
+	q := fmt.Sprintf("UPDATE users SET %s %s", sets, pk.SQLFormat())
 	values = append(values, pk.SQLParams()...)
 
-	result, err := m.db.Exec(q, values...)
+	result, err := m.db.ExecContext(ctx, q, values...)
 	if err != nil {
 		return 0, err
 	}
+	obj.dirty.Clean(columns...)
 	return result.RowsAffected()
 }
 
 func (m *_UserDBMgr) Save(obj *User) (int64, error) {
-	affected, err := m.Update(obj)
+	return m.SaveCtx(context.Background(), obj)
+}
+
+// SaveCtx inserts obj or updates the row of its primary key in a single
+// statement, an object without its auto increment key is created instead.
+// The database picks the branch, so only the save hooks of obj run around it.
+func (m *_UserDBMgr) SaveCtx(ctx context.Context, obj *User) (int64, error) {
+	if obj.Id == 0 {
+		return m.CreateCtx(ctx, obj)
+	}
+	affected, failed, err := m.save(ctx, []*User{obj})
 	if err != nil {
 		return affected, err
 	}
-	if affected == 0 {
-		return m.Create(obj)
+	if len(failed) > 0 {
+		return 0, failed[0]
+	}
+	return affected, nil
+}
+
+func (m *_UserDBMgr) BatchUpsert(objs []*User) (int64, error) {
+	return m.BatchUpsertCtx(context.Background(), objs)
+}
+
+// BatchUpsertCtx saves objs like SaveCtx with one multi-row statement, the
+// objects without their auto increment keys are created by BatchCreateCtx. It
+// returns the number of objects written and a MultiError of the objects left
+// out.
+func (m *_UserDBMgr) BatchUpsertCtx(ctx context.Context, objs []*User) (int64, error) {
+	if len(objs) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*User, 0, len(objs))
+	upserts := make([]*User, 0, len(objs))
+	for _, obj := range objs {
+		if obj.Id == 0 {
+			creates = append(creates, obj)
+		} else {
+			upserts = append(upserts, obj)
+		}
+	}
+
+	var affected int64
+	if len(creates) > 0 {
+		n, err := m.BatchCreateCtx(ctx, creates)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	var failed orm.MultiError
+	if len(upserts) > 0 {
+		n, errs, err := m.save(ctx, upserts)
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		failed = errs
+	}
+	if len(failed) > 0 {
+		return affected, failed
+	}
+	return affected, nil
+}
+
+// save runs the save hooks around the upsert of objs, it returns the number
+// of objects written and the errors of the objects left out.
+func (m *_UserDBMgr) save(ctx context.Context, objs []*User) (int64, orm.MultiError, error) {
+	for _, obj := range objs {
+		if err := m.beforeWrite(obj, orm.BeforeSave); err != nil {
+			return 0, nil, err
+		}
+	}
+	written, failed, err := m.upsert(ctx, objs)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, obj := range written {
+		obj.dirty.Reset()
+		if err := m.hook(obj, orm.AfterSave); err != nil {
+			return int64(len(written)), nil, err
+		}
+	}
+	return int64(len(written)), failed, nil
+}
+
+// upsert inserts objs or updates the rows of their primary keys with one
+// statement, the unique key of another row is never overwritten and fails
+// with a DuplicateKeyError. It returns the objects written and the errors of
+// the others.
+func (m *_UserDBMgr) upsert(ctx context.Context, objs []*User) ([]*User, orm.MultiError, error) {
+	columns := []string{
+		"`id`",
+		"`name`",
+		"`mailbox`",
+		"`sex`",
+		"`age`",
+		"`longitude`",
+		"`latitude`",
+		"`description`",
+		"`password`",
+		"`head_url`",
+		"`status`",
+		"`created_at`",
+		"`updated_at`",
+		"`deleted_at`",
+	}
+	params, values := m.batchValues(objs, true)
+	//! ON DUPLICATE KEY fires on any unique key, the columns are only assigned
+	//! when the duplicate is the row of the primary key
+	guard := "`id` = VALUES(`id`)"
+	updates := make([]string, 0, 14)
+	for _, column := range []string{
+		"`name`",
+		"`mailbox`",
+		"`sex`",
+		"`age`",
+		"`longitude`",
+		"`latitude`",
+		"`description`",
+		"`password`",
+		"`head_url`",
+		"`status`",
+		"`created_at`",
+		"`updated_at`",
+		"`deleted_at`",
+	} {
+		updates = append(updates, fmt.Sprintf("%s = IF(%s, VALUES(%s), %s)", column, guard, column, column))
+	}
+	q := fmt.Sprintf("INSERT INTO users(%s) VALUES %s ON DUPLICATE KEY UPDATE %s",
+		strings.Join(columns, ","),
+		params,
+		strings.Join(updates, ","))
+	result, err := m.db.ExecContext(ctx, q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return nil, nil, err
+	}
+	//! mysql counts 1 for each inserted row, 2 for each updated row and 0 for
+	//! each row left as it was, the rows are read back unless the count tells
+	//! that every obj was written
+	if n == 2*int64(len(objs)) || (n == 1 && len(objs) == 1) {
+		return objs, nil, nil
+	}
+	stored, err := m.stored(ctx, objs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var failed orm.MultiError
+	written := make([]*User, 0, len(objs))
+	for _, obj := range objs {
+		pk := obj.GetPrimaryKey()
+		row := stored[pk.Key()]
+		if row == nil {
+			//! neither inserted nor the row of its primary key, a unique key
+			//! of obj is taken by another row
+			failed = append(failed, &orm.DuplicateKeyError{Err: fmt.Errorf("User %s has the unique key of another row", pk.Key())})
+			continue
+		}
+		written = append(written, obj)
+	}
+	return written, failed, nil
+}
+
+// stored reads the rows of the primary keys of objs from the primary, the
+// soft deleted ones included, by the keys of their primary keys.
+func (m *_UserDBMgr) stored(ctx context.Context, objs []*User) (map[string]*User, error) {
+	conditions := make([]string, 0, len(objs))
+	params := make([]interface{}, 0, len(objs)*1)
+	for _, obj := range objs {
+		conditions = append(conditions, "(`id` = ?)")
+		params = append(params, obj.GetPrimaryKey().SQLParams()...)
+	}
+	query := fmt.Sprintf("SELECT %s FROM users WHERE %s", strings.Join(objs[0].GetColumns(), ","), strings.Join(conditions, " OR "))
+	rows, err := m.FetchBySQLCtx(orm.WithPrimary(ctx), query, params...)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*User, len(rows))
+	for _, row := range rows {
+		stored[row.GetPrimaryKey().Key()] = row
 	}
-	return affected, err
+	return stored, nil
 }
 
 func (m *_UserDBMgr) Delete(obj *User) (int64, error) {
-	return m.DeleteByPrimaryKey(obj.Id)
+	return m.DeleteCtx(context.Background(), obj)
+}
+func (m *_UserDBMgr) DeleteCtx(ctx context.Context, obj *User) (int64, error) {
+	if err := m.hook(obj, orm.BeforeDelete); err != nil {
+		return 0, err
+	}
+	n, err := m.DeleteByPrimaryKeyCtx(ctx, obj.Id)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.hook(obj, orm.AfterDelete)
 }
 
 func (m *_UserDBMgr) DeleteByPrimaryKey(id int32) (int64, error) {
+	return m.DeleteByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_UserDBMgr) DeleteByPrimaryKeyCtx(ctx context.Context, id int32) (int64, error) {
 	pk := &IdOfUserPK{
 		Id: id,
 	}
 	q := fmt.Sprintf("DELETE FROM users %s", pk.SQLFormat())
-	result, err := m.db.Exec(q, pk.SQLParams()...)
+	result, err := m.db.ExecContext(ctx, q, pk.SQLParams()...)
 	if err != nil {
 		return 0, err
 	}
@@ -1070,11 +1583,14 @@ func (m *_UserDBMgr) DeleteByPrimaryKey(id int32) (int64, error) {
 }
 
 func (m *_UserDBMgr) DeleteBySQL(where string, args ...interface{}) (int64, error) {
+	return m.DeleteBySQLCtx(context.Background(), where, args...)
+}
+func (m *_UserDBMgr) DeleteBySQLCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
 	query := fmt.Sprintf("DELETE FROM users")
 	if where != "" {
 		query = fmt.Sprintf("DELETE FROM users WHERE %s", where)
 	}
-	result, err := m.db.Exec(query, args...)
+	result, err := m.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -1096,17 +1612,32 @@ func UserRedisMgr(store *orm.RedisStore) *_UserRedisMgr {
 	return &_UserRedisMgr{RedisStore: store}
 }
 
-//! pipeline
+func (m *_UserRedisMgr) WithContext(ctx context.Context) *_UserRedisMgr {
+	return &_UserRedisMgr{RedisStore: m.RedisStore.WithContext(ctx)}
+}
+
+//! pipeline
 type _UserRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_UserRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_UserRedisPipeline {
 	if len(pipes) > 0 {
-		return &_UserRedisPipeline{pipes[0], nil}
+		return &_UserRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_UserRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_UserRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_UserRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 func (m *_UserRedisMgr) Load(db *_UserDBMgr) error {
@@ -1159,6 +1690,10 @@ func (m *_UserRedisMgr) FindOne(unique Unique) (PrimaryKey, error) {
 	return nil, fmt.Errorf("unique none relation.")
 }
 
+func (m *_UserRedisMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	return m.WithContext(ctx).FindOne(unique)
+}
+
 func (m *_UserRedisMgr) FindOneFetch(unique Unique) (*User, error) {
 	v, err := m.FindOne(unique)
 	if err != nil {
@@ -1167,6 +1702,10 @@ func (m *_UserRedisMgr) FindOneFetch(unique Unique) (*User, error) {
 	return m.Fetch(v)
 }
 
+func (m *_UserRedisMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*User, error) {
+	return m.WithContext(ctx).FindOneFetch(unique)
+}
+
 func (m *_UserRedisMgr) Find(index Index) (int64, []PrimaryKey, error) {
 	if relation := index.IDXRelation(m.RedisStore); relation != nil {
 		strs, err := relation.Find(index.Key())
@@ -1191,6 +1730,10 @@ func (m *_UserRedisMgr) Find(index Index) (int64, []PrimaryKey, error) {
 	return 0, nil, fmt.Errorf("index none relation.")
 }
 
+func (m *_UserRedisMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	return m.WithContext(ctx).Find(index)
+}
+
 func (m *_UserRedisMgr) FindFetch(index Index) (int64, []*User, error) {
 	total, vs, err := m.Find(index)
 	if err != nil {
@@ -1200,6 +1743,10 @@ func (m *_UserRedisMgr) FindFetch(index Index) (int64, []*User, error) {
 	return total, objs, err
 }
 
+func (m *_UserRedisMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*User, error) {
+	return m.WithContext(ctx).FindFetch(index)
+}
+
 func (m *_UserRedisMgr) Range(scope Range) (int64, []PrimaryKey, error) {
 	if relation := scope.RNGRelation(m.RedisStore); relation != nil {
 		strs, err := relation.Range(scope.Key(), scope.Begin(), scope.End())
@@ -1224,6 +1771,10 @@ func (m *_UserRedisMgr) Range(scope Range) (int64, []PrimaryKey, error) {
 	return 0, nil, fmt.Errorf("range none relation.")
 }
 
+func (m *_UserRedisMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	return m.WithContext(ctx).Range(scope)
+}
+
 func (m *_UserRedisMgr) RangeFetch(scope Range) (int64, []*User, error) {
 	total, vs, err := m.Range(scope)
 	if err != nil {
@@ -1233,6 +1784,10 @@ func (m *_UserRedisMgr) RangeFetch(scope Range) (int64, []*User, error) {
 	return total, objs, err
 }
 
+func (m *_UserRedisMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*User, error) {
+	return m.WithContext(ctx).RangeFetch(scope)
+}
+
 func (m *_UserRedisMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
 	if relation := scope.RNGRelation(m.RedisStore); relation != nil {
 		scope.Revert(true)
@@ -1259,6 +1814,10 @@ func (m *_UserRedisMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
 	return 0, nil, fmt.Errorf("revert range none relation.")
 }
 
+func (m *_UserRedisMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	return m.WithContext(ctx).RangeRevert(scope)
+}
+
 func (m *_UserRedisMgr) RangeRevertFetch(scope Range) (int64, []*User, error) {
 	total, vs, err := m.RangeRevert(scope)
 	if err != nil {
@@ -1268,6 +1827,10 @@ func (m *_UserRedisMgr) RangeRevertFetch(scope Range) (int64, []*User, error) {
 	return total, objs, err
 }
 
+func (m *_UserRedisMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*User, error) {
+	return m.WithContext(ctx).RangeRevertFetch(scope)
+}
+
 func (m *_UserRedisMgr) Fetch(pk PrimaryKey) (*User, error) {
 	obj := UserMgr.NewUser()
 
@@ -1295,7 +1858,7 @@ func (m *_UserRedisMgr) Fetch(pk PrimaryKey) (*User, error) {
 
 	if b, err := cmds[0].(*redis.BoolCmd).Result(); err == nil {
 		if !b {
-			return nil, fmt.Errorf("User primary key:(%s) not exist", pk.Key())
+			return nil, &orm.NotFoundError{Object: "User", Key: pk.Key()}
 		}
 	}
 
@@ -1303,63 +1866,134 @@ func (m *_UserRedisMgr) Fetch(pk PrimaryKey) (*User, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := orm.StringScan(strs[0].(string), &obj.Id); err != nil {
-		return nil, err
-	}
-	if err := orm.StringScan(strs[1].(string), &obj.Name); err != nil {
-		return nil, err
+
+	var missing []string
+	if strs[0] == nil {
+		missing = append(missing, "Id")
+	} else {
+		if err := orm.StringScan(strs[0].(string), &obj.Id); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[2].(string), &obj.Mailbox); err != nil {
-		return nil, err
+	if strs[1] == nil {
+		missing = append(missing, "Name")
+	} else {
+		if err := orm.StringScan(strs[1].(string), &obj.Name); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[3].(string), &obj.Sex); err != nil {
-		return nil, err
+	if strs[2] == nil {
+		missing = append(missing, "Mailbox")
+	} else {
+		if err := orm.StringScan(strs[2].(string), &obj.Mailbox); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[4].(string), &obj.Age); err != nil {
-		return nil, err
+	if strs[3] == nil {
+		missing = append(missing, "Sex")
+	} else {
+		if err := orm.StringScan(strs[3].(string), &obj.Sex); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[5].(string), &obj.Longitude); err != nil {
-		return nil, err
+	if strs[4] == nil {
+		missing = append(missing, "Age")
+	} else {
+		if err := orm.StringScan(strs[4].(string), &obj.Age); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[6].(string), &obj.Latitude); err != nil {
-		return nil, err
+	if strs[5] == nil {
+		missing = append(missing, "Longitude")
+	} else {
+		if err := orm.StringScan(strs[5].(string), &obj.Longitude); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[7].(string), &obj.Description); err != nil {
-		return nil, err
+	if strs[6] == nil {
+		missing = append(missing, "Latitude")
+	} else {
+		if err := orm.StringScan(strs[6].(string), &obj.Latitude); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[8].(string), &obj.Password); err != nil {
-		return nil, err
+	if strs[7] == nil {
+		missing = append(missing, "Description")
+	} else {
+		if err := orm.StringScan(strs[7].(string), &obj.Description); err != nil {
+			return nil, err
+		}
 	}
-	if err := orm.StringScan(strs[9].(string), &obj.HeadUrl); err != nil {
-		return nil, err
+	if strs[8] == nil {
+		missing = append(missing, "Password")
+	} else {
+		if err := orm.StringScan(strs[8].(string), &obj.Password); err != nil {
+			return nil, err
+		}
 	}
-	obj.HeadUrl = orm.Decode(obj.HeadUrl)
-	if err := orm.StringScan(strs[10].(string), &obj.Status); err != nil {
-		return nil, err
+	if strs[9] == nil {
+		missing = append(missing, "HeadUrl")
+	} else {
+		if err := orm.StringScan(strs[9].(string), &obj.HeadUrl); err != nil {
+			return nil, err
+		}
+		obj.HeadUrl = orm.Decode(obj.HeadUrl)
 	}
-	var val11 int64
-	if err := orm.StringScan(strs[11].(string), &val11); err != nil {
-		return nil, err
+	if strs[10] == nil {
+		missing = append(missing, "Status")
+	} else {
+		if err := orm.StringScan(strs[10].(string), &obj.Status); err != nil {
+			return nil, err
+		}
 	}
-	obj.CreatedAt = time.Unix(val11, 0)
-	var val12 int64
-	if err := orm.StringScan(strs[12].(string), &val12); err != nil {
-		return nil, err
+	if strs[11] == nil {
+		missing = append(missing, "CreatedAt")
+	} else {
+		var val11 int64
+		if err := orm.StringScan(strs[11].(string), &val11); err != nil {
+			return nil, err
+		}
+		obj.CreatedAt = time.Unix(val11, 0)
 	}
-	obj.UpdatedAt = time.Unix(val12, 0)
-	if strs[13].(string) == "nil" {
-		obj.DeletedAt = nil
+	if strs[12] == nil {
+		missing = append(missing, "UpdatedAt")
 	} else {
-		var val13 int64
-		if err := orm.StringScan(strs[13].(string), &val13); err != nil {
+		var val12 int64
+		if err := orm.StringScan(strs[12].(string), &val12); err != nil {
 			return nil, err
 		}
-		DeletedAtValue := time.Unix(val13, 0)
-		obj.DeletedAt = &DeletedAtValue
+		obj.UpdatedAt = time.Unix(val12, 0)
+	}
+	if strs[13] == nil {
+		missing = append(missing, "DeletedAt")
+	} else {
+		if strs[13].(string) == "nil" {
+			obj.DeletedAt = nil
+		} else {
+			var val13 int64
+			if err := orm.StringScan(strs[13].(string), &val13); err != nil {
+				return nil, err
+			}
+			DeletedAtValue := time.Unix(val13, 0)
+			obj.DeletedAt = &DeletedAtValue
+		}
+	}
+	if m.ReportSchemaDrift("User", pk.Key(), missing) {
+		//! best effort, a failed upgrade is left to the next read
+		pipe := m.BeginPipeline()
+		if err := m.upgrade(pipe, keyOfObject(obj, pk.Key()), obj, missing); err == nil {
+			if _, err := pipe.Exec(); orm.IsNoScript(err) {
+				m.LoadScripts()
+			}
+		}
 	}
 	return obj, nil
 }
 
+func (m *_UserRedisMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*User, error) {
+	return m.WithContext(ctx).Fetch(pk)
+}
+
 func (m *_UserRedisMgr) FetchByPrimaryKeys(pks []PrimaryKey) ([]*User, error) {
 	objs := make([]*User, 0, len(pks))
 	pipe := m.BeginPipeline()
@@ -1386,189 +2020,561 @@ func (m *_UserRedisMgr) FetchByPrimaryKeys(pks []PrimaryKey) ([]*User, error) {
 	if err != nil {
 		return nil, err
 	}
-	errall := []string{}
+	var errall orm.MultiError
+	var upgrades *_UserRedisPipeline
 	sv := ""
 	ok := true
 	for i := 0; i < len(pks); i++ {
 		if b, err := cmds[2*i].(*redis.BoolCmd).Result(); err == nil {
 			if !b {
-				errall = append(errall, fmt.Sprintf("User primary key:(%s) not exist", pks[i].Key()))
+				errall = append(errall, &orm.NotFoundError{Object: "User", Key: pks[i].Key()})
 				continue
 			}
 		}
 
 		strs, err := cmds[2*i+1].(*redis.SliceCmd).Result()
 		if err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
+			errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
 			continue
 		}
 
 		obj := UserMgr.NewUser()
-		sv, ok = strs[0].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[0]))
-			continue
+		var missing []string
+		if strs[0] == nil {
+			missing = append(missing, "Id")
+		} else {
+			sv, ok = strs[0].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[0]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Id); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Id); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[1] == nil {
+			missing = append(missing, "Name")
+		} else {
+			sv, ok = strs[1].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[1]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Name); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		sv, ok = strs[1].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[1]))
-			continue
+		if strs[2] == nil {
+			missing = append(missing, "Mailbox")
+		} else {
+			sv, ok = strs[2].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[2]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Mailbox); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Name); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[3] == nil {
+			missing = append(missing, "Sex")
+		} else {
+			sv, ok = strs[3].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[3]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Sex); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		sv, ok = strs[2].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[2]))
-			continue
+		if strs[4] == nil {
+			missing = append(missing, "Age")
+		} else {
+			sv, ok = strs[4].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[4]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Age); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Mailbox); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[5] == nil {
+			missing = append(missing, "Longitude")
+		} else {
+			sv, ok = strs[5].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[5]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Longitude); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		sv, ok = strs[3].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[3]))
-			continue
+		if strs[6] == nil {
+			missing = append(missing, "Latitude")
+		} else {
+			sv, ok = strs[6].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[6]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Latitude); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Sex); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[7] == nil {
+			missing = append(missing, "Description")
+		} else {
+			sv, ok = strs[7].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[7]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Description); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		sv, ok = strs[4].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[4]))
-			continue
+		if strs[8] == nil {
+			missing = append(missing, "Password")
+		} else {
+			sv, ok = strs[8].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[8]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Password); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Age); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[9] == nil {
+			missing = append(missing, "HeadUrl")
+		} else {
+			sv, ok = strs[9].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[9]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.HeadUrl); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+			obj.HeadUrl = orm.Decode(obj.HeadUrl)
 		}
-		sv, ok = strs[5].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[5]))
-			continue
+		if strs[10] == nil {
+			missing = append(missing, "Status")
+		} else {
+			sv, ok = strs[10].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[10]))
+				continue
+			}
+			if err := orm.StringScan(sv, &obj.Status); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Longitude); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[11] == nil {
+			missing = append(missing, "CreatedAt")
+		} else {
+			var val11 int64
+			sv, ok = strs[11].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[11]))
+				continue
+			}
+			if err := orm.StringScan(sv, &val11); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+			obj.CreatedAt = time.Unix(val11, 0)
 		}
-		sv, ok = strs[6].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[6]))
-			continue
+		if strs[12] == nil {
+			missing = append(missing, "UpdatedAt")
+		} else {
+			var val12 int64
+			sv, ok = strs[12].(string)
+			if !ok {
+				errall = append(errall, fmt.Errorf("convert %v to string error", strs[12]))
+				continue
+			}
+			if err := orm.StringScan(sv, &val12); err != nil {
+				errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+				continue
+			}
+			obj.UpdatedAt = time.Unix(val12, 0)
 		}
-		if err := orm.StringScan(sv, &obj.Latitude); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[13] == nil {
+			missing = append(missing, "DeletedAt")
+		} else {
+			if strs[13].(string) == "nil" {
+				obj.DeletedAt = nil
+			} else {
+				var val13 int64
+				sv, ok = strs[13].(string)
+				if !ok {
+					errall = append(errall, fmt.Errorf("convert %v to string error", strs[13]))
+					continue
+				}
+				if err := orm.StringScan(sv, &val13); err != nil {
+					errall = append(errall, fmt.Errorf("key:%v,err:%w", pks[i].Key(), err))
+					continue
+				}
+				DeletedAtValue := time.Unix(val13, 0)
+				obj.DeletedAt = &DeletedAtValue
+			}
 		}
-		sv, ok = strs[7].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[7]))
-			continue
+		if m.ReportSchemaDrift("User", pks[i].Key(), missing) {
+			if upgrades == nil {
+				upgrades = m.BeginPipeline()
+			}
+			m.upgrade(upgrades, keyOfObject(obj, pks[i].Key()), obj, missing)
 		}
-		if err := orm.StringScan(sv, &obj.Description); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		objs = append(objs, obj)
+	}
+	if upgrades != nil {
+		//! best effort, a failed upgrade is left to the next read
+		if _, err := upgrades.Exec(); orm.IsNoScript(err) {
+			m.LoadScripts()
 		}
-		sv, ok = strs[8].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[8]))
-			continue
+	}
+	if len(errall) > 0 {
+		return objs, errall
+	}
+	return objs, nil
+}
+
+func (m *_UserRedisMgr) FetchByPrimaryKeysCtx(ctx context.Context, pks []PrimaryKey) ([]*User, error) {
+	return m.WithContext(ctx).FetchByPrimaryKeys(pks)
+}
+
+func (m *_UserRedisMgr) Create(obj *User) error {
+	return m.Save(obj)
+}
+
+func (m *_UserRedisMgr) CreateCtx(ctx context.Context, obj *User) error {
+	return m.WithContext(ctx).Create(obj)
+}
+
+func (m *_UserRedisMgr) Update(obj *User) error {
+	return m.Save(obj)
+}
+
+func (m *_UserRedisMgr) UpdateCtx(ctx context.Context, obj *User) error {
+	return m.WithContext(ctx).Update(obj)
+}
+
+func (m *_UserRedisMgr) CreateWithExpire(obj *User, expire time.Duration) error {
+	return m.SaveWithExpire(obj, expire)
+}
+
+func (m *_UserRedisMgr) CreateWithExpireCtx(ctx context.Context, obj *User, expire time.Duration) error {
+	return m.WithContext(ctx).CreateWithExpire(obj, expire)
+}
+
+func (m *_UserRedisMgr) UpdateWithExpire(obj *User, expire time.Duration) error {
+	return m.SaveWithExpire(obj, expire)
+}
+
+func (m *_UserRedisMgr) UpdateWithExpireCtx(ctx context.Context, obj *User, expire time.Duration) error {
+	return m.WithContext(ctx).UpdateWithExpire(obj, expire)
+}
+
+// Delete removes obj with its index entries. BeforeDelete and AfterDelete
+// of obj are passed a nil orm.DB, redis has no database handle.
+func (m *_UserRedisMgr) Delete(obj *User) error {
+	if err := orm.BeforeDelete(nil, obj); err != nil {
+		return err
+	}
+	pk := obj.GetPrimaryKey()
+	pipe := m.BeginPipeline()
+	if err := m.removeIndexes(pipe, obj); err != nil {
+		return err
+	}
+
+	if err := pipe.Del(keyOfObject(obj, pk.Key())).Err(); err != nil {
+		return err
+	}
+
+	if _, err := pipe.Exec(); err != nil {
+		return err
+	}
+	return orm.AfterDelete(nil, obj)
+}
+
+func (m *_UserRedisMgr) DeleteCtx(ctx context.Context, obj *User) error {
+	return m.WithContext(ctx).Delete(obj)
+}
+
+func (m *_UserRedisMgr) SaveBatch(objs []*User) error {
+	return m.SaveBatchWithExpire(objs, 0)
+}
+
+func (m *_UserRedisMgr) SaveBatchCtx(ctx context.Context, objs []*User) error {
+	return m.WithContext(ctx).SaveBatch(objs)
+}
+
+// Save writes obj over its stored hash, Create and Update are the same
+// write. Only BeforeSave and AfterSave of obj run, as redis cannot tell a
+// create from an update, and they are passed a nil orm.DB.
+func (m *_UserRedisMgr) Save(obj *User) error {
+	return m.SaveWithExpire(obj, 0)
+}
+
+func (m *_UserRedisMgr) SaveCtx(ctx context.Context, obj *User) error {
+	return m.WithContext(ctx).Save(obj)
+}
+
+func (m *_UserRedisMgr) SaveBatchWithExpire(objs []*User, expire time.Duration) error {
+	if len(objs) > 0 {
+		for _, obj := range objs {
+			if err := m.beforeSave(obj); err != nil {
+				return err
+			}
 		}
-		if err := orm.StringScan(sv, &obj.Password); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		keys := make([]string, 0, len(objs))
+		for _, obj := range objs {
+			keys = append(keys, keyOfObject(obj, obj.GetPrimaryKey().Key()))
 		}
-		sv, ok = strs[9].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[9]))
-			continue
+		var prevs []*User
+		err := m.Transaction(keys, func(store redis.Cmdable) (err error) {
+			prevs, err = m.previous(store, objs)
+			return err
+		}, func(p *redis.Pipeline) (err error) {
+			pipe := m.BeginPipeline(p)
+			for i, obj := range objs {
+				if _, err = m.addToPipeline(pipe, prevs[i], obj, expire); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
-		if err := orm.StringScan(sv, &obj.HeadUrl); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		for _, obj := range objs {
+			if err := orm.AfterSave(nil, obj); err != nil {
+				return err
+			}
 		}
-		obj.HeadUrl = orm.Decode(obj.HeadUrl)
-		sv, ok = strs[10].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[10]))
-			continue
+	}
+	return nil
+}
+
+func (m *_UserRedisMgr) SaveBatchWithExpireCtx(ctx context.Context, objs []*User, expire time.Duration) error {
+	return m.WithContext(ctx).SaveBatchWithExpire(objs, expire)
+}
+
+func (m *_UserRedisMgr) SaveWithExpire(obj *User, expire time.Duration) error {
+	if obj != nil {
+		if err := m.beforeSave(obj); err != nil {
+			return err
 		}
-		if err := orm.StringScan(sv, &obj.Status); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		objs := []*User{obj}
+		var prevs []*User
+		err := m.Transaction([]string{keyOfObject(obj, obj.GetPrimaryKey().Key())}, func(store redis.Cmdable) (err error) {
+			prevs, err = m.previous(store, objs)
+			return err
+		}, func(p *redis.Pipeline) error {
+			_, err := m.addToPipeline(m.BeginPipeline(p), prevs[0], obj, expire)
+			return err
+		})
+		if err != nil {
+			return err
 		}
-		var val11 int64
-		sv, ok = strs[11].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[11]))
-			continue
+		return orm.AfterSave(nil, obj)
+	}
+	return nil
+}
+
+func (m *_UserRedisMgr) SaveWithExpireCtx(ctx context.Context, obj *User, expire time.Duration) error {
+	return m.WithContext(ctx).SaveWithExpire(obj, expire)
+}
+
+//! beforeSave runs once per save, before the writes which may be retried, the hook gets a nil db
+func (m *_UserRedisMgr) beforeSave(obj *User) error {
+	if err := orm.BeforeSave(nil, obj); err != nil {
+		return err
+	}
+	return nil
+}
+
+//! addToPipeline queues the write of obj, prev is the stored obj read by previous, nil when unknown,
+//! it returns the command of the version script, nil without a version
+func (m *_UserRedisMgr) addToPipeline(pipe *_UserRedisPipeline, prev, obj *User, expire time.Duration) (*redis.Cmd, error) {
+	key := keyOfObject(obj, obj.GetPrimaryKey().Key())
+	if prev != nil {
+		if err := m.removeStaleIndexes(pipe, prev, obj); err != nil {
+			return nil, err
 		}
-		if err := orm.StringScan(sv, &val11); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+	}
+	fields, err := m.hashFields(obj)
+	if err != nil {
+		return nil, err
+	}
+	//! fields
+	pipe.HMSet(key, fields)
+	if err := m.addIndexes(pipe, obj); err != nil {
+		return nil, err
+	}
+	if expire > 0 {
+		pipe.Expire(key, expire)
+	}
+	return nil, nil
+}
+
+//! hashFields returns the fields of the hash of obj with their values
+func (m *_UserRedisMgr) hashFields(obj *User) (map[string]string, error) {
+	fields := make(map[string]string, 14)
+	fields["Id"] = fmt.Sprint(obj.Id)
+	fields["Name"] = fmt.Sprint(obj.Name)
+	fields["Mailbox"] = fmt.Sprint(obj.Mailbox)
+	fields["Sex"] = fmt.Sprint(obj.Sex)
+	fields["Age"] = fmt.Sprint(obj.Age)
+	fields["Longitude"] = fmt.Sprint(obj.Longitude)
+	fields["Latitude"] = fmt.Sprint(obj.Latitude)
+	fields["Description"] = fmt.Sprint(obj.Description)
+	fields["Password"] = fmt.Sprint(obj.Password)
+	fields["HeadUrl"] = orm.Encode(fmt.Sprint(obj.HeadUrl))
+	fields["Status"] = fmt.Sprint(obj.Status)
+	fields["CreatedAt"] = fmt.Sprint(obj.CreatedAt.Unix())
+	fields["UpdatedAt"] = fmt.Sprint(obj.UpdatedAt.Unix())
+	if obj.DeletedAt != nil {
+		fields["DeletedAt"] = fmt.Sprint(obj.DeletedAt.Unix())
+	} else {
+		fields["DeletedAt"] = "nil"
+	}
+	return fields, nil
+}
+
+//! previous reads the stored values of the fields of the index entries of objs, nil for the objects not stored yet
+func (m *_UserRedisMgr) previous(store redis.Cmdable, objs []*User) ([]*User, error) {
+	pipe := store.Pipeline()
+	cmds := make([]*redis.SliceCmd, 0, len(objs))
+	for _, obj := range objs {
+		cmds = append(cmds, pipe.HMGet(keyOfObject(obj, obj.GetPrimaryKey().Key()),
+			"Id",
+			"Mailbox",
+			"Sex",
+			"Age",
+			"Password"))
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, err
+	}
+
+	prevs := make([]*User, len(objs))
+	for i, cmd := range cmds {
+		strs := cmd.Val()
+		prev := UserMgr.NewUser()
+		stored := false
+		if strs[0] != nil {
+			stored = true
+			if err := orm.StringScan(strs[0].(string), &prev.Id); err != nil {
+				return nil, err
+			}
 		}
-		obj.CreatedAt = time.Unix(val11, 0)
-		var val12 int64
-		sv, ok = strs[12].(string)
-		if !ok {
-			errall = append(errall, fmt.Sprintf("convert %v to string error", strs[12]))
-			continue
+		if strs[1] != nil {
+			stored = true
+			if err := orm.StringScan(strs[1].(string), &prev.Mailbox); err != nil {
+				return nil, err
+			}
 		}
-		if err := orm.StringScan(sv, &val12); err != nil {
-			errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-			continue
+		if strs[2] != nil {
+			stored = true
+			if err := orm.StringScan(strs[2].(string), &prev.Sex); err != nil {
+				return nil, err
+			}
 		}
-		obj.UpdatedAt = time.Unix(val12, 0)
-		if strs[13].(string) == "nil" {
-			obj.DeletedAt = nil
-		} else {
-			var val13 int64
-			sv, ok = strs[13].(string)
-			if !ok {
-				errall = append(errall, fmt.Sprintf("convert %v to string error", strs[13]))
-				continue
+		if strs[3] != nil {
+			stored = true
+			if err := orm.StringScan(strs[3].(string), &prev.Age); err != nil {
+				return nil, err
 			}
-			if err := orm.StringScan(sv, &val13); err != nil {
-				errall = append(errall, fmt.Sprintf("key:%v,err:%v", pks[i].Key(), err.Error()))
-				continue
+		}
+		if strs[4] != nil {
+			stored = true
+			if err := orm.StringScan(strs[4].(string), &prev.Password); err != nil {
+				return nil, err
 			}
-			DeletedAtValue := time.Unix(val13, 0)
-			obj.DeletedAt = &DeletedAtValue
 		}
-		objs = append(objs, obj)
-	}
-	if len(errall) > 0 {
-		return objs, errors.New(strings.Join(errall, ERROR_SPLIT))
+		if stored {
+			prevs[i] = prev
+		}
 	}
-	return objs, nil
+	return prevs, nil
 }
 
-func (m *_UserRedisMgr) Create(obj *User) error {
-	return m.Save(obj)
-}
+//! removeStaleIndexes queues the removal of the index entries of prev whose keys obj changes
+func (m *_UserRedisMgr) removeStaleIndexes(pipe *_UserRedisPipeline, prev, obj *User) error {
+	//! uniques
+	uk_prev_0 := strings.Join([]string{
+		"Mailbox",
+		fmt.Sprint(prev.Mailbox),
+		"Password",
+		fmt.Sprint(prev.Password),
+	}, ":")
+	uk_key_0 := strings.Join([]string{
+		"Mailbox",
+		fmt.Sprint(obj.Mailbox),
+		"Password",
+		fmt.Sprint(obj.Password),
+	}, ":")
+	if uk_prev_0 != uk_key_0 {
+		uk_pip_0 := MailboxPasswordOfUserUKRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+		if err := uk_pip_0.PairRem(uk_prev_0); err != nil {
+			return err
+		}
+	}
 
-func (m *_UserRedisMgr) Update(obj *User) error {
-	return m.Save(obj)
-}
+	//! indexes
+	idx_prev_0 := strings.Join([]string{
+		"Sex",
+		fmt.Sprint(prev.Sex),
+	}, ":")
+	idx_key_0 := strings.Join([]string{
+		"Sex",
+		fmt.Sprint(obj.Sex),
+	}, ":")
+	if idx_prev_0 != idx_key_0 {
+		idx_pip_0 := SexOfUserIDXRelationRedisMgr().BeginPipeline(pipe.Pipeline)
+		idx_rel_0 := SexOfUserIDXRelationRedisMgr().NewSexOfUserIDXRelation(idx_prev_0)
+		idx_rel_0.Value = obj.GetPrimaryKey().Key()
+		if err := idx_pip_0.SetRem(idx_rel_0); err != nil {
+			return err
+		}
+	}
 
-func (m *_UserRedisMgr) CreateWithExpire(obj *User, expire time.Duration) error {
-	return m.SaveWithExpire(obj, expire)
+	//! ranges, a changed score alone is rewritten by the add
+	return nil
 }
 
-func (m *_UserRedisMgr) UpdateWithExpire(obj *User, expire time.Duration) error {
-	return m.SaveWithExpire(obj, expire)
+//! upgrade queues the write of the fields missing from the hash key with their values in obj
+func (m *_UserRedisMgr) upgrade(pipe *_UserRedisPipeline, key string, obj *User, missing []string) error {
+	fields, err := m.hashFields(obj)
+	if err != nil {
+		return err
+	}
+	pairs := make([]interface{}, 0, len(missing)*2)
+	for _, name := range missing {
+		pairs = append(pairs, name, fields[name])
+	}
+	orm.HSetMissing(pipe.Pipeline, key, pairs...)
+	return nil
 }
 
-func (m *_UserRedisMgr) Delete(obj *User) error {
+func (m *_UserRedisMgr) addIndexes(pipe *_UserRedisPipeline, obj *User) error {
 	pk := obj.GetPrimaryKey()
-	pipe := m.BeginPipeline()
 	//! uniques
 	uk_key_0 := []string{
 		"Mailbox",
@@ -1577,7 +2583,9 @@ func (m *_UserRedisMgr) Delete(obj *User) error {
 		fmt.Sprint(obj.Password),
 	}
 	uk_pip_0 := MailboxPasswordOfUserUKRelationRedisMgr().BeginPipeline(pipe.Pipeline)
-	if err := uk_pip_0.PairRem(strings.Join(uk_key_0, ":")); err != nil {
+	uk_rel_0 := MailboxPasswordOfUserUKRelationRedisMgr().NewMailboxPasswordOfUserUKRelation(strings.Join(uk_key_0, ":"))
+	uk_rel_0.Value = pk.Key()
+	if err := uk_pip_0.PairAdd(uk_rel_0); err != nil {
 		return err
 	}
 
@@ -1589,7 +2597,7 @@ func (m *_UserRedisMgr) Delete(obj *User) error {
 	idx_pip_0 := SexOfUserIDXRelationRedisMgr().BeginPipeline(pipe.Pipeline)
 	idx_rel_0 := SexOfUserIDXRelationRedisMgr().NewSexOfUserIDXRelation(strings.Join(idx_key_0, ":"))
 	idx_rel_0.Value = pk.Key()
-	if err := idx_pip_0.SetRem(idx_rel_0); err != nil {
+	if err := idx_pip_0.SetAdd(idx_rel_0); err != nil {
 		return err
 	}
 
@@ -1605,7 +2613,7 @@ func (m *_UserRedisMgr) Delete(obj *User) error {
 	}
 	rg_rel_0.Score = score_rg_0
 	rg_rel_0.Value = pk.Key()
-	if err := rg_pip_0.ZSetRem(rg_rel_0); err != nil {
+	if err := rg_pip_0.ZSetAdd(rg_rel_0); err != nil {
 		return err
 	}
 	rg_key_1 := []string{
@@ -1619,84 +2627,14 @@ func (m *_UserRedisMgr) Delete(obj *User) error {
 	}
 	rg_rel_1.Score = score_rg_1
 	rg_rel_1.Value = pk.Key()
-	if err := rg_pip_1.ZSetRem(rg_rel_1); err != nil {
-		return err
-	}
-
-	if err := pipe.Del(keyOfObject(obj, pk.Key())).Err(); err != nil {
-		return err
-	}
-
-	if _, err := pipe.Exec(); err != nil {
+	if err := rg_pip_1.ZSetAdd(rg_rel_1); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (m *_UserRedisMgr) SaveBatch(objs []*User) error {
-	return m.SaveBatchWithExpire(objs, 0)
-}
-
-func (m *_UserRedisMgr) Save(obj *User) error {
-	return m.SaveWithExpire(obj, 0)
-}
-
-func (m *_UserRedisMgr) SaveBatchWithExpire(objs []*User, expire time.Duration) error {
-	if len(objs) > 0 {
-		pipe := m.BeginPipeline()
-		for _, obj := range objs {
-			err := m.addToPipeline(pipe, obj, expire)
-			if err != nil {
-				pipe.Close()
-				return err
-			}
-		}
-		if _, err := pipe.Exec(); err != nil {
-			pipe.Close()
-			return err
-		}
-	}
-	return nil
-}
-
-func (m *_UserRedisMgr) SaveWithExpire(obj *User, expire time.Duration) error {
-	if obj != nil {
-		pipe := m.BeginPipeline()
-		err := m.addToPipeline(pipe, obj, expire)
-		if err != nil {
-			pipe.Close()
-			return err
-		}
-		if _, err = pipe.Exec(); err != nil {
-			pipe.Close()
-			return err
-		}
-	}
-	return nil
-}
-
-func (m *_UserRedisMgr) addToPipeline(pipe *_UserRedisPipeline, obj *User, expire time.Duration) error {
+func (m *_UserRedisMgr) removeIndexes(pipe *_UserRedisPipeline, obj *User) error {
 	pk := obj.GetPrimaryKey()
-	//! fields
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Id", fmt.Sprint(obj.Id))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Name", fmt.Sprint(obj.Name))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Mailbox", fmt.Sprint(obj.Mailbox))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Sex", fmt.Sprint(obj.Sex))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Age", fmt.Sprint(obj.Age))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Longitude", fmt.Sprint(obj.Longitude))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Latitude", fmt.Sprint(obj.Latitude))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Description", fmt.Sprint(obj.Description))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Password", fmt.Sprint(obj.Password))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "HeadUrl", orm.Encode(fmt.Sprint(obj.HeadUrl)))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "Status", fmt.Sprint(obj.Status))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "CreatedAt", fmt.Sprint(obj.CreatedAt.Unix()))
-	pipe.HSet(keyOfObject(obj, pk.Key()), "UpdatedAt", fmt.Sprint(obj.UpdatedAt.Unix()))
-	if obj.DeletedAt != nil {
-		pipe.HSet(keyOfObject(obj, pk.Key()), "DeletedAt", fmt.Sprint(obj.DeletedAt.Unix()))
-	} else {
-		pipe.HSet(keyOfObject(obj, pk.Key()), "DeletedAt", "nil")
-	}
-
 	//! uniques
 	uk_key_0 := []string{
 		"Mailbox",
@@ -1705,9 +2643,7 @@ func (m *_UserRedisMgr) addToPipeline(pipe *_UserRedisPipeline, obj *User, expir
 		fmt.Sprint(obj.Password),
 	}
 	uk_pip_0 := MailboxPasswordOfUserUKRelationRedisMgr().BeginPipeline(pipe.Pipeline)
-	uk_rel_0 := MailboxPasswordOfUserUKRelationRedisMgr().NewMailboxPasswordOfUserUKRelation(strings.Join(uk_key_0, ":"))
-	uk_rel_0.Value = pk.Key()
-	if err := uk_pip_0.PairAdd(uk_rel_0); err != nil {
+	if err := uk_pip_0.PairRem(strings.Join(uk_key_0, ":")); err != nil {
 		return err
 	}
 
@@ -1719,7 +2655,7 @@ func (m *_UserRedisMgr) addToPipeline(pipe *_UserRedisPipeline, obj *User, expir
 	idx_pip_0 := SexOfUserIDXRelationRedisMgr().BeginPipeline(pipe.Pipeline)
 	idx_rel_0 := SexOfUserIDXRelationRedisMgr().NewSexOfUserIDXRelation(strings.Join(idx_key_0, ":"))
 	idx_rel_0.Value = pk.Key()
-	if err := idx_pip_0.SetAdd(idx_rel_0); err != nil {
+	if err := idx_pip_0.SetRem(idx_rel_0); err != nil {
 		return err
 	}
 
@@ -1735,7 +2671,7 @@ func (m *_UserRedisMgr) addToPipeline(pipe *_UserRedisPipeline, obj *User, expir
 	}
 	rg_rel_0.Score = score_rg_0
 	rg_rel_0.Value = pk.Key()
-	if err := rg_pip_0.ZSetAdd(rg_rel_0); err != nil {
+	if err := rg_pip_0.ZSetRem(rg_rel_0); err != nil {
 		return err
 	}
 	rg_key_1 := []string{
@@ -1749,48 +2685,35 @@ func (m *_UserRedisMgr) addToPipeline(pipe *_UserRedisPipeline, obj *User, expir
 	}
 	rg_rel_1.Score = score_rg_1
 	rg_rel_1.Value = pk.Key()
-	if err := rg_pip_1.ZSetAdd(rg_rel_1); err != nil {
+	if err := rg_pip_1.ZSetRem(rg_rel_1); err != nil {
 		return err
 	}
-	if expire > 0 {
-		pipe.Expire(keyOfObject(obj, pk.Key()), expire)
-	}
-
 	return nil
 }
 
 func (m *_UserRedisMgr) Clear() error {
-	if strs, err := m.Keys(pairOfClass("User", "*")).Result(); err == nil {
-		if len(strs) > 0 {
-			m.Del(strs...)
-		}
-	}
-	if strs, err := m.Keys(hashOfClass("User", "object", "*")).Result(); err == nil {
-		if len(strs) > 0 {
-			m.Del(strs...)
-		}
-	}
-	if strs, err := m.Keys(setOfClass("User", "*")).Result(); err == nil {
-		if len(strs) > 0 {
-			m.Del(strs...)
-		}
-	}
-	if strs, err := m.Keys(zsetOfClass("User", "*")).Result(); err == nil {
-		if len(strs) > 0 {
-			m.Del(strs...)
-		}
-	}
-	if strs, err := m.Keys(geoOfClass("User", "*")).Result(); err == nil {
-		if len(strs) > 0 {
-			m.Del(strs...)
-		}
-	}
-	if strs, err := m.Keys(listOfClass("User", "*")).Result(); err == nil {
-		if len(strs) > 0 {
-			m.Del(strs...)
-		}
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+//! ClearWithProgress deletes the keys of the objects and their relations by SCAN, returning their count
+func (m *_UserRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress,
+		pairOfClass("User", "*"),
+		hashOfClass("User", "object", "*"),
+		setOfClass("User", "*"),
+		zsetOfClass("User", "*"),
+		geoOfClass("User", "*"),
+		listOfClass("User", "*"),
+	)
+}
+
+func (m *_UserRedisMgr) ClearWithProgressCtx(ctx context.Context, progress func(*orm.ClearProgress)) (int64, error) {
+	return m.WithContext(ctx).ClearWithProgress(progress)
+}
+
+func (m *_UserRedisMgr) ClearCtx(ctx context.Context) error {
+	return m.WithContext(ctx).Clear()
 }
 
 //! uniques
@@ -1825,6 +2748,10 @@ func MailboxPasswordOfUserUKRelationRedisMgr(stores ...*orm.RedisStore) *_Mailbo
 	return &_MailboxPasswordOfUserUKRelationRedisMgr{_redis_store}
 }
 
+func (m *_MailboxPasswordOfUserUKRelationRedisMgr) WithContext(ctx context.Context) *_MailboxPasswordOfUserUKRelationRedisMgr {
+	return &_MailboxPasswordOfUserUKRelationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) NewMailboxPasswordOfUserUKRelation(key string) *MailboxPasswordOfUserUKRelation {
 	return &MailboxPasswordOfUserUKRelation{
 		Key: key,
@@ -1835,17 +2762,31 @@ func (m *_MailboxPasswordOfUserUKRelationRedisMgr) NewMailboxPasswordOfUserUKRel
 type _MailboxPasswordOfUserUKRelationRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_MailboxPasswordOfUserUKRelationRedisPipeline {
 	if len(pipes) > 0 {
-		return &_MailboxPasswordOfUserUKRelationRedisPipeline{pipes[0], nil}
+		return &_MailboxPasswordOfUserUKRelationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_MailboxPasswordOfUserUKRelationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_MailboxPasswordOfUserUKRelationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_MailboxPasswordOfUserUKRelationRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation pair
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) PairAdd(obj *MailboxPasswordOfUserUKRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Set(pairOfClass("User", obj.GetClassName(), obj.Key), obj.Value, 0).Err()
 }
 
@@ -1854,9 +2795,12 @@ func (pipe *_MailboxPasswordOfUserUKRelationRedisPipeline) PairAdd(obj *MailboxP
 }
 
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) PairGet(key string) (*MailboxPasswordOfUserUKRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	str, err := m.Get(pairOfClass("User", "MailboxPasswordOfUserUKRelation", key)).Result()
 	if err != nil {
-		return nil, err
+		return nil, orm.TranslateRedisError(err, "MailboxPasswordOfUserUKRelation", key)
 	}
 
 	obj := m.NewMailboxPasswordOfUserUKRelation(key)
@@ -1867,6 +2811,9 @@ func (m *_MailboxPasswordOfUserUKRelationRedisMgr) PairGet(key string) (*Mailbox
 }
 
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) PairRem(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(pairOfClass("User", "MailboxPasswordOfUserUKRelation", key)).Err()
 }
 
@@ -1875,18 +2822,20 @@ func (pipe *_MailboxPasswordOfUserUKRelationRedisPipeline) PairRem(key string) e
 }
 
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) FindOne(key string) (string, error) {
-	return m.Get(pairOfClass("User", "MailboxPasswordOfUserUKRelation", key)).Result()
+	if err := m.ContextErr(); err != nil {
+		return "", err
+	}
+	str, err := m.Get(pairOfClass("User", "MailboxPasswordOfUserUKRelation", key)).Result()
+	return str, orm.TranslateRedisError(err, "MailboxPasswordOfUserUKRelation", key)
 }
 
 func (m *_MailboxPasswordOfUserUKRelationRedisMgr) Clear() error {
-	strs, err := m.Keys(pairOfClass("User", "MailboxPasswordOfUserUKRelation", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_MailboxPasswordOfUserUKRelationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, pairOfClass("User", "MailboxPasswordOfUserUKRelation", "*"))
 }
 
 //! indexes
@@ -1921,6 +2870,10 @@ func SexOfUserIDXRelationRedisMgr(stores ...*orm.RedisStore) *_SexOfUserIDXRelat
 	return &_SexOfUserIDXRelationRedisMgr{_redis_store}
 }
 
+func (m *_SexOfUserIDXRelationRedisMgr) WithContext(ctx context.Context) *_SexOfUserIDXRelationRedisMgr {
+	return &_SexOfUserIDXRelationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_SexOfUserIDXRelationRedisMgr) NewSexOfUserIDXRelation(key string) *SexOfUserIDXRelation {
 	return &SexOfUserIDXRelation{
 		Key: key,
@@ -1931,17 +2884,31 @@ func (m *_SexOfUserIDXRelationRedisMgr) NewSexOfUserIDXRelation(key string) *Sex
 type _SexOfUserIDXRelationRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_SexOfUserIDXRelationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_SexOfUserIDXRelationRedisPipeline {
 	if len(pipes) > 0 {
-		return &_SexOfUserIDXRelationRedisPipeline{pipes[0], nil}
+		return &_SexOfUserIDXRelationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_SexOfUserIDXRelationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_SexOfUserIDXRelationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_SexOfUserIDXRelationRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation pair
 func (m *_SexOfUserIDXRelationRedisMgr) SetAdd(relation *SexOfUserIDXRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.SAdd(setOfClass("User", "SexOfUserIDXRelation", relation.Key), relation.Value).Err()
 }
 
@@ -1950,6 +2917,9 @@ func (pipe *_SexOfUserIDXRelationRedisPipeline) SetAdd(relation *SexOfUserIDXRel
 }
 
 func (m *_SexOfUserIDXRelationRedisMgr) SetGet(key string) ([]*SexOfUserIDXRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	strs, err := m.SMembers(setOfClass("User", "SexOfUserIDXRelation", key)).Result()
 	if err != nil {
 		return nil, err
@@ -1967,6 +2937,9 @@ func (m *_SexOfUserIDXRelationRedisMgr) SetGet(key string) ([]*SexOfUserIDXRelat
 }
 
 func (m *_SexOfUserIDXRelationRedisMgr) SetRem(relation *SexOfUserIDXRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.SRem(setOfClass("User", "SexOfUserIDXRelation", relation.Key), relation.Value).Err()
 }
 
@@ -1975,6 +2948,9 @@ func (pipe *_SexOfUserIDXRelationRedisPipeline) SetRem(relation *SexOfUserIDXRel
 }
 
 func (m *_SexOfUserIDXRelationRedisMgr) SetDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(setOfClass("User", "SexOfUserIDXRelation", key)).Err()
 }
 
@@ -1983,18 +2959,19 @@ func (pipe *_SexOfUserIDXRelationRedisPipeline) SetDel(key string) error {
 }
 
 func (m *_SexOfUserIDXRelationRedisMgr) Find(key string) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	return m.SMembers(setOfClass("User", "SexOfUserIDXRelation", key)).Result()
 }
 
 func (m *_SexOfUserIDXRelationRedisMgr) Clear() error {
-	strs, err := m.Keys(setOfClass("User", "SexOfUserIDXRelation", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_SexOfUserIDXRelationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, setOfClass("User", "SexOfUserIDXRelation", "*"))
 }
 
 //! ranges
@@ -2030,6 +3007,10 @@ func IdOfUserRNGRelationRedisMgr(stores ...*orm.RedisStore) *_IdOfUserRNGRelatio
 	return &_IdOfUserRNGRelationRedisMgr{_redis_store}
 }
 
+func (m *_IdOfUserRNGRelationRedisMgr) WithContext(ctx context.Context) *_IdOfUserRNGRelationRedisMgr {
+	return &_IdOfUserRNGRelationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_IdOfUserRNGRelationRedisMgr) NewIdOfUserRNGRelation(key string) *IdOfUserRNGRelation {
 	return &IdOfUserRNGRelation{
 		Key: key,
@@ -2040,17 +3021,31 @@ func (m *_IdOfUserRNGRelationRedisMgr) NewIdOfUserRNGRelation(key string) *IdOfU
 type _IdOfUserRNGRelationRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_IdOfUserRNGRelationRedisPipeline {
 	if len(pipes) > 0 {
-		return &_IdOfUserRNGRelationRedisPipeline{pipes[0], nil}
+		return &_IdOfUserRNGRelationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_IdOfUserRNGRelationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_IdOfUserRNGRelationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_IdOfUserRNGRelationRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation zset
 func (m *_IdOfUserRNGRelationRedisMgr) ZSetAdd(relation *IdOfUserRNGRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.ZAdd(zsetOfClass("User", "IdOfUserRNGRelation", relation.Key), redis.Z{Score: relation.Score, Member: relation.Value}).Err()
 }
 
@@ -2059,6 +3054,9 @@ func (pipe *_IdOfUserRNGRelationRedisPipeline) ZSetAdd(relation *IdOfUserRNGRela
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) ZSetRange(key string, min, max int64) ([]*IdOfUserRNGRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	strs, err := m.ZRange(zsetOfClass("IdOfUserRNGRelation", key), min, max).Result()
 	if err != nil {
 		return nil, err
@@ -2076,6 +3074,9 @@ func (m *_IdOfUserRNGRelationRedisMgr) ZSetRange(key string, min, max int64) ([]
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) ZSetRevertRange(key string, min, max int64) ([]*IdOfUserRNGRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	strs, err := m.ZRevRange(zsetOfClass("IdOfUserRNGRelation", key), min, max).Result()
 	if err != nil {
 		return nil, err
@@ -2093,6 +3094,9 @@ func (m *_IdOfUserRNGRelationRedisMgr) ZSetRevertRange(key string, min, max int6
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) ZSetRem(relation *IdOfUserRNGRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.ZRem(zsetOfClass("User", "IdOfUserRNGRelation", relation.Key), relation.Value).Err()
 }
 
@@ -2101,6 +3105,9 @@ func (pipe *_IdOfUserRNGRelationRedisPipeline) ZSetRem(relation *IdOfUserRNGRela
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) ZSetDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(setOfClass("User", "IdOfUserRNGRelation", key)).Err()
 }
 
@@ -2109,22 +3116,26 @@ func (pipe *_IdOfUserRNGRelationRedisPipeline) ZSetDel(key string) error {
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) Range(key string, min, max int64) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	return m.ZRange(zsetOfClass("User", "IdOfUserRNGRelation", key), min, max).Result()
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) RangeRevert(key string, min, max int64) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	return m.ZRevRange(zsetOfClass("User", "IdOfUserRNGRelation", key), min, max).Result()
 }
 
 func (m *_IdOfUserRNGRelationRedisMgr) Clear() error {
-	strs, err := m.Keys(zsetOfClass("User", "IdOfUserRNGRelation", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_IdOfUserRNGRelationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, zsetOfClass("User", "IdOfUserRNGRelation", "*"))
 }
 
 //! relation
@@ -2158,6 +3169,10 @@ func AgeOfUserRNGRelationRedisMgr(stores ...*orm.RedisStore) *_AgeOfUserRNGRelat
 	return &_AgeOfUserRNGRelationRedisMgr{_redis_store}
 }
 
+func (m *_AgeOfUserRNGRelationRedisMgr) WithContext(ctx context.Context) *_AgeOfUserRNGRelationRedisMgr {
+	return &_AgeOfUserRNGRelationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_AgeOfUserRNGRelationRedisMgr) NewAgeOfUserRNGRelation(key string) *AgeOfUserRNGRelation {
 	return &AgeOfUserRNGRelation{
 		Key: key,
@@ -2168,17 +3183,31 @@ func (m *_AgeOfUserRNGRelationRedisMgr) NewAgeOfUserRNGRelation(key string) *Age
 type _AgeOfUserRNGRelationRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_AgeOfUserRNGRelationRedisPipeline {
 	if len(pipes) > 0 {
-		return &_AgeOfUserRNGRelationRedisPipeline{pipes[0], nil}
+		return &_AgeOfUserRNGRelationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_AgeOfUserRNGRelationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_AgeOfUserRNGRelationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_AgeOfUserRNGRelationRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation zset
 func (m *_AgeOfUserRNGRelationRedisMgr) ZSetAdd(relation *AgeOfUserRNGRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.ZAdd(zsetOfClass("User", "AgeOfUserRNGRelation", relation.Key), redis.Z{Score: relation.Score, Member: relation.Value}).Err()
 }
 
@@ -2187,6 +3216,9 @@ func (pipe *_AgeOfUserRNGRelationRedisPipeline) ZSetAdd(relation *AgeOfUserRNGRe
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) ZSetRange(key string, min, max int64) ([]*AgeOfUserRNGRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	strs, err := m.ZRange(zsetOfClass("AgeOfUserRNGRelation", key), min, max).Result()
 	if err != nil {
 		return nil, err
@@ -2204,6 +3236,9 @@ func (m *_AgeOfUserRNGRelationRedisMgr) ZSetRange(key string, min, max int64) ([
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) ZSetRevertRange(key string, min, max int64) ([]*AgeOfUserRNGRelation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	strs, err := m.ZRevRange(zsetOfClass("AgeOfUserRNGRelation", key), min, max).Result()
 	if err != nil {
 		return nil, err
@@ -2221,6 +3256,9 @@ func (m *_AgeOfUserRNGRelationRedisMgr) ZSetRevertRange(key string, min, max int
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) ZSetRem(relation *AgeOfUserRNGRelation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.ZRem(zsetOfClass("User", "AgeOfUserRNGRelation", relation.Key), relation.Value).Err()
 }
 
@@ -2229,6 +3267,9 @@ func (pipe *_AgeOfUserRNGRelationRedisPipeline) ZSetRem(relation *AgeOfUserRNGRe
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) ZSetDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(setOfClass("User", "AgeOfUserRNGRelation", key)).Err()
 }
 
@@ -2237,20 +3278,24 @@ func (pipe *_AgeOfUserRNGRelationRedisPipeline) ZSetDel(key string) error {
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) Range(key string, min, max int64) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	return m.ZRange(zsetOfClass("User", "AgeOfUserRNGRelation", key), min, max).Result()
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) RangeRevert(key string, min, max int64) ([]string, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	return m.ZRevRange(zsetOfClass("User", "AgeOfUserRNGRelation", key), min, max).Result()
 }
 
 func (m *_AgeOfUserRNGRelationRedisMgr) Clear() error {
-	strs, err := m.Keys(zsetOfClass("User", "AgeOfUserRNGRelation", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_AgeOfUserRNGRelationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, zsetOfClass("User", "AgeOfUserRNGRelation", "*"))
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"github.com/ezbuy/redis-orm/orm"
@@ -9,6 +10,7 @@ import (
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
@@ -49,9 +51,13 @@ func UserInfoDBMgr(db orm.DB) *_UserInfoDBMgr {
 }
 
 func (m *_UserInfoDBMgr) QueryBySQL(q string, args ...interface{}) (results []*UserInfo, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.QueryBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_UserInfoDBMgr) QueryBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*UserInfo, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserInfo fetch error: %v", err)
+		return nil, fmt.Errorf("UserInfo fetch error: %w", err)
 	}
 	defer rows.Close()
 
@@ -67,7 +73,7 @@ func (m *_UserInfoDBMgr) QueryBySQL(q string, args ...interface{}) (results []*U
 	}
 	if err = rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("UserInfo fetch result error: %v", err)
+		return nil, fmt.Errorf("UserInfo fetch result error: %w", err)
 	}
 	return
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"github.com/ezbuy/redis-orm/orm"
 	redis "gopkg.in/redis.v5"
@@ -9,6 +10,7 @@ import (
 )
 
 var (
+	_ context.Context
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
@@ -47,6 +49,10 @@ func SexUserLocationRedisMgr(stores ...*orm.RedisStore) *_SexUserLocationRedisMg
 	return &_SexUserLocationRedisMgr{_redis_store}
 }
 
+func (m *_SexUserLocationRedisMgr) WithContext(ctx context.Context) *_SexUserLocationRedisMgr {
+	return &_SexUserLocationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_SexUserLocationRedisMgr) NewSexUserLocation(key string) *SexUserLocation {
 	return &SexUserLocation{
 		Key: key,
@@ -57,17 +63,31 @@ func (m *_SexUserLocationRedisMgr) NewSexUserLocation(key string) *SexUserLocati
 type _SexUserLocationRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_SexUserLocationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_SexUserLocationRedisPipeline {
 	if len(pipes) > 0 {
-		return &_SexUserLocationRedisPipeline{pipes[0], nil}
+		return &_SexUserLocationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_SexUserLocationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_SexUserLocationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_SexUserLocationRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation pair
 func (m *_SexUserLocationRedisMgr) LocationAdd(relation *SexUserLocation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.GeoAdd(geoOfClass("SexUserLocation", "SexUserLocation", relation.Key), &redis.GeoLocation{
 		Longitude: relation.Longitude,
 		Latitude:  relation.Latitude,
@@ -76,6 +96,9 @@ func (m *_SexUserLocationRedisMgr) LocationAdd(relation *SexUserLocation) error
 }
 
 func (m *_SexUserLocationRedisMgr) LocationRadius(key string, longitude float64, latitude float64, query *redis.GeoRadiusQuery) ([]*SexUserLocation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	locations, err := m.GeoRadius(geoOfClass("SexUserLocation", "SexUserLocation", key), longitude, latitude, query).Result()
 	if err != nil {
 		return nil, err
@@ -95,22 +118,26 @@ func (m *_SexUserLocationRedisMgr) LocationRadius(key string, longitude float64,
 }
 
 func (m *_SexUserLocationRedisMgr) LocationRem(relation *SexUserLocation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.ZRem(geoOfClass("SexUserLocation", "SexUserLocation", relation.Key), fmt.Sprint(relation.Value)).Err()
 }
 
 func (m *_SexUserLocationRedisMgr) LocationDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(geoOfClass("SexUserLocation", "SexUserLocation", key)).Err()
 }
 
 func (m *_SexUserLocationRedisMgr) Clear() error {
-	strs, err := m.Keys(geoOfClass("SexUserLocation", "SexUserLocation", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_SexUserLocationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, geoOfClass("SexUserLocation", "SexUserLocation", "*"))
 }
 
 func (m *_SexUserLocationRedisMgr) Load(db DBFetcher) error {
@@ -162,9 +189,13 @@ func SexUserLocationDBMgr(db orm.DB) *_SexUserLocationDBMgr {
 }
 
 func (m *_SexUserLocationDBMgr) FetchBySQL(q string, args ...interface{}) (results []interface{}, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_SexUserLocationDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []interface{}, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("SexUserLocation fetch error: %v", err)
+		return nil, fmt.Errorf("SexUserLocation fetch error: %w", err)
 	}
 	defer rows.Close()
 
@@ -178,7 +209,7 @@ func (m *_SexUserLocationDBMgr) FetchBySQL(q string, args ...interface{}) (resul
 		results = append(results, &result)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("SexUserLocation fetch result error: %v", err)
+		return nil, fmt.Errorf("SexUserLocation fetch result error: %w", err)
 	}
 	return
 }
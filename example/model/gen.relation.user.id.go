@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"github.com/ezbuy/redis-orm/orm"
 	redis "gopkg.in/redis.v5"
@@ -9,6 +10,7 @@ import (
 )
 
 var (
+	_ context.Context
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
@@ -45,6 +47,10 @@ func UserIdRedisMgr(stores ...*orm.RedisStore) *_UserIdRedisMgr {
 	return &_UserIdRedisMgr{_redis_store}
 }
 
+func (m *_UserIdRedisMgr) WithContext(ctx context.Context) *_UserIdRedisMgr {
+	return &_UserIdRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_UserIdRedisMgr) NewUserId(key string) *UserId {
 	return &UserId{
 		Key: key,
@@ -55,28 +61,48 @@ func (m *_UserIdRedisMgr) NewUserId(key string) *UserId {
 type _UserIdRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_UserIdRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_UserIdRedisPipeline {
 	if len(pipes) > 0 {
-		return &_UserIdRedisPipeline{pipes[0], nil}
+		return &_UserIdRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_UserIdRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_UserIdRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_UserIdRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation list
 func (m *_UserIdRedisMgr) ListLPush(relation *UserId) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.LPush(listOfClass("UserId", "UserId", relation.Key), relation.Value).Err()
 }
 
 func (m *_UserIdRedisMgr) ListRPush(relation *UserId) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.RPush(listOfClass("UserId", "UserId", relation.Key), relation.Value).Err()
 }
 
 func (m *_UserIdRedisMgr) ListLPop(key string) (*UserId, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	str, err := m.LPop(listOfClass("UserId", "UserId", key)).Result()
 	if err != nil {
-		return nil, err
+		return nil, orm.TranslateRedisError(err, "UserId", key)
 	}
 
 	relation := m.NewUserId(key)
@@ -88,9 +114,12 @@ func (m *_UserIdRedisMgr) ListLPop(key string) (*UserId, error) {
 }
 
 func (m *_UserIdRedisMgr) ListRPop(key string) (*UserId, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	str, err := m.RPop(listOfClass("UserId", "UserId", key)).Result()
 	if err != nil {
-		return nil, err
+		return nil, orm.TranslateRedisError(err, "UserId", key)
 	}
 
 	relation := m.NewUserId(key)
@@ -102,6 +131,9 @@ func (m *_UserIdRedisMgr) ListRPop(key string) (*UserId, error) {
 }
 
 func (m *_UserIdRedisMgr) ListLRange(key string, start, stop int64) ([]*UserId, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	strs, err := m.LRange(listOfClass("UserId", "UserId", key), start, stop).Result()
 	if err != nil {
 		return nil, err
@@ -119,26 +151,33 @@ func (m *_UserIdRedisMgr) ListLRange(key string, start, stop int64) ([]*UserId,
 }
 
 func (m *_UserIdRedisMgr) ListLRem(relation *UserId) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.LRem(listOfClass("UserId", "UserId", relation.Key), 0, relation.Value).Err()
 }
 
 func (m *_UserIdRedisMgr) ListLLen(key string) (int64, error) {
+	if err := m.ContextErr(); err != nil {
+		return 0, err
+	}
 	return m.LLen(listOfClass("UserId", "UserId", key)).Result()
 }
 
 func (m *_UserIdRedisMgr) ListLDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(listOfClass("UserId", "UserId", key)).Err()
 }
 
 func (m *_UserIdRedisMgr) Clear() error {
-	strs, err := m.Keys(listOfClass("UserId", "UserId", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_UserIdRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, listOfClass("UserId", "UserId", "*"))
 }
 
 func (m *_UserIdRedisMgr) Load(db DBFetcher) error {
@@ -187,9 +226,13 @@ func UserIdDBMgr(db orm.DB) *_UserIdDBMgr {
 }
 
 func (m *_UserIdDBMgr) FetchBySQL(q string, args ...interface{}) (results []interface{}, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_UserIdDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []interface{}, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserId fetch error: %v", err)
+		return nil, fmt.Errorf("UserId fetch error: %w", err)
 	}
 	defer rows.Close()
 
@@ -203,7 +246,7 @@ func (m *_UserIdDBMgr) FetchBySQL(q string, args ...interface{}) (results []inte
 		results = append(results, &result)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("UserId fetch result error: %v", err)
+		return nil, fmt.Errorf("UserId fetch result error: %w", err)
 	}
 	return
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"github.com/ezbuy/redis-orm/orm"
 	redis "gopkg.in/redis.v5"
@@ -9,6 +10,7 @@ import (
 )
 
 var (
+	_ context.Context
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
@@ -47,6 +49,10 @@ func UserLocationRedisMgr(stores ...*orm.RedisStore) *_UserLocationRedisMgr {
 	return &_UserLocationRedisMgr{_redis_store}
 }
 
+func (m *_UserLocationRedisMgr) WithContext(ctx context.Context) *_UserLocationRedisMgr {
+	return &_UserLocationRedisMgr{m.RedisStore.WithContext(ctx)}
+}
+
 func (m *_UserLocationRedisMgr) NewUserLocation(key string) *UserLocation {
 	return &UserLocation{
 		Key: key,
@@ -57,17 +63,31 @@ func (m *_UserLocationRedisMgr) NewUserLocation(key string) *UserLocation {
 type _UserLocationRedisPipeline struct {
 	*redis.Pipeline
 	Err error
+	ctx context.Context
 }
 
 func (m *_UserLocationRedisMgr) BeginPipeline(pipes ...*redis.Pipeline) *_UserLocationRedisPipeline {
 	if len(pipes) > 0 {
-		return &_UserLocationRedisPipeline{pipes[0], nil}
+		return &_UserLocationRedisPipeline{pipes[0], nil, m.Context()}
+	}
+	return &_UserLocationRedisPipeline{m.Pipeline(), nil, m.Context()}
+}
+
+// Exec sends the queued commands unless the context of the manager is done,
+// the commands are discarded then.
+func (pipe *_UserLocationRedisPipeline) Exec() ([]redis.Cmder, error) {
+	if err := pipe.ctx.Err(); err != nil {
+		pipe.Discard()
+		return nil, err
 	}
-	return &_UserLocationRedisPipeline{m.Pipeline(), nil}
+	return pipe.Pipeline.Exec()
 }
 
 //! redis relation pair
 func (m *_UserLocationRedisMgr) LocationAdd(relation *UserLocation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.GeoAdd(geoOfClass("UserLocation", "UserLocation", relation.Key), &redis.GeoLocation{
 		Longitude: relation.Longitude,
 		Latitude:  relation.Latitude,
@@ -76,6 +96,9 @@ func (m *_UserLocationRedisMgr) LocationAdd(relation *UserLocation) error {
 }
 
 func (m *_UserLocationRedisMgr) LocationRadius(key string, longitude float64, latitude float64, query *redis.GeoRadiusQuery) ([]*UserLocation, error) {
+	if err := m.ContextErr(); err != nil {
+		return nil, err
+	}
 	locations, err := m.GeoRadius(geoOfClass("UserLocation", "UserLocation", key), longitude, latitude, query).Result()
 	if err != nil {
 		return nil, err
@@ -95,22 +118,26 @@ func (m *_UserLocationRedisMgr) LocationRadius(key string, longitude float64, la
 }
 
 func (m *_UserLocationRedisMgr) LocationRem(relation *UserLocation) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.ZRem(geoOfClass("UserLocation", "UserLocation", relation.Key), fmt.Sprint(relation.Value)).Err()
 }
 
 func (m *_UserLocationRedisMgr) LocationDel(key string) error {
+	if err := m.ContextErr(); err != nil {
+		return err
+	}
 	return m.Del(geoOfClass("UserLocation", "UserLocation", key)).Err()
 }
 
 func (m *_UserLocationRedisMgr) Clear() error {
-	strs, err := m.Keys(geoOfClass("UserLocation", "UserLocation", "*")).Result()
-	if err != nil {
-		return err
-	}
-	if len(strs) > 0 {
-		return m.Del(strs...).Err()
-	}
-	return nil
+	_, err := m.ClearWithProgress(nil)
+	return err
+}
+
+func (m *_UserLocationRedisMgr) ClearWithProgress(progress func(*orm.ClearProgress)) (int64, error) {
+	return m.ScanDel(progress, geoOfClass("UserLocation", "UserLocation", "*"))
 }
 
 func (m *_UserLocationRedisMgr) Load(db DBFetcher) error {
@@ -162,9 +189,13 @@ func UserLocationDBMgr(db orm.DB) *_UserLocationDBMgr {
 }
 
 func (m *_UserLocationDBMgr) FetchBySQL(q string, args ...interface{}) (results []interface{}, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_UserLocationDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []interface{}, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserLocation fetch error: %v", err)
+		return nil, fmt.Errorf("UserLocation fetch error: %w", err)
 	}
 	defer rows.Close()
 
@@ -178,7 +209,7 @@ func (m *_UserLocationDBMgr) FetchBySQL(q string, args ...interface{}) (results
 		results = append(results, &result)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("UserLocation fetch result error: %v", err)
+		return nil, fmt.Errorf("UserLocation fetch result error: %w", err)
 	}
 	return
 }
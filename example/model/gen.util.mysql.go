@@ -1,7 +1,11 @@
 package model
 
-import "strings"
+import (
+	"errors"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
 
 func IsErrNotFound(err error) bool {
-	return strings.Contains(err.Error(), "not found")
+	return errors.Is(err, orm.ErrNotFound)
 }
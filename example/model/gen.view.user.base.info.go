@@ -1,22 +1,26 @@
 package model
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/ezbuy/redis-orm/orm"
+	"github.com/ezbuy/redis-orm/orm/sqlbuilder"
 	"gopkg.in/go-playground/validator.v9"
 )
 
 var (
+	_ context.Context
 	_ sql.DB
 	_ time.Time
 	_ fmt.Formatter
 	_ strings.Reader
 	_ orm.VSet
 	_ validator.Validate
+	_ sqlbuilder.Builder
 )
 
 type UserBaseInfo struct {
@@ -389,7 +393,8 @@ func (u *IdOfUserBaseInfoRNG) RNGRelation(store *orm.RedisStore) RangeRelation {
 }
 
 type _UserBaseInfoDBMgr struct {
-	db orm.DB
+	db   orm.DB
+	from string
 }
 
 func (m *_UserBaseInfoMgr) DB(db orm.DB) *_UserBaseInfoDBMgr {
@@ -400,41 +405,67 @@ func UserBaseInfoDBMgr(db orm.DB) *_UserBaseInfoDBMgr {
 	if db == nil {
 		panic(fmt.Errorf("UserBaseInfoDBMgr init need db"))
 	}
-	return &_UserBaseInfoDBMgr{db: db}
+	return &_UserBaseInfoDBMgr{db: db, from: "user_base_info"}
 }
 
 func (m *_UserBaseInfoDBMgr) Search(where string, orderby string, limit string, args ...interface{}) ([]*UserBaseInfo, error) {
+	return m.SearchCtx(context.Background(), where, orderby, limit, args...)
+}
+
+func (m *_UserBaseInfoDBMgr) SearchCtx(ctx context.Context, where string, orderby string, limit string, args ...interface{}) ([]*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
 	conditions := []string{where, orderby, limit}
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), strings.Join(conditions, " "))
-	return m.FetchBySQL(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, strings.Join(conditions, " "))
+	return m.FetchBySQLCtx(ctx, query, args...)
 }
 
 func (m *_UserBaseInfoDBMgr) SearchConditions(conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*UserBaseInfo, error) {
+	return m.SearchConditionsCtx(context.Background(), conditions, orderby, offset, limit, args...)
+}
+
+func (m *_UserBaseInfoDBMgr) SearchConditionsCtx(ctx context.Context, conditions []string, orderby string, offset int, limit int, args ...interface{}) ([]*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
-	q := fmt.Sprintf("SELECT %s FROM user_base_info %s %s %s",
+	q := fmt.Sprintf("SELECT %s FROM %s %s %s %s",
 		strings.Join(obj.GetColumns(), ","),
+		m.from,
 		orm.SQLWhere(conditions),
 		orderby,
 		orm.SQLOffsetLimit(offset, limit))
 
-	return m.FetchBySQL(q, args...)
+	return m.FetchBySQLCtx(ctx, q, args...)
 }
 
 func (m *_UserBaseInfoDBMgr) SearchCount(where string, args ...interface{}) (int64, error) {
-	return m.queryCount(where, args...)
+	return m.SearchCountCtx(context.Background(), where, args...)
+}
+
+func (m *_UserBaseInfoDBMgr) SearchCountCtx(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, where, args...)
 }
 
 func (m *_UserBaseInfoDBMgr) SearchConditionsCount(conditions []string, args ...interface{}) (int64, error) {
-	return m.queryCount(orm.SQLWhere(conditions), args...)
+	return m.SearchConditionsCountCtx(context.Background(), conditions, args...)
+}
+
+func (m *_UserBaseInfoDBMgr) SearchConditionsCountCtx(ctx context.Context, conditions []string, args ...interface{}) (int64, error) {
+	return m.queryCount(ctx, orm.SQLWhere(conditions), args...)
 }
 
 func (m *_UserBaseInfoDBMgr) FetchBySQL(q string, args ...interface{}) (results []*UserBaseInfo, err error) {
-	rows, err := m.db.Query(q, args...)
+	return m.FetchBySQLCtx(context.Background(), q, args...)
+}
+
+func (m *_UserBaseInfoDBMgr) FetchBySQLCtx(ctx context.Context, q string, args ...interface{}) (results []*UserBaseInfo, err error) {
+	rows, err := m.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserBaseInfo fetch error: %v", err)
+		return nil, fmt.Errorf("UserBaseInfo fetch error: %w", err)
 	}
 	defer rows.Close()
+	return m.scan(rows)
+}
+
+// scan reads the objects of rows, whose columns are the ones of GetColumns.
+func (m *_UserBaseInfoDBMgr) scan(rows *sql.Rows) (results []*UserBaseInfo, err error) {
 
 	for rows.Next() {
 		var result UserBaseInfo
@@ -448,12 +479,16 @@ func (m *_UserBaseInfoDBMgr) FetchBySQL(q string, args ...interface{}) (results
 	}
 	if err = rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("UserBaseInfo fetch result error: %v", err)
+		return nil, fmt.Errorf("UserBaseInfo fetch result error: %w", err)
 	}
 	return
 }
 func (m *_UserBaseInfoDBMgr) Exist(pk PrimaryKey) (bool, error) {
-	c, err := m.queryCount(pk.SQLFormat(), pk.SQLParams()...)
+	return m.ExistCtx(context.Background(), pk)
+}
+
+func (m *_UserBaseInfoDBMgr) ExistCtx(ctx context.Context, pk PrimaryKey) (bool, error) {
+	c, err := m.queryCount(ctx, pk.SQLFormat(), pk.SQLParams()...)
 	if err != nil {
 		return false, err
 	}
@@ -462,37 +497,49 @@ func (m *_UserBaseInfoDBMgr) Exist(pk PrimaryKey) (bool, error) {
 
 // Deprecated: Use FetchByPrimaryKey instead.
 func (m *_UserBaseInfoDBMgr) Fetch(pk PrimaryKey) (*UserBaseInfo, error) {
+	return m.FetchCtx(context.Background(), pk)
+}
+
+func (m *_UserBaseInfoDBMgr) FetchCtx(ctx context.Context, pk PrimaryKey) (*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBaseInfo fetch record not found")
+	return nil, &orm.NotFoundError{Object: "UserBaseInfo", Key: pk.Key()}
 }
 
 // primary key
 func (m *_UserBaseInfoDBMgr) FetchByPrimaryKey(id int32) (*UserBaseInfo, error) {
+	return m.FetchByPrimaryKeyCtx(context.Background(), id)
+}
+
+func (m *_UserBaseInfoDBMgr) FetchByPrimaryKeyCtx(ctx context.Context, id int32) (*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
 	pk := &IdOfUserBaseInfoPK{
 		Id: id,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), pk.SQLFormat())
-	objs, err := m.FetchBySQL(query, pk.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, pk.SQLFormat())
+	objs, err := m.FetchBySQLCtx(ctx, query, pk.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBaseInfo fetch record not found")
+	return nil, &orm.NotFoundError{Object: "UserBaseInfo", Key: pk.Key()}
 }
 
 func (m *_UserBaseInfoDBMgr) FetchByPrimaryKeys(ids []int32) ([]*UserBaseInfo, error) {
+	return m.FetchByPrimaryKeysCtx(context.Background(), ids)
+}
+
+func (m *_UserBaseInfoDBMgr) FetchByPrimaryKeysCtx(ctx context.Context, ids []int32) ([]*UserBaseInfo, error) {
 	size := len(ids)
 	if size == 0 {
 		return nil, nil
@@ -502,14 +549,18 @@ func (m *_UserBaseInfoDBMgr) FetchByPrimaryKeys(ids []int32) ([]*UserBaseInfo, e
 		params = append(params, pk)
 	}
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
-	query := fmt.Sprintf("SELECT %s FROM user_base_info WHERE `id` IN (?%s)", strings.Join(obj.GetColumns(), ","),
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `id` IN (?%s)", strings.Join(obj.GetColumns(), ","), m.from,
 		strings.Repeat(",?", size-1))
-	return m.FetchBySQL(query, params...)
+	return m.FetchBySQLCtx(ctx, query, params...)
 }
 
 // indexes
 
 func (m *_UserBaseInfoDBMgr) FindByName(name string, limit int, offset int) ([]*UserBaseInfo, error) {
+	return m.FindByNameCtx(context.Background(), name, limit, offset)
+}
+
+func (m *_UserBaseInfoDBMgr) FindByNameCtx(ctx context.Context, name string, limit int, offset int) ([]*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
 	idx := &NameOfUserBaseInfoIDX{
 		Name:   name,
@@ -517,21 +568,29 @@ func (m *_UserBaseInfoDBMgr) FindByName(name string, limit int, offset int) ([]*
 		offset: offset,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), idx.SQLFormat(true))
-	return m.FetchBySQL(query, idx.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
 }
 
 func (m *_UserBaseInfoDBMgr) FindAllByName(name string) ([]*UserBaseInfo, error) {
+	return m.FindAllByNameCtx(context.Background(), name)
+}
+
+func (m *_UserBaseInfoDBMgr) FindAllByNameCtx(ctx context.Context, name string) ([]*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
 	idx := &NameOfUserBaseInfoIDX{
 		Name: name,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), idx.SQLFormat(true))
-	return m.FetchBySQL(query, idx.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, idx.SQLFormat(true))
+	return m.FetchBySQLCtx(ctx, query, idx.SQLParams()...)
 }
 
 func (m *_UserBaseInfoDBMgr) FindByNameGroup(items []string) ([]*UserBaseInfo, error) {
+	return m.FindByNameGroupCtx(context.Background(), items)
+}
+
+func (m *_UserBaseInfoDBMgr) FindByNameGroupCtx(ctx context.Context, items []string) ([]*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
 	if len(items) == 0 {
 		return nil, nil
@@ -540,75 +599,95 @@ func (m *_UserBaseInfoDBMgr) FindByNameGroup(items []string) ([]*UserBaseInfo, e
 	for _, item := range items {
 		params = append(params, item)
 	}
-	query := fmt.Sprintf("SELECT %s FROM user_base_info where `name` in (?", strings.Join(obj.GetColumns(), ",")) +
+	query := fmt.Sprintf("SELECT %s FROM %s where `name` in (?", strings.Join(obj.GetColumns(), ","), m.from) +
 		strings.Repeat(",?", len(items)-1) + ")"
-	return m.FetchBySQL(query, params...)
+	return m.FetchBySQLCtx(ctx, query, params...)
 }
 
 // uniques
 
 func (m *_UserBaseInfoDBMgr) FetchByMailboxPassword(mailbox string, password string) (*UserBaseInfo, error) {
+	return m.FetchByMailboxPasswordCtx(context.Background(), mailbox, password)
+}
+
+func (m *_UserBaseInfoDBMgr) FetchByMailboxPasswordCtx(ctx context.Context, mailbox string, password string) (*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
 	uniq := &MailboxPasswordOfUserBaseInfoUK{
 		Mailbox:  mailbox,
 		Password: password,
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), uniq.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, uniq.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, uniq.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, uniq.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBaseInfo fetch record not found")
+	return nil, &orm.NotFoundError{Object: "UserBaseInfo", Key: uniq.Key()}
 }
 
 func (m *_UserBaseInfoDBMgr) FindOne(unique Unique) (PrimaryKey, error) {
-	objs, err := m.queryLimit(unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
+	return m.FindOneCtx(context.Background(), unique)
+}
+
+func (m *_UserBaseInfoDBMgr) FindOneCtx(ctx context.Context, unique Unique) (PrimaryKey, error) {
+	objs, err := m.queryLimit(ctx, unique.SQLFormat(true), unique.SQLLimit(), unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("UserBaseInfo find record not found")
+	return nil, &orm.NotFoundError{Object: "UserBaseInfo", Key: unique.Key()}
 }
 
 // Deprecated: Use FetchByXXXUnique instead.
 func (m *_UserBaseInfoDBMgr) FindOneFetch(unique Unique) (*UserBaseInfo, error) {
+	return m.FindOneFetchCtx(context.Background(), unique)
+}
+
+func (m *_UserBaseInfoDBMgr) FindOneFetchCtx(ctx context.Context, unique Unique) (*UserBaseInfo, error) {
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), unique.SQLFormat(true))
-	objs, err := m.FetchBySQL(query, unique.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, unique.SQLFormat(true))
+	objs, err := m.FetchBySQLCtx(ctx, query, unique.SQLParams()...)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) > 0 {
 		return objs[0], nil
 	}
-	return nil, fmt.Errorf("none record")
+	return nil, &orm.NotFoundError{Object: "UserBaseInfo", Key: unique.Key()}
 }
 
 // Deprecated: Use FindByXXXUnique instead.
 func (m *_UserBaseInfoDBMgr) Find(index Index) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindCtx(context.Background(), index)
+}
+
+func (m *_UserBaseInfoDBMgr) FindCtx(ctx context.Context, index Index) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
+	pks, err := m.queryLimit(ctx, index.SQLFormat(true), index.SQLLimit(), index.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_UserBaseInfoDBMgr) FindFetch(index Index) (int64, []*UserBaseInfo, error) {
-	total, err := m.queryCount(index.SQLFormat(false), index.SQLParams()...)
+	return m.FindFetchCtx(context.Background(), index)
+}
+
+func (m *_UserBaseInfoDBMgr) FindFetchCtx(ctx context.Context, index Index) (int64, []*UserBaseInfo, error) {
+	total, err := m.queryCount(ctx, index.SQLFormat(false), index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), index.SQLFormat(true))
-	results, err := m.FetchBySQL(query, index.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, index.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, index.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -616,22 +695,30 @@ func (m *_UserBaseInfoDBMgr) FindFetch(index Index) (int64, []*UserBaseInfo, err
 }
 
 func (m *_UserBaseInfoDBMgr) Range(scope Range) (int64, []PrimaryKey, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeCtx(context.Background(), scope)
+}
+
+func (m *_UserBaseInfoDBMgr) RangeCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
-	pks, err := m.queryLimit(scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
+	pks, err := m.queryLimit(ctx, scope.SQLFormat(true), scope.SQLLimit(), scope.SQLParams()...)
 	return total, pks, err
 }
 
 func (m *_UserBaseInfoDBMgr) RangeFetch(scope Range) (int64, []*UserBaseInfo, error) {
-	total, err := m.queryCount(scope.SQLFormat(false), scope.SQLParams()...)
+	return m.RangeFetchCtx(context.Background(), scope)
+}
+
+func (m *_UserBaseInfoDBMgr) RangeFetchCtx(ctx context.Context, scope Range) (int64, []*UserBaseInfo, error) {
+	total, err := m.queryCount(ctx, scope.SQLFormat(false), scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
 	obj := UserBaseInfoMgr.NewUserBaseInfo()
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(obj.GetColumns(), ","), scope.SQLFormat(true))
-	results, err := m.FetchBySQL(query, scope.SQLParams()...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(obj.GetColumns(), ","), m.from, scope.SQLFormat(true))
+	results, err := m.FetchBySQLCtx(ctx, query, scope.SQLParams()...)
 	if err != nil {
 		return total, nil, err
 	}
@@ -639,21 +726,29 @@ func (m *_UserBaseInfoDBMgr) RangeFetch(scope Range) (int64, []*UserBaseInfo, er
 }
 
 func (m *_UserBaseInfoDBMgr) RangeRevert(scope Range) (int64, []PrimaryKey, error) {
+	return m.RangeRevertCtx(context.Background(), scope)
+}
+
+func (m *_UserBaseInfoDBMgr) RangeRevertCtx(ctx context.Context, scope Range) (int64, []PrimaryKey, error) {
 	scope.Revert(true)
-	return m.Range(scope)
+	return m.RangeCtx(ctx, scope)
 }
 
 func (m *_UserBaseInfoDBMgr) RangeRevertFetch(scope Range) (int64, []*UserBaseInfo, error) {
+	return m.RangeRevertFetchCtx(context.Background(), scope)
+}
+
+func (m *_UserBaseInfoDBMgr) RangeRevertFetchCtx(ctx context.Context, scope Range) (int64, []*UserBaseInfo, error) {
 	scope.Revert(true)
-	return m.RangeFetch(scope)
+	return m.RangeFetchCtx(ctx, scope)
 }
 
-func (m *_UserBaseInfoDBMgr) queryLimit(where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
+func (m *_UserBaseInfoDBMgr) queryLimit(ctx context.Context, where string, limit int, args ...interface{}) (results []PrimaryKey, err error) {
 	pk := UserBaseInfoMgr.NewPrimaryKey()
-	query := fmt.Sprintf("SELECT %s FROM user_base_info %s", strings.Join(pk.Columns(), ","), where)
-	rows, err := m.db.Query(query, args...)
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(pk.Columns(), ","), m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserBaseInfo query limit error: %v", err)
+		return nil, fmt.Errorf("UserBaseInfo query limit error: %w", err)
 	}
 	defer rows.Close()
 
@@ -676,16 +771,16 @@ func (m *_UserBaseInfoDBMgr) queryLimit(where string, limit int, args ...interfa
 	}
 	if err := rows.Err(); err != nil {
 		m.db.SetError(err)
-		return nil, fmt.Errorf("UserBaseInfo query limit result error: %v", err)
+		return nil, fmt.Errorf("UserBaseInfo query limit result error: %w", err)
 	}
 	return
 }
 
-func (m *_UserBaseInfoDBMgr) queryCount(where string, args ...interface{}) (int64, error) {
-	query := fmt.Sprintf("SELECT count(`id`) FROM user_base_info %s", where)
-	rows, err := m.db.Query(query, args...)
+func (m *_UserBaseInfoDBMgr) queryCount(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := fmt.Sprintf("SELECT count(`id`) FROM %s %s", m.from, where)
+	rows, err := m.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("UserBaseInfo query count error: %v", err)
+		return 0, fmt.Errorf("UserBaseInfo query count error: %w", err)
 	}
 	defer rows.Close()
 
@@ -1,7 +1,11 @@
 package model_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	. "github.com/ezbuy/redis-orm/example/model"
@@ -9,8 +13,6 @@ import (
 
 	"log"
 
-	"strings"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -244,6 +246,75 @@ var _ = Describe("redis-orm.mysql", func() {
 			Ω(err).ShouldNot(HaveOccurred())
 		})
 
+		It("save on a taken unique key", func() {
+			tx, err := MySQL().BeginTx()
+			Ω(err).ShouldNot(HaveOccurred())
+			defer tx.Close()
+
+			mgr := UserDBMgr(tx)
+			user := UserMgr.NewUser()
+			user.Name = "user01"
+			user.Mailbox = "unique@sss.fff"
+			user.Password = "123456"
+			_, err = mgr.Create(user)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			//! another key with the same mailbox and password
+			other := UserMgr.NewUser()
+			other.Id = user.Id + 1000
+			other.Name = "user02"
+			other.Mailbox = user.Mailbox
+			other.Password = user.Password
+			_, err = mgr.Save(other)
+			Ω(errors.Is(err, orm.ErrDuplicateKey)).To(Equal(true))
+			tx.SetError(nil)
+
+			//! an unchanged row is not written either but keeps its key
+			n, err := mgr.Save(user)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(n).To(Equal(int64(1)))
+
+			obj, err := mgr.FetchByPrimaryKey(user.Id)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(obj.Name).To(Equal("user01"))
+
+			_, err = mgr.Delete(user)
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+
+		It("comment timestamps", func() {
+			now := time.Now().Truncate(time.Second)
+			orm.Now = func() time.Time { return now }
+			defer func() { orm.Now = time.Now }()
+
+			tx, err := MySQL().BeginTx()
+			Ω(err).ShouldNot(HaveOccurred())
+			defer tx.Close()
+
+			mgr := CommentDBMgr(tx)
+			comment := CommentMgr.NewComment()
+			comment.BlogId = 1
+			comment.Content = "first"
+			_, err = mgr.Create(comment)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(comment.CreatedAt).To(BeTemporally("==", now))
+			Ω(comment.UpdatedAt).To(BeTemporally("==", now))
+
+			created := now
+			now = now.Add(time.Minute)
+			comment.Content = "edited"
+			_, err = mgr.Update(comment)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			obj, err := mgr.FetchByPrimaryKey(comment.Id)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(obj.CreatedAt).To(BeTemporally("==", created))
+			Ω(obj.UpdatedAt).To(BeTemporally("==", now))
+
+			_, err = mgr.Delete(comment)
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+
 		Measure("mysql.bench", func(b Benchmarker) {
 			b.Time("crud.runtime", func() {
 				user := UserMgr.NewUser()
@@ -563,6 +634,33 @@ var _ = Describe("redis-orm.redis", func() {
 			Ω(UserRedisMgr(Redis()).Clear()).ShouldNot(HaveOccurred())
 			Ω(UserRedisMgr(Redis()).Load(UserDBMgr(MySQL()))).ShouldNot(HaveOccurred())
 		})
+		It("clear", func() {
+			Ω(UserRedisMgr(Redis()).Load(UserDBMgr(MySQL()))).ShouldNot(HaveOccurred())
+			batches := 0
+			n, err := UserRedisMgr(Redis()).ClearWithProgress(func(progress *orm.ClearProgress) {
+				batches++
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(n).To(BeNumerically(">=", 100))
+			Ω(batches).To(BeNumerically(">", 0))
+		})
+		It("canceled context", func() {
+			mgr := UserRedisMgr(Redis())
+			Ω(mgr.Load(UserDBMgr(MySQL()))).ShouldNot(HaveOccurred())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := mgr.FetchCtx(ctx, &IdOfUserPK{Id: 1})
+			Ω(err).To(Equal(context.Canceled))
+			Ω(mgr.SaveCtx(ctx, UserMgr.NewUser())).To(Equal(context.Canceled))
+			_, _, err = mgr.FindCtx(ctx, &SexOfUserIDX{Sex: true})
+			Ω(err).To(Equal(context.Canceled))
+			_, err = mgr.ClearWithProgressCtx(ctx, nil)
+			Ω(err).To(Equal(context.Canceled))
+
+			_, err = mgr.Fetch(&IdOfUserPK{Id: 1})
+			Ω(err).ShouldNot(HaveOccurred())
+		})
 	})
 
 	Describe("crud", func() {
@@ -608,7 +706,7 @@ var _ = Describe("redis-orm.redis", func() {
 			time.Sleep(time.Second)
 			_, err = UserRedisMgr(Redis()).Fetch(userWithExpire.GetPrimaryKey())
 			fmt.Printf("createWithExpire after expire:%v", err)
-			Ω(strings.Contains(err.Error(), "not exist")).Should(Equal(true))
+			Ω(errors.Is(err, orm.ErrNotFound)).Should(Equal(true))
 		})
 		It("update", func() {
 			user.Age = int32(40)
@@ -645,7 +743,7 @@ var _ = Describe("redis-orm.redis", func() {
 			time.Sleep(time.Second)
 			_, err = UserRedisMgr(Redis()).Fetch(userWithExpire.GetPrimaryKey())
 			fmt.Printf("updateWithExpire after expire:%v", err)
-			Ω(strings.Contains(err.Error(), "not exist")).Should(Equal(true))
+			Ω(errors.Is(err, orm.ErrNotFound)).Should(Equal(true))
 		})
 		It("delete", func() {
 			Ω(UserRedisMgr(Redis()).Delete(user)).ShouldNot(HaveOccurred())
@@ -657,6 +755,93 @@ var _ = Describe("redis-orm.redis", func() {
 			_, err := UserRedisMgr(Redis()).Fetch(userWithExpire.GetPrimaryKey())
 			Ω(err).Should(HaveOccurred())
 		})
+		It("schema drift", func() {
+			store := *Redis()
+			var drifts []*orm.SchemaDrift
+			store.OnSchemaDrift(func(drift *orm.SchemaDrift) {
+				drifts = append(drifts, drift)
+			})
+			store.LazyUpgrade(true)
+			mgr := UserRedisMgr(&store)
+
+			user = UserMgr.NewUser()
+			user.Id = 103
+			user.Name = "name103"
+			user.Age = int32(30)
+			Ω(mgr.Create(user)).ShouldNot(HaveOccurred())
+			key := fmt.Sprintf("%s:%s:object:%s", user.GetStoreType(), user.GetClassName(), user.GetPrimaryKey().Key())
+			Ω(store.HDel(key, "Age", "Description").Err()).ShouldNot(HaveOccurred())
+
+			obj, err := mgr.Fetch(user.GetPrimaryKey())
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(obj.Name).To(Equal("name103"))
+			Ω(obj.Age).To(Equal(int32(0)))
+			Ω(drifts).To(HaveLen(1))
+			Ω(drifts[0].Missing).To(Equal([]string{"Age", "Description"}))
+
+			//! the upgraded hash has the full field set
+			obj, err = mgr.Fetch(user.GetPrimaryKey())
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(drifts).To(HaveLen(1))
+			Ω(mgr.Delete(obj)).ShouldNot(HaveOccurred())
+		})
+		It("update indexes", func() {
+			mgr := UserRedisMgr(Redis())
+			user = UserMgr.NewUser()
+			user.Id = 104
+			user.Mailbox = "name104@ezbuy.com"
+			user.Password = "pwd104"
+			user.Sex = true
+			Ω(mgr.Create(user)).ShouldNot(HaveOccurred())
+
+			//! a fresh object of the same key, the save reads the stored values
+			moved := UserMgr.NewUser()
+			moved.Id = 104
+			moved.Mailbox = "moved104@ezbuy.com"
+			moved.Password = "pwd104"
+			moved.Sex = false
+			Ω(mgr.Update(moved)).ShouldNot(HaveOccurred())
+
+			_, err := mgr.FindOne(&MailboxPasswordOfUserUK{Mailbox: "name104@ezbuy.com", Password: "pwd104"})
+			Ω(errors.Is(err, orm.ErrNotFound)).Should(Equal(true))
+			pk, err := mgr.FindOne(&MailboxPasswordOfUserUK{Mailbox: "moved104@ezbuy.com", Password: "pwd104"})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(pk.Key()).To(Equal(moved.GetPrimaryKey().Key()))
+
+			_, pks, err := mgr.Find(&SexOfUserIDX{Sex: true})
+			Ω(err).ShouldNot(HaveOccurred())
+			for _, pk := range pks {
+				Ω(pk.Key()).ShouldNot(Equal(moved.GetPrimaryKey().Key()))
+			}
+			Ω(mgr.Delete(moved)).ShouldNot(HaveOccurred())
+		})
+		Measure("redis.save.batch", func(b Benchmarker) {
+			mgr := UserRedisMgr(Redis())
+			users := make([]*User, 0, 1000)
+			for i := 0; i < 1000; i++ {
+				user := UserMgr.NewUser()
+				user.Id = int32(1000 + i)
+				user.Name = fmt.Sprintf("name%d", 1000+i)
+				user.Mailbox = fmt.Sprintf("name%d@ezbuy.com", 1000+i)
+				user.Password = fmt.Sprintf("pwd%d", 1000+i)
+				user.Age = int32(i % 100)
+				users = append(users, user)
+			}
+
+			before := commandsProcessed()
+			b.Time("save.batch.runtime", func() {
+				Ω(mgr.SaveBatch(users)).ShouldNot(HaveOccurred())
+			})
+			//! a HMGET, a HMSET and one command per unique, index and range of
+			//! each user, the WATCH, MULTI, EXEC and INFO around are the constant
+			commands := commandsProcessed() - before
+			b.RecordValue("commands per user", float64(commands)/float64(len(users)))
+			Ω(commands).To(BeNumerically("<=", len(users)*(2+4)+10))
+
+			for _, user := range users {
+				Ω(mgr.Delete(user)).ShouldNot(HaveOccurred())
+			}
+		}, 1)
 
 		Measure("redis.bench", func(b Benchmarker) {
 			b.Time("crud.runtime", func() {
@@ -798,3 +983,17 @@ var _ = Describe("redis-orm.redis", func() {
 		}, 1)
 	})
 })
+
+// commandsProcessed reads the count of the commands run by the redis server
+func commandsProcessed() int64 {
+	info, err := Redis().Info("stats").Result()
+	Ω(err).ShouldNot(HaveOccurred())
+	for _, line := range strings.Split(info, "\r\n") {
+		if strings.HasPrefix(line, "total_commands_processed:") {
+			n, err := strconv.ParseInt(strings.TrimPrefix(line, "total_commands_processed:"), 10, 64)
+			Ω(err).ShouldNot(HaveOccurred())
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+// UserID is the id of the users owning the notes.
+type UserID int32
+
+func (id UserID) String() string {
+	return fmt.Sprintf("user#%d", int32(id))
+}
+
+// Attachment is a file attached to a note, the attachments of a note are
+// stored as a JSON array in its row.
+type Attachment struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// BeforeSave keeps the slugs of the notes trimmed and lower case.
+func (obj *Note) BeforeSave(db orm.DB) error {
+	obj.Slug = strings.ToLower(strings.TrimSpace(obj.Slug))
+	return nil
+}
+
+// BeforeCreate starts the new notes without a content with their slug.
+func (obj *Note) BeforeCreate(db orm.DB) error {
+	if obj.Content == "" {
+		obj.Content = obj.Slug
+	}
+	return nil
+}
@@ -0,0 +1,727 @@
+package model_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	. "github.com/ezbuy/redis-orm/example/model"
+	"github.com/ezbuy/redis-orm/orm"
+
+	. "github.com/onsi/gomega"
+)
+
+// sqlite tests run without the servers of the ginkgo suite:
+// go test ./example/model -run SQLite
+func TestSQLite(t *testing.T) {
+	cases := []struct {
+		name string
+		run  func(g *GomegaWithT)
+	}{
+		{"crud", sqliteCRUD},
+		{"finder", sqliteFinder},
+		{"context", sqliteContext},
+		{"upsert", sqliteUpsert},
+		{"update fields", sqliteUpdateFields},
+		{"version", sqliteVersion},
+		{"soft delete", sqliteSoftDelete},
+		{"auto time", sqliteAutoTime},
+		{"hooks", sqliteHooks},
+		{"enum", sqliteEnum},
+		{"json", sqliteJSON},
+		{"decimal and bytes", sqliteDecimalBytes},
+		{"gotype", sqliteGoType},
+		{"default", sqliteDefault},
+	}
+
+	SQLiteSetup(&SQLiteConfig{
+		Database: ":memory:",
+	})
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			setupSQLite(g)
+			defer teardownSQLite(g)
+			c.run(g)
+		})
+	}
+}
+
+// setupSQLite creates the tables of the sqlite models with 100 todos,
+// title%d of owner i%10 with priority i, and 10 notes, note%d of owner i%2
+// with i stars.
+func setupSQLite(g *GomegaWithT) {
+	for _, model := range []string{"todo", "note"} {
+		script, err := ioutil.ReadFile("../script/gen.script.sqlite." + model + ".sql")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		_, err = SQLite().Exec(string(script))
+		g.Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	tx, err := SQLite().BeginTx()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer tx.Close()
+
+	todos := []*Todo{}
+	for i := 0; i < 100; i++ {
+		todo := TodoMgr.NewTodo()
+		todo.OwnerId = int32(i % 10)
+		todo.Title = fmt.Sprintf("title%d", i)
+		todo.Done = i%2 == 0
+		todo.Priority = int32(i)
+		todo.DueAt = time.Now()
+		todo.CreatedAt = todo.DueAt
+		todos = append(todos, todo)
+	}
+	n, err := TodoDBMgr(tx).BatchCreate(todos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(100)))
+
+	notes := []*Note{}
+	for i := 0; i < 10; i++ {
+		note := NoteMgr.NewNote()
+		note.OwnerId = UserID(i % 2)
+		note.Slug = fmt.Sprintf("note%d", i)
+		note.Stars = int32(i)
+		notes = append(notes, note)
+	}
+	_, err = NoteDBMgr(tx).BatchCreate(notes)
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func teardownSQLite(g *GomegaWithT) {
+	_, err := SQLite().Exec("DROP TABLE todos; DROP TABLE notes")
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func sqliteCRUD(g *GomegaWithT) {
+
+	todo := TodoMgr.NewTodo()
+	todo.OwnerId = 100
+	todo.Title = "todo01"
+	todo.Remark = "remark"
+	todo.DueAt = time.Now().Add(time.Hour).Truncate(time.Second)
+	todo.CreatedAt = time.Now().Truncate(time.Second)
+
+	tx, err := SQLite().BeginTx()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer tx.Close()
+
+	mgr := TodoDBMgr(tx)
+
+	//! create
+	n, err := mgr.Create(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(todo.Id).To(Equal(int64(101)))
+
+	//! update
+	todo.Done = true
+	n, err = mgr.Update(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+
+	//! fetch check
+	obj, err := mgr.FetchByPrimaryKey(todo.Id)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Done).To(Equal(true))
+	g.Expect(obj.Remark).To(Equal(todo.Remark))
+	g.Expect(obj.DueAt.Equal(todo.DueAt)).To(Equal(true))
+	g.Expect(obj.CreatedAt.Equal(todo.CreatedAt)).To(Equal(true))
+
+	obj, err = mgr.FetchByTitle(todo.Title)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Id).To(Equal(todo.Id))
+
+	//! delete
+	n, err = mgr.Delete(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+
+	_, err = mgr.FetchByPrimaryKey(todo.Id)
+	g.Expect(IsErrNotFound(err)).To(Equal(true))
+	g.Expect(errors.Is(err, orm.ErrNotFound)).To(Equal(true))
+
+	_, err = mgr.FetchByTitle(todo.Title)
+	g.Expect(errors.Is(err, orm.ErrNotFound)).To(Equal(true))
+
+	//! save
+	n, err = mgr.Save(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+
+	exist, err := mgr.Exist(todo.GetPrimaryKey())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(exist).To(Equal(true))
+
+	//! duplicate unique title
+	dup := TodoMgr.NewTodo()
+	dup.Title = todo.Title
+	_, err = mgr.Create(dup)
+	g.Expect(errors.Is(err, orm.ErrDuplicateKey)).To(Equal(true))
+	var dupErr *orm.DuplicateKeyError
+	g.Expect(errors.As(err, &dupErr)).To(Equal(true))
+	g.Expect(dupErr.Index).To(Equal("todos.title"))
+}
+
+func sqliteFinder(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	//! index
+	objs, err := mgr.FindAllByOwnerId(3)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(10))
+
+	objs, err = mgr.FindByOwnerId(3, 4, 8)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(2))
+
+	objs, err = mgr.FindByOwnerIdGroup([]int32{1, 2})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(20))
+
+	//! range
+	scope := &PriorityOfTodoRNG{
+		PriorityBegin: 10,
+		PriorityEnd:   35,
+	}
+	scope.Limit(10)
+	total, pks, err := mgr.Range(scope)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(int(total)).To(Equal(24))
+	g.Expect(len(pks)).To(Equal(10))
+
+	keys := make([]int64, len(pks))
+	for i, pk := range pks {
+		keys[i] = pk.(*IdOfTodoPK).Id
+	}
+	objs, err = mgr.FetchByPrimaryKeys(keys)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(10))
+
+	_, objs, err = mgr.RangeRevertFetch(&PriorityOfTodoRNG{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(100))
+	g.Expect(objs[0].Priority).To(Equal(int32(99)))
+
+	//! search
+	objs, err = mgr.SearchConditions([]string{"done = ?"}, "ORDER BY id DESC", 10, 5, true)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(5))
+	g.Expect(objs[0].Title).To(Equal("title78"))
+
+	count, err := mgr.SearchConditionsCount([]string{"priority < ?"}, 50)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(count).To(Equal(int64(50)))
+}
+
+func sqliteContext(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	obj, err := mgr.FetchByPrimaryKeyCtx(ctx, 1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Title).To(Equal("title0"))
+
+	total, objs, err := mgr.RangeFetchCtx(ctx, &PriorityOfTodoRNG{PriorityBegin: 10, PriorityEnd: 20})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(int(total)).To(Equal(9))
+	g.Expect(len(objs)).To(Equal(9))
+
+	//! canceled context
+	canceled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	_, err = mgr.FetchByPrimaryKeyCtx(canceled, 1)
+	g.Expect(err).Should(HaveOccurred())
+
+	_, err = SQLite().BeginTxContext(canceled, nil)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func sqliteUpsert(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	//! save an unchanged row
+	todo, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	n, err := mgr.Save(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+
+	//! save a deleted row keeps its key
+	_, err = mgr.Delete(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	n, err = mgr.Save(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	obj, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Title).To(Equal(todo.Title))
+
+	//! batch upsert of existing and new rows
+	objs, err := mgr.FetchByPrimaryKeys([]int64{2, 3})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	for _, obj := range objs {
+		obj.Done = true
+		obj.Title += "-upsert"
+	}
+	created := TodoMgr.NewTodo()
+	created.Title = "upsert"
+	created.DueAt = time.Now()
+	created.CreatedAt = created.DueAt
+	n, err = mgr.BatchUpsert(append(objs, created))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(3)))
+
+	obj, err = mgr.FetchByTitle("title2-upsert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Done).To(Equal(true))
+	_, err = mgr.FetchByTitle("upsert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	count, err := mgr.SearchConditionsCount(nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(count).To(Equal(int64(101)))
+
+	//! a new key taking the unique title of another row
+	taken := TodoMgr.NewTodo()
+	taken.Id = 1000
+	taken.Title = "title4"
+	_, err = mgr.Save(taken)
+	g.Expect(errors.Is(err, orm.ErrDuplicateKey)).To(Equal(true))
+	obj, err = mgr.FetchByTitle("title4")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Id).To(Equal(int64(5)))
+}
+
+func sqliteUpdateFields(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	todo, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	//! a concurrent writer changes another column
+	_, err = mgr.UpdateBySQL("priority = ?", "id = ?", 1000, todo.Id)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	//! update writes the changed columns only
+	todo.SetTitle("dirty").SetDone(true)
+	g.Expect(todo.DirtyColumns()).To(Equal([]string{TodoColumns.Title, TodoColumns.Done}))
+	n, err := mgr.Update(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(todo.DirtyColumns()).To(BeEmpty())
+
+	obj, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Title).To(Equal("dirty"))
+	g.Expect(obj.Done).To(Equal(true))
+	g.Expect(obj.Priority).To(Equal(int32(1000)))
+
+	//! nothing changed by a mutator, update writes every column
+	todo.Remark = "assigned"
+	n, err = mgr.Update(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+
+	obj, err = mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Remark).To(Equal("assigned"))
+	g.Expect(obj.Priority).To(Equal(todo.Priority))
+
+	//! update all writes the assignments beside the changed columns
+	todo.Remark = "all"
+	todo.SetOwnerId(7)
+	n, err = mgr.UpdateAll(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(todo.DirtyColumns()).To(BeEmpty())
+
+	obj, err = mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Remark).To(Equal("all"))
+	g.Expect(obj.OwnerId).To(Equal(int32(7)))
+
+	//! explicit columns
+	todo.SetRemark("remark").SetOwnerId(99)
+	n, err = mgr.UpdateFields(todo, TodoColumns.Remark)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(todo.DirtyColumns()).To(Equal([]string{TodoColumns.OwnerId}))
+
+	obj, err = mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Remark).To(Equal("remark"))
+	g.Expect(obj.OwnerId).To(Equal(int32(7)))
+
+	_, err = mgr.UpdateFields(todo, TodoColumns.Id)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func sqliteVersion(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	todo, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	stale, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	//! update bumps the version
+	todo.Done = true
+	n, err := mgr.Update(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(todo.Version).To(Equal(int32(1)))
+
+	//! the stale copy is refused
+	stale.Title = "stale"
+	_, err = mgr.Update(stale)
+	g.Expect(errors.Is(err, orm.ErrConflict)).To(Equal(true))
+	var conflict *orm.ConflictError
+	g.Expect(errors.As(err, &conflict)).To(Equal(true))
+	g.Expect(conflict.Version).To(Equal(int64(0)))
+	g.Expect(stale.Version).To(Equal(int32(0)))
+
+	_, err = mgr.Update(stale.SetTitle("stale"))
+	g.Expect(errors.Is(err, orm.ErrConflict)).To(Equal(true))
+
+	_, err = mgr.Save(stale)
+	g.Expect(errors.Is(err, orm.ErrConflict)).To(Equal(true))
+	g.Expect(stale.Version).To(Equal(int32(0)))
+
+	obj, err := mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Title).To(Equal(todo.Title))
+	g.Expect(obj.Version).To(Equal(int32(1)))
+
+	//! save at the current version
+	n, err = mgr.Save(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(todo.Version).To(Equal(int32(2)))
+
+	obj, err = mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Version).To(Equal(int32(2)))
+
+	//! a batch writes the current objects and reports the stale ones
+	current, err := mgr.FetchByPrimaryKey(2)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	current.Title = "current"
+	stale.Title = "stale"
+	n, err = mgr.BatchUpsert([]*Todo{stale, current})
+	g.Expect(n).To(Equal(int64(1)))
+	var errs orm.MultiError
+	g.Expect(errors.As(err, &errs)).To(Equal(true))
+	g.Expect(len(errs)).To(Equal(1))
+	g.Expect(errors.As(err, &conflict)).To(Equal(true))
+	g.Expect(conflict.Key).To(Equal(stale.GetPrimaryKey().Key()))
+	g.Expect(stale.Version).To(Equal(int32(0)))
+	g.Expect(current.Version).To(Equal(int32(1)))
+
+	obj, err = mgr.FetchByPrimaryKey(1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Title).To(Equal(todo.Title))
+	g.Expect(obj.Version).To(Equal(int32(2)))
+	obj, err = mgr.FetchByPrimaryKey(2)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Title).To(Equal("current"))
+	g.Expect(obj.Version).To(Equal(int32(1)))
+}
+
+func sqliteSoftDelete(g *GomegaWithT) {
+	mgr := NoteDBMgr(SQLite())
+
+	//! delete marks the row
+	note, err := mgr.FetchBySlug("note2")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	n, err := mgr.Delete(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(note.DeletedAt).ShouldNot(BeNil())
+	n, err = mgr.DeleteByPrimaryKey(5)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+
+	//! the finders skip the deleted rows
+	_, err = mgr.FetchByPrimaryKey(note.Id)
+	g.Expect(errors.Is(err, orm.ErrNotFound)).To(Equal(true))
+	_, err = mgr.FetchBySlug("note2")
+	g.Expect(errors.Is(err, orm.ErrNotFound)).To(Equal(true))
+	objs, err := mgr.FindAllByOwnerId(0)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(3))
+	total, _, err := mgr.Range(&StarsOfNoteRNG{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(total).To(Equal(int64(8)))
+	count, err := mgr.SearchConditionsCount([]string{"stars < ?"}, 5)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(count).To(Equal(int64(3)))
+
+	//! the deleted rows on demand
+	count, err = mgr.WithDeleted().SearchCount("")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(count).To(Equal(int64(10)))
+	objs, err = mgr.OnlyDeleted().FindAllByOwnerId(0)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(2))
+	g.Expect(objs[0].DeletedAt).ShouldNot(BeNil())
+
+	//! a deleted row is not deleted twice
+	n, err = mgr.Delete(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(0)))
+
+	//! restore
+	n, err = mgr.Restore(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(n).To(Equal(int64(1)))
+	g.Expect(note.DeletedAt).To(BeNil())
+	obj, err := mgr.FetchBySlug("note2")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.DeletedAt).To(BeNil())
+}
+
+func sqliteAutoTime(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local)
+	orm.Now = func() time.Time { return now }
+	defer func() { orm.Now = time.Now }()
+
+	//! create stamps both
+	todo := TodoMgr.NewTodo()
+	todo.Title = "stamped"
+	todo.DueAt = now
+	_, err := mgr.Create(todo)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(todo.CreatedAt).To(BeTemporally("==", now))
+	g.Expect(todo.UpdatedAt).To(BeTemporally("==", now))
+
+	//! update stamps the update time only
+	created := now
+	now = now.Add(time.Hour)
+	_, err = mgr.Update(todo.SetDone(true))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(todo.CreatedAt).To(BeTemporally("==", created))
+	g.Expect(todo.UpdatedAt).To(BeTemporally("==", now))
+
+	obj, err := mgr.FetchByPrimaryKey(todo.Id)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.CreatedAt).To(BeTemporally("==", created))
+	g.Expect(obj.UpdatedAt).To(BeTemporally("==", now))
+
+	//! save keeps the stored creation time
+	now = now.Add(time.Hour)
+	obj.CreatedAt = time.Time{}
+	_, err = mgr.Save(obj)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.CreatedAt).To(BeTemporally("==", created))
+	g.Expect(obj.UpdatedAt).To(BeTemporally("==", now))
+
+	obj, err = mgr.FetchByPrimaryKey(todo.Id)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.CreatedAt).To(BeTemporally("==", created))
+	g.Expect(obj.UpdatedAt).To(BeTemporally("==", now))
+
+	//! save stamps a new row like create
+	saved := TodoMgr.NewTodo()
+	saved.Id = 1000
+	saved.Title = "saved"
+	_, err = mgr.Save(saved)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(saved.CreatedAt).To(BeTemporally("==", now))
+	g.Expect(saved.UpdatedAt).To(BeTemporally("==", now))
+}
+
+func sqliteHooks(g *GomegaWithT) {
+	mgr := NoteDBMgr(SQLite())
+
+	//! BeforeSave of Note normalizes the slug
+	note := NoteMgr.NewNote()
+	note.Slug = " Hooked "
+	_, err := mgr.Create(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(note.Slug).To(Equal("hooked"))
+
+	obj, err := mgr.FetchBySlug("hooked")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Id).To(Equal(note.Id))
+	g.Expect(obj.Content).To(Equal("hooked"))
+
+	//! save runs the save hooks only, a new row as well as a stored one
+	saved := NoteMgr.NewNote()
+	saved.Id = 100
+	saved.Slug = " Saved "
+	_, err = mgr.Save(saved)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(saved.Slug).To(Equal("saved"))
+	g.Expect(saved.Content).To(BeEmpty())
+
+	saved.Slug = " Updated "
+	_, err = mgr.Save(saved)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(saved.Slug).To(Equal("updated"))
+	obj, err = mgr.FetchBySlug("updated")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Id).To(Equal(saved.Id))
+
+	//! the failed validation aborts the write and rolls the transaction back
+	tx, err := SQLite().BeginTx()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	note = NoteMgr.NewNote()
+	note.Slug = "rolled"
+	_, err = NoteDBMgr(tx).Create(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = NoteDBMgr(tx).Create(NoteMgr.NewNote())
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(tx.Close()).ShouldNot(HaveOccurred())
+
+	_, err = mgr.FetchBySlug("rolled")
+	g.Expect(errors.Is(err, orm.ErrNotFound)).To(Equal(true))
+	count, err := mgr.SearchCount("")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(count).To(Equal(int64(12)))
+}
+
+func sqliteEnum(g *GomegaWithT) {
+	mgr := NoteDBMgr(SQLite())
+
+	note, err := mgr.FetchBySlug("note1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(note.Visibility).To(Equal(NoteVisibilityPrivate))
+
+	_, err = mgr.Update(note.SetVisibility(NoteVisibilityPublic))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	//! stored and indexed by the value string
+	count, err := mgr.SearchCount("WHERE visibility = ?", "public")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(count).To(Equal(int64(1)))
+	objs, err := mgr.FindAllByVisibility(NoteVisibilityPublic)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(1))
+	g.Expect(objs[0].Slug).To(Equal("note1"))
+
+	//! the CHECK constraint refuses the other values
+	_, err = mgr.UpdateBySQL("visibility = ?", "id = ?", "secret", note.Id)
+	g.Expect(errors.Is(err, orm.ErrConstraint)).To(Equal(true))
+
+	//! the same values in json and redis
+	b, err := json.Marshal(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(b)).To(ContainSubstring(`"Visibility":"public"`))
+
+	var v NoteVisibility
+	g.Expect(orm.StringScan(fmt.Sprint(NoteVisibilityShared), &v)).ShouldNot(HaveOccurred())
+	g.Expect(v).To(Equal(NoteVisibilityShared))
+	g.Expect(orm.StringScan("secret", &v)).Should(HaveOccurred())
+}
+
+func sqliteJSON(g *GomegaWithT) {
+	mgr := NoteDBMgr(SQLite())
+
+	note := NoteMgr.NewNote()
+	note.Slug = "attached"
+	note.Meta = map[string]interface{}{"color": "red", "pinned": true}
+	note.Attachments = []Attachment{{Name: "a.png", URL: "https://example.com/a.png"}}
+	_, err := mgr.Create(note)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	//! stored as the JSON text
+	var text string
+	g.Expect(SQLite().QueryRow("SELECT attachments FROM notes WHERE slug = ?", "attached").Scan(&text)).ShouldNot(HaveOccurred())
+	g.Expect(text).To(Equal(`[{"name":"a.png","url":"https://example.com/a.png"}]`))
+
+	obj, err := mgr.FetchBySlug("attached")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Meta).To(Equal(map[string]interface{}{"color": "red", "pinned": true}))
+	g.Expect(obj.Attachments).To(Equal(note.Attachments))
+
+	obj.Meta["color"] = "blue"
+	_, err = mgr.UpdateFields(obj, NoteColumns.Meta)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	obj, err = mgr.FetchBySlug("attached")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Meta["color"]).To(Equal("blue"))
+
+	//! the nil values of the batch are stored as null
+	obj, err = mgr.FetchBySlug("note0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Meta).To(BeNil())
+	g.Expect(obj.Attachments).To(BeNil())
+}
+
+func sqliteDecimalBytes(g *GomegaWithT) {
+	mgr := TodoDBMgr(SQLite())
+
+	todo, err := mgr.FetchByTitle("title1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(todo.Budget.IsZero()).To(Equal(true))
+	g.Expect(todo.Attachment).To(BeNil())
+
+	//! the decimals keep all of their digits, rounded to the scale
+	todo.Budget = orm.MustParseDecimal("12345678.045").Add(orm.NewDecimal(10, 2))
+	todo.Attachment = []byte{0x00, 0xff, 0x10}
+	_, err = mgr.UpdateFields(todo, TodoColumns.Budget, TodoColumns.Attachment)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	obj, err := mgr.FetchByTitle("title1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Budget.String()).To(Equal("12345678.15"))
+	g.Expect(obj.Attachment).To(Equal([]byte{0x00, 0xff, 0x10}))
+
+	b, err := json.Marshal(obj.Budget)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(b)).To(Equal("12345678.15"))
+
+	//! the string of redis
+	var d orm.Decimal
+	g.Expect(orm.StringScan(fmt.Sprint(obj.Budget), &d)).ShouldNot(HaveOccurred())
+	g.Expect(d.Cmp(obj.Budget)).To(Equal(0))
+	g.Expect(orm.StringScan("12,5", &d)).Should(HaveOccurred())
+	g.Expect(orm.MustParseDecimal("2.345").Round(2).String()).To(Equal("2.35"))
+	g.Expect(orm.MustParseDecimal("-2.345").Round(2).String()).To(Equal("-2.35"))
+	g.Expect(orm.MustParseDecimal("1.5e-3").String()).To(Equal("0.0015"))
+}
+
+func sqliteGoType(g *GomegaWithT) {
+	mgr := NoteDBMgr(SQLite())
+
+	objs, err := mgr.FindAllByOwnerId(UserID(1))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(len(objs)).To(Equal(5))
+	g.Expect(objs[0].OwnerId).To(Equal(UserID(1)))
+
+	//! the keys of redis are of the value, not of the String method
+	idx := &OwnerIdOfNoteIDX{OwnerId: UserID(1)}
+	g.Expect(idx.Key()).To(Equal("OwnerId:1"))
+	g.Expect(fmt.Sprint(objs[0].OwnerId)).To(Equal("user#1"))
+
+	var id UserID
+	g.Expect(orm.StringScan(orm.Sprint(UserID(7)), &id)).ShouldNot(HaveOccurred())
+	g.Expect(id).To(Equal(UserID(7)))
+	g.Expect(orm.StringScan("user#7", &id)).Should(HaveOccurred())
+}
+
+func sqliteDefault(g *GomegaWithT) {
+	mgr := NoteDBMgr(SQLite())
+
+	//! the constructor and the column share the default
+	g.Expect(NoteMgr.NewNote().Color).To(Equal("yellow"))
+	_, err := SQLite().Exec("INSERT INTO notes(owner_id, slug, content, stars, visibility, meta, attachments) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		1, "raw", "", 0, "private", "null", "null")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	obj, err := mgr.FetchBySlug("raw")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Color).To(Equal("yellow"))
+
+	obj, err = mgr.FetchBySlug("note1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj.Color).To(Equal("yellow"))
+}
@@ -0,0 +1,17 @@
+
+CREATE TABLE [dbo].[testCRUD] (
+	[office_id] INT IDENTITY(1,1) NOT NULL,
+	[office_area] NVARCHAR(100) NOT NULL DEFAULT N'',
+	[office_name] NVARCHAR(100) NOT NULL DEFAULT N'',
+	[search_origin_code] NVARCHAR(100) NOT NULL DEFAULT N'',
+	[processing_origin_code] NVARCHAR(100) NOT NULL DEFAULT N'',
+	[create_by] NVARCHAR(100) NOT NULL DEFAULT N'',
+	[update_by] NVARCHAR(100) NOT NULL DEFAULT N'',
+	[create_date] DATETIME NOT NULL DEFAULT GETDATE(),
+	[update_date] DATETIME NOT NULL DEFAULT GETDATE(),
+	CONSTRAINT [PK_testCRUD] PRIMARY KEY ([office_id])
+);
+GO
+EXEC sp_addextendedproperty N'MS_Description', N'testCRUD', N'SCHEMA', N'dbo', N'TABLE', N'testCRUD';
+GO
+
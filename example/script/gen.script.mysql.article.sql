@@ -0,0 +1,18 @@
+
+CREATE TABLE `articles` (
+	`id` BIGINT(20) NOT NULL AUTO_INCREMENT,
+	`author_id` INT(11) NOT NULL DEFAULT '0',
+	`slug` VARCHAR(64) NOT NULL DEFAULT '',
+	`title` VARCHAR(128) NOT NULL DEFAULT '',
+	`content` TEXT NOT NULL DEFAULT '' COMMENT 'markdown content',
+	`published` TINYINT(1) UNSIGNED NOT NULL DEFAULT '0',
+	`rating` FLOAT NULL ,
+	`hits` INT(11) NOT NULL DEFAULT '0',
+	`published_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	`updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY(`id`),
+	UNIQUE KEY `uniq_slug_of_article_uk` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT 'articles of the blog';
+CREATE INDEX `author_id_of_article_idx` ON `articles`(`author_id`);
+CREATE INDEX `hits_of_article_rng` ON `articles`(`hits`);
+
@@ -4,7 +4,7 @@ CREATE TABLE `blogs` (
 	`user_id` INT(11) NOT NULL DEFAULT '0',
 	`title` VARCHAR(100) NOT NULL DEFAULT '',
 	`content` VARCHAR(100) NOT NULL DEFAULT '',
-	`status` INT(11) NOT NULL DEFAULT '0',
+	`status` ENUM('draft','published','archived') NOT NULL DEFAULT 'draft',
 	`readed` INT(11) NOT NULL DEFAULT '0',
 	`created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 	`updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
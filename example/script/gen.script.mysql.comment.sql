@@ -0,0 +1,12 @@
+
+CREATE TABLE `comments` (
+	`id` BIGINT(20) NOT NULL AUTO_INCREMENT,
+	`blog_id` INT(11) NOT NULL DEFAULT '0',
+	`user_id` INT(11) NOT NULL DEFAULT '0',
+	`content` VARCHAR(100) NOT NULL DEFAULT '',
+	`created_at` BIGINT(20) NOT NULL DEFAULT '0',
+	`updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY(`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT 'blog comments stamped with their creation and update times';
+CREATE INDEX `blog_id_of_comment_idx` ON `comments`(`blog_id`);
+
@@ -0,0 +1,19 @@
+
+CREATE TABLE `notes` (
+	`id` BIGINT(20) NOT NULL AUTO_INCREMENT,
+	`owner_id` INT(11) NOT NULL DEFAULT '0',
+	`slug` VARCHAR(64) NOT NULL DEFAULT '',
+	`content` VARCHAR(100) NOT NULL DEFAULT '',
+	`color` VARCHAR(16) NOT NULL DEFAULT 'yellow',
+	`stars` INT(11) NOT NULL DEFAULT '0',
+	`visibility` ENUM('private','shared','public') NOT NULL DEFAULT 'private',
+	`meta` JSON NOT NULL ,
+	`attachments` JSON NOT NULL ,
+	`deleted_at` BIGINT(20) NULL ,
+	PRIMARY KEY(`id`),
+	UNIQUE KEY `uniq_slug_of_note_uk` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT 'soft deleted notes';
+CREATE INDEX `visibility_of_note_idx` ON `notes`(`visibility`);
+CREATE INDEX `owner_id_of_note_idx` ON `notes`(`owner_id`);
+CREATE INDEX `stars_of_note_rng` ON `notes`(`stars`);
+
@@ -0,0 +1,20 @@
+
+CREATE TABLE `todos` (
+	`id` BIGINT(20) NOT NULL AUTO_INCREMENT,
+	`owner_id` INT(11) NOT NULL DEFAULT '0',
+	`title` VARCHAR(128) NOT NULL DEFAULT '',
+	`done` TINYINT(1) UNSIGNED NOT NULL DEFAULT '0',
+	`priority` INT(11) NOT NULL DEFAULT '0',
+	`remark` VARCHAR(100) NULL ,
+	`budget` DECIMAL(10,2) NOT NULL DEFAULT '0',
+	`attachment` BLOB NULL ,
+	`due_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	`created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	`updated_at` BIGINT(20) NOT NULL DEFAULT '0',
+	`version` INT(11) NOT NULL DEFAULT '0',
+	PRIMARY KEY(`id`),
+	UNIQUE KEY `uniq_title_of_todo_uk` (`title`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT 'todo items';
+CREATE INDEX `owner_id_of_todo_idx` ON `todos`(`owner_id`);
+CREATE INDEX `priority_of_todo_rng` ON `todos`(`priority`);
+
@@ -0,0 +1,20 @@
+
+CREATE TABLE "articles" (
+	"id" BIGINT GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+	"author_id" INTEGER NOT NULL DEFAULT 0,
+	"slug" VARCHAR(64) NOT NULL DEFAULT '',
+	"title" VARCHAR(128) NOT NULL DEFAULT '',
+	"content" TEXT NOT NULL DEFAULT '',
+	"published" BOOLEAN NOT NULL DEFAULT FALSE,
+	"rating" DOUBLE PRECISION NULL,
+	"hits" INTEGER NOT NULL DEFAULT 0,
+	"published_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY ("id"),
+	CONSTRAINT "uniq_slug_of_article_uk" UNIQUE ("slug")
+);
+COMMENT ON TABLE "articles" IS 'articles of the blog';
+COMMENT ON COLUMN "articles"."content" IS 'markdown content';
+CREATE INDEX "author_id_of_article_idx" ON "articles"("author_id");
+CREATE INDEX "hits_of_article_rng" ON "articles"("hits");
+
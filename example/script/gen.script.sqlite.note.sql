@@ -0,0 +1,19 @@
+
+-- soft deleted notes
+CREATE TABLE "notes" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"owner_id" INTEGER NOT NULL DEFAULT 0,
+	"slug" TEXT NOT NULL DEFAULT '',
+	"content" TEXT NOT NULL DEFAULT '',
+	"color" TEXT NOT NULL DEFAULT 'yellow',
+	"stars" INTEGER NOT NULL DEFAULT 0,
+	"visibility" TEXT NOT NULL DEFAULT 'private' CHECK ("visibility" IN ('private', 'shared', 'public')),
+	"meta" TEXT NOT NULL,
+	"attachments" TEXT NOT NULL,
+	"deleted_at" INTEGER NULL,
+	CONSTRAINT "uniq_slug_of_note_uk" UNIQUE ("slug")
+);
+CREATE INDEX "visibility_of_note_idx" ON "notes"("visibility");
+CREATE INDEX "owner_id_of_note_idx" ON "notes"("owner_id");
+CREATE INDEX "stars_of_note_rng" ON "notes"("stars");
+
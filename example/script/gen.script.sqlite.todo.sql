@@ -0,0 +1,20 @@
+
+-- todo items
+CREATE TABLE "todos" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"owner_id" INTEGER NOT NULL DEFAULT 0,
+	"title" TEXT NOT NULL DEFAULT '',
+	"done" BOOLEAN NOT NULL DEFAULT 0,
+	"priority" INTEGER NOT NULL DEFAULT 0,
+	"remark" TEXT NULL,
+	"budget" TEXT NOT NULL DEFAULT '0',
+	"attachment" BLOB NULL,
+	"due_at" DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	"created_at" DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" INTEGER NOT NULL DEFAULT 0,
+	"version" INTEGER NOT NULL DEFAULT 0,
+	CONSTRAINT "uniq_title_of_todo_uk" UNIQUE ("title")
+);
+CREATE INDEX "owner_id_of_todo_idx" ON "todos"("owner_id");
+CREATE INDEX "priority_of_todo_rng" ON "todos"("priority");
+
@@ -28,10 +28,20 @@ CREATE TABLE `blogs` (
   `user_id` INT UNSIGNED     NOT NULL DEFAULT 0,
   `title`       VARCHAR(32)      NOT NULL  DEFAULT '',
   `content`     TEXT             NOT NULL ,
-  `status`      TINYINT(1) UNSIGNED NOT NULL DEFAULT 0,
+  `status`      ENUM('draft','published','archived') NOT NULL DEFAULT 'draft',
   `readed`  INT UNSIGNED     NOT NULL DEFAULT 0,
   `created_at`   TIMESTAMP       NOT NULL  DEFAULT CURRENT_TIMESTAMP,
   `updated_at`   TIMESTAMP       NOT NULL  DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
+DROP TABLE IF EXISTS `comments`;
+CREATE TABLE `comments` (
+  `id`          BIGINT(20)       NOT NULL  PRIMARY KEY AUTO_INCREMENT,
+  `blog_id`     INT(11)          NOT NULL  DEFAULT 0,
+  `user_id`     INT(11)          NOT NULL  DEFAULT 0,
+  `content`     VARCHAR(100)     NOT NULL  DEFAULT '',
+  `created_at`  BIGINT(20)       NOT NULL  DEFAULT '0',
+  `updated_at`  DATETIME         NOT NULL  DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
 CREATE VIEW user_base_info AS SELECT `id`,`name`,`mailbox`,`sex` FROM users;
@@ -121,8 +121,12 @@ func init() {
 		"tpl/conf.mongo.gogo",
 		"tpl/conf.mssql.gogo",
 		"tpl/conf.mysql.gogo",
+		"tpl/conf.postgres.gogo",
+		"tpl/conf.sqlite.gogo",
 		"tpl/util.mysql.gogo",
 		"tpl/util.mssql.gogo",
+		"tpl/util.postgres.gogo",
+		"tpl/util.sqlite.gogo",
 		"tpl/util.elastic.gogo",
 		"tpl/util.redis.gogo",
 		"tpl/conf.orm.gogo",
@@ -132,6 +136,7 @@ func init() {
 		"tpl/object.db.write.gogo",
 		"tpl/object.db.query.gogo",
 		"tpl/object.elastic.gogo",
+		"tpl/object.enum.gogo",
 		"tpl/object.functions.gogo",
 		"tpl/object.gogo",
 		"tpl/object.index.gogo",
@@ -163,6 +168,9 @@ func init() {
 		"tpl/relation.zset.gogo",
 		"tpl/relation.zset.sync.gogo",
 		"tpl/script.mysql.sql",
+		"tpl/script.mssql.sql",
+		"tpl/script.postgres.sql",
+		"tpl/script.sqlite.sql",
 		"tpl/view.gogo",
 	}
 	for _, fname := range files {
@@ -0,0 +1,106 @@
+package orm
+
+// The generated managers call the methods of these interfaces when the object
+// implements them, an error of a Before method aborts the write. db is the
+// database or the transaction of the manager, nil for the redis managers.
+type BeforeCreator interface {
+	BeforeCreate(db DB) error
+}
+
+type AfterCreator interface {
+	AfterCreate(db DB) error
+}
+
+type BeforeUpdater interface {
+	BeforeUpdate(db DB) error
+}
+
+type AfterUpdater interface {
+	AfterUpdate(db DB) error
+}
+
+// BeforeSaver and AfterSaver run around the creates and the updates too.
+type BeforeSaver interface {
+	BeforeSave(db DB) error
+}
+
+type AfterSaver interface {
+	AfterSave(db DB) error
+}
+
+type BeforeDeleter interface {
+	BeforeDelete(db DB) error
+}
+
+type AfterDeleter interface {
+	AfterDelete(db DB) error
+}
+
+// Callback runs the hooks of obj for one kind of write.
+type Callback func(db DB, obj interface{}) error
+
+func BeforeCreate(db DB, obj interface{}) error {
+	if err := BeforeSave(db, obj); err != nil {
+		return err
+	}
+	if h, ok := obj.(BeforeCreator); ok {
+		return h.BeforeCreate(db)
+	}
+	return nil
+}
+
+func AfterCreate(db DB, obj interface{}) error {
+	if h, ok := obj.(AfterCreator); ok {
+		if err := h.AfterCreate(db); err != nil {
+			return err
+		}
+	}
+	return AfterSave(db, obj)
+}
+
+func BeforeUpdate(db DB, obj interface{}) error {
+	if err := BeforeSave(db, obj); err != nil {
+		return err
+	}
+	if h, ok := obj.(BeforeUpdater); ok {
+		return h.BeforeUpdate(db)
+	}
+	return nil
+}
+
+func AfterUpdate(db DB, obj interface{}) error {
+	if h, ok := obj.(AfterUpdater); ok {
+		if err := h.AfterUpdate(db); err != nil {
+			return err
+		}
+	}
+	return AfterSave(db, obj)
+}
+
+func BeforeSave(db DB, obj interface{}) error {
+	if h, ok := obj.(BeforeSaver); ok {
+		return h.BeforeSave(db)
+	}
+	return nil
+}
+
+func AfterSave(db DB, obj interface{}) error {
+	if h, ok := obj.(AfterSaver); ok {
+		return h.AfterSave(db)
+	}
+	return nil
+}
+
+func BeforeDelete(db DB, obj interface{}) error {
+	if h, ok := obj.(BeforeDeleter); ok {
+		return h.BeforeDelete(db)
+	}
+	return nil
+}
+
+func AfterDelete(db DB, obj interface{}) error {
+	if h, ok := obj.(AfterDeleter); ok {
+		return h.AfterDelete(db)
+	}
+	return nil
+}
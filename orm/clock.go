@@ -0,0 +1,7 @@
+package orm
+
+import "time"
+
+// Now is the clock stamping the autocreatetime and autoupdatetime fields and
+// the soft deletes of the generated managers, tests may set a fixed one.
+var Now = time.Now
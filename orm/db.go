@@ -1,53 +1,53 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"strconv"
 	"time"
 
 	"strings"
 
 	_ "github.com/denisenkom/go-mssqldb"
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 type DB interface {
 	Query(sql string, args ...interface{}) (*sql.Rows, error)
+	QueryContext(ctx context.Context, sql string, args ...interface{}) (*sql.Rows, error)
 	Exec(sql string, args ...interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, sql string, args ...interface{}) (sql.Result, error)
 	SetError(err error)
 }
 
 type DBStore struct {
 	*sql.DB
-	debug   bool
-	slowlog time.Duration
+	observer
+	replicas        []*replica
+	next            uint32
+	replicaCooldown time.Duration
+	txRetries       int
+	txBackoff       time.Duration
+	//! the charset of the primary, the replicas are opened with it
+	charset string
 }
 
 func NewDBStore(driver, host string, port int, database, username, password string) (*DBStore, error) {
-	var dsn string
-	switch strings.ToLower(driver) {
-	case "mysql":
-		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&autocommit=true&parseTime=True",
-			username,
-			password,
-			host,
-			port,
-			database)
-	case "mssql":
-		dsn = fmt.Sprintf("server=%s;user id=%s;password=%s;port=%d;database=%s",
-			host, username, password, port, database)
-	default:
-		return nil, fmt.Errorf("unsupport db driver: %s", driver)
-	}
-	db, err := sql.Open(driver, dsn)
+	return NewDBStoreCharset(driver, host, port, database, username, password, "utf8mb4")
+}
+
+func NewDBStoreCharset(driver, host string, port int, database, username, password, charset string) (*DBStore, error) {
+	dsn, err := dataSourceName(driver, host, port, database, username, password, charset)
 	if err != nil {
 		return nil, err
 	}
-	return &DBStore{db, false, time.Duration(0)}, nil
+	return openDBStore(driver, dsn, charset)
 }
 
-func NewDBStoreCharset(driver, host string, port int, database, username, password, charset string) (*DBStore, error) {
+func dataSourceName(driver, host string, port int, database, username, password, charset string) (string, error) {
 	var dsn string
 	switch strings.ToLower(driver) {
 	case "mysql":
@@ -64,59 +64,114 @@ func NewDBStoreCharset(driver, host string, port int, database, username, passwo
 	case "mssql":
 		dsn = fmt.Sprintf("server=%s;user id=%s;password=%s;port=%d;database=%s",
 			host, username, password, port, database)
+	case "postgres":
+		dsn = fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+			host, port, username, password, database)
+	case "sqlite":
+		dsn = database
 	default:
-		return nil, fmt.Errorf("unsupport db driver: %s", driver)
+		return "", fmt.Errorf("unsupport db driver: %s", driver)
 	}
-	db, err := sql.Open(driver, dsn)
+	return dsn, nil
+}
+
+func openDBStore(driver, dsn, charset string) (*DBStore, error) {
+	driver = strings.ToLower(driver)
+	db, err := openDB(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &DBStore{
+		DB:              db,
+		observer:        newObserver(driver),
+		replicaCooldown: defaultReplicaCooldown,
+		txRetries:       defaultTxRetries,
+		txBackoff:       defaultTxBackoff,
+		charset:         charset,
+	}, nil
+}
+
+func openDB(driver, dsn string) (*sql.DB, error) {
+	name := driver
+	if driver == "sqlite" {
+		name = "sqlite3"
+	}
+	db, err := sql.Open(name, dsn)
 	if err != nil {
 		return nil, err
 	}
-	return &DBStore{db, false, time.Duration(0)}, nil
+	//! every connection opens its own in-memory sqlite database
+	if driver == "sqlite" && strings.Contains(dsn, ":memory:") {
+		db.SetMaxOpenConns(1)
+	}
+	return db, nil
 }
 
 func (store *DBStore) Debug(b bool) {
-	store.debug = b
+	store.log.debug = b
 }
 
 func (store *DBStore) SlowLog(duration time.Duration) {
-	store.slowlog = duration
+	store.log.slowlog = duration
+}
+
+// SetLogger replaces the standard log package as the output of Debug and SlowLog.
+func (store *DBStore) SetLogger(logger Logger) {
+	store.log.logger = logger
+}
+
+// AddHook registers hook for the statements of the store and the
+// transactions begun after.
+func (store *DBStore) AddHook(hook QueryHook) {
+	store.hooks = append(store.hooks, hook)
 }
 
 func (store *DBStore) Query(sql string, args ...interface{}) (*sql.Rows, error) {
-	t1 := time.Now()
-	if store.slowlog > 0 {
-		defer func(t time.Time) {
-			span := time.Now().Sub(t1)
-			if span > store.slowlog {
-				log.Println("SLOW: ", span.String(), sql, args)
+	return store.QueryContext(context.Background(), sql, args...)
+}
+
+func (store *DBStore) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	r := store.replica(ctx)
+	var rows *sql.Rows
+	err := store.observe(ctx, query, args, func(ctx context.Context, q string) (int64, error) {
+		var err error
+		if r != nil {
+			if rows, err = r.QueryContext(ctx, q, args...); !isConnError(err) {
+				return -1, err
 			}
-		}(t1)
-	}
-	if store.debug {
-		log.Println("DEBUG: ", sql, args)
-	}
-	return store.DB.Query(sql, args...)
+			r.markDown(store.replicaCooldown)
+		}
+		rows, err = store.DB.QueryContext(ctx, q, args...)
+		return -1, err
+	})
+	return rows, err
 }
 
 func (store *DBStore) Exec(sql string, args ...interface{}) (sql.Result, error) {
-	t1 := time.Now()
-	if store.slowlog > 0 {
-		defer func(t time.Time) {
-			span := time.Now().Sub(t1)
-			if span > store.slowlog {
-				log.Println("SLOW: ", span.String(), sql, args)
-			}
-		}(t1)
-	}
-	if store.debug {
-		log.Println("DEBUG: ", sql, args)
-	}
-	return store.DB.Exec(sql, args...)
+	return store.ExecContext(context.Background(), sql, args...)
+}
+
+func (store *DBStore) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := store.observe(ctx, query, args, func(ctx context.Context, q string) (int64, error) {
+		var err error
+		if result, err = store.DB.ExecContext(ctx, q, args...); err != nil {
+			return -1, err
+		}
+		return rowsAffected(result), nil
+	})
+	return result, err
 }
 
 func (store *DBStore) SetError(err error) {}
 
 func (store *DBStore) Close() error {
+	for _, r := range store.replicas {
+		if err := r.Close(); err != nil {
+			return err
+		}
+	}
+	store.replicas = nil
 	if err := store.DB.Close(); err != nil {
 		return err
 	}
@@ -125,23 +180,28 @@ func (store *DBStore) Close() error {
 }
 
 type DBTx struct {
+	observer
 	tx           *sql.Tx
-	debug        bool
-	slowlog      time.Duration
 	err          error
 	rowsAffected int64
+	savepoints   int
 }
 
 func (store *DBStore) BeginTx() (*DBTx, error) {
-	tx, err := store.Begin()
+	return store.BeginTxContext(context.Background(), nil)
+}
+
+// BeginTxContext starts a transaction bound to ctx, the transaction
+// is rolled back by the driver if ctx is done before Close.
+func (store *DBStore) BeginTxContext(ctx context.Context, opts *sql.TxOptions) (*DBTx, error) {
+	tx, err := store.DB.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	return &DBTx{
-		tx:      tx,
-		debug:   store.debug,
-		slowlog: store.slowlog,
+		observer: store.observer,
+		tx:       tx,
 	}, nil
 }
 
@@ -153,19 +213,16 @@ func (tx *DBTx) Close() error {
 }
 
 func (tx *DBTx) Query(sql string, args ...interface{}) (*sql.Rows, error) {
-	t1 := time.Now()
-	if tx.slowlog > 0 {
-		defer func(t time.Time) {
-			span := time.Now().Sub(t1)
-			if span > tx.slowlog {
-				log.Println("SLOW: ", span.String(), sql, args)
-			}
-		}(t1)
-	}
-	if tx.debug {
-		log.Println("DEBUG: ", sql, args)
-	}
-	result, err := tx.tx.Query(sql, args...)
+	return tx.QueryContext(context.Background(), sql, args...)
+}
+
+func (tx *DBTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	var result *sql.Rows
+	err := tx.observe(ctx, query, args, func(ctx context.Context, q string) (int64, error) {
+		var err error
+		result, err = tx.tx.QueryContext(ctx, q, args...)
+		return -1, err
+	})
 	if err != nil {
 		tx.err = err
 	}
@@ -173,26 +230,53 @@ func (tx *DBTx) Query(sql string, args ...interface{}) (*sql.Rows, error) {
 }
 
 func (tx *DBTx) Exec(sql string, args ...interface{}) (sql.Result, error) {
-	t1 := time.Now()
-	if tx.slowlog > 0 {
-		defer func(t time.Time) {
-			span := time.Now().Sub(t1)
-			if span > tx.slowlog {
-				log.Println("SLOW: ", span.String(), sql, args)
-			}
-		}(t1)
-	}
-	if tx.debug {
-		log.Println("DEBUG: ", sql, args)
-	}
-	result, err := tx.tx.Exec(sql, args...)
+	return tx.ExecContext(context.Background(), sql, args...)
+}
+
+func (tx *DBTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := tx.observe(ctx, query, args, func(ctx context.Context, q string) (int64, error) {
+		var err error
+		if result, err = tx.tx.ExecContext(ctx, q, args...); err != nil {
+			return -1, err
+		}
+		return rowsAffected(result), nil
+	})
 	if err != nil {
 		tx.err = err
 	}
-	tx.err = err
-	return result, tx.err
+	return result, err
 }
 
 func (tx *DBTx) SetError(err error) {
 	tx.err = err
 }
+
+// Rebind replaces the `?` placeholders with `$n` for postgres,
+// the `?` inside quoted strings or identifiers are kept.
+func Rebind(driver, query string) string {
+	if driver != "postgres" || strings.IndexByte(query, '?') < 0 {
+		return query
+	}
+	buf := make([]byte, 0, len(query)+16)
+	var quote byte
+	n := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			n++
+			buf = append(buf, '$')
+			buf = strconv.AppendInt(buf, int64(n), 10)
+			continue
+		}
+		buf = append(buf, c)
+	}
+	return string(buf)
+}
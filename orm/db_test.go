@@ -0,0 +1,38 @@
+package orm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+// openTestStore opens an in-memory sqlite store holding the items 1 to 10.
+func openTestStore(t *testing.T) *orm.DBStore {
+	t.Helper()
+	store, err := orm.NewDBStore("sqlite", "", 0, ":memory:", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	if _, err := store.Exec("CREATE TABLE items (id INTEGER PRIMARY KEY, name TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 10; i++ {
+		if _, err := store.Exec("INSERT INTO items (id, name) VALUES (?, ?)", i, fmt.Sprintf("item%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func itemExists(t *testing.T, db orm.DB, id int) bool {
+	t.Helper()
+	rows, err := db.Query("SELECT id FROM items WHERE id = ?", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	return rows.Next()
+}
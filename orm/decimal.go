@@ -0,0 +1,214 @@
+package orm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, the value of the decimal fields. It is
+// the unscaled integer times 10^-scale, the zero value is 0 and the methods
+// never modify their receiver.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// NewDecimal returns unscaled * 10^-scale, NewDecimal(1250, 2) is 12.50.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	if scale < 0 {
+		return Decimal{unscaled: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale))}
+	}
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// ParseDecimal parses a decimal like `-12.50` or `1.25e3`, the scale is the
+// number of the digits after the point.
+func ParseDecimal(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	exp := int64(0)
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(str[i+1:], 10, 32); err != nil {
+			return Decimal{}, fmt.Errorf("orm: invalid decimal %q", s)
+		}
+		str = str[:i]
+	}
+	digits := str
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		digits = str[:i] + str[i+1:]
+		exp -= int64(len(str) - i - 1)
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok || strings.HasPrefix(str, "+.") || strings.HasPrefix(str, "-.") {
+		return Decimal{}, fmt.Errorf("orm: invalid decimal %q", s)
+	}
+	if exp > 0 {
+		unscaled.Mul(unscaled, pow10(int32(exp)))
+		exp = 0
+	}
+	return Decimal{unscaled: unscaled, scale: int32(-exp)}, nil
+}
+
+// MustParseDecimal is ParseDecimal panicking on the invalid decimals, for
+// the constants of the programs.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the unscaled integer of d at a scale not lower than its own.
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return new(big.Int).Set(d.int())
+	}
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares the numbers of d and o, 1.5 and 1.50 are equal.
+func (d Decimal) Cmp(o Decimal) int {
+	scale := maxScale(d, o)
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Sub(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Sub(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Round returns d at scale, the halves are rounded away from zero.
+func (d Decimal) Round(scale int32) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if scale >= d.scale {
+		return Decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+	div := pow10(d.scale - scale)
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(d.int()), div, new(big.Int))
+	if r.Lsh(r, 1).Cmp(div) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if d.Sign() < 0 {
+		q.Neg(q)
+	}
+	return Decimal{unscaled: q, scale: scale}
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) String() string {
+	unscaled := d.int()
+	if d.scale == 0 {
+		return unscaled.String()
+	}
+	digits := new(big.Int).Abs(unscaled).String()
+	if pad := int(d.scale) - len(digits) + 1; pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(d.scale)
+	s := digits[:point] + "." + digits[point:]
+	if unscaled.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
+
+func maxScale(d, o Decimal) int32 {
+	if d.scale > o.scale {
+		return d.scale
+	}
+	return o.scale
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(b []byte) error {
+	v, err := ParseDecimal(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON writes d as a JSON number keeping all of its digits.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number or a string of a decimal.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	return d.UnmarshalText([]byte(strings.Trim(string(b), `"`)))
+}
+
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d *Decimal) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*d = Decimal{}
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(src))
+	case []byte:
+		return d.UnmarshalText(src)
+	case int64:
+		*d = NewDecimal(src, 0)
+		return nil
+	case float64:
+		return d.UnmarshalText([]byte(strconv.FormatFloat(src, 'f', -1, 64)))
+	}
+	return fmt.Errorf("orm: cannot scan %T into decimal", src)
+}
@@ -0,0 +1,42 @@
+package orm
+
+// Dirty records the columns changed through the Set mutators of a generated
+// object, Update writes only those columns.
+type Dirty struct {
+	columns []string
+}
+
+func (d *Dirty) Mark(column string) {
+	for _, c := range d.columns {
+		if c == column {
+			return
+		}
+	}
+	d.columns = append(d.columns, column)
+}
+
+func (d *Dirty) Columns() []string {
+	return d.columns
+}
+
+// Clean forgets the changes of columns once they are written.
+func (d *Dirty) Clean(columns ...string) {
+	var kept []string
+	for _, c := range d.columns {
+		written := false
+		for _, column := range columns {
+			if c == column {
+				written = true
+				break
+			}
+		}
+		if !written {
+			kept = append(kept, c)
+		}
+	}
+	d.columns = kept
+}
+
+func (d *Dirty) Reset() {
+	d.columns = nil
+}
@@ -0,0 +1,38 @@
+package orm_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+func TestDirty(t *testing.T) {
+	var d orm.Dirty
+	if d.Columns() != nil {
+		t.Errorf("columns expect none, got %v", d.Columns())
+	}
+
+	d.Mark("title")
+	d.Mark("done")
+	d.Mark("title")
+	if expect := []string{"title", "done"}; !reflect.DeepEqual(d.Columns(), expect) {
+		t.Errorf("columns expect %v, got %v", expect, d.Columns())
+	}
+
+	d.Mark("remark")
+	d.Clean("title", "priority")
+	if expect := []string{"done", "remark"}; !reflect.DeepEqual(d.Columns(), expect) {
+		t.Errorf("columns expect %v after clean, got %v", expect, d.Columns())
+	}
+	d.Clean("done", "remark")
+	if d.Columns() != nil {
+		t.Errorf("columns expect none after clean, got %v", d.Columns())
+	}
+
+	d.Mark("title")
+	d.Reset()
+	if d.Columns() != nil {
+		t.Errorf("columns expect none after reset, got %v", d.Columns())
+	}
+}
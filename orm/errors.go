@@ -0,0 +1,178 @@
+package orm
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+var (
+	ErrNotFound     = errors.New("orm: record not found")
+	ErrDuplicateKey = errors.New("orm: duplicate key")
+	ErrConstraint   = errors.New("orm: constraint violation")
+	ErrConflict     = errors.New("orm: version conflict")
+)
+
+// NotFoundError is returned by the generated managers when the record of
+// Key is missing, it matches ErrNotFound with errors.Is.
+type NotFoundError struct {
+	Object string
+	Key    string
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("%s record not found", e.Object)
+	}
+	return fmt.Sprintf("%s record not found: %s", e.Object, e.Key)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// DuplicateKeyError is a write violating the primary key or the unique
+// index Index, it matches ErrDuplicateKey and unwraps to the driver error.
+type DuplicateKeyError struct {
+	Index string
+	Err   error
+}
+
+func (e *DuplicateKeyError) Error() string {
+	if e.Index == "" {
+		return fmt.Sprintf("duplicate key: %v", e.Err)
+	}
+	return fmt.Sprintf("duplicate key on (%s): %v", e.Index, e.Err)
+}
+
+func (e *DuplicateKeyError) Is(target error) bool {
+	return target == ErrDuplicateKey
+}
+
+func (e *DuplicateKeyError) Unwrap() error {
+	return e.Err
+}
+
+// ConstraintError is a write violating a foreign key, check or not null
+// constraint, it matches ErrConstraint and unwraps to the driver error.
+type ConstraintError struct {
+	Constraint string
+	Err        error
+}
+
+func (e *ConstraintError) Error() string {
+	if e.Constraint == "" {
+		return fmt.Sprintf("constraint violation: %v", e.Err)
+	}
+	return fmt.Sprintf("constraint violation on (%s): %v", e.Constraint, e.Err)
+}
+
+func (e *ConstraintError) Is(target error) bool {
+	return target == ErrConstraint
+}
+
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictError is a write of a versioned object whose stored version is no
+// longer Version, it matches ErrConflict with errors.Is.
+type ConflictError struct {
+	Object  string
+	Key     string
+	Version int64
+}
+
+func (e *ConflictError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("%s version conflict", e.Object)
+	}
+	return fmt.Sprintf("%s version conflict: %s at version %d", e.Object, e.Key, e.Version)
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// MultiError collects the errors of a batch, errors.Is and errors.As
+// look into each of them.
+type MultiError []error
+
+func (e MultiError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e MultiError) Unwrap() []error {
+	return e
+}
+
+var (
+	mysqlDupIndex  = regexp.MustCompile(`for key '([^']+)'`)
+	mssqlDupIndex  = regexp.MustCompile(`(?:constraint|unique index) '([^']+)'`)
+	sqliteDupIndex = regexp.MustCompile(`constraint failed: (.+)$`)
+)
+
+// TranslateError turns the duplicate key and constraint errors of the
+// drivers into DuplicateKeyError and ConstraintError, others are kept.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1062:
+			return &DuplicateKeyError{Index: submatch(mysqlDupIndex, mysqlErr.Message), Err: err}
+		case 1048, 1451, 1452, 3819:
+			return &ConstraintError{Err: err}
+		}
+		return err
+	}
+	var mssqlErr mssql.Error
+	if errors.As(err, &mssqlErr) {
+		switch mssqlErr.Number {
+		case 2601, 2627:
+			return &DuplicateKeyError{Index: submatch(mssqlDupIndex, mssqlErr.Message), Err: err}
+		case 515, 547:
+			return &ConstraintError{Constraint: submatch(mssqlDupIndex, mssqlErr.Message), Err: err}
+		}
+		return err
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return &DuplicateKeyError{Index: pqErr.Constraint, Err: err}
+		case "23502", "23503", "23514":
+			return &ConstraintError{Constraint: pqErr.Constraint, Err: err}
+		}
+		return err
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code == sqlite3.ErrConstraint {
+		switch sqliteErr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+			return &DuplicateKeyError{Index: submatch(sqliteDupIndex, sqliteErr.Error()), Err: err}
+		}
+		return &ConstraintError{Err: err}
+	}
+	return err
+}
+
+func submatch(re *regexp.Regexp, s string) string {
+	if m := re.FindStringSubmatch(s); len(m) > 1 {
+		return m[1]
+	}
+	return ""
+}
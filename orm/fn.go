@@ -53,6 +53,58 @@ func MsSQLTimeFormat(t interface{}) string {
 	return tm.UTC().Format("2006-01-02 15:04:05")
 }
 
+func PostgresTimeParse(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		fmt.Println("PostgresTimeParse failed:", err)
+	}
+	return t.Local()
+}
+
+func PostgresTimeFormat(t interface{}) string {
+	tm, err := toTimeE(t)
+	if err != nil {
+		panic(err)
+	}
+	return tm.UTC().Format("2006-01-02 15:04:05.999999")
+}
+
+// timestamp without time zone keeps the local clock of DATETIME
+func PostgresLocalTimeParse(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		fmt.Println("PostgresLocalTimeParse failed:", err)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(),
+		t.Second(), t.Nanosecond(), time.Local)
+}
+
+func SQLiteTimeParse(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		fmt.Println("SQLiteTimeParse failed:", err)
+	}
+	return t.Local()
+}
+
+func SQLiteTimeFormat(t interface{}) string {
+	tm, err := toTimeE(t)
+	if err != nil {
+		panic(err)
+	}
+	return tm.UTC().Format("2006-01-02 15:04:05.999999")
+}
+
+// sqlite driver reads DATETIME as UTC, the local clock is restored
+func SQLiteLocalTimeParse(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		fmt.Println("SQLiteLocalTimeParse failed:", err)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(),
+		t.Second(), t.Nanosecond(), time.Local)
+}
+
 func TimeToLocalTime(c time.Time) string {
 	return c.Local().Format("2006-01-02 15:04:05")
 }
@@ -127,6 +179,15 @@ func ToFloat64(value interface{}) (float64, error) {
 		v, _ := value.(float64)
 		return v, nil
 	}
+	//! the types defined on the numbers
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
 	return float64(0), errors.New("unsupport type to float64")
 }
 
@@ -167,6 +228,16 @@ func MsSQLOffsetLimit(offset, limit int) string {
 	return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
 }
 
+func PostgresOffsetLimit(offset, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+	if offset <= 0 {
+		return fmt.Sprintf("LIMIT %d", limit)
+	}
+	return fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+}
+
 func atoi(b []byte) (int, error) {
 	return strconv.Atoi(string(b))
 }
@@ -286,13 +357,91 @@ func StringScan(str string, v interface{}) error {
 			}
 		}
 		return nil
+	case *Decimal:
+		return v.UnmarshalText(b)
 	case encoding.BinaryUnmarshaler:
 		return v.UnmarshalBinary(b)
+	case encoding.TextUnmarshaler:
+		return v.UnmarshalText(b)
 	default:
+		return scanKind(b, v)
+	}
+
+}
+
+// scanKind scans b into v, a pointer to a type defined on a bool, a number
+// or a string like the gotypes of the fields.
+func scanKind(b []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
 		return fmt.Errorf(
 			"can't unmarshal %T (consider implementing BinaryUnmarshaler)", v)
 	}
+	e := rv.Elem()
+	switch e.Kind() {
+	case reflect.String:
+		e.SetString(string(b))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := parseInt(b, 10, e.Type().Bits())
+		if err != nil {
+			return err
+		}
+		e.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := parseUint(b, 10, e.Type().Bits())
+		if err != nil {
+			return err
+		}
+		e.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := parseFloat(b, e.Type().Bits())
+		if err != nil {
+			return err
+		}
+		e.SetFloat(n)
+	case reflect.Bool:
+		n := len(b) == 1 && b[0] == '1'
+		if !n {
+			var err error
+			if n, err = parseBool(b); err != nil {
+				return err
+			}
+		}
+		e.SetBool(n)
+	default:
+		return fmt.Errorf(
+			"can't unmarshal %T (consider implementing BinaryUnmarshaler)", v)
+	}
+	return nil
+}
 
+// Sprint formats v for redis the way StringScan reads it back, by the
+// MarshalBinary or MarshalText of v if it has, or by the value of the bool,
+// number or string it is defined on, whatever its String method returns.
+func Sprint(v interface{}) string {
+	switch m := v.(type) {
+	case encoding.BinaryMarshaler:
+		if b, err := m.MarshalBinary(); err == nil {
+			return string(b)
+		}
+	case encoding.TextMarshaler:
+		if b, err := m.MarshalText(); err == nil {
+			return string(b)
+		}
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	}
+	return fmt.Sprint(v)
 }
 
 func Encode(src string) string {
@@ -0,0 +1,103 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// Logger receives the debug and slow query logs of DBStore and DBTx.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type stdLogger struct{}
+
+func (stdLogger) Printf(format string, v ...interface{}) {
+	log.Printf(format, v...)
+}
+
+// QueryEvent describes one statement sent to the database. Query is the
+// statement after Rebind, RowsAffected is -1 for queries returning rows.
+// Args is a copy of the statement arguments, a hook may redact it in
+// place before the builtin log prints it.
+type QueryEvent struct {
+	Driver       string
+	Query        string
+	Args         []interface{}
+	Start        time.Time
+	Duration     time.Duration
+	RowsAffected int64
+	Err          error
+}
+
+// QueryHook is notified before and after each statement, the context
+// returned by BeforeQuery is the one the statement is executed with.
+// The hooks run in the order they were added, before the builtin log.
+type QueryHook interface {
+	BeforeQuery(ctx context.Context, event *QueryEvent) context.Context
+	AfterQuery(ctx context.Context, event *QueryEvent)
+}
+
+// logHook is the builtin hook behind Debug and SlowLog.
+type logHook struct {
+	logger  Logger
+	debug   bool
+	slowlog time.Duration
+}
+
+func (h *logHook) BeforeQuery(ctx context.Context, event *QueryEvent) context.Context {
+	if h.debug {
+		h.logger.Printf("DEBUG: %s %v", event.Query, event.Args)
+	}
+	return ctx
+}
+
+func (h *logHook) AfterQuery(ctx context.Context, event *QueryEvent) {
+	if h.slowlog > 0 && event.Duration > h.slowlog {
+		h.logger.Printf("SLOW: %s %s %v", event.Duration.String(), event.Query, event.Args)
+	}
+}
+
+// observer runs the hooks around the statements of DBStore and DBTx.
+type observer struct {
+	driver string
+	log    logHook
+	hooks  []QueryHook
+}
+
+func newObserver(driver string) observer {
+	return observer{driver: driver, log: logHook{logger: stdLogger{}}}
+}
+
+func (o *observer) observe(ctx context.Context, query string, args []interface{}, fn func(ctx context.Context, query string) (int64, error)) error {
+	event := &QueryEvent{
+		Driver:       o.driver,
+		Query:        Rebind(o.driver, query),
+		Args:         append([]interface{}(nil), args...),
+		Start:        time.Now(),
+		RowsAffected: -1,
+	}
+	for _, hook := range o.hooks {
+		ctx = hook.BeforeQuery(ctx, event)
+	}
+	ctx = o.log.BeforeQuery(ctx, event)
+
+	event.RowsAffected, event.Err = fn(ctx, event.Query)
+	event.Duration = time.Since(event.Start)
+
+	for _, hook := range o.hooks {
+		hook.AfterQuery(ctx, event)
+	}
+	o.log.AfterQuery(ctx, event)
+	return TranslateError(event.Err)
+}
+
+func rowsAffected(result sql.Result) int64 {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return -1
+	}
+	return n
+}
@@ -0,0 +1,115 @@
+package orm_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+type recordHook struct {
+	before int
+	events []orm.QueryEvent
+}
+
+func (h *recordHook) BeforeQuery(ctx context.Context, event *orm.QueryEvent) context.Context {
+	h.before++
+	return ctx
+}
+
+func (h *recordHook) AfterQuery(ctx context.Context, event *orm.QueryEvent) {
+	h.events = append(h.events, *event)
+}
+
+func TestQueryHook(t *testing.T) {
+	store := openTestStore(t)
+	hook := &recordHook{}
+	store.AddHook(hook)
+
+	tx, err := store.BeginTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec("UPDATE items SET name = ? WHERE id > ?", "updated", 7); err != nil {
+		t.Fatal(err)
+	}
+	itemExists(t, tx, 1)
+	if err := tx.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if hook.before != 2 || len(hook.events) != 2 {
+		t.Fatalf("hook expect 2 statements, got %d before and %d after", hook.before, len(hook.events))
+	}
+	update, query := hook.events[0], hook.events[1]
+	if update.Driver != "sqlite" {
+		t.Errorf("driver expect sqlite, got %s", update.Driver)
+	}
+	if len(update.Args) != 2 || update.Args[0] != "updated" || update.Args[1] != 7 {
+		t.Errorf("args expect [updated 7], got %v", update.Args)
+	}
+	if update.RowsAffected != 3 {
+		t.Errorf("update rows affected expect 3, got %d", update.RowsAffected)
+	}
+	if query.RowsAffected != -1 || query.Err != nil {
+		t.Errorf("query expect -1 rows affected and no error, got %d, %v", query.RowsAffected, query.Err)
+	}
+}
+
+type bufferLogger struct {
+	lines []string
+}
+
+func (l *bufferLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+// redactHook hides the string arguments of the statements.
+type redactHook struct{}
+
+func (redactHook) BeforeQuery(ctx context.Context, event *orm.QueryEvent) context.Context {
+	for i, arg := range event.Args {
+		if _, ok := arg.(string); ok {
+			event.Args[i] = "***"
+		}
+	}
+	return ctx
+}
+
+func (redactHook) AfterQuery(ctx context.Context, event *orm.QueryEvent) {}
+
+func TestQueryHookRedact(t *testing.T) {
+	store := openTestStore(t)
+	logger := &bufferLogger{}
+	store.SetLogger(logger)
+	store.Debug(true)
+	store.AddHook(redactHook{})
+
+	if _, err := store.Exec("UPDATE items SET name = ? WHERE id = ?", "secret", 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(logger.lines) != 1 {
+		t.Fatalf("log expect 1 line, got %v", logger.lines)
+	}
+	if line := logger.lines[0]; strings.Contains(line, "secret") || !strings.Contains(line, "[*** 1]") {
+		t.Errorf("log expect the redacted args, got %s", line)
+	}
+
+	rows, err := store.Query("SELECT name FROM items WHERE id = ?", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var name string
+	if !rows.Next() {
+		t.Fatal("item 1 expect to exist")
+	}
+	if err := rows.Scan(&name); err != nil {
+		t.Fatal(err)
+	}
+	if name != "secret" {
+		t.Errorf("name expect the unredacted secret, got %s", name)
+	}
+}
@@ -0,0 +1,33 @@
+package orm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON carries the value of a json field to the database, V is written as
+// its JSON text and, when V is a pointer, the column is scanned back into it.
+type JSON struct {
+	V interface{}
+}
+
+func (j JSON) Value() (driver.Value, error) {
+	b, err := json.Marshal(j.V)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (j JSON) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(src), j.V)
+	case []byte:
+		return json.Unmarshal(src, j.V)
+	}
+	return fmt.Errorf("orm: cannot scan %T into json", src)
+}
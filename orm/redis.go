@@ -1,13 +1,28 @@
 package orm
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	redis "gopkg.in/redis.v5"
 )
 
 type RedisStore struct {
 	redis.Cmdable
+	ctx         context.Context
+	driftHook   func(drift *SchemaDrift)
+	lazyUpgrade bool
+}
+
+// SchemaDrift describes an object hash lacking some of the fields of its
+// yaml, usually written before the fields were added. Key is the primary
+// key of the object and Missing the names of the absent fields.
+type SchemaDrift struct {
+	Object  string
+	Key     string
+	Missing []string
 }
 
 func NewRedisClient(host string, port int, password string, db int) (*RedisStore, error) {
@@ -49,3 +64,326 @@ func NewRedisFailoverClient(failoverOpt *redis.FailoverOptions) (*RedisStore, er
 
 	return &RedisStore{Cmdable: client}, nil
 }
+
+// WithContext returns a store bound to ctx. redis.v5 can not interrupt a
+// command on the wire, the generated managers check ctx before each command,
+// pipeline and transaction instead: a canceled or expired ctx stops them
+// with ctx.Err(), while a command already sent runs until the ReadTimeout
+// and WriteTimeout of the client.
+func (store *RedisStore) WithContext(ctx context.Context) *RedisStore {
+	s := *store
+	s.ctx = ctx
+	if client, ok := store.Cmdable.(*redis.Client); ok {
+		s.Cmdable = client.WithContext(ctx)
+	}
+	return &s
+}
+
+// Context returns the context the store is bound to, context.Background
+// when it is not bound.
+func (store *RedisStore) Context() context.Context {
+	if store.ctx == nil {
+		return context.Background()
+	}
+	return store.ctx
+}
+
+// ContextErr returns the error of the context the store is bound to, the
+// commands should not be sent once it is not nil.
+func (store *RedisStore) ContextErr() error {
+	return store.Context().Err()
+}
+
+// OnSchemaDrift sets the hook called by Fetch and FetchByPrimaryKeys for
+// each hash missing fields, the missing fields keep their defaults.
+func (store *RedisStore) OnSchemaDrift(hook func(drift *SchemaDrift)) {
+	store.driftHook = hook
+}
+
+// LazyUpgrade makes Fetch and FetchByPrimaryKeys write the missing fields
+// back to the hashes they read. The upgrade is best effort, a failed write
+// leaves the hash to the next read.
+func (store *RedisStore) LazyUpgrade(enabled bool) {
+	store.lazyUpgrade = enabled
+}
+
+// ReportSchemaDrift passes the missing fields of the hash of object key to
+// the drift hook and reports whether they should be written back.
+func (store *RedisStore) ReportSchemaDrift(object, key string, missing []string) bool {
+	if len(missing) == 0 {
+		return false
+	}
+	if store.driftHook != nil {
+		store.driftHook(&SchemaDrift{Object: object, Key: key, Missing: missing})
+	}
+	return store.lazyUpgrade
+}
+
+// maxTransactionRetries bounds the runs of a Transaction whose watched keys
+// keep changing.
+const maxTransactionRetries = 3
+
+// Transaction runs read then executes the commands queued by write in a
+// MULTI/EXEC. On the single node clients read sees the store through a
+// client watching keys, a change of them before the EXEC runs both again.
+// The cluster clients run a MULTI/EXEC per slot and the ring clients a plain
+// pipeline, both without the watch. The context of the store is checked
+// before the read and the EXEC of each run.
+func (store *RedisStore) Transaction(keys []string, read func(redis.Cmdable) error, write func(*redis.Pipeline) error) error {
+	if client, ok := store.Cmdable.(*redis.Client); ok {
+		for i := 0; i < maxTransactionRetries; i++ {
+			if err := store.ContextErr(); err != nil {
+				return err
+			}
+			err := client.Watch(func(tx *redis.Tx) error {
+				if err := read(tx); err != nil {
+					return err
+				}
+				if err := store.ContextErr(); err != nil {
+					return err
+				}
+				_, err := tx.Pipelined(write)
+				return err
+			}, keys...)
+			if IsNoScript(err) {
+				err = store.LoadScripts()
+				if err == nil {
+					continue
+				}
+			}
+			if err != redis.TxFailedErr {
+				return err
+			}
+		}
+		return redis.TxFailedErr
+	}
+
+	err := store.transaction(read, write)
+	if IsNoScript(err) {
+		if err := store.LoadScripts(); err != nil {
+			return err
+		}
+		err = store.transaction(read, write)
+	}
+	return err
+}
+
+//! transaction runs read and write once without a watch
+func (store *RedisStore) transaction(read func(redis.Cmdable) error, write func(*redis.Pipeline) error) error {
+	if err := store.ContextErr(); err != nil {
+		return err
+	}
+	if err := read(store.Cmdable); err != nil {
+		return err
+	}
+	var pipe *redis.Pipeline
+	if client, ok := store.Cmdable.(interface{ TxPipeline() *redis.Pipeline }); ok {
+		pipe = client.TxPipeline()
+	} else {
+		pipe = store.Pipeline()
+	}
+	if err := write(pipe); err != nil {
+		pipe.Close()
+		return err
+	}
+	if err := store.ContextErr(); err != nil {
+		pipe.Close()
+		return err
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+// ClearProgress is passed to the progress callback of ScanDel after each
+// deleted batch, Deleted counts the keys of all the nodes and patterns.
+type ClearProgress struct {
+	Node    string
+	Pattern string
+	Deleted int64
+}
+
+// scanCount is the COUNT hint of the SCAN of ScanDel, the size of a batch.
+const scanCount = 1000
+
+// ScanDel deletes the keys matching patterns and returns their count. The
+// keys are found by SCAN and deleted by UNLINK a batch at a time, on every
+// master of a cluster and every shard of a ring. The progress callback may
+// be nil, the calls are serialized. The context of the store is checked
+// before each batch.
+func (store *RedisStore) ScanDel(progress func(*ClearProgress), patterns ...string) (int64, error) {
+	var mu sync.Mutex
+	var deleted int64
+	scan := func(client *redis.Client, single bool) error {
+		unlink := true
+		for _, pattern := range patterns {
+			var cursor uint64
+			for {
+				if err := store.ContextErr(); err != nil {
+					return err
+				}
+				keys, next, err := client.Scan(cursor, pattern, scanCount).Result()
+				if err != nil {
+					return err
+				}
+				if len(keys) > 0 {
+					n, err := unlinkKeys(client, keys, single, &unlink)
+					mu.Lock()
+					deleted += n
+					if progress != nil {
+						progress(&ClearProgress{Node: client.String(), Pattern: pattern, Deleted: deleted})
+					}
+					mu.Unlock()
+					if err != nil {
+						return err
+					}
+				}
+				if next == 0 {
+					break
+				}
+				cursor = next
+			}
+		}
+		return nil
+	}
+
+	var err error
+	switch client := store.Cmdable.(type) {
+	case *redis.Client:
+		err = scan(client, false)
+	case *redis.ClusterClient:
+		err = client.ForEachMaster(func(node *redis.Client) error {
+			return scan(node, true)
+		})
+	case *redis.Ring:
+		err = client.ForEachShard(func(shard *redis.Client) error {
+			return scan(shard, false)
+		})
+	default:
+		err = fmt.Errorf("orm: cannot scan the keys of %T", client)
+	}
+	return deleted, err
+}
+
+// unlinkKeys deletes keys on client by UNLINK, or by DEL once the server is
+// found older than 4.0. The single keys commands serve the cluster nodes
+// whose keys may belong to different slots.
+func unlinkKeys(client *redis.Client, keys []string, single bool, unlink *bool) (int64, error) {
+	pipe := client.Pipeline()
+	defer pipe.Close()
+	del := pipe.Del
+	if *unlink {
+		del = pipe.Unlink
+	}
+	var cmds []*redis.IntCmd
+	if single {
+		for _, key := range keys {
+			cmds = append(cmds, del(key))
+		}
+	} else {
+		cmds = append(cmds, del(keys...))
+	}
+	_, err := pipe.Exec()
+	if err != nil && *unlink && strings.Contains(err.Error(), "unknown command") {
+		*unlink = false
+		return unlinkKeys(client, keys, single, unlink)
+	}
+	var n int64
+	for _, cmd := range cmds {
+		n += cmd.Val()
+	}
+	return n, err
+}
+
+// TranslateRedisError turns the redis.Nil reply of the missing key into
+// NotFoundError of object, other errors are kept.
+func TranslateRedisError(err error, object, key string) error {
+	if err == redis.Nil {
+		return &NotFoundError{Object: object, Key: key}
+	}
+	return err
+}
+
+// IsVersionConflict reports whether err is the reply of a write queued by
+// HSetVersion which failed its version check.
+func IsVersionConflict(err error) bool {
+	return err != nil && err.Error() == ErrConflict.Error()
+}
+
+// IsNoScript reports whether err is the reply of a script queued by
+// HSetVersion or HSetMissing on a node which does not hold it, LoadScripts
+// loads them.
+func IsNoScript(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT ")
+}
+
+//! KEYS[1]: hash, ARGV: version field, version, strict, field value pairs...
+var versionScript = redis.NewScript(`
+local stored = redis.call('HGET', KEYS[1], ARGV[1])
+if stored then
+	if ARGV[3] == '1' then
+		if stored ~= ARGV[2] then
+			return redis.error_reply('orm: version conflict')
+		end
+	elseif tonumber(stored) > tonumber(ARGV[2]) then
+		return redis.error_reply('orm: version conflict')
+	end
+end
+redis.call('HMSET', KEYS[1], unpack(ARGV, 4))
+return 1
+`)
+
+// HSetVersion queues the write of the hash key on pipe behind a check of
+// its version field. Strict writes need the stored version to equal version,
+// the others only refuse to replace a newer one. A rejected write fails the
+// Exec of pipe with ErrConflict. The script is sent by its sha1, a node
+// lacking it fails the Exec with a NOSCRIPT error.
+func HSetVersion(pipe *redis.Pipeline, key, field string, version int64, strict bool, pairs ...interface{}) *redis.Cmd {
+	mode := "0"
+	if strict {
+		mode = "1"
+	}
+	args := make([]interface{}, 0, len(pairs)+3)
+	args = append(args, field, version, mode)
+	args = append(args, pairs...)
+	return versionScript.EvalSha(pipe, []string{key}, args...)
+}
+
+//! KEYS[1]: hash, ARGV: field value pairs...
+var missingScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+local n = 0
+for i = 1, #ARGV, 2 do
+	n = n + redis.call('HSETNX', KEYS[1], ARGV[i], ARGV[i+1])
+end
+return n
+`)
+
+// HSetMissing queues the write of the pairs to the hash key on pipe, only
+// the fields it lacks are set and a deleted hash is not created again. Like
+// HSetVersion the script is sent by its sha1.
+func HSetMissing(pipe *redis.Pipeline, key string, pairs ...interface{}) *redis.Cmd {
+	return missingScript.EvalSha(pipe, []string{key}, pairs...)
+}
+
+// LoadScripts loads the scripts of HSetVersion and HSetMissing on every
+// master of a cluster and every shard of a ring. Transaction calls it and
+// runs again on a NOSCRIPT error, the pipelines of the callers should too.
+func (store *RedisStore) LoadScripts() error {
+	load := func(client redis.Cmdable) error {
+		for _, script := range []*redis.Script{versionScript, missingScript} {
+			if err := script.Load(client).Err(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	switch client := store.Cmdable.(type) {
+	case *redis.ClusterClient:
+		return client.ForEachMaster(func(node *redis.Client) error { return load(node) })
+	case *redis.Ring:
+		return client.ForEachShard(func(shard *redis.Client) error { return load(shard) })
+	}
+	return load(store.Cmdable)
+}
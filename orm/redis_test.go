@@ -0,0 +1,53 @@
+package orm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ezbuy/redis-orm/orm"
+	redis "gopkg.in/redis.v5"
+)
+
+func TestRedisContext(t *testing.T) {
+	//! nothing listens on the port, a command sent fails with a dial error
+	store := &orm.RedisStore{Cmdable: redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: 0})}
+	if err := store.ContextErr(); err != nil {
+		t.Fatalf("unbound store expect no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bound := store.WithContext(ctx)
+	cancel()
+	if bound.Context() != ctx || store.Context() == ctx {
+		t.Error("WithContext expect to bind a copy of the store")
+	}
+
+	read := func(redis.Cmdable) error {
+		t.Error("transaction expect to stop before the read")
+		return nil
+	}
+	write := func(*redis.Pipeline) error { return nil }
+	if err := bound.Transaction([]string{"key"}, read, write); err != context.Canceled {
+		t.Errorf("transaction expect %v, got %v", context.Canceled, err)
+	}
+	if _, err := bound.ScanDel(nil, "key:*"); err != context.Canceled {
+		t.Errorf("scan expect %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestIsNoScript(t *testing.T) {
+	for _, c := range []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{redis.Nil, false},
+		{orm.ErrConflict, false},
+		{errors.New("NOSCRIPT No matching script. Please use EVAL."), true},
+	} {
+		if got := orm.IsNoScript(c.err); got != c.want {
+			t.Errorf("IsNoScript(%v) expect %v, got %v", c.err, c.want, got)
+		}
+	}
+}
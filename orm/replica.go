@@ -0,0 +1,111 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+const defaultReplicaCooldown = 10 * time.Second
+
+type replica struct {
+	*sql.DB
+	downUntil int64
+}
+
+func (r *replica) healthy(now time.Time) bool {
+	return atomic.LoadInt64(&r.downUntil) <= now.UnixNano()
+}
+
+func (r *replica) markDown(cooldown time.Duration) {
+	atomic.StoreInt64(&r.downUntil, time.Now().Add(cooldown).UnixNano())
+}
+
+type primaryKey struct{}
+
+// WithPrimary marks ctx to read from the primary even when the store has
+// replicas, use it to read your own writes before the replicas catch up.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func usePrimary(ctx context.Context) bool {
+	b, _ := ctx.Value(primaryKey{}).(bool)
+	return b
+}
+
+// AddReplica opens a read only replica with the driver and the charset of
+// the store, the replicas serve Query in turn while Exec and transactions
+// stay on the primary. Replicas should be added before the store is shared
+// by goroutines.
+func (store *DBStore) AddReplica(host string, port int, database, username, password string) error {
+	dsn, err := dataSourceName(store.driver, host, port, database, username, password, store.charset)
+	if err != nil {
+		return err
+	}
+	db, err := openDB(store.driver, dsn)
+	if err != nil {
+		return err
+	}
+	store.replicas = append(store.replicas, &replica{DB: db})
+	return nil
+}
+
+// ReplicaCooldown sets how long a replica failing with a connection error
+// is skipped before it is tried again.
+func (store *DBStore) ReplicaCooldown(duration time.Duration) {
+	store.replicaCooldown = duration
+}
+
+func (store *DBStore) SetMaxIdleConns(n int) {
+	store.DB.SetMaxIdleConns(n)
+	for _, r := range store.replicas {
+		r.SetMaxIdleConns(n)
+	}
+}
+
+func (store *DBStore) SetMaxOpenConns(n int) {
+	store.DB.SetMaxOpenConns(n)
+	for _, r := range store.replicas {
+		r.SetMaxOpenConns(n)
+	}
+}
+
+func (store *DBStore) SetConnMaxLifetime(d time.Duration) {
+	store.DB.SetConnMaxLifetime(d)
+	for _, r := range store.replicas {
+		r.SetConnMaxLifetime(d)
+	}
+}
+
+// replica picks the next healthy replica, nil means the primary.
+func (store *DBStore) replica(ctx context.Context) *replica {
+	size := len(store.replicas)
+	if size == 0 || usePrimary(ctx) {
+		return nil
+	}
+	now := time.Now()
+	start := int(atomic.AddUint32(&store.next, 1))
+	for i := 0; i < size; i++ {
+		r := store.replicas[(start+i)%size]
+		if r.healthy(now) {
+			return r
+		}
+	}
+	return nil
+}
+
+func isConnError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if err == driver.ErrBadConn {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,52 @@
+package orm_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ezbuy/redis-orm/orm"
+)
+
+func TestReplica(t *testing.T) {
+	dir := t.TempDir()
+	store, err := orm.NewDBStore("sqlite", "", 0, filepath.Join(dir, "primary.db"), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	//! the replica is an empty database, reads routed to it miss the table
+	if err := store.AddReplica("", 0, filepath.Join(dir, "replica.db"), "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Exec("CREATE TABLE items (id INTEGER PRIMARY KEY, name TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Exec("INSERT INTO items (id, name) VALUES (?, ?)", 1, "item1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Query("SELECT id FROM items"); err == nil {
+		t.Error("query expect to be routed to the replica")
+	}
+
+	//! read your writes
+	rows, err := store.QueryContext(orm.WithPrimary(context.Background()), "SELECT id FROM items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+
+	//! transactions stay on the primary
+	tx, err := store.BeginTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !itemExists(t, tx, 1) {
+		t.Error("transaction expect to read the primary")
+	}
+	if err := tx.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
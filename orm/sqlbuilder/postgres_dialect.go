@@ -0,0 +1,37 @@
+package sqlbuilder
+
+import (
+	"time"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+)
+
+var _ dbr.Dialect = PostgresDialect{}
+
+type PostgresDialect struct {
+}
+
+func (d PostgresDialect) QuoteIdent(s string) string {
+	return dialect.PostgreSQL.QuoteIdent(s)
+}
+
+func (d PostgresDialect) EncodeString(s string) string {
+	return dialect.PostgreSQL.EncodeString(s)
+}
+
+func (d PostgresDialect) EncodeBool(b bool) string {
+	return dialect.PostgreSQL.EncodeBool(b)
+}
+
+func (d PostgresDialect) EncodeTime(t time.Time) string {
+	return `'` + t.Format(mysqlTimeFormat) + `'`
+}
+
+func (d PostgresDialect) EncodeBytes(b []byte) string {
+	return dialect.PostgreSQL.EncodeBytes(b)
+}
+
+func (d PostgresDialect) Placeholder(n int) string {
+	return dialect.PostgreSQL.Placeholder(n)
+}
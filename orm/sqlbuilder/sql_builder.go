@@ -14,6 +14,14 @@ var (
 	MSSQL = SQLBuilder{
 		d: MSSQLDialect{},
 	}
+
+	Postgres = SQLBuilder{
+		d: PostgresDialect{},
+	}
+
+	SQLite = SQLBuilder{
+		d: SQLiteDialect{},
+	}
 )
 
 type SQLBuilder struct {
@@ -32,3 +40,13 @@ func (s *SQLBuilder) MustBuild(b Builder) string {
 
 	return out
 }
+
+// BuildArgs renders b with placeholders and returns the values apart, for
+// statements sent with arguments instead of interpolated values.
+func (s *SQLBuilder) BuildArgs(b Builder) (string, []interface{}, error) {
+	buf := dbr.NewBuffer()
+	if err := b.Build(s.d, buf); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), buf.Value(), nil
+}
@@ -1,10 +1,11 @@
-package sqlbuilder
+package sqlbuilder_test
 
 import (
 	"testing"
 	"time"
 
 	"github.com/ezbuy/redis-orm/example/model"
+	. "github.com/ezbuy/redis-orm/orm/sqlbuilder"
 )
 
 func TestSQLBuilder(t *testing.T) {
@@ -12,8 +13,8 @@ func TestSQLBuilder(t *testing.T) {
 	testDate := time.Date(2017, 5, 27, 11, 20, 33, 0, time.Local)
 
 	cases := []struct {
-		b                  Builder
-		mysqlOut, mssqlOut string
+		b                                       Builder
+		mysqlOut, mssqlOut, pgsqlOut, sqliteOut string
 	}{
 		{
 			And(
@@ -34,12 +35,16 @@ func TestSQLBuilder(t *testing.T) {
 			"(`OrderId` IN (11864555,11864554,11864553,11864552,11864551,11864550,11864549,11864548)) AND (`PurchaseType` = 'Ezbuy') AND (`PoPlaceDate` IS NOT NULL) AND (`OrderDate` < '2017-05-27 11:20:33.000000')",
 			// select top 5 * from [order] where ([OrderId] IN (11864555,11864554,11864553,11864552,11864551,11864550,11864549,11864548)) AND ([PurchaseType] = N'Ezbuy') AND ([PoPlaceDate] IS NOT NULL) AND ([OrderDate] < N'2017-05-27 11:15:49.723')
 			"([OrderId] IN (11864555,11864554,11864553,11864552,11864551,11864550,11864549,11864548)) AND ([PurchaseType] = N'Ezbuy') AND ([PoPlaceDate] IS NOT NULL) AND ([OrderDate] < N'2017-05-27 11:20:33.000')",
+			`("OrderId" IN (11864555,11864554,11864553,11864552,11864551,11864550,11864549,11864548)) AND ("PurchaseType" = 'Ezbuy') AND ("PoPlaceDate" IS NOT NULL) AND ("OrderDate" < '2017-05-27 11:20:33.000000')`,
+			`("OrderId" IN (11864555,11864554,11864553,11864552,11864551,11864550,11864549,11864548)) AND ("PurchaseType" = 'Ezbuy') AND ("PoPlaceDate" IS NOT NULL) AND ("OrderDate" < '2017-05-27 11:20:33.000000')`,
 		},
 		{
 			Set().Add("ShipperName", "顺丰快递").Add("TrackingNo", "123223323423").Add("SyncDate", testDate),
 			"`ShipperName` = '顺丰快递', `TrackingNo` = '123223323423', `SyncDate` = '2017-05-27 11:20:33.000000'",
 			// update OrderTracking set [ShipperName] = N'顺丰快递', [TrackingNo] = N'123223323423', [SyncDate] = N'2017-05-27 11:20:33.000' where OrderTrackingId = 7739010;
 			"[ShipperName] = N'顺丰快递', [TrackingNo] = N'123223323423', [SyncDate] = N'2017-05-27 11:20:33.000'",
+			`"ShipperName" = '顺丰快递', "TrackingNo" = '123223323423', "SyncDate" = '2017-05-27 11:20:33.000000'`,
+			`"ShipperName" = '顺丰快递', "TrackingNo" = '123223323423', "SyncDate" = '2017-05-27 11:20:33.000000'`,
 		},
 		{
 			And(
@@ -47,6 +52,8 @@ func TestSQLBuilder(t *testing.T) {
 			),
 			"`id` = 1",
 			"[id] = 1",
+			`"id" = 1`,
+			`"id" = 1`,
 		},
 	}
 
@@ -55,6 +62,10 @@ func TestSQLBuilder(t *testing.T) {
 
 		mssqlOut := MSSQL.MustBuild(c.b)
 
+		pgsqlOut := Postgres.MustBuild(c.b)
+
+		sqliteOut := SQLite.MustBuild(c.b)
+
 		if c.mysqlOut != mysqlOut {
 			t.Errorf("#%d [mysql] expected %q, got %q", i+1, c.mysqlOut, mysqlOut)
 		}
@@ -62,5 +73,13 @@ func TestSQLBuilder(t *testing.T) {
 		if c.mssqlOut != mssqlOut {
 			t.Errorf("#%d [mssql] expected %q, got %q", i+1, c.mssqlOut, mssqlOut)
 		}
+
+		if c.pgsqlOut != pgsqlOut {
+			t.Errorf("#%d [postgres] expected %q, got %q", i+1, c.pgsqlOut, pgsqlOut)
+		}
+
+		if c.sqliteOut != sqliteOut {
+			t.Errorf("#%d [sqlite] expected %q, got %q", i+1, c.sqliteOut, sqliteOut)
+		}
 	}
 }
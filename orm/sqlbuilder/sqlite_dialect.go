@@ -0,0 +1,37 @@
+package sqlbuilder
+
+import (
+	"time"
+
+	"github.com/gocraft/dbr"
+	"github.com/gocraft/dbr/dialect"
+)
+
+var _ dbr.Dialect = SQLiteDialect{}
+
+type SQLiteDialect struct {
+}
+
+func (d SQLiteDialect) QuoteIdent(s string) string {
+	return dialect.SQLite3.QuoteIdent(s)
+}
+
+func (d SQLiteDialect) EncodeString(s string) string {
+	return dialect.SQLite3.EncodeString(s)
+}
+
+func (d SQLiteDialect) EncodeBool(b bool) string {
+	return dialect.SQLite3.EncodeBool(b)
+}
+
+func (d SQLiteDialect) EncodeTime(t time.Time) string {
+	return `'` + t.Format(mysqlTimeFormat) + `'`
+}
+
+func (d SQLiteDialect) EncodeBytes(b []byte) string {
+	return dialect.SQLite3.EncodeBytes(b)
+}
+
+func (d SQLiteDialect) Placeholder(n int) string {
+	return dialect.SQLite3.Placeholder(n)
+}
@@ -0,0 +1,165 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	defaultTxRetries = 3
+	defaultTxBackoff = 20 * time.Millisecond
+)
+
+// TxRetry sets how many times WithTx reruns a transaction failing on a
+// deadlock or lock timeout, the wait doubles from backoff between the runs.
+func (store *DBStore) TxRetry(retries int, backoff time.Duration) {
+	store.txRetries = retries
+	store.txBackoff = backoff
+}
+
+func (store *DBStore) WithTx(fn func(tx *DBTx) error) error {
+	return store.WithTxContext(context.Background(), fn)
+}
+
+// WithTxContext runs fn in a transaction which is committed when fn returns
+// nil and rolled back when fn returns an error or panics, the panic goes on
+// after the rollback. The whole fn is run again when the transaction fails on
+// a deadlock, a lock wait timeout or a serialization failure.
+func (store *DBStore) WithTxContext(ctx context.Context, fn func(tx *DBTx) error) error {
+	for attempt := 0; ; attempt++ {
+		retryable, err := store.runTx(ctx, fn)
+		if err == nil || !retryable || attempt >= store.txRetries {
+			return err
+		}
+
+		backoff := store.txBackoff << uint(attempt)
+		if store.txBackoff > 0 {
+			backoff += time.Duration(rand.Int63n(int64(store.txBackoff)))
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (store *DBStore) runTx(ctx context.Context, fn func(tx *DBTx) error) (bool, error) {
+	tx, err := store.BeginTxContext(ctx, nil)
+	if err != nil {
+		return IsRetryableTxError(err), err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		tx.tx.Rollback()
+		//! the managers may format the driver error, the tx keeps the raw one
+		return IsRetryableTxError(err) || IsRetryableTxError(tx.err), err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return IsRetryableTxError(err), err
+	}
+	return false, nil
+}
+
+// WithTx runs fn inside a savepoint of the transaction, only the statements
+// of fn are rolled back when it returns an error or panics, the panic goes on
+// after the rollback.
+func (tx *DBTx) WithTx(fn func(tx *DBTx) error) error {
+	tx.savepoints++
+	defer func() { tx.savepoints-- }()
+
+	name := fmt.Sprintf("orm_savepoint_%d", tx.savepoints)
+	if _, err := tx.Exec(savepointSQL(tx.driver, "save", name)); err != nil {
+		return err
+	}
+
+	last := tx.err
+	rollback := func() error {
+		if _, err := tx.Exec(savepointSQL(tx.driver, "rollback", name)); err != nil {
+			return err
+		}
+		tx.err = last
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			rollback()
+			panic(r)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rbErr := rollback(); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+	if q := savepointSQL(tx.driver, "release", name); q != "" {
+		if _, err := tx.Exec(q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func savepointSQL(driver, op, name string) string {
+	if driver == "mssql" {
+		switch op {
+		case "save":
+			return "SAVE TRANSACTION " + name
+		case "rollback":
+			return "ROLLBACK TRANSACTION " + name
+		}
+		return ""
+	}
+	switch op {
+	case "save":
+		return "SAVEPOINT " + name
+	case "rollback":
+		return "ROLLBACK TO SAVEPOINT " + name
+	}
+	return "RELEASE SAVEPOINT " + name
+}
+
+// IsRetryableTxError reports whether err is a deadlock, lock wait timeout
+// or serialization failure after which the transaction can be run again.
+func IsRetryableTxError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		//! 1213: deadlock, 1205: lock wait timeout
+		return mysqlErr.Number == 1213 || mysqlErr.Number == 1205
+	}
+	var mssqlErr mssql.Error
+	if errors.As(err, &mssqlErr) {
+		//! 1205: deadlock victim, 1222: lock request timeout
+		return mssqlErr.Number == 1205 || mssqlErr.Number == 1222
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		//! 40001: serialization failure, 40P01: deadlock detected
+		return pqErr.Code == "40001" || pqErr.Code == "40P01"
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	return false
+}
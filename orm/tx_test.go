@@ -0,0 +1,140 @@
+package orm_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ezbuy/redis-orm/orm"
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestWithTx(t *testing.T) {
+	errRollback := errors.New("rollback")
+	deleteItem := func(tx *orm.DBTx, id int) {
+		if _, err := tx.Exec("DELETE FROM items WHERE id = ?", id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		name    string
+		fn      func(tx *orm.DBTx) error
+		err     error
+		deleted []int
+	}{
+		{
+			name: "commit",
+			fn: func(tx *orm.DBTx) error {
+				deleteItem(tx, 1)
+				return nil
+			},
+			deleted: []int{1},
+		},
+		{
+			name: "rollback on error",
+			fn: func(tx *orm.DBTx) error {
+				deleteItem(tx, 1)
+				return errRollback
+			},
+			err: errRollback,
+		},
+		{
+			name: "nested calls are savepoints",
+			fn: func(tx *orm.DBTx) error {
+				deleteItem(tx, 1)
+				if err := tx.WithTx(func(tx *orm.DBTx) error {
+					deleteItem(tx, 2)
+					return errRollback
+				}); err != errRollback {
+					t.Errorf("nested expect %v, got %v", errRollback, err)
+				}
+				return nil
+			},
+			deleted: []int{1},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			store := openTestStore(t)
+			if err := store.WithTx(c.fn); err != c.err {
+				t.Fatalf("WithTx expect %v, got %v", c.err, err)
+			}
+			deleted := map[int]bool{}
+			for _, id := range c.deleted {
+				deleted[id] = true
+			}
+			for id := 1; id <= 3; id++ {
+				if itemExists(t, store, id) == deleted[id] {
+					t.Errorf("item %d expect deleted %v", id, deleted[id])
+				}
+			}
+		})
+	}
+}
+
+func TestWithTxPanic(t *testing.T) {
+	for _, nested := range []bool{false, true} {
+		store := openTestStore(t)
+		fn := func(tx *orm.DBTx) error {
+			tx.Exec("DELETE FROM items WHERE id = ?", 1)
+			panic("boom")
+		}
+		recovered := func() (r interface{}) {
+			defer func() { r = recover() }()
+			if nested {
+				store.WithTx(func(tx *orm.DBTx) error {
+					tx.Exec("DELETE FROM items WHERE id = ?", 2)
+					defer func() {
+						//! the savepoint is rolled back before the panic goes on
+						if !itemExists(t, tx, 1) || itemExists(t, tx, 2) {
+							t.Error("nested panic expect only its savepoint rolled back")
+						}
+					}()
+					return tx.WithTx(fn)
+				})
+			} else {
+				store.WithTx(fn)
+			}
+			return nil
+		}()
+		if recovered != "boom" {
+			t.Errorf("nested %v expect the panic raised again, got %v", nested, recovered)
+		}
+		for id := 1; id <= 2; id++ {
+			if !itemExists(t, store, id) {
+				t.Errorf("nested %v expect item %d rolled back", nested, id)
+			}
+		}
+	}
+}
+
+func TestWithTxRetry(t *testing.T) {
+	store := openTestStore(t)
+	store.TxRetry(2, time.Millisecond)
+
+	for _, c := range []struct {
+		busyRuns int
+		runs     int
+		fails    bool
+	}{
+		{busyRuns: 2, runs: 3},
+		{busyRuns: 5, runs: 3, fails: true},
+	} {
+		runs := 0
+		err := store.WithTx(func(tx *orm.DBTx) error {
+			runs++
+			if runs <= c.busyRuns {
+				return sqlite3.Error{Code: sqlite3.ErrBusy}
+			}
+			return nil
+		})
+		if (err != nil) != c.fails || orm.IsRetryableTxError(err) != c.fails {
+			t.Errorf("busy %d runs expect failure %v, got %v", c.busyRuns, c.fails, err)
+		}
+		if runs != c.runs {
+			t.Errorf("busy %d runs expect %d runs, got %d", c.busyRuns, c.runs, runs)
+		}
+	}
+}
@@ -15,6 +15,10 @@ var SupportedESFieldTypes = map[string]string{
 	"datetime":  "date",
 	"timestamp": "date",
 	"timeint":   "long",
+	"json":      "object",
+	//! the field types named as their go types
+	"orm.Decimal": "double",
+	"[]byte":      "binary",
 }
 
 var ESAnalyzableFields = map[string]bool{
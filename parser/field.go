@@ -3,13 +3,18 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"math"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ezbuy/utils/container/set"
 )
 
 var (
+	enumValuePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
 	nullablePrimitiveSet = map[string]bool{
 		"uint8":   true,
 		"uint16":  true,
@@ -31,13 +36,20 @@ type Field struct {
 	Type      string
 	sqlType   string
 	sqlColumn string
-	Size      int
-	Flags     set.Set
-	Attrs     map[string]string
-	Comment   string
-	Validator string
-	Obj       *MetaObject
-	ESIndex   ESIndex
+	goType    string
+	precision int
+	scale     int
+	//! the value of `default:`
+	defaultValue *string
+	Size         int
+	Flags        set.Set
+	Attrs        map[string]string
+	Comment      string
+	Validator    string
+	Obj          *MetaObject
+	ESIndex      ESIndex
+	//! values of an enum field
+	Enum []string
 }
 
 func NewField() *Field {
@@ -64,6 +76,9 @@ var SupportedFieldTypes = map[string]string{
 	"datetime":  "datetime",
 	"timestamp": "timestamp",
 	"timeint":   "timeint",
+	"json":      "json",
+	"decimal":   "orm.Decimal",
+	"bytes":     "[]byte",
 }
 
 func (f *Field) SetType(t string) error {
@@ -75,6 +90,8 @@ func (f *Field) SetType(t string) error {
 	switch f.Obj.Db {
 	case "mysql":
 	case "mssql":
+	case "postgres":
+	case "sqlite":
 	case "redis":
 	case "mongo":
 	case "elastic":
@@ -83,6 +100,245 @@ func (f *Field) SetType(t string) error {
 	return nil
 }
 
+// setEnum makes the field a named type of the object with one constant for
+// each value of `{enum: [value, ...]}`.
+func (f *Field) setEnum(data map[interface{}]interface{}) error {
+	values, ok := data["enum"].([]interface{})
+	if !ok || len(data) != 1 || len(values) == 0 {
+		return fmt.Errorf("field (%s) type should be a type name or {enum: [value, ...]}", f.Name)
+	}
+	seen := map[string]bool{}
+	for _, v := range values {
+		value := fmt.Sprint(v)
+		if !enumValuePattern.MatchString(value) || seen[value] {
+			return fmt.Errorf("field (%s) enum value (%s) invalid or duplicated", f.Name, value)
+		}
+		seen[value] = true
+		f.Enum = append(f.Enum, value)
+	}
+	f.Type = f.Obj.Name + f.Name
+	return nil
+}
+
+func (f *Field) IsEnum() bool {
+	return len(f.Enum) > 0
+}
+
+func (f *Field) IsDecimal() bool {
+	return f.Type == "orm.Decimal"
+}
+
+func (f *Field) IsBytes() bool {
+	return f.Type == "[]byte"
+}
+
+func (f *Field) IsJSON() bool {
+	return f.Type == "json"
+}
+
+// goTypePattern matches the gotype of a field, the package of a named
+// type may be given by its import path like `[]github.com/acme/geo.Address`.
+var goTypePattern = regexp.MustCompile(`^((?:\*|\[\])*)(?:([\w.\-]+(?:/[\w.\-]+)*)/)?(?:(\w+)\.)?(\w+)$`)
+
+// HasGoType reports whether the field is of a user defined type stored as
+// its bool, number or string type.
+func (f *Field) HasGoType() bool {
+	return f.goType != "" && !f.IsJSON()
+}
+
+// Sprint is the expression of the redis string of value, the values of a
+// gotype are formatted by their MarshalBinary or MarshalText if they have.
+func (f *Field) Sprint(value string) string {
+	if f.HasGoType() {
+		return "orm.Sprint(" + value + ")"
+	}
+	return "fmt.Sprint(" + value + ")"
+}
+
+// GoImport is the import path of the gotype of a field.
+func (f *Field) GoImport() string {
+	m := goTypePattern.FindStringSubmatch(f.goType)
+	if m == nil || m[3] == "" {
+		return ""
+	}
+	return strings.TrimPrefix(m[2]+"/"+m[3], "/")
+}
+
+func (f *Field) goTypeName() string {
+	m := goTypePattern.FindStringSubmatch(f.goType)
+	if m == nil {
+		return f.goType
+	}
+	if m[3] == "" {
+		return m[1] + m[4]
+	}
+	return m[1] + m[3] + "." + m[4]
+}
+
+func (f *Field) HasDefault() bool {
+	return f.defaultValue != nil
+}
+
+var decimalPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.([0-9]+))?$`)
+
+// checkDefault checks the `default:` of the field against its type.
+func (f *Field) checkDefault() error {
+	if !f.HasDefault() {
+		return nil
+	}
+	value := *f.defaultValue
+	if f.IsPrimary() || f.IsAutoIncrement() || f.IsTime() || f.IsJSON() || f.IsBytes() || f.IsVersion() {
+		return errors.New("field (" + f.Name + ") of a primary, time, json, bytes or version can't have a default")
+	}
+
+	var err error
+	t := f.storageType()
+	switch {
+	case f.IsEnum():
+		for _, v := range f.Enum {
+			if v == value {
+				return nil
+			}
+		}
+		err = errors.New("not an enum value")
+	case f.IsDecimal():
+		m := decimalPattern.FindStringSubmatch(value)
+		if m == nil || len(m[2]) > f.scale {
+			err = errors.New("not a decimal of the scale")
+		}
+	case t == "bool":
+		_, err = strconv.ParseBool(value)
+	case strings.HasPrefix(t, "int"):
+		_, err = strconv.ParseInt(value, 10, typeBits(t))
+	case strings.HasPrefix(t, "uint"):
+		_, err = strconv.ParseUint(value, 10, typeBits(t))
+	case strings.HasPrefix(t, "float"):
+		var v float64
+		if v, err = strconv.ParseFloat(value, typeBits(t)); err == nil && (math.IsInf(v, 0) || math.IsNaN(v)) {
+			err = errors.New("not a finite number")
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("field (%s) default (%s) invalid: %v", f.Name, value, err)
+	}
+	return nil
+}
+
+// typeBits is the size of the int, uint and float types, 0 means int.
+func typeBits(t string) int {
+	n, _ := strconv.Atoi(strings.TrimLeft(t, "uintfloa"))
+	return n
+}
+
+// DefaultValue is the go expression of the default of the field, which the
+// constructor of the object sets. The numbers are formatted again, a default
+// like 010 would be an octal literal as it is.
+func (f *Field) DefaultValue() string {
+	value := *f.defaultValue
+	t := f.storageType()
+	switch {
+	case f.IsEnum():
+		for _, v := range f.EnumValues() {
+			if v.Value == value {
+				return v.Const
+			}
+		}
+	case f.IsDecimal():
+		return fmt.Sprintf("orm.MustParseDecimal(%s)", strconv.Quote(value))
+	case f.IsString():
+		return strconv.Quote(value)
+	case t == "bool":
+		b, _ := strconv.ParseBool(value)
+		return strconv.FormatBool(b)
+	case strings.HasPrefix(t, "int"):
+		i, _ := strconv.ParseInt(value, 10, typeBits(t))
+		return strconv.FormatInt(i, 10)
+	case strings.HasPrefix(t, "uint"):
+		u, _ := strconv.ParseUint(value, 10, typeBits(t))
+		return strconv.FormatUint(u, 10)
+	case strings.HasPrefix(t, "float"):
+		v, _ := strconv.ParseFloat(value, typeBits(t))
+		return strconv.FormatFloat(v, 'g', -1, typeBits(t))
+	}
+	return value
+}
+
+func (f *Field) sqlDefaultValue(driver string) string {
+	value := *f.defaultValue
+	driver = strings.ToLower(driver)
+	if f.IsString() || f.IsEnum() {
+		value = "'" + strings.Replace(value, "'", "''", -1) + "'"
+		if driver == "mssql" {
+			return "N" + value
+		}
+		return value
+	}
+	if f.storageType() == "bool" {
+		b, _ := strconv.ParseBool(value)
+		if driver == "postgres" {
+			return strings.ToUpper(strconv.FormatBool(b))
+		}
+		value = "0"
+		if b {
+			value = "1"
+		}
+	}
+	//! the numbers are quoted like the zero defaults of mysql
+	if driver == "mysql" || (driver == "sqlite" && f.IsDecimal()) {
+		return "'" + value + "'"
+	}
+	return value
+}
+
+type EnumValue struct {
+	Const string
+	Value string
+}
+
+// EnumValues returns the constants of the enum type, the first value is the
+// zero one.
+func (f *Field) EnumValues() []EnumValue {
+	values := make([]EnumValue, len(f.Enum))
+	for i, value := range f.Enum {
+		name := ""
+		for _, part := range strings.Split(value, "_") {
+			if part != "" {
+				name += strings.ToUpper(part[:1]) + part[1:]
+			}
+		}
+		values[i] = EnumValue{Const: f.Type + name, Value: value}
+	}
+	return values
+}
+
+// enumSize is the length of the longest value.
+func (f *Field) enumSize() int {
+	size := 0
+	for _, value := range f.Enum {
+		if len(value) > size {
+			size = len(value)
+		}
+	}
+	return size
+}
+
+// SQLCheck is the CHECK constraint of an enum column for the databases
+// without ENUM.
+func (f *Field) SQLCheck(driver string) string {
+	if !f.IsEnum() {
+		return ""
+	}
+	prefix := "'"
+	if strings.ToLower(driver) == "mssql" {
+		prefix = "N'"
+	}
+	values := make([]string, len(f.Enum))
+	for i, value := range f.Enum {
+		values[i] = prefix + value + "'"
+	}
+	return fmt.Sprintf("CHECK (%s IN (%s))", f.SQLName(driver), strings.Join(values, ", "))
+}
+
 func (f *Field) FieldName() string {
 	if f.Obj.DbContains("mysql") {
 		return fmt.Sprintf("`%s`", f.ColumnName())
@@ -128,6 +384,18 @@ func (f *Field) IsFullText() bool {
 	return f.Flags.Contains("fulltext")
 }
 
+func (f *Field) IsVersion() bool {
+	return f.Flags.Contains("version")
+}
+
+func (f *Field) IsAutoCreateTime() bool {
+	return f.Flags.Contains("autocreatetime")
+}
+
+func (f *Field) IsAutoUpdateTime() bool {
+	return f.Flags.Contains("autoupdatetime")
+}
+
 func (f *Field) IsEncode() bool {
 	if f.IsString() {
 		return f.Flags.Contains("encode") || f.Flags.Contains("base64")
@@ -180,6 +448,15 @@ func (f *Field) HasIndex() bool {
 }
 
 func (f *Field) GetType() string {
+	if f.goType != "" {
+		return f.goTypeName()
+	}
+	return f.storageType()
+}
+
+// storageType is the go type of the values read from and written to the
+// databases, which GetType overrides by the gotype of the field.
+func (f *Field) storageType() string {
 	st := f.Type
 	if transform := f.GetTransform(); transform != nil {
 		st = transform.TypeTarget
@@ -198,7 +475,7 @@ func (f *Field) GetNames() string {
 }
 
 func (f *Field) IsNullablePrimitive() bool {
-	return f.IsNullable() && nullablePrimitiveSet[f.GetType()]
+	return f.IsNullable() && nullablePrimitiveSet[f.storageType()]
 }
 
 func (f *Field) GetNullSQLType() string {
@@ -239,7 +516,7 @@ func (f *Field) NullSQLTypeValue() string {
 }
 
 func (f *Field) NullSQLTypeNeedCast() bool {
-	t := f.GetType()
+	t := f.storageType()
 	if strings.HasPrefix(t, "int") && t != "int64" {
 		return true
 	} else if strings.HasPrefix(t, "float") && t != "float64" {
@@ -282,6 +559,30 @@ var transformMap = map[string]Transform{
 		"string", "orm.TimeParseLocalTime(%v)",
 		"time.Time", "orm.TimeToLocalTime(%v)",
 	},
+	"postgres_timestamp": { // TIMESTAMP (string, UTC)
+		"string", `orm.PostgresTimeParse(%v)`,
+		"time.Time", `orm.PostgresTimeFormat(%v)`,
+	},
+	"postgres_timeint": { // BIGINT
+		"int64", "time.Unix(%v, 0)",
+		"time.Time", "%v.Unix()",
+	},
+	"postgres_datetime": { // TIMESTAMP (string, localtime)
+		"string", "orm.PostgresLocalTimeParse(%v)",
+		"time.Time", "orm.TimeToLocalTime(%v)",
+	},
+	"sqlite_timestamp": { // DATETIME (string, UTC)
+		"string", `orm.SQLiteTimeParse(%v)`,
+		"time.Time", `orm.SQLiteTimeFormat(%v)`,
+	},
+	"sqlite_timeint": { // INTEGER
+		"int64", "time.Unix(%v, 0)",
+		"time.Time", "%v.Unix()",
+	},
+	"sqlite_datetime": { // DATETIME (string, localtime)
+		"string", "orm.SQLiteLocalTimeParse(%v)",
+		"time.Time", "orm.TimeToLocalTime(%v)",
+	},
 	"redis_timestamp": { // TIMESTAMP (string, UTC)
 		"string", `orm.TimeParse(%v)`,
 		"time.Time", `orm.TimeFormat(%v)`,
@@ -322,6 +623,12 @@ func (f *Field) GetTransform() *Transform {
 }
 
 func (f *Field) GetTransformValue(prefix string) string {
+	if f.IsJSON() {
+		return fmt.Sprintf("orm.JSON{V: %s}", prefix+f.Name)
+	}
+	if f.IsDecimal() {
+		return fmt.Sprintf("%s.Round(%d)", prefix+f.Name, f.scale)
+	}
 	t := f.GetTransform()
 	if t == nil {
 		return prefix + f.Name
@@ -344,6 +651,10 @@ func (f *Field) GetTag() string {
 			tags["db"] = false
 		case "mssql":
 			tags["db"] = false
+		case "postgres":
+			tags["db"] = false
+		case "sqlite":
+			tags["db"] = false
 		}
 	}
 
@@ -382,6 +693,12 @@ func (f *Field) Read(data map[interface{}]interface{}) error {
 				return errors.New("invalid field name: " + key)
 			}
 			f.Name = key
+			if enum, ok := v.(map[interface{}]interface{}); ok {
+				if err := f.setEnum(enum); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := f.SetType(v.(string)); err != nil {
 				return err
 			}
@@ -396,8 +713,17 @@ func (f *Field) Read(data map[interface{}]interface{}) error {
 			f.sqlType = v.(string)
 		case "sqlcolumn":
 			f.sqlColumn = v.(string)
+		case "gotype":
+			f.goType = v.(string)
+		case "precision":
+			f.precision = v.(int)
+		case "scale":
+			f.scale = v.(int)
 		case "comment":
 			f.Comment = v.(string)
+		case "default":
+			value := fmt.Sprint(v)
+			f.defaultValue = &value
 		case "validator":
 			f.Validator = strings.ToLower(v.(string))
 		case "attrs":
@@ -428,10 +754,75 @@ func (f *Field) Read(data map[interface{}]interface{}) error {
 		}
 	}
 
+	if f.IsJSON() {
+		if f.goType == "" {
+			f.goType = "map[string]interface{}"
+		}
+		if f.IsPrimary() || f.Flags.Contains("nullable") || f.HasIndex() || f.IsVersion() || f.IsAutoCreateTime() || f.IsAutoUpdateTime() {
+			return errors.New("json field (" + f.Name + ") should not be primary, nullable, indexed, version or auto time")
+		}
+	} else if f.goType != "" {
+		if !(f.IsNumber() || f.IsString()) || f.IsNeedTransform() || f.Flags.Contains("nullable") || f.IsEncode() {
+			return errors.New("gotype field (" + f.Name + ") should be a not nullable bool, number or string")
+		}
+	}
+
+	if f.IsDecimal() {
+		if f.precision == 0 {
+			f.precision = 10
+		}
+		if f.precision > 38 || f.scale < 0 || f.scale > f.precision {
+			return fmt.Errorf("decimal field (%s) precision %d or scale %d invalid", f.Name, f.precision, f.scale)
+		}
+	} else if f.precision != 0 || f.scale != 0 {
+		return errors.New("field (" + f.Name + ") precision and scale are only for the decimal type")
+	}
+
+	if err := f.checkDefault(); err != nil {
+		return err
+	}
+
+	if f.IsDecimal() || f.IsBytes() {
+		if f.IsPrimary() || f.Flags.Contains("nullable") || f.IsVersion() || f.IsAutoCreateTime() || f.IsAutoUpdateTime() {
+			return errors.New("decimal or bytes field (" + f.Name + ") should not be primary, nullable, version or auto time")
+		}
+		if f.IsBytes() && f.HasIndex() {
+			return errors.New("bytes field (" + f.Name + ") should not be indexed")
+		}
+	}
+
 	if f.Obj.DbContains("elastic") && f.ESIndex.ShouldIndex() {
-		if err := f.ESIndex.SetType(f.Type); err != nil {
+		esType := f.Type
+		if f.IsEnum() {
+			esType = "string"
+		}
+		if err := f.ESIndex.SetType(esType); err != nil {
 			return err
 		}
+		//! a slice is mapped by its elements, the objects of it are nested
+		if f.IsJSON() && strings.HasPrefix(f.goType, "[]") {
+			if err := f.ESIndex.SetType(strings.TrimLeft(f.goType, "[]*")); err != nil {
+				f.ESIndex.Type = "nested"
+			}
+		}
+	}
+
+	if f.IsEnum() {
+		if f.IsPrimary() || f.Flags.Contains("nullable") || f.IsRange() || f.IsVersion() || f.IsAutoCreateTime() || f.IsAutoUpdateTime() {
+			return errors.New("enum field (" + f.Name + ") should not be primary, nullable, range, version or auto time")
+		}
+	}
+
+	if f.IsVersion() {
+		if f.IsPrimary() || f.IsNullable() || !(strings.HasPrefix(f.Type, "int") || strings.HasPrefix(f.Type, "uint")) {
+			return errors.New("version field (" + f.Name + ") should be a not nullable integer")
+		}
+	}
+
+	if f.IsAutoCreateTime() || f.IsAutoUpdateTime() {
+		if f.IsPrimary() || !f.IsTime() {
+			return errors.New("auto time field (" + f.Name + ") should be a timeint, timestamp or datetime")
+		}
 	}
 
 	//! single field primary adjust for redis ops
@@ -470,6 +861,44 @@ func (f *Field) SQLColumn(driver string) string {
 			columns = append(columns, "COMMENT", "'"+f.Comment+"'")
 		}
 		return strings.Join(columns, " ")
+	case "mssql":
+		columns := make([]string, 0, 5)
+		columns = append(columns, f.SQLName(driver))
+		columns = append(columns, f.SQLType(driver))
+		if f.IsAutoIncrement() {
+			columns = append(columns, "IDENTITY(1,1)")
+		}
+		columns = append(columns, f.SQLNull(driver))
+		if !f.IsAutoIncrement() {
+			columns = append(columns, f.SQLDefault(driver))
+		}
+		columns = append(columns, f.SQLCheck(driver))
+		return strings.TrimSpace(strings.Join(columns, " "))
+	case "postgres":
+		columns := make([]string, 0, 5)
+		columns = append(columns, f.SQLName(driver))
+		columns = append(columns, f.SQLType(driver))
+		if f.IsAutoIncrement() {
+			columns = append(columns, "GENERATED BY DEFAULT AS IDENTITY")
+		}
+		columns = append(columns, f.SQLNull(driver))
+		if !f.IsAutoIncrement() {
+			columns = append(columns, f.SQLDefault(driver))
+		}
+		columns = append(columns, f.SQLCheck(driver))
+		return strings.TrimSpace(strings.Join(columns, " "))
+	case "sqlite":
+		//! autoincrement is only allowed on the INTEGER PRIMARY KEY column
+		if f.IsAutoIncrement() {
+			return f.SQLName(driver) + " INTEGER PRIMARY KEY AUTOINCREMENT"
+		}
+		columns := make([]string, 0, 4)
+		columns = append(columns, f.SQLName(driver))
+		columns = append(columns, f.SQLType(driver))
+		columns = append(columns, f.SQLNull(driver))
+		columns = append(columns, f.SQLDefault(driver))
+		columns = append(columns, f.SQLCheck(driver))
+		return strings.TrimSpace(strings.Join(columns, " "))
 	}
 	return ""
 }
@@ -477,6 +906,10 @@ func (f *Field) SQLName(driver string) string {
 	switch strings.ToLower(driver) {
 	case "mysql":
 		return "`" + Camel2Name(f.Name) + "`"
+	case "mssql":
+		return "[" + f.ColumnName() + "]"
+	case "postgres", "sqlite":
+		return `"` + f.ColumnName() + `"`
 	}
 	return ""
 }
@@ -485,10 +918,65 @@ func (f *Field) SQLType(driver string) string {
 	if f.sqlType != "" {
 		return strings.ToUpper(f.sqlType)
 	}
+	if f.IsEnum() {
+		switch strings.ToLower(driver) {
+		case "mysql":
+			values := make([]string, len(f.Enum))
+			for i, value := range f.Enum {
+				values[i] = "'" + value + "'"
+			}
+			return fmt.Sprintf("ENUM(%s)", strings.Join(values, ","))
+		case "mssql":
+			return fmt.Sprintf("NVARCHAR(%d)", f.enumSize())
+		case "postgres":
+			return fmt.Sprintf("VARCHAR(%d)", f.enumSize())
+		case "sqlite":
+			return "TEXT"
+		}
+	}
+	if f.IsDecimal() {
+		switch strings.ToLower(driver) {
+		case "mysql", "mssql", "postgres":
+			return fmt.Sprintf("DECIMAL(%d,%d)", f.precision, f.scale)
+		case "sqlite":
+			//! the numerics of sqlite are floats, the text keeps all the digits
+			return "TEXT"
+		}
+	}
+	if f.IsBytes() {
+		switch strings.ToLower(driver) {
+		case "mysql":
+			if f.Size > 0 {
+				return fmt.Sprintf("VARBINARY(%d)", f.Size)
+			}
+			return "BLOB"
+		case "mssql":
+			if f.Size > 0 {
+				return fmt.Sprintf("VARBINARY(%d)", f.Size)
+			}
+			return "VARBINARY(MAX)"
+		case "postgres":
+			return "BYTEA"
+		case "sqlite":
+			return "BLOB"
+		}
+	}
+	if f.IsJSON() {
+		switch strings.ToLower(driver) {
+		case "mysql":
+			return "JSON"
+		case "mssql":
+			return "NVARCHAR(MAX)"
+		case "postgres":
+			return "JSONB"
+		case "sqlite":
+			return "TEXT"
+		}
+	}
 	switch strings.ToLower(driver) {
 	case "mysql":
 		if f.IsNumber() {
-			switch f.GetType() {
+			switch f.storageType() {
 			case "bool":
 				return "TINYINT(1) UNSIGNED"
 			case "uint8":
@@ -525,15 +1013,99 @@ func (f *Field) SQLType(driver string) string {
 			}
 			return fmt.Sprintf("VARCHAR(%d)", f.Size)
 		}
-		return f.GetType()
+		return f.storageType()
+	case "mssql":
+		if f.IsNumber() {
+			switch f.storageType() {
+			case "bool":
+				return "BIT"
+			case "uint8":
+				return "TINYINT"
+			case "int8", "int16":
+				return "SMALLINT"
+			case "uint16", "int32", "int":
+				return "INT"
+			case "uint32", "uint64", "int64":
+				return "BIGINT"
+			case "float32":
+				return "REAL"
+			case "float64":
+				return "FLOAT"
+			case "time.Time", "*time.Time":
+				return "BIGINT"
+			}
+		}
+		if f.IsString() {
+			switch f.Type {
+			case "datetime", "timestamp":
+				return "DATETIME"
+			}
+			if f.Size == 0 {
+				return "NVARCHAR(100)"
+			}
+			if f.Size > 4000 {
+				return "NVARCHAR(MAX)"
+			}
+			return fmt.Sprintf("NVARCHAR(%d)", f.Size)
+		}
+		return f.storageType()
+	case "postgres":
+		if f.IsNumber() {
+			switch f.storageType() {
+			case "bool":
+				return "BOOLEAN"
+			case "uint8", "int8", "int16":
+				return "SMALLINT"
+			case "uint16", "int32", "int":
+				return "INTEGER"
+			case "uint32", "uint64", "int64":
+				return "BIGINT"
+			case "float32":
+				return "REAL"
+			case "float64":
+				return "DOUBLE PRECISION"
+			case "time.Time", "*time.Time":
+				return "BIGINT"
+			}
+		}
+		if f.IsString() {
+			switch f.Type {
+			case "datetime", "timestamp":
+				return "TIMESTAMP"
+			}
+			if f.Size == 0 {
+				return "VARCHAR(100)"
+			}
+			return fmt.Sprintf("VARCHAR(%d)", f.Size)
+		}
+		return f.storageType()
+	case "sqlite":
+		if f.IsNumber() {
+			switch f.storageType() {
+			case "bool":
+				return "BOOLEAN"
+			case "float32", "float64":
+				return "REAL"
+			}
+			return "INTEGER"
+		}
+		if f.IsString() {
+			switch f.Type {
+			case "datetime", "timestamp":
+				return "DATETIME"
+			}
+			return "TEXT"
+		}
+		return f.storageType()
 	}
 	return ""
 }
 
 func (f *Field) SQLNull(driver string) string {
 	switch strings.ToLower(driver) {
-	case "mysql":
-		if f.IsNullable() {
+	case "mysql", "mssql", "postgres", "sqlite":
+		//! the nil slices are stored as NULL
+		if f.IsNullable() || f.IsBytes() {
 			return "NULL"
 		}
 		return "NOT NULL"
@@ -542,9 +1114,26 @@ func (f *Field) SQLNull(driver string) string {
 }
 
 func (f *Field) SQLDefault(driver string) string {
+	if f.HasDefault() {
+		return "DEFAULT " + f.sqlDefaultValue(driver)
+	}
 	if f.IsNullable() {
 		return ""
 	}
+	if f.IsEnum() {
+		if strings.ToLower(driver) == "mssql" {
+			return "DEFAULT N'" + f.Enum[0] + "'"
+		}
+		return "DEFAULT '" + f.Enum[0] + "'"
+	}
+	if f.IsDecimal() {
+		switch strings.ToLower(driver) {
+		case "mysql", "sqlite":
+			return "DEFAULT '0'"
+		case "mssql", "postgres":
+			return "DEFAULT 0"
+		}
+	}
 	switch strings.ToLower(driver) {
 	case "mysql":
 		if f.IsTime() {
@@ -562,6 +1151,42 @@ func (f *Field) SQLDefault(driver string) string {
 			return "DEFAULT ''"
 		}
 		return ""
+	case "mssql":
+		if f.IsTime() && f.IsString() {
+			return "DEFAULT GETDATE()"
+		}
+		if f.IsNumber() {
+			return "DEFAULT 0"
+		}
+		if f.IsString() {
+			return "DEFAULT N''"
+		}
+		return ""
+	case "postgres":
+		if f.IsTime() && f.IsString() {
+			return "DEFAULT CURRENT_TIMESTAMP"
+		}
+		if f.storageType() == "bool" {
+			return "DEFAULT FALSE"
+		}
+		if f.IsNumber() {
+			return "DEFAULT 0"
+		}
+		if f.IsString() {
+			return "DEFAULT ''"
+		}
+		return ""
+	case "sqlite":
+		if f.IsTime() && f.IsString() {
+			return "DEFAULT CURRENT_TIMESTAMP"
+		}
+		if f.IsNumber() {
+			return "DEFAULT 0"
+		}
+		if f.IsString() {
+			return "DEFAULT ''"
+		}
+		return ""
 	}
 	return ""
 }
@@ -576,6 +1201,14 @@ func (fs Fields) GetFuncParam() string {
 	return strings.Join(params, ", ")
 }
 
+func (fs Fields) GetFuncArgs() string {
+	var args []string
+	for _, f := range fs {
+		args = append(args, CamelName(f.Name))
+	}
+	return strings.Join(args, ", ")
+}
+
 func (fs Fields) GetObjectParam() string {
 	var params []string
 	for _, f := range fs {
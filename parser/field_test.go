@@ -0,0 +1,44 @@
+package parser
+
+import "testing"
+
+func TestFieldDefault(t *testing.T) {
+	cases := []struct {
+		typ   string
+		value interface{}
+		expr  string
+		valid bool
+	}{
+		{"int32", "010", "10", true},
+		{"int64", "-0", "0", true},
+		{"int8", "+7", "7", true},
+		{"uint16", "007", "7", true},
+		{"uint8", "256", "", false},
+		{"float64", "1.50", "1.5", true},
+		{"float32", 2.5, "2.5", true},
+		{"float64", "inf", "", false},
+		{"float64", "-Inf", "", false},
+		{"float64", "NaN", "", false},
+		{"float64", "1e400", "", false},
+		{"bool", "1", "true", true},
+		{"string", `say "hi"`, `"say \"hi\""`, true},
+	}
+	for _, c := range cases {
+		f := NewField()
+		f.Obj = NewMetaObject("model")
+		err := f.Read(map[interface{}]interface{}{"Value": c.typ, "default": c.value})
+		if !c.valid {
+			if err == nil {
+				t.Errorf("%s default %v expect an error", c.typ, c.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s default %v: %v", c.typ, c.value, err)
+			continue
+		}
+		if expr := f.DefaultValue(); expr != c.expr {
+			t.Errorf("%s default %v expect %s, got %s", c.typ, c.value, c.expr, expr)
+		}
+	}
+}
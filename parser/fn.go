@@ -65,6 +65,19 @@ func Camel2Name(s string) string {
 	return nameBuf.String()
 }
 
+func Name2Camel(s string) string {
+	parts := strings.Split(s, "_")
+	nameBuf := bytes.NewBuffer(nil)
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		nameBuf.WriteString(strings.ToUpper(part[:1]))
+		nameBuf.WriteString(part[1:])
+	}
+	return nameBuf.String()
+}
+
 func CamelName(argName string) string {
 	size := len(argName)
 	if size <= 0 {
@@ -48,6 +48,10 @@ func (idx *Index) GetFuncParam() string {
 	return Fields(idx.Fields).GetFuncParam()
 }
 
+func (idx *Index) GetFuncArgs() string {
+	return Fields(idx.Fields).GetFuncArgs()
+}
+
 func (idx *Index) GetFuncName() string {
 	params := make([]string, len(idx.Fields))
 	for i, f := range idx.Fields {
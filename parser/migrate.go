@@ -0,0 +1,268 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Migration holds the ordered up/down statements between two schemas,
+// Reviews are the ambiguous changes which should be checked by hand.
+type Migration struct {
+	Up      []string
+	Down    []string
+	Reviews []string
+	downs   []string
+}
+
+func (m *Migration) add(up, down string) {
+	m.Up = append(m.Up, up)
+	m.downs = append(m.downs, down)
+}
+
+func (m *Migration) review(format string, args ...interface{}) {
+	m.Reviews = append(m.Reviews, fmt.Sprintf(format, args...))
+}
+
+func (m *Migration) IsEmpty() bool {
+	return len(m.Up) == 0 && len(m.Reviews) == 0
+}
+
+func (m *Migration) Script(up bool) string {
+	lines := []string{}
+	for _, review := range m.Reviews {
+		lines = append(lines, "-- REVIEW: "+review)
+	}
+	stmts := m.Down
+	if up {
+		stmts = m.Up
+	}
+	lines = append(lines, stmts...)
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// DiffTables generates the migration from olds to news schema, the
+// statements are of the mysql DDL which is the only driver supported.
+func DiffTables(driver string, olds, news []*Table) (*Migration, error) {
+	switch strings.ToLower(driver) {
+	case "mysql":
+	default:
+		return nil, fmt.Errorf("unsupport db driver: %s", driver)
+	}
+
+	m := &Migration{}
+	oldMap := map[string]*Table{}
+	for _, t := range olds {
+		oldMap[t.Name] = t
+	}
+	newMap := map[string]*Table{}
+	for _, t := range news {
+		newMap[t.Name] = t
+	}
+
+	dropped := []*Table{}
+	for _, t := range sortedTables(olds) {
+		if _, ok := newMap[t.Name]; !ok {
+			dropped = append(dropped, t)
+		}
+	}
+	added := []*Table{}
+	for _, t := range sortedTables(news) {
+		if old, ok := oldMap[t.Name]; ok {
+			diffMySQLTable(m, old, t)
+		} else {
+			added = append(added, t)
+		}
+	}
+
+	for _, t := range dropped {
+		m.add(fmt.Sprintf("DROP TABLE `%s`;", t.Name), strings.Join(t.MySQLCreate(), "\n"))
+		for _, a := range added {
+			if sameColumnNames(t, a) {
+				m.review("table `%s` dropped and `%s` added with the same columns, check whether it is a rename: RENAME TABLE `%s` TO `%s`;",
+					t.Name, a.Name, t.Name, a.Name)
+			}
+		}
+	}
+	for _, t := range added {
+		m.add(strings.Join(t.MySQLCreate(), "\n"), fmt.Sprintf("DROP TABLE `%s`;", t.Name))
+	}
+
+	for i := len(m.downs) - 1; i >= 0; i-- {
+		m.Down = append(m.Down, m.downs[i])
+	}
+	return m, nil
+}
+
+func sortedTables(tables []*Table) []*Table {
+	sorted := make([]*Table, len(tables))
+	copy(sorted, tables)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return sorted
+}
+
+func sameColumnNames(a, b *Table) bool {
+	if len(a.Columns) != len(b.Columns) {
+		return false
+	}
+	for _, c := range a.Columns {
+		if b.ColumnByName(c.Name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func diffMySQLTable(m *Migration, old, t *Table) {
+	alter := fmt.Sprintf("ALTER TABLE `%s` ", t.Name)
+
+	//! indexes are dropped first, so that columns could be changed
+	oldUniques, newUniques := diffIndexes(old.Uniques, t.Uniques)
+	oldIndexes, newIndexes := diffIndexes(old.Indexes, t.Indexes)
+	for _, idx := range oldUniques {
+		m.add(idx.MySQLDrop(t.Name), idx.MySQLCreate(t.Name, true))
+	}
+	for _, idx := range oldIndexes {
+		m.add(idx.MySQLDrop(t.Name), idx.MySQLCreate(t.Name, false))
+	}
+
+	//! added & changed columns, at the position of the new table
+	addedColumns := []*Column{}
+	for i, c := range t.Columns {
+		position := "FIRST"
+		if i > 0 {
+			position = "AFTER `" + t.Columns[i-1].Name + "`"
+		}
+		oc := old.ColumnByName(c.Name)
+		if oc == nil {
+			addedColumns = append(addedColumns, c)
+			m.add(alter+"ADD COLUMN "+c.MySQLDefinition()+" "+position+";",
+				alter+"DROP COLUMN `"+c.Name+"`;")
+			continue
+		}
+		if !sameColumn(oc, c) {
+			if !sameColumnType(oc, c) {
+				m.review("column `%s`.`%s` type changed from %s to %s, check the data could be converted",
+					t.Name, c.Name, oc.SQLType(), c.SQLType())
+			}
+			m.add(alter+"MODIFY COLUMN "+c.MySQLDefinition()+";",
+				alter+"MODIFY COLUMN "+oc.MySQLDefinition()+";")
+		}
+	}
+
+	//! dropped columns, restored at the position of the old table
+	for i, oc := range old.Columns {
+		if t.ColumnByName(oc.Name) != nil {
+			continue
+		}
+		position := "FIRST"
+		if i > 0 {
+			position = "AFTER `" + old.Columns[i-1].Name + "`"
+		}
+		m.add(alter+"DROP COLUMN `"+oc.Name+"`;",
+			alter+"ADD COLUMN "+oc.MySQLDefinition()+" "+position+";")
+		for _, c := range addedColumns {
+			if sameColumnType(oc, c) {
+				m.review("column `%s`.`%s` dropped and `%s` added with the same type, check whether it is a rename: %sCHANGE COLUMN `%s` %s;",
+					t.Name, oc.Name, c.Name, alter, oc.Name, c.MySQLDefinition())
+			}
+		}
+	}
+
+	if !sameColumnList(old.PrimaryKey, t.PrimaryKey) {
+		m.review("table `%s` primary key changed from (%s) to (%s)",
+			t.Name, strings.Join(old.PrimaryKey, ","), strings.Join(t.PrimaryKey, ","))
+		m.add(alterPrimaryKey(alter, old.PrimaryKey, t.PrimaryKey), alterPrimaryKey(alter, t.PrimaryKey, old.PrimaryKey))
+	}
+
+	for _, idx := range newUniques {
+		m.add(idx.MySQLCreate(t.Name, true), idx.MySQLDrop(t.Name))
+	}
+	for _, idx := range newIndexes {
+		m.add(idx.MySQLCreate(t.Name, false), idx.MySQLDrop(t.Name))
+	}
+
+	if old.Comment != t.Comment {
+		m.add(alter+"COMMENT "+mysqlQuote(t.Comment)+";", alter+"COMMENT "+mysqlQuote(old.Comment)+";")
+	}
+}
+
+func alterPrimaryKey(alter string, from, to []string) string {
+	actions := []string{}
+	if len(from) > 0 {
+		actions = append(actions, "DROP PRIMARY KEY")
+	}
+	if len(to) > 0 {
+		actions = append(actions, fmt.Sprintf("ADD PRIMARY KEY(%s)", mysqlColumns(to)))
+	}
+	return alter + strings.Join(actions, ", ") + ";"
+}
+
+// indexes are compared by columns, names are ignored
+func diffIndexes(olds, news []*TableIndex) (dropped, added []*TableIndex) {
+	for _, o := range olds {
+		if !containsIndex(news, o) {
+			dropped = append(dropped, o)
+		}
+	}
+	for _, n := range news {
+		if !containsIndex(olds, n) {
+			added = append(added, n)
+		}
+	}
+	return
+}
+
+func containsIndex(indexes []*TableIndex, idx *TableIndex) bool {
+	for _, i := range indexes {
+		if sameColumnList(i.Columns, idx.Columns) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameColumnList(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if strings.ToLower(a[i]) != strings.ToLower(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameColumn(a, b *Column) bool {
+	return sameColumnType(a, b) &&
+		a.Nullable == b.Nullable &&
+		a.AutoIncrement == b.AutoIncrement &&
+		a.Comment == b.Comment &&
+		normalizeDefault(a.Default) == normalizeDefault(b.Default)
+}
+
+// integer display width is ignored except tinyint(1) for bool
+func sameColumnType(a, b *Column) bool {
+	if a.Type != b.Type || a.Unsigned != b.Unsigned {
+		return false
+	}
+	switch a.Type {
+	case "tinyint":
+		return (a.Size() == 1) == (b.Size() == 1)
+	case "smallint", "mediumint", "int", "integer", "bigint":
+		return true
+	}
+	return strings.ToLower(strings.Join(a.Args, ",")) == strings.ToLower(strings.Join(b.Args, ","))
+}
+
+func normalizeDefault(value *string) string {
+	if value == nil {
+		return "NULL"
+	}
+	s := *value
+	if strings.ToUpper(strings.TrimSuffix(s, "()")) == "CURRENT_TIMESTAMP" {
+		return "CURRENT_TIMESTAMP"
+	}
+	return s
+}
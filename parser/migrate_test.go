@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const testOldDDL = "CREATE TABLE `orders` (" +
+	"`id` INT(11) NOT NULL AUTO_INCREMENT," +
+	"`user_id` INT(11) NOT NULL DEFAULT '0'," +
+	"`memo` VARCHAR(64) NOT NULL DEFAULT ''," +
+	"`amount` FLOAT NULL," +
+	"`status` INT(11) NOT NULL DEFAULT '0'," +
+	"PRIMARY KEY(`id`)," +
+	"KEY `idx_status` (`status`)" +
+	") COMMENT 'orders';" +
+	"CREATE TABLE `logs` (`id` INT NOT NULL, PRIMARY KEY(`id`));"
+
+const testNewDDL = "CREATE TABLE `orders` (" +
+	"`id` INT NOT NULL AUTO_INCREMENT," +
+	"`user_id` INT NOT NULL DEFAULT 0," +
+	"`remark` VARCHAR(64) NOT NULL DEFAULT ''," +
+	"`amount` FLOAT NOT NULL DEFAULT '0'," +
+	"`status` INT NOT NULL DEFAULT '1'," +
+	"`created_at` BIGINT(20) NOT NULL DEFAULT '0'," +
+	"PRIMARY KEY(`id`)," +
+	"UNIQUE KEY `uniq_user` (`user_id`, `created_at`)" +
+	") COMMENT 'orders';" +
+	"CREATE TABLE `events` (`id` INT NOT NULL, PRIMARY KEY(`id`));"
+
+func TestDiffTables(t *testing.T) {
+	olds, err := ParseMySQLDDL(testOldDDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	news, err := ParseMySQLDDL(testNewDDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := DiffTables("mysql", olds, news)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ups := []string{
+		"DROP INDEX `idx_status` ON `orders`;",
+		"ALTER TABLE `orders` ADD COLUMN `remark` VARCHAR(64) NOT NULL DEFAULT '' AFTER `user_id`;",
+		"ALTER TABLE `orders` MODIFY COLUMN `amount` FLOAT NOT NULL DEFAULT '0';",
+		"ALTER TABLE `orders` MODIFY COLUMN `status` INT NOT NULL DEFAULT '1';",
+		"ALTER TABLE `orders` ADD COLUMN `created_at` BIGINT(20) NOT NULL DEFAULT '0' AFTER `status`;",
+		"ALTER TABLE `orders` DROP COLUMN `memo`;",
+		"CREATE UNIQUE INDEX `uniq_user` ON `orders`(`user_id`,`created_at`);",
+		"DROP TABLE `logs`;",
+	}
+	if len(m.Up) != len(ups)+1 {
+		t.Fatalf("up statements mismatch:\n%s", m.Script(true))
+	}
+	for i, up := range ups {
+		if m.Up[i] != up {
+			t.Errorf("up statement %d expect %s, got %s", i, up, m.Up[i])
+		}
+	}
+	if !strings.HasPrefix(m.Up[len(ups)], "CREATE TABLE `events`") {
+		t.Errorf("table events should be created, got %s", m.Up[len(ups)])
+	}
+	if len(m.Down) != len(m.Up) || m.Down[0] != "DROP TABLE `events`;" ||
+		m.Down[len(m.Down)-1] != "CREATE INDEX `idx_status` ON `orders`(`status`);" {
+		t.Errorf("down statements mismatch:\n%s", m.Script(false))
+	}
+
+	reviews := strings.Join(m.Reviews, "\n")
+	if !strings.Contains(reviews, "`orders`.`memo` dropped and `remark` added") {
+		t.Errorf("column rename should be reviewed:\n%s", reviews)
+	}
+	if !strings.Contains(reviews, "table `logs` dropped and `events` added") {
+		t.Errorf("table rename should be reviewed:\n%s", reviews)
+	}
+}
+
+func TestDiffTablesSnapshot(t *testing.T) {
+	var models map[string]map[string]interface{}
+	data := `
+User:
+  dbs: [mysql]
+  dbtable: users
+  fields:
+    - Id: int32
+      flags: [primary, autoinc]
+    - Name: string
+      flags: [index]
+      size: 32
+    - Age: int32
+      flags: [range]
+    - Mailbox: string
+    - Description: string
+      flags: [nullable]
+    - CreatedAt: timestamp
+  uniques: [[Mailbox, Name]]
+`
+	if err := yaml.Unmarshal([]byte(data), &models); err != nil {
+		t.Fatal(err)
+	}
+	obj := NewMetaObject("model")
+	if err := obj.Read("User", models["User"]); err != nil {
+		t.Fatal(err)
+	}
+	table, err := obj.SQLTable("mysql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := ParseMySQLDDL(strings.Join(table.MySQLCreate(), "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := DiffTables("mysql", snapshot, []*Table{table})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.IsEmpty() {
+		t.Errorf("snapshot should be the same as the object:\n%s", m.Script(true))
+	}
+}
@@ -34,6 +34,10 @@ type MetaObject struct {
 	Relation *Relation
 	//! importSQL
 	ImportSQL string
+	//! softdelete
+	softDelete string
+	//! validate before the writes
+	AutoValidate bool
 	//! elastic
 	ElasticIndexAll bool
 }
@@ -116,6 +120,121 @@ func (o *MetaObject) NoneIncrementFields() []*Field {
 	return fields
 }
 
+// SoftDeleteField returns the nullable time field marking the soft deleted
+// rows, nil when the rows of the object are deleted for good.
+func (o *MetaObject) SoftDeleteField() *Field {
+	if o.softDelete == "" {
+		return nil
+	}
+	return o.FieldByName(o.softDelete)
+}
+
+// EnumFields returns the fields of the enum types of the object.
+func (o *MetaObject) EnumFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields() {
+		if f.IsEnum() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// JSONFields returns the fields of the json type of the object.
+func (o *MetaObject) JSONFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields() {
+		if f.IsJSON() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Imports returns the packages of the gotypes of the fields, but the ones
+// the generated code always imports.
+func (o *MetaObject) Imports() []string {
+	seen := map[string]bool{
+		"context": true, "fmt": true, "time": true, "strings": true,
+		"database/sql": true, "encoding/json": true,
+		"github.com/ezbuy/redis-orm/orm": true,
+	}
+	var imports []string
+	for _, f := range o.Fields() {
+		if pkg := f.GoImport(); pkg != "" && !seen[pkg] {
+			seen[pkg] = true
+			imports = append(imports, pkg)
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+// DefaultFields returns the fields with a `default:` value.
+func (o *MetaObject) DefaultFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields() {
+		if f.HasDefault() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// AutoTimeFields returns the fields flagged autocreatetime or autoupdatetime.
+func (o *MetaObject) AutoTimeFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields() {
+		if f.IsAutoCreateTime() || f.IsAutoUpdateTime() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// AutoCreateTimeFields returns the fields flagged autocreatetime.
+func (o *MetaObject) AutoCreateTimeFields() []*Field {
+	var fields []*Field
+	for _, f := range o.Fields() {
+		if f.IsAutoCreateTime() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// VersionField returns the field flagged version for optimistic locking, nil
+// when the object has none.
+func (o *MetaObject) VersionField() *Field {
+	for _, f := range o.Fields() {
+		if f.IsVersion() {
+			return f
+		}
+	}
+	return nil
+}
+
+// PreviousFields returns the fields a redis save reads back from the stored
+// hash to replace its stale index entries: the fields of the uniques, indexes
+// and ranges, the version and the soft delete field, in the yaml order.
+func (o *MetaObject) PreviousFields() []*Field {
+	names := map[string]bool{o.softDelete: true}
+	for _, indexes := range [][]*Index{o.uniques, o.indexes, o.ranges} {
+		for _, index := range indexes {
+			for _, f := range index.Fields {
+				names[f.Name] = true
+			}
+		}
+	}
+	var fields []*Field
+	for _, f := range o.Fields() {
+		if names[f.Name] || f.IsVersion() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 func (o *MetaObject) Uniques() []*Index {
 	sort.Sort(IndexArray(o.uniques))
 	return o.uniques
@@ -158,6 +277,10 @@ func (o *MetaObject) Read(name string, data map[string]interface{}) error {
 		delete(data, "db")
 		delete(data, "dbs")
 	}
+	//! the fields take es_index_all as their default, read it before them
+	if val, ok := data["es_index_all"]; ok {
+		o.ElasticIndexAll = val.(bool)
+	}
 
 	for key, val := range data {
 		switch key {
@@ -175,6 +298,10 @@ func (o *MetaObject) Read(name string, data map[string]interface{}) error {
 
 		case "importSQL":
 			o.ImportSQL = val.(string)
+		case "softdelete":
+			o.softDelete = val.(string)
+		case "validate":
+			o.AutoValidate = val.(bool)
 		case "fields":
 			fieldData := val.([]interface{})
 			o.fields = make([]*Field, len(fieldData))
@@ -225,9 +352,6 @@ func (o *MetaObject) Read(name string, data map[string]interface{}) error {
 				return fmt.Errorf("object (%s) %s", o.Name, err.Error())
 			}
 			o.Relation = relation
-
-		case "es_index_all":
-			o.ElasticIndexAll = val.(bool)
 		}
 	}
 
@@ -242,11 +366,14 @@ func (o *MetaObject) Read(name string, data map[string]interface{}) error {
 		if field.HasIndex() && field.IsNullable() {
 			return fmt.Errorf("object (%s) field (%s) should not be nullable for indexing", o.Name, field.Name)
 		}
+		if field.IsVersion() && field != o.VersionField() {
+			return fmt.Errorf("object (%s) field (%s) is a second version field", o.Name, field.Name)
+		}
 	}
 
 	if o.Relation == nil {
 		if o.primary == nil {
-			if o.DbContains("mysql") || o.DbContains("mssql") {
+			if o.DbContains("mysql") || o.DbContains("mssql") || o.DbContains("postgres") || o.DbContains("sqlite") {
 				return fmt.Errorf("object (%s) needs a primary key declare.", o.Name)
 			}
 		} else {
@@ -262,6 +389,16 @@ func (o *MetaObject) Read(name string, data map[string]interface{}) error {
 		}
 	}
 
+	if o.softDelete != "" {
+		f := o.SoftDeleteField()
+		if f == nil {
+			return fmt.Errorf("object (%s) softdelete field (%s) not exist", o.Name, o.softDelete)
+		}
+		if !f.IsNullable() || !f.IsTime() || o.DbTable == "" {
+			return fmt.Errorf("object (%s) softdelete field (%s) should be a nullable time of a table", o.Name, f.Name)
+		}
+	}
+
 	for _, unique := range o.uniques {
 		if err := unique.buildUnique(); err != nil {
 			return fmt.Errorf("object (%s) %s", o.Name, err.Error())
@@ -27,6 +27,10 @@ func (pk *PrimaryKey) GetFuncParam() string {
 	return Fields(pk.Fields).GetFuncParam()
 }
 
+func (pk *PrimaryKey) GetFuncArgs() string {
+	return Fields(pk.Fields).GetFuncArgs()
+}
+
 func (pk *PrimaryKey) FirstField() *Field {
 	if len(pk.Fields) > 0 {
 		return pk.Fields[0]
@@ -73,6 +77,28 @@ func (pk *PrimaryKey) SQLColumn(driver string) string {
 			columns = append(columns, f.SQLName(driver))
 		}
 		return fmt.Sprintf("PRIMARY KEY(%s)", strings.Join(columns, ","))
+	case "mssql":
+		columns := make([]string, 0, len(pk.Fields))
+		for _, f := range pk.Fields {
+			columns = append(columns, f.SQLName(driver))
+		}
+		return fmt.Sprintf("CONSTRAINT [PK_%s] PRIMARY KEY (%s)", pk.Obj.DbTable, strings.Join(columns, ","))
+	case "postgres":
+		columns := make([]string, 0, len(pk.Fields))
+		for _, f := range pk.Fields {
+			columns = append(columns, f.SQLName(driver))
+		}
+		return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(columns, ","))
+	case "sqlite":
+		//! declared within the autoincrement column
+		if pk.IsAutocrement() {
+			return ""
+		}
+		columns := make([]string, 0, len(pk.Fields))
+		for _, f := range pk.Fields {
+			columns = append(columns, f.SQLName(driver))
+		}
+		return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(columns, ","))
 	}
 	return ""
 }
@@ -0,0 +1,373 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Table is a database table definition, read from a live database or
+// from a DDL script, used to reverse engineer yaml model files.
+type Table struct {
+	Name       string
+	Comment    string
+	Columns    []*Column
+	PrimaryKey []string
+	Uniques    []*TableIndex
+	Indexes    []*TableIndex
+}
+
+type Column struct {
+	Name          string
+	Type          string
+	Args          []string
+	Unsigned      bool
+	Nullable      bool
+	AutoIncrement bool
+	Default       *string
+	Comment       string
+}
+
+type TableIndex struct {
+	Name    string
+	Columns []string
+}
+
+func NewTable(name string) *Table {
+	return &Table{
+		Name:    name,
+		Columns: []*Column{},
+		Uniques: []*TableIndex{},
+		Indexes: []*TableIndex{},
+	}
+}
+
+func (t *Table) ColumnByName(name string) *Column {
+	for _, c := range t.Columns {
+		if strings.ToLower(c.Name) == strings.ToLower(name) {
+			return c
+		}
+	}
+	return nil
+}
+
+func (t *Table) ModelName() string {
+	return Name2Camel(t.Name)
+}
+
+func (t *Table) FileName() string {
+	return strings.ToLower(strings.Replace(t.Name, "_", ".", -1)) + ".yaml"
+}
+
+func (t *Table) isPrimary(column string) bool {
+	for _, name := range t.PrimaryKey {
+		if strings.ToLower(name) == strings.ToLower(column) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) singleIndexes(indexes []*TableIndex) map[string]bool {
+	singles := map[string]bool{}
+	for _, idx := range indexes {
+		if len(idx.Columns) == 1 {
+			singles[strings.ToLower(idx.Columns[0])] = true
+		}
+	}
+	return singles
+}
+
+func (t *Table) fieldNames(columns []string) []string {
+	names := make([]string, 0, len(columns))
+	for _, name := range columns {
+		if c := t.ColumnByName(name); c != nil {
+			names = append(names, c.FieldName())
+		}
+	}
+	return names
+}
+
+// Yaml renders the table as a yaml model which MetaObject.Read accepts.
+func (t *Table) Yaml(driver, dbname string) []byte {
+	buf := bytes.NewBuffer(nil)
+	uniques := t.singleIndexes(t.Uniques)
+	indexes := t.singleIndexes(t.Indexes)
+	skipped := []string{}
+
+	fmt.Fprintf(buf, "%s:\n", t.ModelName())
+	fmt.Fprintf(buf, "  dbs: [%s]\n", strings.ToLower(driver))
+	if dbname != "" {
+		fmt.Fprintf(buf, "  dbname: %s\n", yamlString(dbname))
+	}
+	fmt.Fprintf(buf, "  dbtable: %s\n", yamlString(t.Name))
+	if t.Comment != "" {
+		fmt.Fprintf(buf, "  comment: %s\n", yamlString(t.Comment))
+	}
+
+	buf.WriteString("  fields:\n")
+	for _, c := range t.Columns {
+		typ, size := c.FieldType()
+		//! the decimal fields can be neither nullable nor primary
+		if typ == "decimal" && (c.Nullable || t.isPrimary(c.Name)) {
+			typ = "float64"
+		}
+		fmt.Fprintf(buf, "    - %s: %s\n", c.FieldName(), typ)
+
+		flags := []string{}
+		name := strings.ToLower(c.Name)
+		if len(t.PrimaryKey) == 1 && t.isPrimary(c.Name) {
+			flags = append(flags, "primary")
+		}
+		if c.AutoIncrement {
+			flags = append(flags, "autoinc")
+		}
+		nullable := c.Nullable && !t.isPrimary(c.Name)
+		if nullable {
+			flags = append(flags, "nullable")
+		}
+		//! indexed fields can not be nullable, keep the column data safe
+		if uniques[name] || indexes[name] {
+			if nullable {
+				skipped = append(skipped, c.Name)
+			} else if uniques[name] {
+				flags = append(flags, "unique")
+			} else {
+				flags = append(flags, "index")
+			}
+		}
+		if len(flags) > 0 {
+			fmt.Fprintf(buf, "      flags: [%s]\n", strings.Join(flags, ", "))
+		}
+		if size > 0 {
+			fmt.Fprintf(buf, "      size: %d\n", size)
+		}
+		if typ == "decimal" {
+			if len(c.Args) > 0 {
+				fmt.Fprintf(buf, "      precision: %s\n", c.Args[0])
+			}
+			if len(c.Args) > 1 {
+				fmt.Fprintf(buf, "      scale: %s\n", c.Args[1])
+			}
+		} else if c.NeedSQLType() {
+			fmt.Fprintf(buf, "      sqltype: %s\n", yamlString(c.SQLType()))
+		}
+		if def := c.FieldDefault(typ); def != "" && !t.isPrimary(c.Name) {
+			fmt.Fprintf(buf, "      default: %s\n", yamlString(def))
+		}
+		if Camel2Name(c.FieldName()) != c.Name {
+			fmt.Fprintf(buf, "      sqlcolumn: %s\n", yamlString(c.Name))
+		}
+		if c.Comment != "" {
+			fmt.Fprintf(buf, "      comment: %s\n", yamlString(c.Comment))
+		}
+	}
+
+	if len(t.PrimaryKey) > 1 {
+		fmt.Fprintf(buf, "  primary: [%s]\n", strings.Join(t.fieldNames(t.PrimaryKey), ", "))
+	}
+	writeIndexes := func(key string, indexes []*TableIndex) {
+		groups := []string{}
+		for _, idx := range indexes {
+			if len(idx.Columns) > 1 {
+				groups = append(groups, "["+strings.Join(t.fieldNames(idx.Columns), ", ")+"]")
+			}
+		}
+		if len(groups) > 0 {
+			fmt.Fprintf(buf, "  %s: [%s]\n", key, strings.Join(groups, ", "))
+		}
+	}
+	writeIndexes("uniques", t.Uniques)
+	writeIndexes("indexes", t.Indexes)
+
+	for _, name := range skipped {
+		fmt.Fprintf(buf, "  # index on nullable column `%s` skipped\n", name)
+	}
+	return buf.Bytes()
+}
+
+func (c *Column) FieldName() string {
+	return Name2Camel(c.Name)
+}
+
+func (c *Column) Size() int {
+	if len(c.Args) == 0 {
+		return 0
+	}
+	var size int
+	fmt.Sscanf(c.Args[0], "%d", &size)
+	return size
+}
+
+// FieldType maps the column type to a yaml field type and size
+func (c *Column) FieldType() (string, int) {
+	prefix := ""
+	if c.Unsigned {
+		prefix = "u"
+	}
+	switch c.Type {
+	case "bit", "bool", "boolean":
+		return "bool", 0
+	case "tinyint":
+		if c.Size() == 1 {
+			return "bool", 0
+		}
+		return prefix + "int8", 0
+	case "smallint":
+		return prefix + "int16", 0
+	case "mediumint", "int", "integer":
+		return prefix + "int32", 0
+	case "bigint":
+		return prefix + "int64", 0
+	case "float":
+		return "float32", 0
+	case "double", "real":
+		return "float64", 0
+	case "decimal", "numeric":
+		return "decimal", 0
+	case "datetime":
+		return "datetime", 0
+	case "timestamp":
+		return "timestamp", 0
+	case "char", "varchar":
+		return "string", c.Size()
+	}
+	return "string", 0
+}
+
+// FieldDefault is the `default:` of the yaml field of the type for the
+// column, empty when the column has no default but the zero one which the
+// generated columns get anyway, or one the field type can't hold.
+func (c *Column) FieldDefault(typ string) string {
+	if c.Default == nil || c.AutoIncrement {
+		return ""
+	}
+	value := *c.Default
+	switch {
+	case typ == "bool":
+		if b, err := strconv.ParseBool(value); err == nil && b {
+			return "true"
+		}
+	case typ == "string":
+		return value
+	case typ == "decimal":
+		if decimalPattern.MatchString(value) && strings.Trim(value, "+-0.") != "" {
+			return value
+		}
+	case strings.HasPrefix(typ, "int"):
+		if n, err := strconv.ParseInt(value, 10, typeBits(typ)); err == nil && n != 0 {
+			return strconv.FormatInt(n, 10)
+		}
+	case strings.HasPrefix(typ, "uint"):
+		if n, err := strconv.ParseUint(value, 10, typeBits(typ)); err == nil && n != 0 {
+			return strconv.FormatUint(n, 10)
+		}
+	case strings.HasPrefix(typ, "float"):
+		if n, err := strconv.ParseFloat(value, typeBits(typ)); err == nil && n != 0 && !math.IsInf(n, 0) && !math.IsNaN(n) {
+			return strconv.FormatFloat(n, 'g', -1, typeBits(typ))
+		}
+	}
+	return ""
+}
+
+// NeedSQLType reports whether the sql type generated from the field type
+// differs from the column type, so that the origin type should be kept.
+func (c *Column) NeedSQLType() bool {
+	switch c.Type {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint",
+		"float", "varchar", "datetime", "timestamp":
+		return false
+	}
+	return true
+}
+
+func (c *Column) SQLType() string {
+	st := strings.ToUpper(c.Type)
+	if len(c.Args) > 0 {
+		st = fmt.Sprintf("%s(%s)", st, strings.Join(c.Args, ","))
+	}
+	if c.Unsigned {
+		st = st + " UNSIGNED"
+	}
+	return st
+}
+
+func yamlString(s string) string {
+	out, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("%q", s)
+	}
+	return strings.TrimRight(string(out), "\n")
+}
+
+// SQLTable is the table definition of the object, which is the same as
+// the script template generates, for the mysql driver only.
+func (o *MetaObject) SQLTable(driver string) (*Table, error) {
+	if o.DbTable == "" {
+		return nil, fmt.Errorf("object (%s) is not a table", o.Name)
+	}
+	table := NewTable(o.DbTable)
+	table.Comment = o.Comment()
+
+	switch strings.ToLower(driver) {
+	case "mysql":
+		for _, f := range o.Fields() {
+			column := &Column{
+				Name:          f.ColumnName(),
+				Nullable:      f.IsNullable(),
+				AutoIncrement: f.IsAutoIncrement(),
+				Comment:       f.Comment,
+			}
+			if err := parseMySQLColumnType(column, f.SQLType(driver)); err != nil {
+				return nil, fmt.Errorf("object (%s) %s", o.Name, err.Error())
+			}
+			if def := strings.TrimPrefix(f.SQLDefault(driver), "DEFAULT "); def != "" && !f.IsAutoIncrement() {
+				tokens, err := tokenizeDDL(def)
+				if err != nil {
+					return nil, fmt.Errorf("object (%s) %s", o.Name, err.Error())
+				}
+				value := tokens[0].value()
+				column.Default = &value
+			}
+			table.Columns = append(table.Columns, column)
+		}
+	default:
+		return nil, fmt.Errorf("unsupport db driver: %s", driver)
+	}
+
+	if o.PrimaryKey() != nil {
+		for _, f := range o.PrimaryKey().Fields {
+			table.PrimaryKey = append(table.PrimaryKey, f.ColumnName())
+		}
+	}
+	indexColumns := func(idx *Index) []string {
+		columns := make([]string, len(idx.Fields))
+		for i, f := range idx.Fields {
+			columns[i] = f.ColumnName()
+		}
+		return columns
+	}
+	for _, idx := range o.Uniques() {
+		if !idx.HasPrimaryKey() {
+			table.Uniques = append(table.Uniques, &TableIndex{
+				Name:    "uniq_" + Camel2Name(idx.Name),
+				Columns: indexColumns(idx),
+			})
+		}
+	}
+	for _, indexes := range [][]*Index{o.Indexes(), o.Ranges()} {
+		for _, idx := range indexes {
+			if !idx.HasPrimaryKey() {
+				table.Indexes = append(table.Indexes, &TableIndex{
+					Name:    Camel2Name(idx.Name),
+					Columns: indexColumns(idx),
+				})
+			}
+		}
+	}
+	return table, nil
+}
@@ -0,0 +1,622 @@
+package parser
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// mysql DDL tokens
+type ddlToken struct {
+	text   string
+	quoted bool
+}
+
+func (t ddlToken) is(keywords ...string) bool {
+	if t.quoted {
+		return false
+	}
+	for _, k := range keywords {
+		if strings.EqualFold(t.text, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// string literal value without the quotes
+func (t ddlToken) value() string {
+	s := t.text
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') {
+		q := s[:1]
+		s = s[1 : len(s)-1]
+		s = strings.Replace(s, q+q, q, -1)
+		s = strings.Replace(s, "\\"+q, q, -1)
+	}
+	return s
+}
+
+func tokenizeDDL(data string) ([]ddlToken, error) {
+	tokens := []ddlToken{}
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '#' || (c == '-' && strings.HasPrefix(data[i:], "--")):
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+		case c == '/' && strings.HasPrefix(data[i:], "/*"):
+			end := strings.Index(data[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i = i + 2 + end + 2
+		case c == '`':
+			end := strings.IndexByte(data[i+1:], '`')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated identifier")
+			}
+			tokens = append(tokens, ddlToken{data[i+1 : i+1+end], true})
+			i = i + 1 + end + 1
+		case c == '\'' || c == '"':
+			j := i + 1
+			for ; j < len(data); j++ {
+				if data[j] == '\\' {
+					j++
+					continue
+				}
+				if data[j] == c {
+					if j+1 < len(data) && data[j+1] == c {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(data) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, ddlToken{data[i : j+1], false})
+			i = j + 1
+		case strings.IndexByte("(),;=", c) >= 0:
+			tokens = append(tokens, ddlToken{string(c), false})
+			i++
+		default:
+			j := i
+			for j < len(data) && strings.IndexByte(" \t\r\n(),;=`'\"", data[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, ddlToken{data[i:j], false})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type ddlParser struct {
+	tokens []ddlToken
+	pos    int
+}
+
+func (p *ddlParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *ddlParser) peek() ddlToken {
+	if p.eof() {
+		return ddlToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *ddlParser) next() ddlToken {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *ddlParser) accept(keywords ...string) bool {
+	if p.peek().is(keywords...) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *ddlParser) expect(keyword string) error {
+	if !p.accept(keyword) {
+		return fmt.Errorf("expect `%s` but got `%s`", keyword, p.peek().text)
+	}
+	return nil
+}
+
+// skip to the end of current statement
+func (p *ddlParser) skipStatement() {
+	for !p.eof() && !p.next().is(";") {
+	}
+}
+
+// skip a balanced group, or a single token
+func (p *ddlParser) skipGroup() {
+	if !p.accept("(") {
+		p.next()
+		return
+	}
+	for depth := 1; depth > 0 && !p.eof(); {
+		t := p.next()
+		if t.is("(") {
+			depth++
+		} else if t.is(")") {
+			depth--
+		}
+	}
+}
+
+// name or db.name, returns the last part
+func (p *ddlParser) name() string {
+	t := p.next()
+	name := t.text
+	if i := strings.LastIndex(name, "."); !t.quoted && i >= 0 && i < len(name)-1 {
+		name = name[i+1:]
+	}
+	for strings.HasPrefix(p.peek().text, ".") && !p.peek().quoted {
+		t := p.next()
+		if t.text == "." {
+			name = p.next().text
+		} else {
+			name = t.text[1:]
+		}
+	}
+	return name
+}
+
+// (a, b(10), c DESC)
+func (p *ddlParser) indexColumns() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	columns := []string{}
+	for !p.eof() {
+		columns = append(columns, p.name())
+		for !p.eof() && !p.peek().is(",", ")") {
+			p.skipGroup()
+		}
+		if p.accept(")") {
+			return columns, nil
+		}
+		p.accept(",")
+	}
+	return nil, fmt.Errorf("unexpected end of index columns")
+}
+
+// (arg, arg, ...) of the column type
+func (p *ddlParser) typeArgs() []string {
+	args := []string{}
+	if !p.accept("(") {
+		return args
+	}
+	for !p.eof() && !p.accept(")") {
+		t := p.next()
+		if !t.is(",") {
+			args = append(args, t.text)
+		}
+	}
+	return args
+}
+
+func (p *ddlParser) column(table *Table) error {
+	column := &Column{Name: p.name(), Nullable: true}
+	column.Type = strings.ToLower(p.next().text)
+	column.Args = p.typeArgs()
+
+	for !p.eof() && !p.peek().is(",", ")") {
+		switch {
+		case p.accept("unsigned"):
+			column.Unsigned = true
+		case p.accept("not"):
+			if err := p.expect("null"); err != nil {
+				return err
+			}
+			column.Nullable = false
+		case p.accept("null"):
+			column.Nullable = true
+		case p.accept("auto_increment"):
+			column.AutoIncrement = true
+		case p.accept("default"):
+			t := p.next()
+			if t.is("(") {
+				p.pos--
+				p.skipGroup()
+				continue
+			}
+			if !t.is("null") {
+				value := t.value()
+				if p.peek().is("(") {
+					p.skipGroup()
+					value += "()"
+				}
+				column.Default = &value
+			}
+		case p.accept("comment"):
+			column.Comment = p.next().value()
+		case p.accept("primary"):
+			p.accept("key")
+			table.PrimaryKey = []string{column.Name}
+		case p.accept("unique"):
+			p.accept("key")
+			table.Uniques = append(table.Uniques, &TableIndex{Columns: []string{column.Name}})
+		case p.accept("key"):
+			table.PrimaryKey = []string{column.Name}
+		case p.accept("on"):
+			//! ON UPDATE CURRENT_TIMESTAMP
+			p.accept("update")
+			p.next()
+			if p.peek().is("(") {
+				p.skipGroup()
+			}
+		case p.accept("character", "charset", "collate"):
+			p.accept("set")
+			p.accept("=")
+			p.next()
+		default:
+			p.skipGroup()
+		}
+	}
+	table.Columns = append(table.Columns, column)
+	return nil
+}
+
+// index name is optional before the column list
+func (p *ddlParser) indexName() string {
+	if p.peek().is("(") {
+		return ""
+	}
+	return p.name()
+}
+
+func (p *ddlParser) definition(table *Table) error {
+	t := p.peek()
+	if p.accept("constraint") {
+		if !p.peek().is("primary", "unique", "foreign", "check") {
+			p.next()
+		}
+		t = p.peek()
+	}
+	switch {
+	case p.accept("primary"):
+		if err := p.expect("key"); err != nil {
+			return err
+		}
+		columns, err := p.indexColumns()
+		if err != nil {
+			return err
+		}
+		table.PrimaryKey = columns
+	case p.accept("unique"):
+		p.accept("key", "index")
+		name := p.indexName()
+		columns, err := p.indexColumns()
+		if err != nil {
+			return err
+		}
+		table.Uniques = append(table.Uniques, &TableIndex{Name: name, Columns: columns})
+	case p.accept("key", "index"):
+		name := p.indexName()
+		columns, err := p.indexColumns()
+		if err != nil {
+			return err
+		}
+		table.Indexes = append(table.Indexes, &TableIndex{Name: name, Columns: columns})
+	case t.is("fulltext", "spatial", "foreign", "check"):
+		//! not supported by models
+	default:
+		return p.column(table)
+	}
+	//! skip the rest options of the definition
+	for !p.eof() && !p.peek().is(",", ")") {
+		p.skipGroup()
+	}
+	return nil
+}
+
+func (p *ddlParser) createTable() (*Table, error) {
+	if p.accept("if") {
+		if err := p.expect("not"); err != nil {
+			return nil, err
+		}
+		if err := p.expect("exists"); err != nil {
+			return nil, err
+		}
+	}
+	table := NewTable(p.name())
+	if p.accept("like") {
+		return nil, fmt.Errorf("table (%s) CREATE TABLE ... LIKE not support", table.Name)
+	}
+	if err := p.expect("("); err != nil {
+		return nil, fmt.Errorf("table (%s) %s", table.Name, err.Error())
+	}
+	for !p.eof() {
+		if err := p.definition(table); err != nil {
+			return nil, fmt.Errorf("table (%s) %s", table.Name, err.Error())
+		}
+		if p.accept(")") {
+			break
+		}
+		if err := p.expect(","); err != nil {
+			return nil, fmt.Errorf("table (%s) %s", table.Name, err.Error())
+		}
+	}
+
+	//! table options
+	for !p.eof() && !p.peek().is(";") {
+		if p.accept("comment") {
+			p.accept("=")
+			table.Comment = p.next().value()
+			continue
+		}
+		p.next()
+	}
+	return table, nil
+}
+
+// ParseMySQLDDL reads the tables declared by CREATE TABLE statements,
+// all other statements are ignored.
+func ParseMySQLDDL(data string) ([]*Table, error) {
+	tokens, err := tokenizeDDL(data)
+	if err != nil {
+		return nil, err
+	}
+	p := &ddlParser{tokens: tokens}
+	tables := []*Table{}
+	for !p.eof() {
+		if p.accept("create") {
+			p.accept("temporary")
+			unique := p.accept("unique")
+			switch {
+			case p.accept("table"):
+				table, err := p.createTable()
+				if err != nil {
+					return nil, err
+				}
+				tables = append(tables, table)
+			case p.accept("index"):
+				if err := p.createIndex(tables, unique); err != nil {
+					return nil, err
+				}
+			}
+		}
+		p.skipStatement()
+	}
+	return tables, nil
+}
+
+// CREATE [UNIQUE] INDEX name ON table (columns)
+func (p *ddlParser) createIndex(tables []*Table, unique bool) error {
+	index := &TableIndex{Name: p.name()}
+	if err := p.expect("on"); err != nil {
+		return fmt.Errorf("index (%s) %s", index.Name, err.Error())
+	}
+	tableName := p.name()
+	columns, err := p.indexColumns()
+	if err != nil {
+		return fmt.Errorf("index (%s) %s", index.Name, err.Error())
+	}
+	index.Columns = columns
+	for _, table := range tables {
+		if table.Name == tableName {
+			if unique {
+				table.Uniques = append(table.Uniques, index)
+			} else {
+				table.Indexes = append(table.Indexes, index)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("index (%s) table (%s) not declared", index.Name, tableName)
+}
+
+// parse column type like `int(10) unsigned`
+func parseMySQLColumnType(column *Column, columnType string) error {
+	tokens, err := tokenizeDDL(columnType)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("column (%s) type empty", column.Name)
+	}
+	p := &ddlParser{tokens: tokens}
+	column.Type = strings.ToLower(p.next().text)
+	column.Args = p.typeArgs()
+	for !p.eof() {
+		if p.accept("unsigned") {
+			column.Unsigned = true
+			continue
+		}
+		p.next()
+	}
+	return nil
+}
+
+// ReadMySQLTables reads the base tables of the database from information_schema.
+func ReadMySQLTables(db *sql.DB, database string) ([]*Table, error) {
+	tables := []*Table{}
+	tableMap := map[string]*Table{}
+
+	rows, err := db.Query("SELECT `TABLE_NAME`, `TABLE_COMMENT` FROM `information_schema`.`TABLES` WHERE `TABLE_SCHEMA` = ? AND `TABLE_TYPE` = 'BASE TABLE' ORDER BY `TABLE_NAME`", database)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var name, comment string
+		if err := rows.Scan(&name, &comment); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		table := NewTable(name)
+		table.Comment = comment
+		tables = append(tables, table)
+		tableMap[name] = table
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.Query("SELECT `TABLE_NAME`, `COLUMN_NAME`, `COLUMN_TYPE`, `IS_NULLABLE`, `COLUMN_DEFAULT`, `EXTRA`, `COLUMN_COMMENT` FROM `information_schema`.`COLUMNS` WHERE `TABLE_SCHEMA` = ? ORDER BY `TABLE_NAME`, `ORDINAL_POSITION`", database)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var tableName, name, columnType, nullable, extra, comment string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&tableName, &name, &columnType, &nullable, &defaultValue, &extra, &comment); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		table, ok := tableMap[tableName]
+		if !ok {
+			continue
+		}
+		column := &Column{
+			Name:          name,
+			Nullable:      nullable == "YES",
+			AutoIncrement: strings.Contains(strings.ToLower(extra), "auto_increment"),
+			Comment:       comment,
+		}
+		if defaultValue.Valid {
+			column.Default = &defaultValue.String
+		}
+		if err := parseMySQLColumnType(column, columnType); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("table (%s) %s", tableName, err.Error())
+		}
+		table.Columns = append(table.Columns, column)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.Query("SELECT `TABLE_NAME`, `INDEX_NAME`, `NON_UNIQUE`, `COLUMN_NAME` FROM `information_schema`.`STATISTICS` WHERE `TABLE_SCHEMA` = ? AND `INDEX_TYPE` NOT IN ('FULLTEXT', 'SPATIAL') ORDER BY `TABLE_NAME`, `INDEX_NAME`, `SEQ_IN_INDEX`", database)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var last *TableIndex
+	for rows.Next() {
+		var tableName, indexName, columnName string
+		var nonUnique int
+		if err := rows.Scan(&tableName, &indexName, &nonUnique, &columnName); err != nil {
+			return nil, err
+		}
+		table, ok := tableMap[tableName]
+		if !ok {
+			continue
+		}
+		if indexName == "PRIMARY" {
+			table.PrimaryKey = append(table.PrimaryKey, columnName)
+			continue
+		}
+		if last == nil || last.Name != tableName+"."+indexName {
+			last = &TableIndex{Name: tableName + "." + indexName}
+			if nonUnique == 0 {
+				table.Uniques = append(table.Uniques, last)
+			} else {
+				table.Indexes = append(table.Indexes, last)
+			}
+		}
+		last.Columns = append(last.Columns, columnName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		for _, idx := range append(table.Uniques, table.Indexes...) {
+			idx.Name = strings.TrimPrefix(idx.Name, table.Name+".")
+		}
+	}
+	return tables, nil
+}
+
+func mysqlQuote(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+func mysqlDefault(value string) string {
+	switch strings.ToUpper(strings.TrimSuffix(value, "()")) {
+	case "CURRENT_TIMESTAMP", "NOW", "LOCALTIME", "LOCALTIMESTAMP", "NULL":
+		return strings.ToUpper(value)
+	}
+	return mysqlQuote(value)
+}
+
+// MySQLDefinition is the column definition used by CREATE/ALTER TABLE.
+func (c *Column) MySQLDefinition() string {
+	columns := make([]string, 0, 6)
+	columns = append(columns, "`"+c.Name+"`", c.SQLType())
+	if c.Nullable {
+		columns = append(columns, "NULL")
+	} else {
+		columns = append(columns, "NOT NULL")
+	}
+	if c.AutoIncrement {
+		columns = append(columns, "AUTO_INCREMENT")
+	} else if c.Default != nil {
+		columns = append(columns, "DEFAULT", mysqlDefault(*c.Default))
+	}
+	if c.Comment != "" {
+		columns = append(columns, "COMMENT", mysqlQuote(c.Comment))
+	}
+	return strings.Join(columns, " ")
+}
+
+func mysqlColumns(columns []string) string {
+	names := make([]string, len(columns))
+	for i, name := range columns {
+		names[i] = "`" + name + "`"
+	}
+	return strings.Join(names, ",")
+}
+
+// MySQLIndexName is the index name, mysql names the anonymous index
+// after its first column.
+func (idx *TableIndex) MySQLIndexName() string {
+	if idx.Name != "" {
+		return idx.Name
+	}
+	return idx.Columns[0]
+}
+
+// MySQLCreate is the CREATE TABLE statement followed by CREATE INDEX statements.
+func (t *Table) MySQLCreate() []string {
+	defs := make([]string, 0, len(t.Columns)+len(t.Uniques)+1)
+	for _, c := range t.Columns {
+		defs = append(defs, c.MySQLDefinition())
+	}
+	if len(t.PrimaryKey) > 0 {
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY(%s)", mysqlColumns(t.PrimaryKey)))
+	}
+	for _, idx := range t.Uniques {
+		defs = append(defs, fmt.Sprintf("UNIQUE KEY `%s` (%s)", idx.MySQLIndexName(), mysqlColumns(idx.Columns)))
+	}
+
+	stmts := []string{fmt.Sprintf("CREATE TABLE `%s` (\n\t%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT %s;",
+		t.Name, strings.Join(defs, ",\n\t"), mysqlQuote(t.Comment))}
+	for _, idx := range t.Indexes {
+		stmts = append(stmts, idx.MySQLCreate(t.Name, false))
+	}
+	return stmts
+}
+
+func (idx *TableIndex) MySQLCreate(table string, unique bool) string {
+	if unique {
+		return fmt.Sprintf("CREATE UNIQUE INDEX `%s` ON `%s`(%s);", idx.MySQLIndexName(), table, mysqlColumns(idx.Columns))
+	}
+	return fmt.Sprintf("CREATE INDEX `%s` ON `%s`(%s);", idx.MySQLIndexName(), table, mysqlColumns(idx.Columns))
+}
+
+func (idx *TableIndex) MySQLDrop(table string) string {
+	return fmt.Sprintf("DROP INDEX `%s` ON `%s`;", idx.MySQLIndexName(), table)
+}
@@ -0,0 +1,147 @@
+package parser
+
+import (
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const testDDL = `
+-- accounts of the shop
+DROP TABLE IF EXISTS ` + "`shop_accounts`" + `;
+CREATE TABLE IF NOT EXISTS ` + "`ezorm`.`shop_accounts`" + ` (
+  ` + "`shop_id`" + `    INT UNSIGNED NOT NULL,
+  ` + "`user_id`" + `    BIGINT(20) NOT NULL DEFAULT '0',
+  ` + "`nick_name`" + `  VARCHAR(64) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT 'it''s nick',
+  ` + "`level`" + `      TINYINT NOT NULL DEFAULT 1,
+  ` + "`enabled`" + `    TINYINT(1) NOT NULL DEFAULT 0,
+  ` + "`balance`" + `    DECIMAL(10,2) DEFAULT NULL,
+  ` + "`price`" + `      DECIMAL(12,3) NOT NULL DEFAULT '1.500',
+  ` + "`remark`" + `     TEXT,
+  ` + "`userID`" + `     INT NOT NULL,
+  ` + "`updated_at`" + ` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (` + "`shop_id`, `user_id`" + `),
+  UNIQUE KEY ` + "`uniq_nick`" + ` (` + "`shop_id`,`nick_name`(32)" + `),
+  KEY ` + "`idx_level`" + ` (` + "`level`" + `),
+  KEY (` + "`balance`" + `),
+  FULLTEXT KEY ` + "`ft_remark`" + ` (` + "`remark`" + `)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='店铺账号';
+CREATE INDEX ` + "`idx_enabled_level`" + ` ON ` + "`shop_accounts`" + `(` + "`enabled`,`level`" + `);
+CREATE VIEW shop_levels AS SELECT ` + "`shop_id`,`level`" + ` FROM shop_accounts;
+`
+
+func TestParseMySQLDDL(t *testing.T) {
+	tables, err := ParseMySQLDDL(testDDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 1 {
+		t.Fatalf("tables expect 1, got %d", len(tables))
+	}
+
+	table := tables[0]
+	if table.Name != "shop_accounts" || table.Comment != "店铺账号" {
+		t.Errorf("table name or comment mismatch: %s %s", table.Name, table.Comment)
+	}
+	if len(table.Columns) != 10 {
+		t.Fatalf("columns expect 10, got %d", len(table.Columns))
+	}
+	if len(table.PrimaryKey) != 2 || len(table.Uniques) != 1 || len(table.Indexes) != 3 {
+		t.Errorf("keys mismatch: %v %v %v", table.PrimaryKey, table.Uniques, table.Indexes)
+	}
+
+	cases := []struct {
+		column   string
+		typ      string
+		size     int
+		nullable bool
+	}{
+		{"shop_id", "uint32", 0, false},
+		{"user_id", "int64", 0, false},
+		{"nick_name", "string", 64, false},
+		{"level", "int8", 0, false},
+		{"enabled", "bool", 0, false},
+		{"balance", "decimal", 0, true},
+		{"price", "decimal", 0, false},
+		{"remark", "string", 0, true},
+		{"updated_at", "timestamp", 0, false},
+	}
+	for _, c := range cases {
+		column := table.ColumnByName(c.column)
+		if column == nil {
+			t.Errorf("column %s not found", c.column)
+			continue
+		}
+		typ, size := column.FieldType()
+		if typ != c.typ || size != c.size || column.Nullable != c.nullable {
+			t.Errorf("column %s expect %s(%d) nullable %v, got %s(%d) nullable %v",
+				c.column, c.typ, c.size, c.nullable, typ, size, column.Nullable)
+		}
+	}
+	if c := table.ColumnByName("nick_name"); c.Comment != "it's nick" {
+		t.Errorf("column comment mismatch: %s", c.Comment)
+	}
+	if c := table.ColumnByName("balance"); c.SQLType() != "DECIMAL(10,2)" {
+		t.Errorf("column sql type mismatch: %s", c.SQLType())
+	}
+}
+
+func TestTableYaml(t *testing.T) {
+	tables, err := ParseMySQLDDL(testDDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var models map[string]map[string]interface{}
+	data := tables[0].Yaml("mysql", "ezorm")
+	if err := yaml.Unmarshal(data, &models); err != nil {
+		t.Fatalf("%s\n%s", err, data)
+	}
+	obj := NewMetaObject("model")
+	if err := obj.Read("ShopAccounts", models["ShopAccounts"]); err != nil {
+		t.Fatalf("%s\n%s", err, data)
+	}
+
+	if obj.DbTable != "shop_accounts" || obj.DbName != "ezorm" {
+		t.Errorf("object table mismatch: %s.%s", obj.DbName, obj.DbTable)
+	}
+	if len(obj.PrimaryKey().Fields) != 2 {
+		t.Errorf("primary key expect 2 fields, got %d", len(obj.PrimaryKey().Fields))
+	}
+	if len(obj.Uniques()) != 1 || len(obj.Indexes()) != 2 {
+		t.Errorf("index mismatch: %d uniques, %d indexes", len(obj.Uniques()), len(obj.Indexes()))
+	}
+	if f := obj.FieldByName("Balance"); f == nil || !f.IsNullable() || f.HasIndex() {
+		t.Errorf("nullable column should not be indexed")
+	}
+	if f := obj.FieldByName("UserID"); f == nil || f.ColumnName() != "userID" {
+		t.Errorf("column name should be kept by sqlcolumn")
+	}
+	if f := obj.FieldByName("Remark"); f == nil || f.SQLType("mysql") != "TEXT" {
+		t.Errorf("column type should be kept by sqltype")
+	}
+	if f := obj.FieldByName("Balance"); f == nil || f.IsDecimal() || f.SQLType("mysql") != "DECIMAL(10,2)" {
+		t.Errorf("nullable decimal column should be a float64 of the decimal sqltype")
+	}
+	if f := obj.FieldByName("Price"); f == nil || !f.IsDecimal() || f.SQLType("mysql") != "DECIMAL(12,3)" {
+		t.Errorf("decimal column should be a decimal of the precision and scale")
+	}
+
+	defaults := map[string]string{
+		"Level":    "1",
+		"Price":    `orm.MustParseDecimal("1.500")`,
+		"NickName": "",
+		"UserId":   "",
+		"Enabled":  "",
+	}
+	for name, value := range defaults {
+		f := obj.FieldByName(name)
+		if value == "" {
+			if f.HasDefault() {
+				t.Errorf("field %s expect the zero default, got %s", name, f.DefaultValue())
+			}
+		} else if !f.HasDefault() || f.DefaultValue() != value {
+			t.Errorf("field %s default expect %s, got %v", name, value, f.HasDefault())
+		}
+	}
+}
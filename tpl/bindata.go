@@ -5,7 +5,9 @@
 // tpl/conf.mssql.gogo
 // tpl/conf.mysql.gogo
 // tpl/conf.orm.gogo
+// tpl/conf.postgres.gogo
 // tpl/conf.redis.gogo
+// tpl/conf.sqlite.gogo
 // tpl/object.db.gogo
 // tpl/object.db.query.gogo
 // tpl/object.db.read.gogo
@@ -41,11 +43,16 @@
 // tpl/relation.set.sync.gogo
 // tpl/relation.zset.gogo
 // tpl/relation.zset.sync.gogo
+// tpl/script.mssql.sql
 // tpl/script.mysql.sql
+// tpl/script.postgres.sql
+// tpl/script.sqlite.sql
 // tpl/util.elastic.gogo
 // tpl/util.mssql.gogo
 // tpl/util.mysql.gogo
+// tpl/util.postgres.gogo
 // tpl/util.redis.gogo
+// tpl/util.sqlite.gogo
 // tpl/view.gogo
 // DO NOT EDIT!
 
@@ -114,7 +121,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _tplConfElasticGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4f\x4f\xdc\x3e\x10\x3d\xdb\x9f\x62\x7e\x39\xa0\x04\xad\x0c\xbf\xf6\xb6\x6a\x4e\x10\x41\xa5\x42\x11\x4b\x4f\x55\x85\x82\x77\x92\x5a\x64\xed\xc8\x71\xb6\xd0\x28\xdf\xbd\xb2\xe3\xc4\x5e\xfe\xa8\x87\x72\x09\xf6\xcc\xbc\x79\xef\xcd\x78\x87\x61\x8b\x95\x90\x08\x09\x57\xb2\x62\xd8\x94\x9d\x11\x3c\x19\xc7\xb6\xe4\x8f\x65\x8d\x30\x0c\xec\x42\xdd\x4c\x87\x71\xa4\x54\xec\x5a\xa5\x0d\xa4\x94\x24\x12\xcd\xc9\x4f\x63\xda\x84\x92\xa4\x7b\x96\xdc\x7e\x8d\xd8\x61\x42\x29\xf1\x40\x90\xd4\xaa\x7d\xac\x99\x90\x27\xaa\x11\x7b\xd4\x78\xe2\x23\x6c\xff\x21\xa1\x19\xa5\x5c\xc9\xce\xc1\x61\x77\xd6\x08\x94\xe6\x1c\xab\xb2\x6f\xcc\x55\xf9\x74\x8b\x46\x68\xec\x00\x72\xf8\xf8\x2a\x7e\x79\x77\x77\x73\x27\x76\xa8\x7a\x03\x39\xfc\x7f\x0a\xc7\x60\x7b\xb3\x0d\x72\x25\xb7\x16\x79\x5f\x6a\x8b\x7b\x8f\xdd\x3d\xaf\x6a\x98\xff\x8a\xcd\x99\x92\x95\xa8\x7d\xc4\x61\x4e\x91\xe3\x62\x33\xb5\x88\x43\xf7\x4a\x72\x04\x2b\x8f\x7d\x95\x1c\x2d\x70\xd5\x4b\x0e\xc5\x24\x63\x83\xa6\x6f\x53\x8b\x3f\xe3\x66\x30\x84\xa6\x39\xf0\xaa\xa6\xe3\x61\xcd\xd4\x24\xcd\x42\xc3\xa5\x24\xb4\x64\xe7\x2a\xb5\x45\xa9\xc3\x23\xbc\x11\x2b\x40\xad\x61\x9d\x83\x07\x67\xd7\xf8\x6b\x86\xa2\x84\x88\xca\xc5\xff\xcb\x41\x8a\xc6\xd5\x90\xb6\x94\x82\xa7\xa8\xb5\x8d\x8f\x94\x92\x58\x72\x0e\xbc\x11\x94\x8c\x19\xa5\x44\xa3\xe9\xb5\x84\x10\xb5\x94\xcd\x73\x8b\x8b\x2a\xe8\x8c\xee\xb9\x23\x5a\xc8\x6d\xab\x84\x34\x1d\x00\x7c\xff\xd1\x19\x2d\x64\x4d\xc9\x34\x2f\x2d\xec\xbc\x84\xb5\xb0\x90\xe5\x43\x83\x17\xbf\x45\x0b\xf0\xa0\x54\x43\x49\x3c\x32\x37\xab\xf3\x5e\x97\x46\x28\x49\xc9\x67\xb9\xc5\xa7\xeb\x72\x87\x00\xe0\x11\x67\xd3\x52\x74\x3e\x79\x6f\x23\xcd\x90\x2e\xfe\x39\x6b\x94\x76\x56\xe1\x4c\x6f\x05\xbb\x85\xd3\x0a\x70\xa1\xb3\x02\xbb\xb4\x33\x91\x75\x0e\xc8\x8a\x50\x83\xec\x2a\xae\x62\x45\x54\x87\x2c\x92\x40\xa9\xb5\x3c\xb4\x80\x4f\x39\x9c\x3a\xdf\xa3\xbb\x1c\xde\x5d\x6b\xea\x26\x22\xaa\x03\x36\x0b\x46\x7c\xf9\x0a\x24\x66\xe1\x50\xe2\xed\x98\xdf\x57\x70\x8a\x92\xf9\x39\xb2\x0d\x9a\x6f\xb7\x5f\xd2\xc5\x23\xc6\x58\xb6\x3a\x8c\x07\xf9\x69\x10\xf2\x32\xc9\xfa\x91\x06\x4b\x5f\x86\x2f\x8d\x69\x7d\xf3\x23\xab\x84\x4d\x87\xc1\x93\x5e\xc7\x9a\x47\x5b\x9c\x4d\x56\xbc\x58\x60\xbf\x96\x52\x34\x4e\xdd\xa4\xd5\x5f\x1e\xcd\xb3\xb7\x89\xd3\x7f\x6b\xfb\x88\xad\x15\x94\x84\x85\x5a\x03\xb2\xe5\xb0\xa2\x64\x5c\x59\xbc\x78\xbf\xa7\xe7\x10\xf6\xfb\x78\x16\x32\xff\x18\x84\xe5\x7c\x7b\x35\x5d\x5a\x06\x2e\x6d\x83\x7a\x2f\x38\xa6\xc2\x1e\x7c\x7e\x06\x0b\x64\x9c\x63\x7b\x89\x0a\xa6\xcc\x3c\x87\x24\x71\xa2\xfd\x39\xa6\x7d\x20\x1c\x3d\xaf\x29\x9c\x66\xfe\xeb\xca\xb2\x77\xa8\xdd\xf4\xe6\xaa\x6c\x5b\x21\xeb\xbf\xf0\x7b\x95\xf8\xaf\x24\x03\xe0\x1b\x4c\x87\x01\xe5\x76\x1c\xe9\x9f\x00\x00\x00\xff\xff\x98\x25\x93\x7f\x85\x06\x00\x00")
+var _tplConfElasticGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x56\x4d\x6f\xe3\x36\x10\x3d\x5b\xbf\x62\x2a\x60\x0d\x29\x50\xe9\xb4\xdd\x93\x51\x1f\xda\x8d\x37\xbb\x68\x93\x0d\xe2\xf4\x54\x14\x81\x2c\x8d\x1c\x36\x12\xa9\x4a\x94\xbb\x59\xc3\xff\xbd\x33\xa4\xbe\xec\x24\x4d\x80\x04\x70\x24\x72\x86\x8f\xef\xcd\x8c\xc8\xd9\xed\x52\xcc\xa4\x42\xf0\x13\xad\x32\x81\x79\x5c\x1b\x99\xf8\xfb\x7d\x19\x27\xf7\xf1\x06\x61\xb7\x13\xe7\xfa\xca\x0d\xf6\x7b\xcf\x93\x45\xa9\x2b\x03\x81\x37\xf1\xb1\xaa\x74\x55\xfb\xf4\x96\x15\x86\x1f\x0a\xcd\xec\xce\x98\x92\xdf\xeb\x07\x95\xf0\xd3\xc8\x02\x7d\x8f\x5e\x36\xd2\xdc\x35\x6b\x91\xe8\x62\x86\xdf\xd6\xcd\xc3\xac\xc2\x54\xd6\xdf\xeb\xaa\x98\xd1\x8f\x5c\xdb\xbd\xc1\xdf\xe8\xf2\x7e\x23\xa4\x9a\xe9\x5c\x6e\xb1\xc2\x59\x6b\x11\xdb\x1f\x7d\x2f\xf4\x3c\x62\x5a\x5b\x06\x58\x7f\xc8\x25\x2a\x73\x86\x59\xdc\xe4\xe6\x22\xfe\x7a\x8d\x46\x56\x58\x03\x2c\xe0\xa7\x47\xf6\x4f\x37\x37\x57\x37\x44\x47\x37\x86\xec\x3f\x9c\xc2\x09\x30\x3b\xb1\x42\x42\x4c\x19\x79\x1b\x57\x8c\x7b\x8b\xf5\x6d\x92\x6d\xa0\xfb\x5b\xae\x3e\x50\x70\xe4\xa6\xb5\x58\x4c\x67\x39\x21\x93\x1d\x8e\x4d\xb7\x5a\x25\x08\x1c\x00\xf1\x85\xde\x18\x38\x6b\x54\x02\x4b\x27\x63\x85\xa6\x29\x03\xc6\xef\x70\x43\xd8\x0d\x9b\x2e\x80\xfe\x7b\xfb\xc3\x35\x6e\x93\x20\x1c\x36\xec\x97\x0c\x5b\x8a\x33\x1d\xf0\xa2\xc0\xe2\x4d\xc8\x12\x01\xe5\x08\xe6\x0b\x68\xc1\xc5\x25\xfe\xdb\x41\x91\x87\xcc\xac\xfd\xbb\x05\x28\x99\xdb\x35\x93\x32\x56\x32\x09\x68\x96\xed\x44\x62\x32\x96\x4c\xd4\x72\xe9\x4d\xf6\x24\x68\x52\x91\x8a\x4a\xc1\x60\x65\xca\xe6\xa1\xc4\x5e\x15\xd4\xa6\x6a\x12\x4b\x74\xa9\xd2\x52\x4b\x65\x28\x31\xf0\xe7\x5f\x34\x2f\x15\x05\xd3\xe5\xab\x92\x9c\x2f\xc9\x21\x5c\xaa\x78\x9d\xe3\xf9\x37\x59\x02\xac\xb5\xce\xbd\xc9\x38\x65\x36\x57\x67\x4d\x15\x1b\xa9\x95\x37\xf9\xac\x52\xfc\x7a\x19\x17\x48\x98\x2d\x62\x17\xb4\x00\x6d\x9c\xda\xd8\x8e\x34\x43\xd0\xc7\xcf\x86\x46\x57\x36\x54\xd8\xd1\x8b\xa0\xe8\x39\x91\x43\x4f\x27\x02\x2e\xeb\x8e\x08\xc5\x13\xc5\x72\x58\x83\xe2\x62\xbc\x4a\x2c\x47\xeb\x50\x8c\x24\x50\xd8\x28\xe4\xc3\x16\xf0\xf3\x02\x4e\x6d\xdc\x47\x73\x04\xfe\x5c\x59\x7b\x36\x23\x04\x31\x66\xd3\x63\x8c\x27\x1f\x81\x8c\x59\x58\x94\x71\x75\x74\xdf\xd7\x10\x29\x82\xeb\x26\xa9\x5c\xff\xb8\xfe\x3d\xe8\x63\x24\x84\x08\xa3\x43\xfb\x20\x3f\x18\x84\x1c\x3b\x71\x3c\x82\x21\xa4\xc7\xe6\x4f\xc4\xbe\xdd\x7c\xca\x4a\x84\x1b\xec\x5a\xd2\xf3\xb1\xe6\x3d\x2f\x0e\x5d\x28\x8e\x0a\xb8\x2d\x4b\x1a\x5a\x75\x4e\x6b\x3b\x39\xed\x72\xcf\x8e\xee\x6d\xce\x1f\x31\x87\x82\x66\xfa\x82\x9a\x53\xd6\xfa\x01\x59\xf6\x11\xe3\x8d\xeb\xdb\x7d\x0e\x43\x7d\x9f\x74\x42\xba\xc3\x60\x28\xce\xa7\x4b\xd3\xba\x85\x60\xdd\x56\x58\x6d\x65\x82\x81\xe4\x41\xeb\x4f\x1f\x7a\x07\x39\xf6\xe1\xbd\x48\xb3\xf3\x5c\x2c\xc0\xf7\xad\xe8\x76\x3c\xa6\x7d\x20\x1c\x5b\x5e\xce\x1c\x84\xed\xd3\x2e\x0b\x9f\xa1\x76\xd5\x50\x5a\xcb\x92\xb8\xbc\xc0\xef\x91\xe3\x5b\x49\x0e\x80\xaf\x63\x7a\x8e\xe6\x05\x8a\x83\xc7\x5b\xb9\x11\xd2\xeb\x48\x9d\x61\x8e\x06\x5f\xe0\x75\xe0\xf4\x56\x6a\x0e\xec\x75\xec\x7e\x6d\xf2\xfb\x17\xb8\x8d\x5c\xde\xca\x8c\xa1\x9e\xe0\x35\x9b\x75\x07\xcf\xa5\x36\x1f\x75\xa3\x52\xa8\x90\x9b\x8b\x1a\xcc\x1d\xc2\xfb\xd3\xf7\xa0\x33\x88\xa1\x90\x75\x4d\xdc\x20\xd5\x49\x53\x30\x9e\x53\x74\xb4\x96\xef\xac\xee\x48\xe7\xbb\x83\x29\xf2\x75\x8e\x83\xa4\x25\x5b\x07\x7a\xb6\x7b\x11\xbf\xd4\xbc\x32\x82\x29\x86\x30\x9d\x12\xe9\x95\x89\x4d\x53\xb3\x4e\x7b\x0c\xb9\x61\xb7\xcb\x21\x6f\x16\x66\x41\x21\xd1\x79\x8e\x49\xcb\x3c\x8b\x65\x8e\x29\x48\x83\x45\xed\x24\xac\xc9\x91\xc4\xfd\xd3\x20\x35\x2e\x74\x88\x6a\x88\x15\xe3\x50\xe3\x23\x2e\xe8\x74\x96\x16\x25\x7a\x42\x2c\xc8\x9a\x7c\xad\x63\xc7\xc1\xfa\x1e\x06\xa1\x27\x12\xe8\xf5\xdf\xc4\xa3\x4d\x67\x04\xdc\x0b\x1d\xa4\xf4\x1a\xeb\x92\xda\x27\x12\x6b\xf5\x77\x51\x2a\x98\xc4\x11\x1d\x6f\x92\x91\xc3\x6d\x64\x85\xf0\x35\x51\xc5\x8a\x1a\x42\x82\x14\x1f\xad\xc2\xb6\xcf\xe0\xd2\x20\x8f\xff\x0f\x9c\xeb\x2e\xdc\x36\x0b\xa0\xcf\x9b\xee\x93\xc0\x0e\x29\xf4\x8f\xd4\xed\xbe\x58\x19\x73\x70\x72\x22\xf8\x0d\x1f\xe6\x6e\x97\xcf\xe9\x9e\x5b\x93\x09\xb5\x6c\x46\xaa\x06\x6d\x9b\xf2\x1c\x32\xf5\xa5\x2e\xeb\x59\xe0\xdf\x13\xc4\xbb\x6d\x54\x5b\x5a\xf3\x77\x69\x44\x01\xa0\x09\x3f\xea\x70\xa3\xb1\x8c\x76\x60\x17\x87\x21\xd7\x35\xeb\xcc\x51\x39\xe8\x90\x65\x9e\x1e\xdd\x38\xd6\xab\x1d\x5a\x2f\xae\x96\xdd\x8e\xf8\x50\xe3\xfc\x1f\x0e\x29\xa1\x8d\x69\x0b\x00\x00")
 
 func tplConfElasticGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -174,7 +181,7 @@ func tplConfMssqlGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplConfMysqlGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xc1\x6e\x9c\x30\x10\x86\xcf\xf6\x53\x4c\x38\xc1\x2a\x85\xbe\xc0\xf6\xd0\xac\xd4\x54\xca\x26\xa9\x68\xcf\x91\xd7\x0c\xd4\xea\xe2\xa1\x63\xd3\x84\x20\xde\xbd\x32\x6c\x52\x60\x9b\x16\x89\x83\xc7\xf3\xff\x33\xf3\x79\xfa\xbe\xc0\xd2\x58\x84\x48\x93\x2d\xd3\xba\x73\x3f\x8f\xd1\x30\x34\x4a\xff\x50\x15\x42\xdf\xa7\x9f\xe8\x7e\x3a\x0c\x83\xcc\xb2\x0b\xf8\x93\x27\x4d\xdd\x10\x7b\x88\xa5\x88\x5c\x67\x75\x24\x45\xe4\x4d\x8d\x91\x94\x22\xaa\x8c\xff\xde\x1e\x52\x4d\x75\x86\xcf\x87\xb6\xcb\x18\x0b\xe3\xde\x11\xd7\x19\x71\x1d\xc9\x44\xca\x5f\x8a\x83\xf6\x61\x34\x7b\x70\x9e\x18\x61\x43\x5c\xa7\xbb\x8f\x79\x38\xbc\x5e\xe9\xb2\x02\x80\x7d\x97\x7f\xb9\xb9\x22\x5b\x9a\xea\xf5\x86\xac\x46\x80\x50\x3c\xbd\xb3\x1a\x83\xab\xef\x1a\x9c\xe7\x82\xf3\xdc\x6a\x0f\xbd\x14\xd7\xe4\x3c\xcc\x3e\xe7\xd9\xd8\x4a\x8a\xfb\x30\xc5\xec\x33\xd6\x4b\xf1\xcd\x21\xdf\xaa\x1a\xcf\x92\x95\x73\x8f\xc4\xc5\x3a\xbe\x53\x5e\x1d\x94\x3b\xcf\x27\x3a\xe6\xe6\x19\x17\xe6\x57\x64\xed\x5e\x3d\xdd\x98\x12\xbf\x9a\x1a\x21\x60\x4b\x77\x2d\x2b\x6f\xc8\xca\x41\xca\xb2\xb5\x7a\x9a\x22\x47\xdf\x36\xb1\x2e\x61\x33\x1b\x2a\x09\xe3\xcc\xe8\x6c\x61\xa3\xcb\xa5\x2e\x4e\x16\x30\x83\x20\x00\x47\x1e\x7f\xe2\x05\xc3\x74\x47\x71\x90\xc6\xa3\xf1\xe2\x49\x2e\x47\xc9\x16\x82\xd7\x2d\x3e\x9e\xec\xe2\x68\x5a\x95\x4b\x29\xc4\xac\x91\x34\x20\x5e\xc7\x02\xde\x75\xec\x85\xd6\x3a\xfe\x42\xfd\xcc\xe3\x44\x3d\x91\x42\x98\x72\x6c\xe9\x62\x0b\xd6\x1c\xc7\x7e\x45\xa3\xac\xd1\x31\x32\x87\xfb\x61\x35\x41\x9a\xa3\x9f\x01\x0f\xac\xe3\x11\xf8\x35\xb5\x7c\x32\x9c\x95\x5a\xbf\xcd\x07\x78\x3f\x15\xf9\x9f\xe7\xdb\x1e\x6f\x75\xb5\x57\x4f\x9f\x8b\x23\x86\x6c\x17\x2f\x88\x4d\x3b\x93\xfc\x5d\x73\xd7\xa0\xfd\x97\x66\x48\xa4\x60\xf4\x2d\x5b\x98\xab\xc3\x7e\xf4\x3d\xda\x62\x18\xe4\xef\x00\x00\x00\xff\xff\x2f\xf7\x3c\xd3\xf8\x03\x00\x00")
+var _tplConfMysqlGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x85\x54\xdb\x8e\xda\x30\x10\x7d\x4e\xbe\x62\x36\x4f\x09\xa2\xa6\xcf\xad\xa8\xb4\x2d\x52\xb7\xd2\xde\x5a\xda\xa7\x6a\x85\x8c\xe3\x80\x55\x62\xa7\xb6\xd3\x6d\x36\xca\xbf\xef\xd8\x04\xd6\x09\x4b\x8b\x64\x48\xe6\x72\x3c\x67\xe6\x0c\x6d\x9b\xf3\x42\x48\x0e\x09\x53\xb2\x20\x65\x63\x7e\xef\x92\xae\xab\x28\xfb\x45\x37\x1c\xda\x96\x7c\x56\xf7\xfb\x97\xae\x8b\x67\xb3\x0b\x78\x89\x8b\x45\x59\x29\x6d\x21\x8d\xa3\xc4\x34\x92\x25\xf8\x6b\x45\xc9\x93\x18\x1f\x36\xc2\x6e\xeb\x35\x61\xaa\x9c\xf1\xa7\x75\xdd\xcc\x34\xcf\x85\x79\xa3\x74\x39\xc3\x93\xc4\x59\x1c\xff\xa1\xda\xe5\xae\x3c\xd8\xca\x58\xa5\x39\x4c\xd0\x49\x16\x1f\x97\xee\xe5\xe8\x62\xc5\x06\x00\x6e\x9a\xe5\xd7\xeb\x4f\x78\xbb\xd8\x1c\x3d\x4a\x32\x0e\xe0\x2e\x27\x77\xf8\xe8\x50\x6d\x53\xf1\x30\x16\x8c\xd5\x35\xb3\xd0\xc6\xd1\x95\x32\x16\x82\x0f\x7a\x84\x44\xb0\x7b\xc7\x22\xf8\x08\x69\xe3\xe8\x87\xe1\xfa\x96\x96\xfc\x24\x98\x1a\xf3\xa8\x74\x3e\xb6\x2f\xa8\xa5\x6b\x6a\x4e\xe3\x95\xda\x2d\xc5\x13\x1f\x80\x63\x69\xf2\x86\xfe\xbd\x16\x05\xff\x8e\x1d\x03\xd7\x36\xb2\xa8\x35\xb5\x42\xc9\x38\xfa\xc6\xab\x9d\x60\xd4\x1c\x72\x7e\x3e\x78\x42\xbd\xb9\xef\x41\x17\xe3\x3c\xe0\xd4\x01\xc2\x00\x05\xcd\x69\x8e\x5f\xde\x0e\x66\x4b\x5d\x31\x60\xb7\x1c\xf2\x43\x9d\x54\xe6\x0e\x80\x32\xa6\x6a\x69\x41\x15\xde\x5d\x69\x51\x52\xdd\x90\xa0\x8f\x43\xf4\x51\x3b\x07\x3d\x74\xdc\xb0\xac\xa2\x96\x6c\x9f\xba\xe4\xb6\xae\x52\x56\xc0\x24\x98\x48\xe6\x92\x83\xd1\xce\x61\xc2\x8a\x61\x5e\x9a\x0d\x94\xe0\x12\x9c\x5a\xb8\xf6\x47\xe9\x81\x00\xc8\x42\xa5\x2e\x35\xf5\xc0\x03\x3d\x4d\x7d\xca\x1c\x1c\xd6\x2d\x7f\xec\xe1\xd2\x64\xaf\xf3\x29\x46\x07\x85\x10\x47\x68\x6c\x73\xbc\xc6\xb6\xc3\xa8\xc7\xf6\x83\x64\x4e\x30\x7a\xc9\x64\x68\x17\x85\x2f\xe9\x62\x0e\x52\xec\x7c\xbd\x51\x45\xa5\x60\x29\x5a\x9d\xbf\xc3\x53\x28\x0d\xab\xe9\x71\x7a\xef\xe6\xa0\xa9\xc4\x6d\x0c\x20\x8f\x0a\xf1\x08\x3d\xe8\x1c\x42\xee\xe4\x32\xcf\xfb\xb0\xb4\x87\x7a\x61\x18\x1d\x2c\x47\x7e\x67\x09\x9e\x65\xf8\x2a\xc5\xf7\x27\xf4\x86\xfc\x3c\xc1\x6e\x34\x26\x82\x3a\x09\x56\xc2\x6d\x43\xea\x57\xe2\x4a\xd5\xba\xef\x5a\x70\xd9\x78\x7b\x3e\xc0\xdb\xfd\x55\xff\xc3\x3c\x8f\x91\x9d\xa9\x0a\x43\xbe\xe4\x3b\xee\xa2\x4d\x3a\x90\xc5\x7e\xab\xb3\xd7\x73\xee\x2a\x2e\xff\x95\xd3\xe1\xd1\xb8\x1b\x5a\x0e\x46\xe6\x96\xa0\x6d\xb9\xcc\xf1\xbf\xf6\x19\x12\x79\xb5\x8a\x9a\x05\x00\x00")
 
 func tplConfMysqlGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -214,7 +221,27 @@ func tplConfOrmGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplConfRedisGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\xc1\x6e\x1b\x37\x10\x3d\x2f\xbf\x62\xcc\xc0\xc0\x6e\xb0\x59\xe5\x2c\x40\x05\x82\x40\x90\x5d\x04\x91\xa0\x75\x2f\x0e\x02\x63\xb5\x22\x65\xda\x5a\x72\x41\x52\x75\x64\x61\x81\x1e\x82\xa2\x08\x0a\x34\x97\x02\xfd\x80\x9e\x7a\xea\x31\x40\xfb\x39\x89\x73\xec\x2f\x14\x43\x6e\xbc\x94\xed\x83\xd5\x43\x9d\x8b\xb4\x1c\xce\xe3\xbc\x37\x7c\x9c\xcd\x66\xce\xb8\x90\x0c\x68\xa9\x24\xcf\x34\x9b\x0b\x43\x9b\xa6\x2e\xca\xf3\x62\xc1\x60\xb3\xc9\x46\x6a\xe2\x17\x4d\x43\x7a\xbd\x3d\xe8\xf2\x88\xa8\x6a\xa5\x2d\xc4\x24\xa2\x4c\x6b\xa5\x0d\x25\x11\xe5\x95\xc5\x3f\x63\xb5\x90\x0b\x43\x09\x89\xe8\x42\xd8\xd3\xd5\x2c\x2b\x55\xd5\x63\x97\xb3\xd5\xba\xe7\xe0\x4f\x94\xae\x7a\x4a\x57\x94\x24\x84\x7c\x5f\x68\x3c\xe7\xc4\xed\x9c\x18\xab\x34\x83\xc7\x4a\x57\xd9\x14\x03\x39\xae\x31\xad\x54\xd2\xb8\x82\x93\x67\x87\x53\x18\x00\xad\x0b\xa1\x29\x89\x0e\x9e\xe5\x07\xb8\x3c\x2d\xcc\x29\x25\x51\x3e\x3c\x02\x5c\x1a\x86\x5c\x8e\x71\x39\x00\x7a\xe9\x97\xa3\xe1\xd8\x6d\x2e\x98\xa2\x24\x7a\x71\x98\xbb\xcd\xa5\x30\x16\xc9\x0e\xa7\xd3\xf1\xf4\x24\x9f\xbc\x38\x74\xe1\x47\x4f\x1e\x39\x7e\x76\x5d\x33\x18\xcf\xce\x58\x69\x41\x48\xcb\x34\x2f\x4a\x06\x1b\x12\x8d\x98\x7d\xbe\x2c\x8c\x79\x59\x54\x2c\x4e\xc0\xcb\x76\x61\x47\xfa\x68\x5d\xdf\x08\x4f\xb4\xa8\x0a\xbd\xbe\x9d\x7f\x28\xe7\xec\x0d\x33\x71\x02\xaf\x5e\xb7\xe1\xa6\x2d\xec\x9a\xf0\x5c\x49\x2e\x16\x88\x58\x95\x76\x43\xa2\x03\x65\x2c\x44\x10\x7d\x39\x62\x82\x77\x11\x45\x42\x5a\x12\x4d\x0a\x63\x2e\x94\x9e\x5f\xef\x36\x84\xf0\x95\x2c\xfd\x49\x39\xb3\xdf\xd5\x71\xc9\xe1\x71\x70\x70\x82\x6a\x5c\xe3\x53\x60\x5a\x43\x7f\x00\xd8\xff\x97\xec\xc2\x27\x2d\x05\x93\x36\x2e\x79\x86\x75\x53\x28\x79\x86\x05\xfd\x47\x5b\x2d\x85\xa7\x09\x89\x04\x77\xf8\xbd\x01\x48\xb1\xc4\x43\xa3\xba\x90\xa2\x8c\x99\xd6\x09\x89\x9a\x1b\x97\x3c\x00\xf7\xbf\x4d\x30\x4e\x6e\x5e\x3e\x9e\xa3\x99\x5d\x69\x09\x21\x1c\x61\xbd\x1e\x7c\xfa\xfd\xed\xe7\xf7\x3f\x3a\x0f\xfe\xf3\xf7\xcf\x57\xef\xde\x7d\xfc\xf0\xc3\xc7\x0f\x7f\xb8\xc0\xa7\x5f\x7e\xbb\xfa\xe9\xbd\xfb\xbc\xfa\xf5\xcf\xcf\x7f\xbd\xf5\x75\xf2\x7a\x29\xec\x10\xa3\x48\x0c\xdc\x3e\x76\xde\x7d\xb8\x56\x18\x6c\x41\x6b\xe3\xcc\xa5\x63\x66\xe6\x31\x49\x0a\x81\x53\x12\x24\x67\x56\x4b\x8b\x90\xaa\x38\x67\x71\x7b\x50\x0a\x4b\x26\x63\x63\x92\x84\x44\x5c\x69\x10\x29\xb8\x63\x75\x21\x17\x0c\x8c\x71\xed\xf1\xd0\x57\xe2\x35\x0c\x3c\x0f\x83\x5d\x8f\x8d\xef\x56\xab\xda\x27\x79\xbd\x7b\xb0\xb2\x62\x09\xa8\xc3\x0a\x25\x8d\x57\x74\xce\xd6\x63\xee\x2d\x1a\xab\xd9\x59\xeb\xd6\x14\xe3\x06\xb2\x2c\xf3\x52\xbe\x58\x0e\x2b\x0b\xee\xe8\x61\x42\x02\xdf\xc0\x53\xc7\xc6\xac\x38\x17\x6f\x42\xed\xdf\x2a\xe1\x93\x52\xa0\x7d\x9a\x38\xc6\x8e\x13\xaf\x6c\x96\xd7\x5a\x48\xcb\x63\xba\x6f\xfa\xfb\xa6\xaf\x5c\xd1\xfe\xbe\xa1\x29\xa8\xd9\x59\xb6\xfd\x0e\xae\x63\xc1\x93\x49\xc1\x57\xdc\x52\xeb\xb4\xb8\x24\x94\x92\x5c\xbb\x63\x3b\x7e\x0f\x89\xe6\x42\xd8\xf2\xf4\x0e\x2a\xb8\x59\x16\x86\x01\x4e\x92\x7e\xa7\x09\x07\x4a\x50\xe2\x16\x59\x2c\x95\x65\x59\xd2\xa2\x71\xf0\x04\x68\x9c\x3f\x3b\xa0\xf3\xe1\x51\x00\x36\xcc\xee\x80\x3d\xde\x06\x5f\xee\x86\x1e\x0d\xc7\x01\x78\xc1\xd4\x0e\x58\x1c\x99\x01\x18\x27\xe7\xfd\xd0\xdd\xfd\x52\x7a\x7d\xa5\x61\xc3\x4b\xfc\x6d\x6f\xef\x7f\x36\xae\x73\x2c\x7a\x21\x05\xc7\xe2\x4e\x5b\xde\xc6\x6d\x83\x3a\xa3\x86\x46\x78\x68\x55\xe8\xd1\x9d\x55\x05\xa0\x4e\x55\xe0\xb1\x87\x16\x95\x0f\x8f\x76\xd6\xd4\x61\x3a\x49\x97\x5f\x91\xa6\xe3\xff\x22\xea\xf8\x2e\x55\xc1\x83\x7e\x68\x51\xa3\xe1\x78\x67\x4d\x1d\xa6\x93\x14\x8e\x99\x87\xd6\x84\x13\x70\x67\x51\x01\xc8\xa9\xda\x6c\x98\x9c\x37\x0d\xf9\x37\x00\x00\xff\xff\xf5\x0d\x22\xe3\x83\x0b\x00\x00")
+var _tplConfPostgresGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x5d\x6f\xdb\x30\x0c\x7c\x16\x7f\x05\xe7\x27\x3b\xe8\x9c\xfd\x81\xec\x61\x0d\xb0\x0e\x58\xdb\x00\xd9\x9e\x0b\x45\xa1\x3d\x61\xb1\x68\x50\xf2\xda\xd4\xd0\x7f\x1f\xe4\xc6\x85\xf3\xb1\xae\x02\x12\x40\xa4\xee\xc8\xbb\x73\xdf\x6f\xa9\xb2\x8e\x30\x33\xec\xaa\xb2\x65\x1f\x6a\x21\x9f\xc5\xd8\x6a\xf3\x5b\xd7\x84\x7d\x5f\x7e\xe5\xd5\xcb\x25\x46\xb0\x4d\xcb\x12\x30\x07\x95\xf9\xbd\x33\x19\xa8\x2c\xd8\x86\x32\x00\x95\xd5\x36\xfc\xea\x36\xa5\xe1\x66\x4e\xcf\x9b\x6e\x3f\x17\xda\x5a\xff\x91\xa5\x99\xb3\x34\x19\x14\x00\x7f\xb4\x24\xec\xc3\x38\xe8\xc1\x07\x16\xc2\x19\x4b\x53\x2e\xbf\xac\xd3\x65\xda\x35\x55\x8d\x88\xab\xc3\xf5\x9a\x5d\x65\xeb\x69\x9f\x9d\x21\xc4\xb4\x48\x79\xef\x0c\xa5\x09\x61\xdf\xd2\x09\x02\x7d\x90\xce\x04\xec\x41\xdd\xb0\x0f\x38\x39\x3e\x88\x75\x35\xa8\x55\x12\x35\x39\xd6\x05\x50\x3f\x3d\xc9\x9d\x6e\xe8\xec\xb1\xf6\xfe\x91\x65\x7b\x5a\x5f\xea\xa0\x37\xda\x9f\xbf\x67\xde\xad\xed\xf3\x6b\x7d\x20\xbf\x66\xe7\x6e\xf5\xd3\x77\x5b\xd1\x0f\xdb\x10\x26\x17\xcb\x65\x27\x3a\x58\x76\x10\x01\xaa\xce\x99\x57\x21\x6b\x0a\x5d\x9b\x9b\x0a\x67\xc7\xd2\x0a\xec\xa7\x7e\x24\xbf\x16\x38\x33\xd5\x19\x41\x5e\x1c\x99\x9c\x60\x29\x0b\x92\xe1\xc7\x72\xea\x6a\xb9\xe4\x3c\x11\xe4\xc3\x84\x49\x73\x08\xec\x2a\x81\x70\x81\x89\xf1\x8e\x1e\x0f\xa4\x79\x36\xbe\xca\xae\x40\x4d\x41\xa6\xaa\xcb\x64\xfd\x85\x72\x72\xfe\x42\x79\xf4\xf2\x42\x6b\x8c\xe5\x42\x6b\x4c\xa6\x00\xa5\x6c\x35\x2c\xf9\x61\x81\xce\xee\x06\x11\xaa\xd5\xce\x9a\x9c\x44\x52\x3f\x9e\xcb\x2a\xd7\x14\x26\xb9\xa4\x48\xf2\xf4\x57\xde\x70\x27\x07\xce\xe3\x81\xa7\x29\x7e\xc6\x4f\x2f\xa3\xde\xc1\xfc\x26\xd3\x1b\x1b\xde\xea\xa7\x6f\xdb\x1d\x25\x80\x3f\x21\x19\xbf\xb4\xe2\x9f\xc8\xfb\x96\xdc\x7f\x90\xb1\x00\x25\x14\x3a\x71\x13\xb1\x03\x07\x44\xe8\x7b\x72\xdb\x18\xe1\xef\x00\x7e\x38\x96\x61\x39\x04\x00\x00")
+
+func tplConfPostgresGogoBytes() ([]byte, error) {
+	return bindataRead(
+		_tplConfPostgresGogo,
+		"tpl/conf.postgres.gogo",
+	)
+}
+
+func tplConfPostgresGogo() (*asset, error) {
+	bytes, err := tplConfPostgresGogoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/conf.postgres.gogo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplConfRedisGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x56\xcd\x4e\xdb\x40\x10\x3e\x27\x4f\x31\x18\xb5\xb2\x91\x71\x38\x23\x51\x09\xa1\x08\x52\x51\x88\x12\x7a\x01\x21\xe4\x38\xeb\x64\x21\xb1\xa3\xdd\x4d\xf9\x89\x22\xf5\x80\xaa\x0a\x55\x2a\x97\x4a\x7d\x80\x9e\x7a\xea\x11\xa9\x7d\x1c\x7e\x8e\x7d\x85\xce\x8c\x0d\xde\x00\x07\xd2\x43\xa9\x14\x92\xd9\xd9\xf9\x76\xe6\xfb\x76\x76\xc4\x68\xd4\x16\xb1\x4c\x04\x38\x51\x9a\xc4\x81\x12\x6d\xa9\x9d\xf1\x78\x10\x46\x07\x61\x47\xc0\x68\x14\xac\xa6\xf5\x6c\x31\x1e\x97\x2b\x95\x19\x28\xe2\xca\xb2\x3f\x48\x95\x01\xb7\x5c\x72\x84\x52\xa9\xd2\x0e\x5a\x71\xdf\xd0\x8f\x36\x4a\x26\x1d\xf4\xa0\xdd\x91\xa6\x3b\x6c\x05\x51\xda\xaf\x88\x93\xd6\xf0\xb8\xc2\xf0\xf9\x54\xf5\x2b\xf8\xe7\x94\xbd\x72\xf9\x5d\xa8\xe8\x9c\x3d\xde\xd9\xd3\x26\x55\x02\xe6\x70\x33\x68\x90\xa3\x49\x6b\x0a\xc3\xe4\x9a\x13\xd6\x97\x6b\x0d\x58\x02\x67\x10\x4a\x85\xe9\xd6\x96\x9b\x6b\xb4\xec\x86\xba\x8b\xcb\x66\x75\x0b\x68\xa9\x05\xd5\xb2\x4d\x4b\x5c\x9d\x64\xcb\xd5\xea\x26\x6f\x76\x44\x8a\xab\xf5\x5a\x93\x37\x7b\x52\x1b\x2a\xb6\xda\x68\x6c\x36\xf6\x9a\xf5\xf5\x1a\xbb\x67\xe7\x67\xb9\x3e\x73\x3c\x10\xb0\xd9\xda\x17\x91\x01\x99\x18\xa1\xe2\x30\x42\x79\xf0\x34\x61\x56\x7a\xa1\xd6\x1b\x61\x5f\xb8\x1e\x64\xb4\xd9\xcd\x45\x6f\x21\x6e\xd2\x5d\x57\xb2\x1f\xaa\xe3\x87\xf1\xb5\xa4\x2d\x8e\x84\x46\xe7\xce\x6e\xee\x1e\xe7\x89\x59\x84\x15\x14\x5e\x76\x08\x31\x8c\x0c\x66\x5e\x4b\x51\x8a\x12\x94\x6e\x8f\xa8\xd3\x5d\x94\x4a\x58\x1d\xda\x58\xd1\x61\xaa\xda\x77\xbb\x78\x52\x3c\x4c\xa2\xec\xa4\xa6\x30\x6f\x07\x6e\x14\xc3\x9c\x75\xb0\x47\x6c\x58\x78\x1f\xf0\x36\x61\x71\x09\x48\xff\x0d\x71\x98\x05\xf5\xa4\x48\x0c\x82\x02\xca\xeb\x03\x1a\x94\x30\x33\xf2\x6c\x3e\x2c\x78\xe5\x92\x8c\x19\x3f\xb3\x04\x89\xec\xd1\xa1\xa5\x41\x98\xc8\xc8\x45\x27\xee\x8e\xef\x5d\xf2\x12\xf0\xef\x64\x81\xa8\xc1\xbd\xcb\xa7\x73\x94\x30\x43\x95\x80\x0d\x27\x58\xa5\x02\x57\xdf\x4e\x6f\xce\x3f\x70\x0f\xfe\xfe\xf5\xe9\xfa\xec\xec\xf2\xe2\xfd\xe5\xc5\x77\x76\x5c\x7d\xfe\x7a\xfd\xf1\x9c\xcd\xeb\x2f\x3f\x6e\x7e\x9e\x66\x79\x9a\x83\x9e\x34\x55\xf2\x52\x61\xc0\xfb\xa4\x3c\x1b\x94\x8d\x3a\xb2\x3f\xec\x19\xc9\x32\xbc\x21\x8b\xc3\x6f\x09\x62\xbb\x07\xcb\x9a\xc0\x3e\xbc\xe4\x40\x56\xf0\xb6\x4a\xf6\x30\x5d\xad\x49\xcb\xfc\x3d\x04\x9c\x97\x50\x41\x96\xdc\xf3\xc1\x6a\x39\x8f\x58\x6a\x84\x12\xa4\x1f\x1e\x08\x37\xaf\xc8\x87\x9e\x48\x5c\xad\x3d\x8c\x88\xb1\x40\xe9\x03\x1f\xab\xc2\x04\x9f\x29\xa6\xc8\x52\x13\x74\x47\xee\xa2\xaa\x79\x85\x78\x7d\xae\xce\x64\xcf\x0b\xcb\x82\x32\xe1\x66\x60\x68\xf0\x8e\x48\x10\x23\xf1\x69\x65\xd2\x1c\x88\xe3\xcd\x38\xeb\x75\x37\x6d\xed\xe7\x6d\xef\x93\x5f\x43\x10\x04\x19\x95\xdb\xde\xa5\xcc\xa8\x08\x95\x47\x01\x1e\xbc\x82\x05\xae\x46\x0f\xe3\x58\x1e\xd9\xdc\x5f\xa7\x32\x0b\xf2\xc1\x59\x74\xbc\x42\x2c\x9c\x19\x28\x0c\x06\x99\xd8\x75\x5e\xe8\x45\xfc\xa4\x9c\x14\x2d\xc7\x07\xb4\x83\xc9\x07\x75\xe7\xb3\xde\x1e\x2a\xc2\x19\x27\xd8\x32\x17\x0e\x22\x2a\xde\x5d\x9b\x4d\xfa\x9f\x40\x51\x1f\x4a\x13\x75\x1f\x29\x85\x36\xa3\x50\x0b\xa0\x91\xb4\x58\x70\xa2\xc9\x64\xa5\x78\x50\x2c\xa5\xc2\x4c\x5e\x8e\xa6\x09\x66\xa1\x69\x90\x4d\x81\xc6\x09\x67\x81\x71\xce\x4d\x81\xdd\x9e\x04\x9f\x4c\x87\xc6\x69\x6a\x81\x71\xa6\x4e\x81\xa5\xd9\x6b\x81\x69\x04\x3f\x0d\x5d\xdc\xaf\xe3\xdc\x5d\xa9\x2d\x78\x44\xdf\xf9\xed\xfd\xe3\xc6\xe5\x8e\xa5\x5e\xc0\xd1\x48\x55\x3c\xda\x96\x0f\x71\x93\xa0\xa2\x51\xed\x46\x78\x6e\x56\xd4\xa3\x53\xb3\xb2\x40\x05\x2b\xab\xc7\x9e\x9b\x14\x76\xff\xd4\x9c\x0a\x4c\x41\xe9\xe4\x3f\xe2\xb4\xfd\x37\xa4\xb6\x1f\x63\x65\x3d\xe8\xe7\x26\x85\x93\x66\x6a\x4e\x05\xa6\xa0\x64\x8f\x99\xe7\xe6\x44\x13\x70\x6a\x52\x16\x88\x59\x8d\x46\x22\x69\xe3\x7f\xe7\x7f\x00\x77\xa8\x89\x53\xcc\x0b\x00\x00")
 
 func tplConfRedisGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -234,6 +261,26 @@ func tplConfRedisGogo() (*asset, error) {
 	return a, nil
 }
 
+var _tplConfSqliteGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x4f\x4f\xdc\x30\x10\xc5\xcf\xf6\xa7\x18\x7c\x4a\xd0\x92\x70\x46\xa2\x87\xb2\x52\x55\x09\x0a\xd5\xf6\x8e\xbc\xce\x38\x3b\x6a\x62\xa7\xf6\xa4\xb0\x44\xf9\xee\x95\xb3\x61\xff\xa9\x10\x29\x07\x6b\xfc\x7b\x33\xef\x79\x86\xa1\x42\x4b\x0e\x41\x19\xef\x6c\x11\xff\x34\xc4\xa8\xc6\xb1\xd3\xe6\xb7\xae\x11\x86\xa1\xf8\xe6\x9f\x76\x87\x71\x94\xd4\x76\x3e\x30\x64\x52\xa8\xb8\x75\x46\x49\xa1\x98\x5a\x54\x52\x0a\x55\x13\x6f\xfa\x75\x61\x7c\x5b\xe2\xdb\xba\xdf\x96\x01\x2b\x8a\x57\x3e\xb4\xa5\x0f\xad\x92\xb9\x94\x7f\x75\x48\xec\xf3\xae\xcd\x73\x64\x1f\x10\x2e\x7d\x68\x8b\xe5\xd7\x55\x3a\x1c\x6a\xc6\xd6\x00\xb0\xfa\x79\x4f\x8c\x77\xde\x59\xaa\x0f\x35\xef\x0c\x02\xa4\x01\x8a\x47\x67\x30\x29\xf3\xb6\xc3\x93\xdb\x10\x39\xf4\x86\x61\x90\x62\xa9\x59\xaf\x75\x44\x98\xbf\xc8\x81\x5c\x0d\x65\x09\x96\x1a\x84\x4e\xf3\x06\x7c\x00\x75\xd3\x62\xeb\xc3\xf6\x46\x49\xf1\xe4\x7d\xb3\xa2\xb7\x3d\x42\x8e\xa5\xb8\xf3\xce\x3d\xe8\xd7\x7b\xb2\xf8\x8b\x5a\x84\xe4\xbc\x58\xf6\x41\x33\x79\x27\x47\x29\x6d\xef\xcc\x3c\xc4\x0a\xb9\xef\x32\x63\xe1\xf2\x78\xa8\x1c\x86\x83\x8b\xe4\xf0\x16\x2e\x8d\x3d\x43\xb3\xfc\x24\x92\x84\xa4\xdc\x30\x4c\xbf\x0f\xa7\x39\x14\x4b\x9f\x25\x38\x9b\xb4\xf7\xa5\x29\xda\x45\x02\xe0\x16\x92\xda\x0f\x7c\x99\x05\x33\x35\xbf\xf2\x02\x94\x5a\xc0\xf5\x42\x8a\x03\x67\x6c\x5d\xbc\xe7\xb5\xab\x2b\x95\x4b\x21\xc8\x4e\x5a\x17\xb7\xe0\xa8\x99\x3a\x89\x4e\x3b\x32\x19\x86\x90\xea\xa3\x14\xa2\x2c\x2f\xa0\xf3\xbe\x01\x8a\xd0\xa0\x65\x60\x0f\xbc\x41\xa8\xd0\xea\xbe\xe1\x08\xbd\x6b\x30\x46\x48\x9b\x46\x75\x1f\xb0\x5a\x00\xb9\xab\x5d\xea\x50\xcd\x5d\x67\x21\x8a\x50\x05\xdf\x75\x58\xc1\x0b\xf1\x06\x88\x27\xd0\xa1\x99\xd2\x9e\x26\x3a\x1e\xfa\xfc\x71\xbe\xc0\x35\x0c\xc7\xce\xa6\x44\x8a\x15\xf2\xd1\xcd\xf4\x82\xd9\x27\x2a\xef\xce\xce\x7a\xed\xb7\xe3\xe3\x26\x0f\xfa\xf5\x7b\xd5\xa4\x5d\x74\x31\xfb\x1f\x9b\x7f\x84\x3d\x76\xe8\x3e\xc7\x46\x29\xc6\x5c\x8a\x80\xdc\x07\xb7\x1f\x6c\xf2\x27\x47\x39\x0c\xe8\xaa\x71\x94\xff\x06\x00\xa3\xe2\x52\x29\xda\x03\x00\x00")
+
+func tplConfSqliteGogoBytes() ([]byte, error) {
+	return bindataRead(
+		_tplConfSqliteGogo,
+		"tpl/conf.sqlite.gogo",
+	)
+}
+
+func tplConfSqliteGogo() (*asset, error) {
+	bytes, err := tplConfSqliteGogoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/conf.sqlite.gogo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _tplObjectDbGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\x41\x0a\xc2\x30\x10\x85\xe1\x7d\x4f\xf1\x08\x6e\x9b\x03\x08\xee\xbc\x81\x5e\x20\x69\x5e\x21\x25\x26\x1a\x53\x44\xc2\xdc\x5d\x1a\x10\x8c\x1b\xdd\xcd\x1b\x7e\xbe\x5a\x1d\x67\x1f\x09\x95\xec\xc2\xa9\x68\x67\x95\xc8\x50\xeb\x2e\xd9\x05\xfb\x03\x74\x5b\x23\xfc\x8c\x48\x6c\x5f\x7d\xb4\xa7\xb4\xe6\x89\x50\x0a\xa3\xc8\x00\x00\x5b\x52\x78\xb9\x06\x53\x3e\x2d\x9d\x69\x9c\x82\xee\xba\x8e\x3a\x1b\x1b\xfe\x92\x1e\xd9\x17\x7e\x53\x8c\xae\xed\x76\x87\x3b\x7f\x2a\xb7\x95\xf9\xf9\x56\x7a\x81\xd1\x89\x0c\xaf\x00\x00\x00\xff\xff\xc8\x3c\x48\x88\x11\x01\x00\x00")
 
 func tplObjectDbGogoBytes() ([]byte, error) {
@@ -254,7 +301,7 @@ func tplObjectDbGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectDbQueryGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\xdd\x4e\xe3\x3c\x10\xbd\xb6\x9f\x62\xbe\xaa\xa0\x04\x15\x3f\x40\x3f\xf5\xa6\xc0\xae\x56\x02\x76\x51\x11\x37\xab\xd5\x2a\x89\x27\xc5\x28\x75\x5a\xc7\x2d\x54\x56\xde\x7d\x65\x3b\x49\xd3\x34\xe1\xe7\x02\x29\xb6\x67\xce\x19\x9f\x39\x8c\x6b\x0c\xc7\x54\x48\x84\x51\x1e\xbf\x60\xa2\x19\x8f\xd9\x66\x8b\x6a\x3f\x2a\x4b\x6a\xcc\x38\x8f\x5f\x60\x3a\x03\x56\x96\x94\xea\xfd\x1a\xe1\xaf\xdf\x64\xf7\xd1\x0a\xcb\xf2\x7a\x7e\xb7\x54\x50\x68\xb5\x4d\x34\x18\x4a\x78\x0c\xb9\x5a\xb1\xeb\x39\x2d\x29\x4d\xb7\x32\x81\x60\x05\x17\xc7\x39\x77\x4b\x15\xc2\xf5\x3c\x68\x62\xc3\x6e\x84\x47\x35\x94\x28\xd4\x5b\x25\xe1\xf4\x30\xe0\x71\xd8\x50\xf4\x1e\x7f\x88\x2d\x52\xe0\x31\xcc\x66\x20\x45\x66\xd7\x64\x1d\x49\x91\x04\xe9\x4a\xb3\x1b\xa5\x72\x95\x06\xa3\x9e\x44\x21\x85\x06\x89\xc8\x81\xc7\xa3\x30\xa4\xa4\x6c\xaa\x3c\xef\x21\x32\x3c\x9e\x02\x8f\xcb\x77\xe4\x70\x71\x21\x3c\x58\xd1\xe7\xfb\xc5\xc3\x6d\xb0\xb1\x82\x0a\xb9\x9c\x40\xa4\x96\x05\x30\xc6\x40\x48\x8d\x2a\x8d\x12\x34\x65\x08\x81\xc2\x62\x9b\xe9\x02\x7e\xff\xb9\x38\x82\x9a\x00\x2a\x65\xff\x72\x15\x3a\xf9\xf2\xd7\xc2\xef\x4d\x67\xb0\xb2\xad\x75\x2c\xc1\xc6\x23\x33\xc6\x42\xa7\x83\x8d\xf8\xef\x20\x44\x75\x1f\x29\xb2\x09\x0c\xca\x01\x29\xea\xe4\xd9\x93\x4d\xe1\x6c\x37\x72\x44\x5e\x10\x8e\x29\x2a\xb0\xec\xec\x2a\xcb\x0b\x0c\x42\x4a\x89\x31\x2a\x92\x4b\x84\xb1\x90\x1c\xdf\x26\x30\x4e\x05\x66\xdc\x56\xe6\x50\xbf\xd9\x55\x51\x96\x94\x10\x63\x2e\x41\xa4\x55\x00\xfb\x51\xdc\x6f\xb3\x2c\x8a\x33\x04\x77\x4a\x76\x91\xb2\x2d\xf7\xa7\x55\x31\xc5\x26\x63\xcd\xde\x77\xd4\x36\x65\xf1\x70\xfb\xb8\x5f\x63\x03\x89\x59\x81\xc7\xb8\x88\xfc\x51\x45\xb2\x48\x73\xb5\x7a\x07\xbc\x0d\xdc\xc4\x33\x8b\xfd\x53\x89\xa5\x90\x07\x06\xc9\xe1\xd2\xae\xea\x85\xfd\xaf\x21\x69\x5e\x69\x71\x8f\x6f\x3a\x70\x8d\x71\x34\xbe\x8b\xc7\xee\xa5\x84\xd8\x66\xcc\x7c\xc2\x22\x89\x64\x50\x61\x7f\x42\x3c\xcf\x5d\xcb\x97\xab\x1e\x05\x07\xee\xee\x13\xc9\x79\xe7\xe6\x93\xb6\x72\x75\x4c\xe5\x3e\xd6\x89\x0d\x27\x2d\x11\x4e\x15\x21\x21\x25\x3d\x56\x23\xce\x95\x0b\xd4\xce\x63\x81\x77\xd0\xb1\x03\x51\x29\x4a\x88\x15\xf2\x0b\x16\x3a\xf5\x50\x4f\xaf\x87\x8d\x56\xdd\xd5\x55\xdc\xb9\x27\x7b\x8a\x32\xc1\x7d\xf1\x15\xc4\xab\xd0\xcf\x30\xde\xd9\x3a\x82\xb5\x12\x52\xa7\x30\x3a\x2b\x9e\xa2\x6c\x8b\x23\x68\x25\x87\x35\x2a\xe9\x60\xba\x50\x9b\xde\xe5\x3a\xac\x0f\x7e\x76\xc1\x43\x48\xbf\x72\x21\xb5\x47\xba\x84\xaa\x96\x3e\xf3\x5e\xe5\x72\x87\x4a\x3f\xe6\x30\xde\x35\x58\xfd\x8d\x85\x19\x74\x7d\xe1\x58\x5a\x02\xa0\xe4\x35\x48\xe9\xcd\x62\x3e\x82\x94\x22\xab\x12\x9a\x4e\xb4\x5d\x36\x9c\xf8\xf9\x8b\xb5\x13\x69\xab\xd4\x9a\xa3\xe1\xfc\xb4\x1b\x86\x8a\xa2\xe4\x34\xbf\xd5\x31\xeb\xbd\xab\xa8\xd0\x07\xa0\xa3\x89\xe2\x66\x54\xf0\x85\xd6\x87\x07\xbe\x23\xcd\xba\x66\xf8\xd8\x3e\x1d\x45\x7a\x15\xaa\x7b\xdb\x95\xe7\x46\x26\x39\xaf\x90\x06\xbb\xe5\x1e\x61\xb4\x81\x43\x63\xa3\xcb\x63\x4c\xfd\x55\xbf\x72\x33\x88\xd6\x6b\x94\xbc\x7e\xf6\x26\x70\xee\xbf\xfc\x63\x53\x0d\x95\x6a\x64\xde\x28\x15\x84\xf0\x7f\x67\xcc\xf4\x4e\x99\xaf\x3c\x73\xd5\xac\xee\x7d\xed\x3c\x0e\xb5\x3f\x97\x7c\xe9\xff\x02\x00\x00\xff\xff\xcf\x01\x96\x8e\x54\x09\x00\x00")
+var _tplObjectDbQueryGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x56\xdb\x6e\x9b\x40\x10\x7d\x86\xaf\x98\x22\xc7\x82\x88\xec\x07\xb8\xf2\x8b\x9d\xb4\x6a\xd5\x3a\x8d\x6c\xe5\xa5\xaa\x2a\x0c\x8b\x43\x8b\x17\x7b\x59\x72\x11\xe2\xdf\x3b\xbb\xcb\xdd\x90\xc4\x52\x1f\x2c\x7b\x97\x99\x73\x66\xcf\x9c\x1d\x9c\xe7\x01\x0d\x23\x46\xc1\x4a\xb6\x7f\xa8\x2f\x48\xb0\x25\xc7\x8c\xf2\x17\xab\x28\xcc\x3c\x9f\xe0\x2e\xcc\xe6\x40\x70\x65\x8a\x97\x03\x85\xdf\x7a\x93\xac\xbc\x3d\x2d\x8a\xeb\xc5\xf7\x1d\x87\x54\xf0\xcc\x17\x90\x9b\x46\xb0\x85\x84\xef\xc9\xf5\xc2\xc4\xf8\x30\x63\x3e\xd8\x7b\xb8\xec\xe6\x60\x86\x03\xd7\x0b\xbb\x8e\x75\xfa\x11\x1a\x15\xe1\x38\x15\x19\x67\x70\xfa\x10\x93\x9d\x9a\x62\xf0\xf1\x9b\xd8\x51\x08\x18\x35\x9f\x03\x8b\x62\xb9\x36\x0e\x1e\x8b\x7c\x3b\xdc\x0b\x72\xc3\x79\xc2\x43\xdb\x1a\x48\x8c\x58\x24\x80\x51\x1a\x60\xb2\xe5\x38\xa6\x51\xd4\x55\x4e\x07\x88\xf2\x60\x3b\xc3\xc8\xe2\x15\x39\x54\x9c\x03\x77\x52\xf4\xc5\xcb\xfa\xee\x9b\x7d\x94\x82\x46\x6c\xe7\x82\xc7\x77\x29\x10\x42\x90\x56\x50\x1e\x7a\x3e\xcd\x0b\x07\x6c\x4e\xd3\x2c\x16\x29\xfc\xfc\x75\xd9\x81\x72\x81\x72\x2e\x3f\x09\x02\x36\xf2\xed\x49\x03\xbe\x14\xcf\xb6\x9f\x20\xda\xb3\x20\x0b\xcf\xff\xbb\xe3\x49\xc6\x02\xdb\x71\xe1\xa8\xe9\x90\xcd\x39\xa7\x5a\x05\x28\x9e\xa1\x02\x5d\xea\x6f\xc4\xfb\x6f\xa7\x48\x9e\x52\xbd\x87\x46\xdc\x4b\x83\x2a\xf6\x92\x48\x92\x77\x8b\x97\x9d\x95\xd1\x1f\x9a\xd6\x96\x42\xe0\xd2\x85\xd1\x06\x43\x48\x85\xff\xa0\x89\x67\x70\xf1\x64\x29\x52\xdd\x62\xbc\x24\x94\x83\xac\x84\x2c\xe3\x24\xa5\xb6\x63\x9a\x46\x9e\x73\x8f\xed\x28\x4c\x22\x16\x50\x2c\x62\x12\x46\x34\x0e\x64\x95\x0a\xf5\x93\x5c\xa5\x78\x71\x0c\x8c\xbc\x02\xac\x4a\x07\x90\x2f\xe9\x2a\x8b\x63\x6f\x1b\x53\x50\x4f\x8d\x47\x8f\x4b\x13\xeb\xa7\x65\x31\xe9\x31\x26\xf5\xde\x67\x2a\x64\x0a\xca\xbd\xc1\x3b\x58\x43\xd2\x38\xa5\x5d\x5c\x34\xe6\x06\x8b\x4a\x43\x74\xff\x2b\xe0\x6d\xe0\x3a\x9e\x48\xec\x5b\x1e\xed\x22\xd6\x30\xb0\x00\xae\xe4\xaa\x5a\xc8\x39\x60\x60\xb4\xd6\x62\x25\x1b\xa0\x9a\xa4\x68\x74\x47\xbb\xf7\x11\x1f\xc9\x66\xcc\x75\xc2\xda\xf7\x98\x5d\x62\xbf\x43\x3c\xcd\x5d\xc9\x87\xac\xa7\x0a\x8e\x9c\x5d\x27\x1a\xd3\xde\xc9\xdd\x31\xe5\xbe\xae\x6f\x57\x55\x92\xd4\x42\xae\xf3\xfb\x19\x4c\x4b\x9b\x92\x1e\x90\xd3\x85\xaa\xe8\xc6\xa2\xdd\x96\x9e\xa7\xe2\x1a\x68\xb2\x01\xd7\x1a\xca\xec\x6b\x2a\x94\x5d\x6d\x6d\xc6\xae\x99\x71\x0f\xb7\x64\x4f\xce\x70\xe3\xa9\x1d\x07\x6c\x33\xee\xd9\xf2\xac\xaa\xe2\xde\x39\xc9\xbd\x17\x47\x81\x2e\xbe\x84\x78\x8a\xc4\x03\x4c\x1e\x65\x1d\xf6\x01\xe7\x81\x08\xc1\xba\x48\x31\x2e\xa3\x16\xb4\x92\x9d\x0a\xd5\xe8\x61\xaa\x50\x99\xde\xe7\x6a\xd6\xcd\xd5\x50\xc1\x63\x48\x3f\x12\xa4\xd7\x48\x57\x50\xd6\x32\x74\x0f\x70\xb2\x3c\x52\x2e\x36\x09\xd6\x5d\x63\x0d\x37\x16\x7d\x3d\x1d\x62\x69\x09\x80\x5d\xae\x40\x0a\x6d\x96\xfc\x2d\x48\x6c\x6d\x99\x50\x77\xa2\xed\xb2\xf1\xc4\xf7\x1f\xac\x9d\x68\xb6\x4a\xad\x38\x6a\xce\x77\xbb\x61\xac\x28\xd3\x38\xcd\x6f\x75\x4c\x7a\x6f\xe9\xa5\xa2\x01\xea\x0c\x27\x35\xee\xec\x33\x5a\xef\x34\x7c\x1d\xcd\x8c\xb3\xed\xd3\x53\x64\x50\xa1\xaa\xb7\x7d\x79\x6e\x98\x9f\x04\x25\xd2\x68\xb7\xd4\x3f\x14\x2a\x03\xc7\xc6\x46\x9f\x27\xcf\xab\x5f\xd5\xcb\x73\x0e\xde\xe1\x80\x9b\xd5\xdb\xd4\x85\xa9\xfe\xa5\xdf\x5b\xe5\x50\x29\xa7\x2f\xce\x11\x9c\xd6\x1f\x7b\x63\x66\x70\xca\x9c\xf3\xc6\x2c\xc7\xfe\xe0\x8b\x53\xe3\x98\xf2\xbf\xa4\x2e\xfd\x1f\xd2\xc8\xc7\x65\x71\x0a\x00\x00")
 
 func tplObjectDbQueryGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -274,7 +321,7 @@ func tplObjectDbQueryGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectDbReadGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x7b\x6f\xdb\x38\x12\xff\x5b\xfa\x14\x73\x42\x1b\x48\x5b\x55\xcd\x02\x87\xfb\xc3\x0b\xb7\xb8\xbc\x7a\xbd\x6b\xd3\x36\xee\xee\x16\x08\x82\x85\x6c\xd1\x29\x37\x32\xe5\x90\x74\x9a\x9c\xa1\xef\x7e\x20\x87\x92\x28\x59\xb2\x65\x37\xd9\x76\x6f\x5b\x14\x81\xc5\xc7\xcc\x70\x7e\xf3\xe2\x63\xb9\x4c\xc8\x94\x32\x02\x5e\x36\xfe\x9d\x4c\x64\x94\x8c\x23\x4e\xe2\xc4\xcb\x73\x77\xb9\x7c\x94\x8d\x7f\x87\xc1\x10\x22\xfc\xa2\x2c\x21\xb7\x44\xa8\x16\xd5\x13\xbd\xc2\x6f\xec\x5c\x30\x7a\xbd\xb0\x3a\x7f\xc6\x6f\xec\x9c\x73\x3a\x8b\xf9\x5d\xd9\xf9\x0e\xbf\xff\x43\xee\x6a\xfd\x27\x94\xa4\x89\x1e\x64\x1a\xa2\x13\xca\x85\xc4\xe6\x3c\x77\xe5\xdd\x9c\xc0\x6f\x28\x57\x74\x1a\xcf\x48\x9e\x1f\x1d\xbc\xb9\xe4\x20\x24\x5f\x4c\x24\x2c\x5d\x27\x19\x43\xc6\x67\xd1\xd1\x81\x9b\xbb\xee\x74\xc1\x26\xe0\xcf\xe0\x87\xfa\x9c\x37\x97\x3c\x80\xa3\x03\xbf\x1c\x1b\x34\x47\x20\xd5\xa5\xeb\x70\x22\x17\x9c\xc1\x6a\xa7\x9f\x8c\x83\x92\x45\x6b\xf7\x46\xda\x74\x0a\xc9\x18\x86\x43\x60\x34\x55\xdf\xce\x3c\x66\x74\xe2\x4f\x67\x32\x3a\xe6\x3c\xe3\x53\xdf\x6b\x99\x48\x19\x95\xc0\x08\x49\x20\x19\x7b\x41\xe0\x3a\x79\x29\xe5\x5e\x0b\xa3\x65\x32\x1e\x40\x32\xce\xd7\xa8\x43\x8f\x0b\x60\x44\x62\x3e\xf9\xe4\x7f\xfe\x44\x38\x51\x0a\xa5\xec\x32\x84\x8c\x27\x84\x8f\xef\xca\xef\x94\xce\xa8\x2c\xbf\x62\x7e\x29\x20\x8a\x22\xca\x24\xe1\xd3\x78\x42\x96\x79\x00\xfe\xf9\xc5\x0f\x35\xfa\x21\x10\xb5\x9c\x40\xad\xd1\x18\x54\x13\x8e\xe8\x94\x7c\xae\xb5\xf9\x81\xeb\x4c\x32\x96\x50\x49\x33\xa6\x8d\xea\xfc\x02\xb9\x2e\xb5\x80\xa5\x64\x46\xa4\xdc\x75\xae\x17\x04\x2d\x4c\x29\x70\x34\xe7\x94\xc9\xa9\xef\x8d\x8e\x5f\x1f\x1f\x7e\x80\xc7\x02\x4e\xce\xde\xbe\x29\x18\x9f\xf0\x6c\x76\x74\x90\xe7\xf0\x58\x78\xa1\x59\x8e\x88\xfe\x9d\x51\xe6\xab\xee\x97\x44\x1e\x66\xe9\x62\xc6\x84\x1f\x84\xe0\x85\x5e\xd0\x18\x54\x89\x16\x82\x07\x1a\x06\x83\xc1\x2c\x3a\x21\x72\xf2\xe9\xe0\x6e\xf4\xfe\xb5\xaf\x45\x42\x35\x45\x51\x14\xf4\xc5\xe0\xb0\xa4\x6e\x31\x2a\x15\xb0\x0a\x4a\x36\x9d\x0a\x22\x81\x32\x59\x00\xa4\x7f\x3e\x2c\x3a\xcb\xe5\x53\xa0\x53\x74\xe7\xa3\xf1\x61\xc6\x64\x4c\x99\x00\x6f\x26\xc4\x75\xaa\xc2\x87\xb2\xef\x42\xd2\xe1\x10\x3c\x4f\xdb\x78\xd9\xa2\xdd\x63\xf4\xfe\xf5\x5b\xd5\x70\x70\xa7\x6d\xdd\x0e\x03\x91\xfe\x8b\xfc\xbc\x10\xa6\x71\x2a\x08\x5a\xbb\xe2\x4c\x58\xa2\x58\x5c\x6f\x8f\x37\xfe\xf7\x42\xd7\x71\x9c\x3e\xc0\xab\x71\x46\xd4\x5f\x95\xe5\x59\x90\x14\x9d\x68\x88\xea\xf7\x66\xa5\x20\xb1\x37\x42\xad\x5c\xc3\xf6\x5a\x01\xe6\x23\x84\x06\x3e\x65\x4e\x48\x8b\xa4\x82\x54\xb3\x7a\xce\xd1\xaa\xe9\x30\xc8\x5d\x8c\x71\xc1\x64\x23\x2a\xb4\x5b\x16\x65\xf2\x1f\x7f\x2f\xcc\x69\x69\x09\xa0\xdd\xc0\xa2\xf3\x25\x1e\x81\x64\x5a\xdd\x62\x77\xa9\x3a\xf1\xdd\x42\x50\x5b\xcb\x2b\x8a\x82\xba\x4c\x9c\x88\x45\x2a\x95\xec\x2d\xbe\x68\xf9\x23\xcf\x3e\x0b\x6c\x1b\x0c\x61\xa6\x72\xf3\x7b\x25\x74\x0d\x46\xe5\x66\x6a\xc4\xdf\xaa\x3c\x62\x96\xc8\x68\x1a\x42\x67\x36\x81\xa9\x12\x18\x99\x0d\xe0\xf1\x8d\xa7\x19\xa1\x87\x25\x64\x4a\x38\x28\xee\xd1\x61\x9a\x09\xe2\x07\xae\x72\x3b\x1e\xb3\x4b\x02\x58\x06\x84\xf0\x68\x5a\x66\x6b\xed\x62\xea\x4b\x68\x63\x2d\xbc\x40\x0f\x88\x5e\x89\xd3\x45\x9a\xc6\xe3\x94\x00\x9a\xf2\x4d\xcc\x95\x5f\x62\xaf\x11\x46\x5c\xa7\x51\xd9\xf6\x92\x48\x35\x65\xf4\xfe\xf5\x87\xbb\x39\x29\x49\x2a\x67\xa8\xd3\x25\x24\xf9\xc0\x63\x26\xa6\x19\x9f\xad\x21\x6e\x13\x2e\xc7\x47\x8a\xf6\x5b\x4e\x2f\x29\xab\x38\xb0\x04\x9e\xe6\x55\x88\x01\xed\x48\xd3\xcc\xe8\xe2\x94\xdc\x4a\x5f\x03\xa3\xd9\x20\x8a\xf5\x68\xe9\x3a\x8e\x02\x63\x88\x13\x46\x93\x98\xf9\x86\x76\x0f\xe5\x21\xef\x42\x7d\x19\x6f\xd1\x60\xc7\xda\x71\xa2\xb3\xd7\x58\x79\x68\x6b\xae\x18\x63\xac\x2f\x6a\x8c\x0d\x42\x4b\x09\xab\x1a\x71\x54\x7c\x59\x35\x35\x47\x5b\xe5\x88\x48\x6d\x63\x3e\x5a\x50\xdd\x02\x09\xe7\xae\xe3\x28\x45\x6e\x61\x42\xab\x36\xd4\x82\x75\xb7\xa1\x99\xb5\x6a\x89\x1b\xeb\x8c\x7e\x89\x53\x9a\xa0\xf0\x86\xc4\x67\x2a\x3f\xc1\xa3\x1b\x25\x87\x8f\x79\x04\xbc\xc7\xe2\x97\x38\x5d\x10\x0f\xac\xc9\x41\x41\xd5\x69\xd0\xd4\x43\x4d\xe6\xac\xf1\xaa\xbe\x2b\x7b\xd6\x83\xbb\x28\xbd\xcb\x28\x93\x48\xe9\x29\x18\x59\xda\x8c\xf7\x30\x63\x37\x84\xcb\x0f\x19\x3c\xba\x29\x69\xb5\x03\x0b\x43\x68\xda\x85\xe6\x62\x29\xc0\x64\x53\xf5\x2f\x47\x63\x59\x6e\x22\xc9\x68\x6a\x26\x94\x48\xd8\x56\xd6\x3d\xb1\xff\xc2\xec\x89\xae\x25\x6a\xc1\xa3\xe4\xd9\xdb\x1a\xba\x84\x72\x9d\xd5\xf9\x16\x62\xca\xf6\x0e\x63\x21\x2b\x42\xb5\x88\xa2\x63\x94\xbf\x05\xf4\x41\xc5\xaf\xa6\xb3\xa6\x31\x6c\x36\x9f\x86\x46\x5a\x35\x54\x60\xdb\x54\xcf\x31\x9b\x64\x89\xa1\xd4\x89\x96\xde\xc3\x10\x35\xb0\x2b\x6c\x34\xf9\x2c\x97\xc5\xaf\x22\xcb\x0d\x21\x9e\xcf\x09\x4b\x8a\xb4\x17\xc2\x1e\xfe\xc2\x64\x63\x82\x8a\x09\x99\xc7\x9c\xfb\x01\xfc\xd4\x08\x33\xad\x51\x66\x9b\x34\x67\x62\x75\x6b\xb6\x43\x3a\x2a\xc3\x1b\x15\x31\x52\x54\x70\xa3\x6c\xc1\x27\x04\x3c\x55\xba\xad\x4f\xff\xc7\xb7\x54\x48\x7f\x7e\x05\xd5\xd6\x36\x00\x7f\x9c\x65\xa9\x5d\x60\x4f\xac\x6c\x6e\xd5\x1f\xf3\x2b\x55\x7e\x9c\x64\x7c\x16\x4b\x55\x78\xe2\xf7\xbb\x98\xc7\x33\xe1\x07\x1b\xd2\xbc\xae\x8c\x4d\x98\xad\xf6\x82\xfe\x44\x0d\xdd\x0f\x42\xed\xa9\xb9\xeb\x3e\x7b\x06\x47\x64\xce\xc9\x24\x96\x24\x19\xc0\xcf\x82\x14\x25\x4b\x25\x31\x50\x26\x24\x89\x93\xa8\x4f\xad\xb3\xb2\xd8\xfb\xd9\x57\x3c\xf0\x36\xae\xae\xea\x40\xcb\x66\xd7\x58\xab\xbb\xb7\xad\xc0\xa8\x32\x1e\x5a\x76\x4a\xb4\x28\x22\x80\xe7\xb0\x6f\x0f\x54\x8d\xe7\xfb\x17\x08\x8f\x85\x5b\x4f\x6b\x9e\x64\x3c\x01\x96\x49\x98\x66\x0b\x96\x78\x81\x41\xb8\x38\x67\xb9\x22\x77\xfd\xca\xd5\x0a\x40\xbf\xda\x7f\x29\xc5\x9d\x2c\xd8\x44\xaf\x39\xcf\xef\x0d\xda\xf9\x95\x1a\xba\x67\x31\xc2\xbe\xa5\x2a\xb8\x6c\xe6\x87\x19\xc3\x13\x9d\x8c\xab\x48\xa2\x6a\x87\xef\x66\xb1\xab\x59\x14\x71\xbf\xd0\xef\x2b\x31\xa2\xec\x32\x25\xba\xda\xca\xfb\xee\x6b\x2a\x43\x11\x7e\x73\xa7\xae\xea\xf5\x78\x46\x44\x9e\xc3\xf9\x45\x4b\x27\x66\xc8\x0d\x67\x0f\x82\xfe\x57\x97\x50\x4a\x35\xdd\x0c\x50\xc9\x7a\xec\x70\x58\x57\x9d\x56\x51\xa1\xb6\xb9\xc6\x45\x63\x17\x5f\x11\xff\xfc\xc2\xda\x80\x85\xb0\x1f\x6a\x12\x01\xd6\xf6\xbf\x29\x30\xd5\x50\x2c\x4e\xd7\xac\x0e\x0f\xe9\x34\xe5\x32\xa9\xe1\xb7\x22\x81\xd9\xe4\xc1\x63\xdd\xaf\xff\x3a\x3e\x3b\x5e\x91\xd2\x3a\x2d\x81\x57\xa7\xe0\xbf\x78\x2c\x82\x9e\xc6\xef\x56\x07\x21\x67\x64\x4e\x62\xe9\x7b\xe1\x0b\x0f\x55\x04\x4f\x7f\xdc\x70\xb8\x85\xeb\x37\x7b\x64\xeb\xfc\xe1\xd9\x33\x30\xe7\xc5\x6e\x73\xfb\x33\x18\x16\x47\xc9\x3d\xcc\x8f\xb2\xe4\xe0\xae\x38\x7c\x2e\x22\x93\x51\x61\xb3\xd9\x04\xac\xda\x29\x58\x75\x38\x76\x8f\x87\x5f\x34\xb9\x2d\x42\x19\x0a\x50\x06\x32\xc7\x96\xa9\x16\xc7\xb4\x4c\x03\x00\x14\x4e\x69\x1d\x45\x1b\x80\x91\x31\xfc\x43\x22\x1d\x4d\x6e\xad\x50\x27\xf9\x82\x6c\x00\xd8\x4c\xa8\x45\xba\x5e\xb0\xfd\x33\x4d\xb7\x45\xee\x2b\x43\xf4\xa7\x02\xa0\x88\xeb\xb8\x94\x6d\xa3\x7a\xdd\xad\xaa\x6b\x16\x33\xec\x25\xcf\x16\x73\x9f\x4a\x32\x13\x18\xd3\x57\xc6\xf5\x0a\xea\x5b\x01\x86\x69\x51\xf3\x0c\xbe\x30\xba\x57\x84\xaa\x18\xaf\xbe\xab\x28\x8f\x4b\x5b\x17\xd0\xd5\x08\x0c\xe9\x3b\x19\x04\x9e\x98\xb6\x69\xce\x0e\xd5\x94\x81\xff\xa2\x9f\xe5\x04\xf0\xa4\x2b\x52\x5b\x7a\x7b\x0a\x3f\x06\xf0\x04\xbc\xc0\xeb\x1f\xb5\xad\xb0\x5d\x0f\xe0\xe6\x4e\xcf\x0e\xe0\xd8\x34\x18\x16\xf7\x7d\xbd\x0b\x88\xf2\x8a\x70\x35\x12\xd4\xdb\xef\xbb\xea\x54\xd4\x8b\x48\x60\x38\xd9\x65\x67\xc5\xfc\x8f\x0f\x05\x8a\x77\x4b\x2c\xd8\x58\x7b\x16\xf3\xbe\xfd\xea\xd3\xb2\xa7\xcd\x01\xe9\x2d\x23\x3e\xc2\x01\x78\x7b\x1c\x80\x5f\xd5\x9d\x0d\xfc\x45\x73\x2b\x8d\x97\x22\x06\xce\x86\x52\x43\xa8\xda\x71\x5c\xad\xe9\x5b\xd1\x23\x65\x49\xf7\xde\xae\x63\xf7\xfe\xf1\xe3\x47\x54\x56\xef\xcd\x3b\x6a\x1a\xf7\xf0\x4d\x75\xff\x29\xf6\xf0\xed\x18\xf7\x75\x9c\xaf\x0c\x39\xcb\x18\x31\x20\x77\x43\xab\xb3\xf3\x4e\xc8\xfa\x3a\xdd\x80\x7e\x9a\x51\xdd\x7e\x9d\x5f\xb4\xfb\x91\xcc\x64\x9c\xb6\x9f\x49\x61\xda\xaa\x74\x8c\x17\xb0\x21\x94\xed\x3d\x35\x68\x58\xd4\x14\x39\xbf\xea\xf0\xde\x26\x53\xe3\xbc\x65\x73\xe9\xbb\x1d\x52\xd4\x79\x16\x6c\xfa\x95\xab\xe8\x10\x1d\xea\xeb\xf6\x8b\xaf\xa3\xc2\x6f\xca\x1f\x5b\x51\xd3\x68\x98\xf3\xde\x35\x1e\x79\x1f\xe6\x54\xef\x2a\xb9\x9a\x83\xcf\xf5\xc8\x9f\xa9\xb2\xc6\x17\x93\x6c\x4e\xf0\xf7\x17\x39\x8d\xa6\xd3\x82\x78\xd9\xfe\x10\x4e\xd3\x64\x6a\x9c\xa6\x6c\x2e\x9d\xa6\x43\x8a\x5d\x9d\x46\xab\x0b\xbd\xa6\x43\x7f\x3b\x79\xcd\x43\xea\xf0\x1b\x72\x9a\x56\xd4\x7a\x3a\xcd\x7d\xa8\xe2\x8b\x9d\xe6\x8c\xdc\x10\x2e\xb7\x73\x1d\x14\xdc\xcc\xd4\x2b\xb6\x76\x29\x96\x2b\xf6\x38\x61\xb0\x64\xd8\xd1\x06\x37\x0b\x63\x11\xee\x21\x91\xe5\x92\xf5\xe7\x32\x1b\xdf\x64\x55\xef\x41\x1a\x6a\xab\x9e\xad\xe0\x09\x65\x9b\xe1\x5a\x47\xf8\xf7\x67\xb5\xf3\xab\x68\xc5\x62\xf5\xa2\x82\x35\xaf\x52\x1a\xaf\xdd\x76\x7e\x99\x82\x6b\x40\xad\xf5\x7f\x9f\x62\x0e\xfa\x06\xc3\x7d\x77\xfd\x83\x8b\xea\x6d\xe9\x5f\xe9\xc5\x8a\x2a\x5e\xb5\x46\x9f\x0f\x61\x1f\xf6\xf6\x8a\x83\xd1\xe7\x43\xd3\xae\xaf\xfc\xc7\x9c\xc4\x57\x2e\xde\xea\xe3\x80\x27\x4f\xdc\xf2\x2e\xb7\x9f\x09\x6e\xfd\x02\xa6\x0e\xc8\x5f\xf9\x15\x4c\x8b\x69\x7e\x7f\x09\xf3\xfd\x25\xcc\xf7\x97\x30\xff\xaf\x2f\x61\x5a\x1e\xc2\x0c\x1e\xea\x25\x8c\x9d\x56\xfb\xbd\x87\xe9\x51\xef\xec\xfe\x3c\x78\x6d\xa5\x32\xd1\x64\xd7\x5c\x72\x06\x6b\x2a\x99\xfb\x2b\x54\xf6\x37\xeb\x53\x4b\xba\x45\x99\xa2\xd2\x3d\xce\xd1\x0a\xe9\x4c\xd6\xcd\x24\xba\xa7\x27\x05\x3f\x6d\x97\x7b\xf6\xab\xcc\x53\x26\xf7\xaa\xf8\xd7\x24\x8b\x9a\xdf\x3a\xaa\x2d\x2c\xf7\x7f\x01\x00\x00\xff\xff\xba\xc3\xd5\xd3\x1e\x34\x00\x00")
+var _tplObjectDbReadGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x5b\x5b\x73\xdb\x36\x16\x7e\x96\x7e\x05\x56\x13\x7b\xc8\x44\x66\xdd\x99\x9d\x7d\x50\xc7\xed\xd4\xb7\xae\xbb\x8e\x9d\x5a\x4e\x93\x99\x4c\xa6\x43\x4b\x90\xcd\x35\x45\xca\x24\x15\x5b\xab\xd1\x7f\xdf\x03\x1c\x00\x04\x29\x92\x02\x24\xb9\xf1\x76\x93\x87\x58\x04\x81\x73\xc3\x77\x2e\xb8\x70\x3e\x1f\xd2\x51\x10\x51\xd2\x89\x6f\xfe\x4d\x07\x99\x37\xbc\xf1\x12\xea\x0f\x3b\x8b\x45\x7b\x3e\x7f\x05\x8d\xa4\x77\x40\x3c\x7c\x0a\xa2\x21\x7d\xa2\x29\x6b\x61\x6f\xbc\x33\x7c\xc6\x97\xd3\x28\x78\x98\x6a\x2f\xdf\xe3\x33\xbe\x9c\x24\xc1\xd8\x4f\x66\xea\xe5\x3b\x7c\xfe\x17\x9d\x15\xde\x9f\x06\x34\x1c\xf2\x4e\xa2\xc1\x3b\x0d\x92\x34\xc3\x66\xec\x99\xc6\xa3\x6c\x48\x43\x9a\x51\x45\xac\x0f\x4d\xc7\xbc\x89\x77\x84\x7e\xd9\x6c\x42\xc9\x1f\x28\xbf\x77\xe1\x8f\xe9\x62\x71\x7c\xf8\xf6\x36\x21\x69\x96\x4c\x07\x19\x99\xb7\x5b\xc3\x1b\x42\x48\x9c\x8c\xbd\xe3\xc3\x76\x6b\x94\xc4\x63\xf6\x2e\x88\x6e\xdb\x8b\x76\x7b\x34\x8d\x06\xc4\x19\x93\xd7\x45\x12\x40\xc0\x25\xc7\x87\x0e\x0c\xc5\x81\x6e\xb9\x07\x32\x01\xea\x09\xcd\xa6\x49\x44\x96\x5f\xc2\x60\x57\xb1\xa8\x7c\xbd\x92\x76\x30\x22\xd0\xeb\xe0\x80\x44\x41\xc8\x9e\x5b\x13\x3f\x0a\x06\xce\x68\x9c\x79\x27\x49\x12\x27\x23\xa7\x53\x31\x30\x88\x82\x8c\x44\x94\x0e\x61\x70\xc7\x75\xdb\xad\x45\xbb\x35\x9f\xef\x11\xa0\xa6\xd9\x14\x6c\x27\x65\xdf\xad\x60\x3f\x1f\xde\xf4\x60\x7c\x97\x30\x83\xf5\x48\xc7\xe9\x9f\x9c\x9f\x1c\x5d\x93\xd7\xe4\xf4\xea\xf2\xad\xd4\xe7\x14\x5e\x1e\x1f\x2e\x16\xe4\xc3\x3f\x4f\xae\x4e\x48\x61\xd2\x3c\x3e\x45\x48\x92\x9c\xf5\xc9\xc5\xfb\xf3\x73\x57\x0e\x3c\xbe\xb9\xf6\x6f\x42\x78\xd3\x11\xc2\xd1\x30\xb5\x15\xa9\x24\x83\xa2\x14\x31\x5c\x30\x04\x55\xa8\xdc\xfe\xee\x3b\xf2\x21\xc8\xee\x10\x44\x43\x82\xec\x52\xe2\x93\xb1\x1f\xf9\xb7\x34\x21\x8f\x77\x71\x4a\xc9\x88\x39\x40\x02\xed\x61\x1a\x93\x94\x52\x92\xdd\x51\xc2\x28\x91\xa1\x1c\x19\x3f\xa6\x5e\x1d\x7e\xb8\xbc\xae\xce\xc9\x59\x89\xa0\x5a\x95\xc7\x5e\xa3\xd2\xa8\xd4\x65\x14\xce\x4c\x95\xaa\xd5\x87\xc4\x40\x65\x95\x52\x1a\xa7\x6d\x29\xb5\x05\x70\x5d\x5e\xd7\x03\x0c\xb1\x80\xb8\x58\xa1\x5c\x9f\xfa\xc9\xe0\xce\x79\xbc\xa3\x09\x15\x61\xa2\x0b\x6e\x0a\x66\xbb\x99\xa9\xe7\x30\x18\x83\x87\xc9\x27\x3f\xb9\x4d\x89\xe7\x79\x41\x94\xd1\x64\xe4\x0f\xe8\x7c\xe1\x12\xe7\xd3\xe7\xd7\x05\xfa\x5d\x42\x99\xc7\xba\x9a\x6d\xc6\x1e\x72\x3b\xca\x9e\x9c\x41\x0c\xa3\x9f\x32\xef\xd0\x1f\xdc\xdf\x26\xf1\x34\x02\xdb\x76\x09\x17\x43\xf1\x17\x8c\x91\x23\x30\x74\xdb\x86\xea\x70\x06\xd9\x13\x91\x4c\x8e\xf0\xaf\xa0\xff\x6c\x6a\x8a\x8c\x52\x0e\xac\xde\x05\x7d\x2c\xb4\x39\x10\xa2\x40\xb4\x61\x90\x05\x71\xc4\xb3\xca\xa7\xcf\xc8\x75\x5e\x69\x00\xf0\x73\x48\x37\x98\x62\x58\x28\xec\x43\x06\x89\x32\x88\x85\x02\x45\x3b\x29\xc2\x08\xfe\xee\xa4\x9d\xae\xd0\x20\xf5\x7e\x8d\x83\xc8\x61\x6c\x7f\xa1\xd9\x51\x1c\x4e\xc7\x51\xca\x8c\xdc\xe9\x76\xe0\xff\xb1\xc7\xb0\x58\xea\x9c\x4b\x05\xdd\x08\x8f\xa5\x6a\xee\x4e\x69\x36\xb8\x3b\x9c\xf5\x7f\x3b\x17\xe6\xed\x12\x2e\xd5\x1a\xd3\xa3\xb8\x68\x0c\x95\x0d\x96\xe7\x25\x1e\x8d\x52\x9a\x41\x98\xcf\xe4\x1c\xf1\x9f\x9b\xe3\x50\x31\xaf\x47\xa4\x6e\x11\x35\x2b\x28\xd0\xfa\xf0\x2c\xf2\xad\x02\xea\x9f\x6b\x17\x1b\xe0\xca\x2c\x83\x31\x87\x09\xec\x07\x20\x65\x67\x9c\xa6\x0f\x21\x2b\xad\x58\x12\x97\x92\x42\x26\xef\x74\x78\x22\x57\x2d\xbc\x06\x00\x10\x5d\xb2\x86\xc3\x19\x4f\xe8\x7a\x89\xa4\x07\x3a\xc0\xf2\x08\x12\x12\xcd\x53\x3a\xc6\xb4\xd6\x83\x91\x2b\x48\x87\x00\xf6\x2d\x13\x9f\x60\xfd\x84\x5b\xb0\x9f\x42\xd0\x0f\xcc\x25\x35\xa0\xba\xe2\x25\x62\x81\xfd\x5e\x6d\x12\x24\xf6\x36\x65\x7a\xf3\x49\x3b\x67\xd3\xe5\x14\x70\xc4\x9c\xad\xa5\x4a\x83\x4a\x82\x93\x38\xcd\x6e\x13\x9a\x6a\x34\xdf\x89\x26\x43\xb2\xf9\x40\x43\x51\x30\x87\x34\x47\x81\x75\x3c\x60\x1a\x65\xa5\xa4\x53\x0d\x5b\x78\xfa\xc7\xdf\x1b\x7d\x18\x28\xad\x4c\x28\x6b\xc9\x67\x98\x45\x6c\xe5\xe6\x41\x13\x0d\xc0\xcd\xb7\xb6\x88\x2a\x88\x20\xad\xaa\x88\xb1\xae\x4d\x0b\xa4\xcd\x82\xe3\x86\xf2\xdb\x45\xc2\x4d\x6d\x5e\xeb\xda\x16\x6a\xe4\x9e\xe0\x3c\x2c\x81\x81\x14\x25\x03\x07\x9d\x86\x19\xd3\xa0\x22\x08\x57\x09\x5c\x72\xb3\x4a\xeb\x3f\xac\x27\x6d\xad\xa5\xb7\xa7\x05\x54\xd6\xd8\x06\x51\x9a\xd5\xbd\xde\x6f\x68\x7f\xce\x68\x39\x68\xb0\x8c\xc1\x7a\xff\x2d\x5f\xf7\x09\x43\xc0\x63\x97\xd4\xae\xfe\xc8\x88\x29\x85\x8c\x7b\x64\xe7\xb1\xc3\x99\x62\xb2\x80\xa5\x3f\x2c\x03\xf8\x9a\xe5\x28\x84\xb5\x80\xa3\x97\x32\xe9\xc0\x8f\x1c\xf6\xce\x15\x8b\x09\xd6\x40\xd8\xde\x40\xca\xd7\x09\xb8\x5f\x00\xab\x83\x11\x41\x5d\x70\x39\x31\xc0\x7c\x01\x82\xe3\x72\x22\x8e\x28\xef\x93\xa7\x92\x55\x4b\x09\xc5\x98\xbc\x86\xd4\xe0\x5d\x31\x11\x8c\xed\xca\x32\x60\xe2\x47\xb7\x94\xe0\x6e\x45\x97\xbc\x1a\xa9\x4d\x05\xbe\x7e\x60\x4f\x29\x0f\xf1\x32\x25\xf1\x0e\xde\x59\x7a\x31\x0d\x43\xb6\x3c\x20\x98\x00\xbe\xf8\x09\xcb\xf6\xf8\x56\x18\x93\x09\xa4\xda\x40\x25\x36\x04\xe0\x72\x3d\x9b\x50\x45\x52\x65\x26\x45\x17\x56\xdd\xd7\x20\x54\x3a\x02\x97\x6a\x20\xae\x13\x56\xfd\x3d\x46\xfb\x32\x09\x6e\x83\x28\xe7\x10\x0d\xc9\xde\x22\xcf\xf6\x84\xa7\x1f\xe8\x8d\x73\x79\xc1\x00\xc4\x41\xc6\xd9\xa0\xe5\x8a\x85\x0b\xbc\x62\x66\x3b\xc0\x01\x7d\x66\x71\x41\xdb\xc0\x78\xc8\x5b\x9a\x0f\xb8\x2e\x5b\xb0\x46\x77\x1c\xd8\xda\x2d\x69\xde\xad\xb3\xdc\xaf\xfd\xcb\x0b\x39\x88\xd9\x82\x3d\xcf\x7f\xef\x91\x5d\x01\x07\xaf\x44\xc8\x2d\x92\x92\xec\xea\x7a\x77\x35\x7b\x2e\x1b\xb7\xc5\x12\xfc\xb2\xd7\xb5\xb8\xb3\xf6\x69\xc6\xdd\xcd\x41\x67\x2a\x3a\x23\xb4\x41\x13\x9b\x13\x0b\x34\x2e\xc3\xb1\x02\x36\xf5\x98\x15\xba\x72\x89\x4b\x7a\x7a\xbf\xfb\x61\x30\x44\xe1\x05\x89\xc7\x20\xbb\x23\xaf\xbe\x30\x39\x1c\xac\x0e\x49\x67\x27\x85\x7e\x53\xda\x21\xda\x60\x57\x52\x6d\x95\x68\xf2\xae\xa2\x1e\x2e\xf0\xca\x9f\x73\xd7\xe0\x9d\xeb\x28\xbd\x83\x62\x33\x43\x4a\x7b\x44\xc8\x52\xe5\x07\x10\x19\xbf\xd0\x24\xbb\x8e\x41\x6e\x45\xab\x7a\x62\x01\xd7\xbb\x55\x5c\x34\x03\x88\x1a\x99\xfd\x5b\x20\x58\xe6\xab\x48\xc2\xd4\x8a\x01\x6a\x26\x74\x94\xd5\x0f\x34\x57\x4c\x1f\xd8\xd6\x44\x95\x3c\x14\x4f\x63\x34\xd4\x09\xd5\x6e\x2d\x8f\xd7\x66\x8c\x61\xef\xc8\x4f\xb3\x9c\x50\x21\x38\xf1\x70\xe7\x58\x4c\xbd\x9b\xf3\x2b\xd8\xac\x65\x0d\x9f\x92\x45\x2a\x2d\x24\xe7\xb6\x6c\x9e\x93\x68\x10\x0f\x05\xa5\xda\xd9\xe2\xdb\xaf\x94\x75\xac\x0b\x1b\x65\x3e\xf3\xb9\xfc\x25\x93\xd4\x01\xf1\x27\x13\x68\x94\x59\xab\x4b\x76\xf1\x17\xe6\x5d\x11\x54\x44\xf4\x85\x38\x02\xd1\xfa\x87\x52\x98\xa9\x8c\x32\x36\x19\x5f\x84\xfd\xca\xc4\x8f\x74\x58\x5e\x17\x26\x8a\xa8\x5c\x48\xf5\xe3\x69\x32\xa0\xb0\x22\x05\x85\x9a\xf3\xf4\xc9\x53\x90\x66\xce\xe4\x9e\xe4\x9b\xf9\x90\xa7\x6f\xe2\x38\xac\x2c\x2f\x79\xf7\xfa\x42\x6d\x72\x6f\x50\xa0\xe5\x34\xaa\x6a\xb3\x55\xa2\x0c\xb4\x7a\xab\x5c\xec\x4e\xee\x59\xad\x7b\x0a\x93\xef\x67\x28\x0e\x7b\x7e\xe7\x27\xfe\x18\xd6\xbe\x2b\x2a\x30\xbe\xfe\x16\x61\x3f\xdf\xad\x76\x06\xac\xeb\x3e\x10\x63\x91\x03\x6b\xa8\x63\x3a\x49\xe8\xc0\xcf\xe8\xb0\x47\xde\x83\x1f\x88\x8a\x33\x17\x1b\xaa\xc9\x34\x83\x1a\xcb\x33\x29\x55\x97\x8c\x6f\xb0\xab\xc3\x07\x6e\x38\x0d\x39\x0d\x93\x69\xd8\xce\x96\xca\xf3\x6d\xee\x15\xa7\xde\xe5\x62\xe9\xa5\x79\xfd\x9e\x9e\x15\x48\xf2\xca\x00\x23\x40\x48\xb9\x68\x50\xdb\xfe\x48\xf6\xf5\x8e\xac\xf1\xd3\xfe\x67\x84\x8d\x86\x27\x4e\x60\x97\x85\xa7\x8b\x38\x3b\x65\x13\xc6\xdd\x7f\x7e\xc9\x0b\xf1\xfc\x14\x40\x6d\x0b\x81\xf9\x7b\x4c\x46\xf8\xeb\xb8\xf2\x48\x40\x9e\xc4\xdd\xd3\x99\xd9\x6a\x28\x9f\x4b\x27\xdf\x85\x62\x06\x3d\x85\xd1\x5c\xf7\xc5\xc2\x02\x7a\x3a\xc1\x7a\x18\x2e\x73\xfa\x19\x16\x42\x2c\xf6\x9a\x2e\xe2\x4a\x5c\xaa\x80\xba\x9e\x3a\x36\xa0\x05\x57\xe8\x61\x2d\x22\x19\xe1\xbb\x39\x2b\xdd\x75\xe6\x20\x13\x1e\x4d\xc6\x09\x4b\x24\xac\x74\xfc\x06\xf8\x2d\x00\x5e\x56\x00\xd2\xd4\x67\x69\x1f\xec\x14\xaa\x83\x62\x5b\x2c\xa5\x4e\x79\x27\x96\x2d\x02\x61\x08\x60\x13\x96\xa6\x15\x2f\xb1\x56\x32\xdd\x73\x5f\x66\x68\xe2\x23\x65\x49\xd6\xf1\x92\xd4\xc0\x4d\xb6\xa8\x71\x1a\xfc\x87\x2f\x1f\x18\x28\x9a\x14\x61\xc0\xe1\x7d\x0f\x0e\x8a\xa0\xe1\xe0\x90\x80\x99\x70\x44\x72\xf4\xfa\xf7\x14\x18\x6b\x7b\x32\x5d\xb2\xdf\xe5\x24\x5c\x5c\x22\xff\xc1\x33\x14\x74\xc5\x85\x59\x83\x76\x78\xb6\xce\x29\xab\x82\x0e\x9f\x31\x4f\x32\xce\xcf\x91\xc2\xd4\xc9\x66\xdd\x96\x3f\x39\xbb\x20\xce\x4f\x3b\xa9\x6b\xe9\xf9\xed\x7c\x8b\xff\x8a\x4e\x28\x78\x7f\xa7\xfb\x53\x07\xad\x43\xf6\xbe\x37\x3c\xc9\x42\x13\x88\x1d\x35\x6d\xff\x1b\x92\x8b\xb8\x20\xd2\x2e\x6f\x24\xf4\x0e\xe4\xdd\x11\x03\x9f\x83\x9e\x87\x33\x79\xdb\x44\x46\x66\x61\xc5\x72\xb3\x08\xd8\x85\xa3\x9d\xfc\xc4\xc7\xd8\xeb\x1a\x58\x36\xfa\x5f\xa1\x3f\x66\x28\x75\xe0\x85\x62\x98\xb8\xe2\x2a\xee\x35\x4e\xb9\x3d\x43\xd8\x80\x38\x18\x3e\xc9\x9c\x86\x02\xa8\x8c\xd6\xd2\x65\x2a\x24\x34\x2e\x53\x8f\x10\x61\x1a\x76\xd2\xc5\x45\x83\x26\x71\xa4\xf2\xdc\x29\x0f\xa4\xd6\x72\x1e\x08\x46\x0d\xc1\x2e\x06\x16\x52\x9e\xd1\x8c\xfe\x1c\x86\xb6\x28\xb6\x81\x6b\x03\x79\x5b\xc4\x6e\xae\x90\x2d\x4a\xbf\x32\x1c\xff\xd7\xc0\x26\x8b\x18\xd4\xc6\xb6\x84\x29\x46\x97\xfc\x3e\x9d\xe8\xf6\x0b\xa0\x63\xe2\x04\x19\x1d\xa7\x98\xce\x97\xfa\x59\x56\x30\x26\xfc\xea\x21\xca\x05\xb1\x0f\x9a\x0d\x6c\xaa\x70\xb9\xb1\xba\x56\x10\xc5\xd2\x17\x35\xdb\xb0\x8e\xc9\x09\xe5\xd5\x0c\x7b\xce\xeb\x19\x54\xad\xa9\x74\x61\x3d\xb0\x78\x31\x75\x01\x3c\xcb\xad\x32\x96\x5e\x94\x04\x11\x14\x25\x76\xbe\xe2\x92\x37\x75\x35\x89\x66\xb2\x3d\xf2\x3d\x74\x24\x1d\xb7\x63\x5f\x9f\x68\x05\x4a\xb1\x54\x11\xd7\x55\xf5\x52\x05\x9b\xa0\x56\x99\xaa\xab\xab\x66\x55\xb4\xba\xfd\xba\x1c\xe7\x8b\xed\xeb\x2c\x97\x6b\x88\x37\x46\xf9\xe2\x00\xdb\xa5\x73\x13\xc7\x9a\x28\x6f\xaf\xa6\x8d\x07\x31\xea\x32\xca\x0b\x4e\xfa\x3a\x3a\x67\xfe\xa7\x86\x79\xc6\xb6\x22\xce\x1b\x2f\xa6\xe5\xf8\x97\xbd\x9c\xe6\x52\xaa\x05\xb5\xf1\x95\x49\x16\x9f\x2f\x23\xea\xe0\xdc\x10\xbc\x0c\x0e\x80\xc8\x17\x9c\xb5\xd9\x03\x86\xd5\x83\x1b\xe9\x19\x26\x08\x49\xa9\x0a\xb4\x66\x82\x95\x26\x93\xcf\x1c\xde\x05\xe2\x33\x29\x90\x57\x02\x81\xde\x8e\x9d\x0b\x4d\x2f\x7f\xbe\x41\xce\xc2\x9e\x61\xcd\xae\xf5\xc7\x8f\x1f\xd1\x7c\xc6\x9b\xd6\x38\x27\xb8\x77\x5d\x9e\x00\xc3\xda\x42\x8e\xdf\x1e\x44\x9a\x77\xb3\xcd\xc5\x7c\x19\xbb\xd9\xd5\x90\xb4\x8d\x4b\x7f\x19\xa4\xf2\x32\x71\x2d\xa0\x3a\xbc\xd8\x21\xfc\x1b\x97\xfc\xde\xd4\xa7\xcf\x06\x11\xac\xa1\xbc\xe5\xd4\xcc\xa0\x59\x5f\xbd\xda\x08\x96\xc5\x99\x1f\x36\x1c\x7c\x61\x49\x97\xc3\x05\xef\x92\x6a\xed\x86\x20\x10\x7c\x0a\x58\x98\xdc\x37\x05\xcf\x32\x67\x11\x3b\x55\xb3\x0a\x9d\x35\xa2\x14\x19\x4b\x5e\x66\xb6\xc5\x10\x54\x63\x48\xc3\x48\xb4\x22\x0c\xd9\x4c\x75\x73\x08\xb2\x16\xf3\x2b\xce\xf9\x4b\x09\x83\x95\xe0\xe2\xa0\x11\x07\xf2\x06\x81\x70\x1b\x2e\x50\x7c\xa5\xb8\x8b\x13\xe1\x66\x64\x5c\xb1\x65\x89\x93\x0e\xe2\x09\xc5\xdf\x16\x61\x88\xf7\xaf\x07\x27\x27\xea\x1a\x8a\x50\x0b\x4c\x2b\xd1\x56\x81\x92\x13\xab\x00\xa5\x6a\x7f\xb6\x40\x54\xe6\x2c\x02\x91\x6a\x56\x81\xa8\x46\x94\x75\x03\x11\x37\x1c\x46\xa2\x1a\x4b\x1a\x44\xa2\x9c\xc8\x96\x66\xbb\x39\x16\x59\x0b\xfa\x35\xa7\xfd\x65\x84\xa2\x4a\x78\x59\x86\xa2\x6d\x58\x63\xe3\x50\x74\x45\xd9\x3d\xb5\x4d\x02\x12\x52\xd8\x12\x50\x35\x62\x1b\x07\x27\xb4\xaf\x50\x90\x4f\x50\x65\x2c\x55\x80\xb5\x93\x71\x2b\x3e\xae\x91\xda\xaa\x01\xb7\xec\xef\xab\x2d\xa9\x33\x34\x97\xb8\x18\xb4\x57\x7d\x6f\xb2\xf2\x4b\xaf\xfc\x3a\x79\x09\x10\x42\x95\xb9\xbc\xbc\x51\x15\x3c\xb4\x2b\x31\x1b\x45\x8e\xc9\xbd\x57\x1b\x35\xb8\x3e\xae\xe1\x77\x02\xa5\x4f\x0c\xd7\xfe\x56\x00\x55\x41\xe3\x99\x7e\x31\xd0\x16\x87\x79\x20\xdf\x7e\xbb\xf9\x0a\x79\xfe\x51\xff\xff\xd3\x1d\x7c\xb6\x22\xe6\x16\xfd\x91\x6d\xfd\xef\xee\xca\x73\x59\x78\xc4\x76\x7e\xf3\xf8\x26\xa1\xfe\x7d\x1b\x2f\x17\x63\x87\x37\x6f\xda\xea\x4a\xa9\x19\x12\xad\xef\xf4\x17\x27\xe4\x79\xef\xf5\xbf\xec\xcb\xf8\x15\xd0\xfc\x76\x21\xff\xdb\x85\xfc\x6f\x17\xf2\xff\xaa\x17\xf2\x2b\xee\xe3\xf7\x9e\xeb\x42\xbe\x9e\x56\xcd\xae\xe5\x1b\xd4\x42\x6a\x0d\xb5\x85\x6f\x6f\xe7\x2b\xaa\x98\x01\xe7\xd5\x70\x23\xcd\x2d\x56\x39\xcf\x5b\xc4\xec\xaf\xb6\x35\x17\xd8\xa2\x84\x61\xa5\x00\x8e\xe1\x76\xa9\x4d\xe4\xe5\x04\xbb\xcb\x07\xb9\x3f\xd8\xe5\xa5\xfd\x3c\x2b\xa9\xc4\x9f\x2f\xd0\x38\x49\xb9\x2e\xd3\x0e\xdc\x24\xaa\xff\x0b\x05\x1b\x17\xc6\xb3\x49\x00\x00")
 
 func tplObjectDbReadGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -294,7 +341,7 @@ func tplObjectDbReadGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectDbWriteGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xc9\x6e\xdb\x48\x13\x3e\x93\x4f\x51\x21\x9c\x1f\xa4\x41\x77\x7c\xf8\x31\x07\x01\x82\xe1\x45\x9a\xd1\x8c\x23\x3b\x96\x9c\x39\x18\x46\xd0\x22\x8b\x0e\x6d\xb2\xa9\x34\x5b\x71\x0c\x82\xef\x3e\xe8\x45\x24\xb5\xd3\x0b\x3c\x97\xc9\xc1\x10\xab\xab\x6b\xaf\xaf\xaa\x53\x14\x21\x46\x31\x43\x70\xb2\xc9\x3d\x06\x82\x84\x13\xf2\xc8\x63\x81\x4e\x59\xda\x45\xb1\x97\x4d\xee\xa1\xd3\x05\xa2\xbf\xa6\x3c\x4e\x29\x7f\x92\x14\x79\x42\x2e\xf5\xf7\x5f\xf8\xb4\x70\xde\x8f\x31\x09\x15\x93\x21\x90\x7e\xcc\x73\xa1\xc9\x65\x69\xdb\xd1\x8c\x05\xe0\xa6\xb0\xff\x4d\xab\x20\x43\x9a\x62\x59\x9e\x9d\x7c\xbe\xe3\x1e\x9c\x50\x11\x7c\x3f\xe5\x48\x05\xba\xd9\xe4\x3e\x87\x9b\xdb\xfd\x05\x3e\x0f\xdc\x98\x89\xdf\xfe\xef\x03\x72\x9e\x71\x0f\x0a\xdb\x8a\x23\x48\x90\x29\x7e\x0f\xba\x5d\x38\x94\x44\x8b\xa3\x98\x71\x06\x87\x3e\xb0\x38\xb1\xad\xd2\xb6\xad\x29\xe5\x34\xcd\xa5\x75\x29\x7d\x40\xf7\xe6\x36\x17\x3c\x66\x77\xbe\x64\xaa\x24\x78\xb6\xf5\x93\x26\x33\x6c\xf2\xc5\x4c\x20\x8f\x68\x80\x45\xb9\xc8\xbc\x5f\x14\x09\x32\x1d\x91\x61\xc6\x70\xc0\x02\x8e\x29\x32\xed\x70\x5e\x96\x9e\x6d\x45\x19\x87\x6f\x3e\x98\x70\x72\xca\xee\x10\x94\x6f\xd2\x4a\x63\x52\x17\xe8\x74\x8a\x2c\x74\xf5\xb7\x0f\x51\x2a\xc8\x68\xca\x63\x26\x22\xd7\x71\x3f\xe6\x9e\xe3\x83\xb6\x36\x27\x7f\x66\x31\x73\x33\x9e\x92\x21\x3e\x8e\x14\x6d\x94\xc4\x01\xba\xbb\x6c\xf1\xc1\x39\x72\x3c\x1f\x1c\xdf\xf1\xa4\x9b\x56\x51\x1c\x18\x7b\xf6\x62\x1f\xf6\xa2\x2a\x77\x52\x84\xbe\x05\x07\x65\x69\x5b\x9a\x35\x8e\x80\x65\xc2\xf0\x91\x41\x7e\x3c\x13\x59\xa5\x44\xb3\xcd\xf9\x28\x0b\x6b\xbe\xe1\x2c\x49\xe8\x24\xc1\x06\x05\x31\x1c\x73\xca\xf2\x28\xe3\xa9\xb9\x29\xd3\x28\xf5\x16\x85\x61\xd3\x09\x97\x09\x65\x71\xa2\x82\x25\xff\x99\xdc\x54\x01\xd3\xdf\x2a\xc9\x9e\x66\x29\x01\x93\x1c\x77\x5e\xa8\xf4\xfc\x8e\xa2\xb2\xe5\xab\x3c\x54\x0d\x41\x1c\x95\x3c\x25\xb0\xf2\x4c\x09\x8e\xa3\xda\x91\x1e\x0b\xb2\x10\xe7\x1e\x6c\x52\x25\x73\xa5\x39\xdd\x36\x5a\xbd\x45\x7d\xbb\xa4\xb7\x76\x44\x49\x64\x61\x9d\xd0\xf9\x47\xe3\x77\x69\x5b\x3f\x66\xa8\xfb\x7c\xa1\x06\x07\xc3\x51\xef\x6a\x0c\x83\xe1\xf8\x02\x4c\x47\xf6\x79\x96\x9e\x9d\x94\xa5\x2c\x4f\xf8\x7a\x7c\x7e\xdd\x1b\xc1\xc7\x7c\xa5\x50\x27\xf7\xf9\xcd\xe1\xad\xb4\x6e\xa1\x2a\x4f\xb3\x64\x96\xb2\xdc\x35\x05\xb9\x74\x6b\xde\x07\xaa\x56\x6d\x8b\x63\x3e\x4b\x84\x6a\x7a\xd5\x96\x12\xab\x7a\xbf\x30\x70\x95\xb1\x3e\xe8\x58\x10\x42\x3c\x05\x07\x92\xed\x43\x5d\x37\x35\x14\x20\xe7\xca\x47\x43\xd1\x62\xc9\x55\xf6\x98\x1f\x47\x11\x06\x02\x43\xd7\xb3\x4b\xdb\xfe\xf4\x09\x28\xbf\x9b\x49\x3b\x01\x7f\xd1\x74\x9a\x60\x47\x12\x73\x14\x1d\x87\x76\x8f\x7c\x98\x74\x8f\x1c\x49\x79\xfc\x8e\x1c\x3b\x4e\xd0\x3d\x52\x45\x1f\x1a\xb2\xb6\xbf\xb3\x00\x1d\x85\x43\x1d\x1f\x9c\x89\xfc\x13\xc8\x3f\xa1\x53\x12\x42\x76\x40\xe2\xf5\x34\xa4\x02\x4f\x9e\x46\x5f\xce\xdd\x1c\x85\xaf\x35\xc2\x1c\xba\x28\xbf\xcb\x81\x10\xd2\xd0\xb3\x0e\x23\xd7\x27\xf5\xfa\xf2\xec\x78\xdc\x5b\xce\x27\x8c\x7a\x63\x93\x48\x14\x3a\xa0\x5a\xe7\x87\x2e\x38\x8e\x8a\xa8\x16\xf7\x1c\x69\xf0\xf7\x1f\xbd\xab\x5e\x25\xd6\xb8\xe1\x99\x6c\x6c\xcf\xae\xf4\xf1\xed\x72\xbb\x3d\xdc\xf5\xf0\x81\xdd\x93\xa7\x1e\x28\x2f\x86\x63\xdb\xfa\xf1\xc2\x5e\xd3\x53\xc1\xb6\xac\xe5\x76\xdb\xd9\x6a\xcb\x77\x16\x9b\xad\xc5\xfc\x6b\xb8\xd6\x18\x74\xed\xc7\x49\xcb\x69\xf2\xf2\x61\xd2\x6a\x96\xec\x1c\x25\x8b\x93\xe4\xd5\xf8\x5b\xe3\xee\xfb\x8d\x91\xe5\x29\xf2\x5a\x27\x36\x8c\x8d\xc6\xcf\xcd\xcd\xfc\x7c\x98\x36\x05\x50\xed\x92\xba\x4a\x9a\x35\x92\xd0\x5c\x0c\x58\x8e\x5c\x0c\xc2\x4a\xa7\x69\xfd\xf3\xc6\x99\x2b\xad\x5f\x55\xb9\xac\x53\xe5\xc8\x54\x4e\x73\xa3\xad\x0a\x08\x96\x0f\x64\xbc\x9e\xa6\x58\x96\x6e\xd3\x14\x6f\x29\x24\xaf\x41\x24\x3d\x00\x5a\x22\x52\xa0\x5b\x5d\x46\x61\xbe\xde\x16\xad\x3a\x73\x63\x5f\x9a\x45\x5f\x9d\x3b\x55\x99\xa8\x4b\x55\x50\x8e\x14\x0e\xad\xaf\x07\xb5\x78\x3f\x28\x8c\xd4\xd0\x54\xbf\x1c\xdc\xb5\xe8\xb7\x7d\x8c\xac\x2e\x18\xc6\xe5\xf9\x1a\x31\x7d\x20\xa3\x2f\xe7\xfd\x8c\xa7\x54\xb8\xad\xb6\xf9\x35\x68\x06\x07\x73\x6a\xfd\x8c\x79\x01\xce\xed\x0c\xe8\x7f\x0b\xf3\xdb\x2f\xcc\xef\xba\x2f\x6f\x52\xa2\xab\xf0\x52\x0d\x56\xd7\x53\x88\xf7\xb6\xd0\xf8\x3a\x4c\x19\xd1\x9f\x6d\x11\x85\x1a\xb1\x0d\xb3\x6b\x44\xda\x66\xec\xc2\x45\x65\xb3\xac\x73\x43\x5c\x79\xa7\xa7\xa4\xde\xbc\xbc\xa6\x87\x8b\x62\x76\x7a\x76\x86\x09\xb6\x46\xcb\x4a\xb7\xbe\x75\xf2\xd4\xc0\xa6\x1a\xe8\x65\xa1\x5c\xa8\xff\x22\x51\xf9\x94\xe5\xd1\xd2\x8c\x2d\x02\xfb\x33\x16\xcc\xc5\xad\xdb\x2c\x1f\x3a\x5d\xf8\x5f\xe3\x8a\x96\xae\xb0\xbc\x29\xe6\x34\x63\xb9\xe0\xb3\x40\x64\x7c\xfe\x7c\x5b\x01\xd4\xb3\xde\x79\x6f\xdc\x83\xfe\xd5\xc5\xe7\x15\x54\x55\x70\xba\x02\x99\x9b\x0b\x15\xd6\x96\xf6\x3b\x54\xec\x3c\xa0\xf2\x19\xf4\x86\x2f\xa0\x2d\xb1\x71\x9e\xf1\xf4\xd9\x16\xe2\xc6\xc3\xe7\x5f\x7b\xf3\x14\x85\xc6\xab\x7f\x02\x00\x00\xff\xff\xd4\xd6\xe3\x5d\xf4\x13\x00\x00")
+var _tplObjectDbWriteGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe5\x3d\x6b\x6f\x1b\x39\x92\x9f\xa5\x5f\xc1\x15\x26\x81\x34\xe9\x68\x92\xc5\xe2\x3e\x38\xf0\x05\x8e\x2d\x4f\x7c\xe3\xd8\x19\x5b\x9e\xb9\x45\x10\x0c\xda\x12\x65\xf7\x5a\xea\x96\xbb\x5b\x71\x7c\x5a\xfd\xf7\xad\x07\xc9\x26\xfb\x2d\x39\x99\xdb\xc3\x1d\xf6\xb2\x16\x9b\x2c\x92\xc5\x7a\x57\x91\xbb\x5e\x4f\xe5\x2c\x08\xa5\xe8\x45\xd7\xff\x90\x93\x74\x38\xbd\x1e\x3e\xc4\x41\x2a\x7b\x9b\x4d\x77\xbd\xfe\x01\x5a\xc5\xde\xbe\x18\xf2\xaf\x65\x1c\x2c\xfc\xf8\x11\x5b\xf0\xcb\xf0\x23\xff\xfe\x45\x3e\x3a\xdf\x8f\x03\x39\x9f\x52\x27\xd5\x30\x3c\x0e\xe2\x24\xe5\x66\xee\xf9\x45\xc6\x49\x10\x85\x06\xd2\x6f\xfc\x9b\xba\x70\x8f\x24\x9a\xa5\x53\x39\x97\xa9\x34\x9d\x2e\xa1\xe9\x88\x9a\xb2\x7e\x2f\x45\xec\x87\x37\x52\xfc\x10\x78\xe2\x87\x99\x99\x18\xbb\x53\xa7\x44\xf5\x0a\x66\x22\x8c\x52\xd1\x8f\x62\xd5\x6d\x78\x92\xa8\xe5\x67\x0d\x6a\x15\x03\x18\xd3\x9d\xad\xc2\x09\x74\x87\xfd\xff\xc8\x78\x18\x9e\xf9\x0b\xb9\xd9\x0c\xc4\xa5\x4c\xa1\x85\xc7\x70\x5b\xff\x8b\x3f\x17\xa6\xed\x67\x99\x8e\x1f\x97\xd4\xd5\x1d\x2a\xd6\xdd\x0e\xfe\xca\x8d\x16\xfb\x02\xc6\xf3\xa7\x69\x10\xa7\x8f\xc3\x0f\x7e\x7c\xd7\x77\x86\x1e\x46\xf3\xd5\x22\x4c\xf2\x43\x07\xdd\x4e\x2c\xd3\x55\x1c\x0a\xe8\xda\xe5\x9d\xca\x50\x63\x86\xff\xea\xfe\xf4\x93\x38\x42\xb0\x0a\x86\xe0\x11\x89\x48\x6f\xa5\x98\xa8\xb6\xc9\x2d\x62\x71\x0a\x6d\x71\xb4\xba\xb9\xa5\x6f\xb0\x4f\xb1\x58\xa5\x7e\x1a\xc5\x89\xf0\xc3\x29\x22\x10\x81\x21\x7d\xa4\x32\x14\x49\x10\x4e\xe4\xb0\x06\x51\xf6\xac\xfd\x81\xf8\xf4\x39\x49\xe3\x20\xbc\x41\x34\x64\xab\x56\x5b\x36\xdd\xba\x06\xf7\x0b\xf1\xe3\x1f\x0e\xc4\xa3\x77\x1f\x6e\xe2\x81\x78\xe7\xa7\x93\xdb\xc3\x58\xfa\xa9\xc4\x69\x13\x00\x9c\x9f\xb9\x1f\x84\xe9\x7f\xfc\xcd\x13\x32\x8e\x23\x18\x91\x4d\xb8\x18\x5a\xa3\x0f\xd3\xaf\xfd\x49\x14\xa6\xf2\x6b\x0a\xcd\x93\xbb\x1b\xd8\x7b\x38\xed\x0f\x3c\x5c\x57\xb2\xdd\x4a\x08\x56\xfa\x55\x68\x78\x87\xfc\xdf\x0c\xaa\xd5\x0a\x81\x42\xe7\x32\xa4\x1d\x0d\xc4\xfe\xbe\x78\x85\x8d\x7a\xdd\xaf\x3c\x11\x06\x40\x23\x9b\x6e\x67\x06\x24\xfc\x07\xc1\x45\x4a\x67\xea\xa7\x49\xb0\x3b\x00\x01\x88\xf8\x61\x31\xbc\x96\xd0\x55\xfe\x8e\xdc\x8c\x50\x61\x48\x0c\xdb\xa7\x46\x5e\xf3\xe0\x0d\x75\xfe\xcb\x3e\xc2\xa6\xe1\xd6\x74\xf0\x05\x1a\x36\x34\xa5\x62\x20\xda\xc0\xc1\x2a\x8d\xc6\xc1\x42\x1a\xee\xea\x84\xd1\x03\x4e\x88\xd0\xcf\xa2\x07\x38\xc1\xfa\x25\x22\x90\x34\x5a\x4d\x6e\xfb\x30\xd0\x13\x69\xbc\x92\x03\x33\x8b\x22\xd9\xce\xd2\x8f\xfd\x45\xe2\x21\x63\xac\x64\xa2\xf6\x83\xd8\xfe\x8d\x1a\x08\x4b\x9e\x98\xf9\xf3\x04\x07\xdf\xaf\x24\x4b\xa5\xd9\x22\x1d\x5e\x82\xd0\x09\xd3\x59\xbf\x77\x72\x76\x39\xba\x18\x8b\x93\xb3\xf1\xb9\x50\xd8\x3f\x8e\xa3\xc5\xd1\x3b\x60\xd8\x67\x80\xe3\xdf\x0e\x4e\xaf\x46\x97\xe2\x59\xd2\xf3\x04\x53\x66\x32\xfc\xaf\x28\xe0\x23\xf8\xf4\xea\x33\x32\xf2\x59\x14\xca\x93\x70\x12\xcb\x85\x0c\x53\x43\xa3\x9e\xe8\x79\x3d\xf8\x97\x57\x49\x4c\x98\xac\xe6\xa9\x97\xe1\x1e\xc4\xe8\xe8\xab\x9c\x28\x2a\x40\xca\xf0\x04\xad\x52\x6f\x69\x38\x1c\x0e\xba\xfa\xb8\xac\x13\xc8\x1d\x00\xa0\xc5\x9f\xcd\x40\x30\xcb\xa9\x81\xce\x93\x0d\x2f\xa2\x87\xe4\x40\x7d\xeb\xb7\x84\xd5\x78\x30\xcc\x8c\x17\x32\x91\x29\xc2\x74\xe8\xe9\x36\x8a\xee\x32\x42\x3a\x98\xa5\x32\x6e\xa2\x23\x67\xed\x86\x9c\x0a\x1f\x91\xb4\x59\x50\x59\x34\x2b\xe2\x95\x12\x52\x4c\xb3\x02\xe7\x4f\x44\x34\xa3\xe5\xe3\x4e\x7c\x31\xa1\xf9\x3d\x90\x4e\x62\xb5\x9c\xc2\x9f\x82\x9a\x13\xff\x8b\xec\xe6\x89\x16\x28\x27\xc0\x2e\x20\x70\x41\x98\xe1\x64\x5f\x54\x4b\x82\x10\xd7\x6b\xa2\x3d\x82\x45\x4c\x29\xfc\xeb\x28\x4e\x79\x05\xa4\x12\x87\x0d\xd2\x20\xc7\x6f\x39\x71\xe8\x89\x89\x3f\x9f\x5f\x83\x98\x21\xf4\x1d\xaa\x1f\x03\x35\xd9\xba\x5b\x81\x6b\x3d\xaa\x88\x64\x85\x46\x7d\xb6\xd5\xfb\xb5\x41\x93\xb2\x55\x5f\xfa\x45\x98\x44\xb9\x20\xf8\x47\xb8\xa8\x3e\x7c\x1c\x94\x4f\xc4\x7c\xaa\xbf\x64\xe7\x87\xeb\xce\x0e\x2e\xdb\x31\x1d\x9a\x85\x5b\xd0\xbb\x77\xdc\x27\x05\x2a\x4c\xfc\x49\x8a\xd6\x00\x74\x5b\xe0\xc9\x22\xa4\x38\xe2\xb1\x4d\x58\xd7\x98\x7a\x02\xba\x75\xcf\x3e\xee\x9e\xb8\x63\x37\xc4\x14\xb0\x61\x49\x2c\xe0\xda\x70\x0a\xe6\x05\xed\x79\x01\xfc\x1b\xbc\x8c\x41\x6e\x2a\x19\xc4\xf8\x01\x89\x86\x5f\x7d\x38\x3c\x11\x68\xa1\x83\x70\x58\x4b\x8b\x00\x08\x35\x9c\x3f\xe2\xb7\xf9\x6a\x0a\xda\xfa\xe1\x16\xd4\xf0\x43\x90\xde\x1a\x11\x85\x7d\x80\x77\x1b\x49\x35\x27\x4a\x0b\x0a\xca\xcb\x81\xbd\x8e\xa2\x39\x28\x2d\x16\x93\x1e\x74\x07\x11\x2b\xe3\x99\x3f\x91\xeb\x0d\x69\xaf\x24\xf8\x1f\x32\xd4\xd6\x6b\x50\x62\x4c\x84\x8e\xec\xcc\xd4\x05\xe0\xdd\x85\x8d\xe8\xa5\xe1\xce\xe8\x6c\xc0\x46\xab\x03\x62\x0d\xff\x4e\xf6\xb5\x25\xe1\xa1\x6c\x33\x4a\x13\x4e\xc4\x52\x17\xdc\xcf\x5a\xa6\xdb\xf9\x47\x9c\xb1\x49\x55\xa9\x69\xf7\x85\xbf\x5c\xc2\xf1\xf5\xb5\x56\x72\xd4\x0c\xea\x92\x82\x06\x41\x5d\x28\x1f\x2e\xa9\xed\x72\x1e\x4c\x64\x1f\xe7\x03\xbd\xf1\xb6\xa7\xb4\x07\x2e\xb7\xd3\xc2\x7e\x15\x2f\x11\x07\x1d\xc3\xde\xda\x56\x45\x16\x37\x38\xe4\x2e\xe5\xa8\x85\xff\x53\x68\x31\xfb\xe0\xdf\x9e\x6b\xb2\x22\x1f\x02\x36\x16\x44\x16\xe4\x0f\x0c\x7b\x64\x61\x76\x48\x6e\xf3\x0a\x24\x28\x5c\x5c\x06\x9a\x83\x66\x29\x67\xab\xf9\xdc\xbf\x9e\x4b\xab\x45\xca\xa9\x01\x98\x2d\xae\xd4\xf8\xb5\xb4\x46\xf5\x4a\xa1\x8b\x5a\x09\x2f\xe1\xbb\x6d\xcc\x6c\x61\x14\x4e\xa2\xa9\x54\x6b\xaf\x9a\x07\xcf\x99\x3b\xf6\xdb\x4c\x39\x70\x66\x6b\x80\xdd\x76\x0f\x96\x38\xb6\xff\xce\xe4\x91\x43\x99\x9a\x84\xd9\x80\xe1\xb9\x94\xb4\xf2\xe3\x9b\x15\x51\x8d\xfc\xea\x2f\x96\x73\xb9\x87\x8d\x20\x4c\xf6\x7a\xfe\xfe\x5b\x4f\x5c\xef\xbf\xed\x91\xf9\x7f\x2b\x63\xb9\xd7\x9b\xec\xbf\x25\x2a\x98\xaa\x66\x06\xbc\xe7\xb0\xdc\xba\xe7\x03\x67\xf4\xae\xf1\x9f\x09\xfe\x33\xed\x6d\xc0\xf0\x69\x10\x4e\x57\xa4\xc9\xdf\x3d\x5e\xfe\x7a\xda\x87\xe9\x3d\x9e\x51\x68\x96\x87\x65\x26\x02\xa0\x38\x12\xa8\xc6\xe0\xb7\xc0\x55\x1b\xfc\xd9\x3c\x3c\x01\x99\x67\x8d\xf6\x7f\x1e\x74\x99\xfd\xbf\xfb\x0e\xca\xad\xda\xab\x8f\x47\x07\xe3\x51\xde\xa0\x15\x97\xa3\xb1\xb2\x64\x65\xca\xc6\x20\xcf\x09\x2a\xac\xd7\x23\x76\x61\x70\xdb\x40\x13\xbf\xbf\x1f\x5d\x8c\x0c\x58\xb5\x8d\x81\xa2\xad\xf6\x16\xaf\x41\x68\x2b\x1b\x55\xb5\x94\x5b\xb9\x8d\x47\x92\xf9\x85\x62\x0b\xa7\xb0\x85\x3f\xd8\x7a\xee\x3a\x4f\x50\xb4\x72\x03\x9f\xe2\xc1\xb5\xf0\x1f\x16\x43\x36\x9b\xf9\x8c\x68\x6b\xad\x0e\xa6\xc4\x3f\x28\x58\xf2\x75\x5e\x42\x33\x02\xb3\x75\xed\x8a\xbd\x7a\x4f\x35\x73\x3d\x8d\xb3\x6a\x1c\x50\x4b\x74\x66\xd6\x46\x89\x1e\x6f\x32\x70\x58\xc7\x9b\x95\x90\xa2\xd4\x61\x30\xd6\xda\x5a\x39\xf7\x09\xc8\xd1\x35\x6e\xd1\x0f\xc0\x64\xee\x2d\x92\xe4\x7e\xde\xc3\xf0\x53\xe7\xa7\x9f\xfe\x22\xe8\xa7\xb8\xf5\x13\x11\x46\xe2\xd4\x4f\xd2\x93\x30\x91\x71\x7a\x32\x65\x3b\x31\x98\x02\x94\x20\x7d\x24\xbb\x70\x95\x2e\x57\x60\xa6\x3d\xf2\x17\xea\x07\x12\x64\x6b\x9f\xf8\xfc\x6a\xfc\xf1\x0a\xbf\x63\xb7\xd1\xd1\x30\x17\xd4\x63\x9b\x44\x69\x6e\xb6\x5d\xd9\xfc\xe9\x16\xad\x83\xb6\x9b\x5e\x46\x49\x7a\x03\xdc\xce\xfb\xbe\xdf\xd1\x8d\xa7\x3f\x2f\x46\xe3\xab\x8b\xb3\x93\xb3\x9f\x45\xcd\xba\xed\xc5\x3e\x75\x46\x03\x4b\xe9\xdf\x7c\x18\xa1\x31\x84\x90\x1f\x63\xab\xe7\x41\xb7\x85\x41\x5b\x62\x31\x2b\xe2\x6b\x67\x57\x5a\xb1\xd1\x3a\xd3\xd2\xa6\xe6\xff\x3f\x66\xdf\xff\x45\xbb\x2f\xf7\x67\x03\x37\x82\xf7\x53\xc7\x91\xd5\x32\x8a\x98\x15\x3c\xd8\xc4\x35\x01\x7e\x45\x8d\xaf\x6d\x00\xc4\xd2\xef\xe0\x8f\xa8\x38\x3b\xca\x75\xa0\xfb\xfb\xed\x43\x60\x53\x39\x93\xb1\xc0\xd9\x86\x87\xf3\x28\x91\x7d\x66\x8d\xd8\x28\x1e\x41\x8a\x80\xbd\x39\xea\x76\x86\xf3\x0f\xec\x90\xe8\x3e\x7f\xb8\x9c\xf8\x61\xff\x79\x5f\x91\xa4\x23\x23\x94\x56\x29\x89\x66\x95\x3a\xff\x65\xb1\x52\xa3\x6c\x5f\xbc\xa0\x85\xbb\x73\xc3\xf0\x2d\x43\x2e\xaf\x98\xd4\x88\x0c\xe6\xa0\x1a\xed\x3e\x55\x81\x34\x47\xb8\xb5\x33\xd3\xb6\x3f\x11\xad\x67\x4b\xc9\x8a\x48\x72\xee\xe8\x2b\x37\x74\x69\xeb\x32\x3b\xcc\xd8\x10\x89\xee\x54\x9f\x1a\xc5\x0e\xdc\x0f\x26\x0d\xd3\xb7\x97\x32\x28\x8b\x5f\x55\x44\x54\xb3\x8e\x2d\x3d\x81\x6d\xcd\x4e\x1e\xd5\x6c\x76\x82\x7b\x65\xba\x72\x2c\x72\xcb\xec\x8d\x8e\xbf\x25\x11\xc2\x32\x11\x4d\xf5\x05\x7e\xc7\x06\xd6\x9d\x94\x4b\xec\x11\xc4\x0a\x2c\x89\x3b\xe1\xcf\xe7\xce\x94\x18\x7a\x42\x58\x21\x68\x37\xf1\xe0\xdb\x6b\xf0\x53\x68\x78\x1c\x8a\x31\x74\x9f\xb1\xae\xf1\x93\x24\xb8\x09\xe1\x2b\xd8\x8f\x80\xde\xf9\xa3\xb8\x96\x09\xd8\x2f\x7a\xa5\x08\x29\x4b\x35\x81\xdb\x42\x71\x2e\x9d\x68\x02\xab\x86\xb7\x7f\x30\x9f\x03\x06\xda\xf9\x8f\x4f\x37\xc3\xc9\xd6\xd5\x31\xd3\x5c\x96\xea\x0d\x45\x92\xa8\x71\x20\xfe\xd3\xcd\xd5\xe8\x73\x65\x3d\xab\xd6\xe1\xf1\x01\x30\x18\x62\xb3\x4d\x81\x10\x78\x7b\x96\x59\xde\x92\xec\x60\xdc\x6e\x94\xa7\x27\x6c\x47\x7c\xdc\x5b\xd3\x5f\x9e\x20\x34\x89\x55\xd0\xa2\x73\xc2\x40\x4f\x60\x70\x0c\xdb\x6e\xee\x89\x47\xd9\x7c\x2c\xad\x0c\xa6\xbc\xb9\xd4\x2a\x95\xec\x1a\x55\xec\x08\xa1\x4f\xe2\xb6\xf3\xc2\xb0\x7d\xa0\x66\x69\x4e\xfd\x7a\x95\x6a\xbf\x35\xdd\xf0\xbe\xca\x83\xe7\xea\x54\x81\x54\xd9\x3e\xdd\x0e\xb1\x55\x04\x65\x00\xeb\xf8\x8a\x21\x2e\x33\x58\xd3\x17\x49\x00\x24\x9e\x9b\xe0\x0b\xc8\x80\x3c\x99\xe1\x97\x10\x16\xcb\xe2\x02\x7e\x21\x28\x90\x45\xd4\xa3\x14\x7f\x26\x21\xa4\x6a\x0f\x00\x55\x30\x04\x85\x14\x06\xe2\x75\x6c\x5d\xcb\x1c\x10\x62\x38\x85\xae\x53\xd0\xd3\x3e\xdc\x06\x93\x5b\xec\x7c\xbd\x5a\x2c\x51\xe3\xaa\x54\x12\x90\xe4\x0c\xdc\x43\x56\xe2\xf8\x9d\x71\xa3\x63\xf4\x08\x2a\x01\xd2\x87\xdf\x1a\x22\xba\x75\x8b\xe8\x0b\xb4\x44\xe1\xd0\x4a\xd7\xb7\x3f\xb8\x2d\xd8\xa2\xe5\x81\xaa\x14\xb4\xea\x5b\x9b\x85\x6e\x19\xa6\xe0\xf5\xee\x18\xa6\x58\xd9\x54\x9a\xc9\x50\xbd\xbc\xad\xc2\x49\x0d\x21\x0a\xb5\xce\x66\xce\xc9\xaf\x69\x3b\xec\xeb\xc4\x45\x09\xf2\xbb\x9d\x04\x34\x36\xec\x1b\xcc\xeb\xeb\x55\x30\x9f\xca\x18\xad\x42\x2b\x9d\xae\xb2\x40\x26\x4d\xa1\x81\x52\xfe\xe4\x21\x48\x81\x30\x55\x97\xf5\xd3\x52\x0b\xbf\x19\x06\xe9\x74\x26\x3e\x78\x2c\x3d\x23\x7b\x98\x99\x94\x23\xbd\x87\x83\x31\x50\xc1\xcc\x80\x7a\x5a\xfa\xb0\x0a\xc6\x51\xa5\x8b\xe5\x4a\xbc\xf6\xb3\x24\xa9\xdf\x62\x1a\xc7\x93\x55\x62\xb9\xdd\x1c\xbb\x3b\xb9\x6d\x7c\x5c\xcc\xca\x1d\x4c\xa7\x8a\xb9\x32\xd7\xd6\xf1\x6c\xf3\x9d\xda\xfa\x82\x1b\xb3\xfe\x5a\x87\x36\x0f\x7e\x17\x37\xd6\x71\x2f\x76\x5f\xb1\xe3\xbe\x56\xf9\xb2\xe8\xf8\xf9\x60\x99\xef\xb9\x0e\x01\x06\x6b\x48\xd0\xce\xfa\x3d\xb7\xc0\x4a\xc5\xca\x14\x1b\x3c\x03\x2b\x39\x52\x64\xd2\xd3\xfc\x33\x68\x59\xd5\x52\x1a\x2b\xd4\x05\x27\xb5\xcc\x55\x84\x55\x9a\xbc\x73\x59\x40\xe3\xb1\x82\x40\x5b\x23\xb6\x3a\x12\x60\xfd\x89\x49\x42\x5b\x0f\xda\xb3\xe7\xe7\x65\xc2\x56\x08\x7e\xf1\x7a\x50\x16\x61\xa8\x08\x12\x28\xc8\xa6\x8c\xc7\x88\x76\x4b\xc4\x7d\xb8\xbc\xfc\xf5\x74\xf8\x0e\x7f\x1d\xc4\x37\x49\x9f\xd3\x17\x0e\x25\x57\x86\x27\xda\xc0\xff\xa8\x3a\x6f\x37\x05\x00\x50\x35\x90\x8d\x13\xc0\xf2\xb1\x36\xa4\x0a\x7c\x2b\x1c\x3c\x56\xe2\x80\xd1\xdc\x42\xcf\x75\x3b\xcb\x3b\xed\xab\x00\x85\x64\x95\x99\xda\x91\x2d\x9c\xf9\xfd\x76\xa9\x25\xfc\xcf\xc1\xd9\x11\x7c\x75\x82\xc0\xfb\xe2\x2d\x67\x87\x60\x67\xcb\x3b\xc4\xc6\x31\xd0\xa5\x0f\x7a\x2b\x4b\xc7\x17\x42\x5c\xdc\xf1\x23\xc5\x3a\xfb\x03\x76\x87\x2a\x43\x6d\x36\xfd\x0d\x9a\x42\xb7\x4d\x5b\xf8\x36\x6b\xb5\x2b\xd5\xbe\x67\x84\xa5\x70\x66\xdf\xb2\x16\x0c\x35\x9d\x0e\x9f\x95\x18\x7a\xcf\xa9\x6c\xc6\x36\x6b\xd7\xe7\x54\x22\xbc\x27\x5c\xa1\x0b\x28\x05\x32\xdb\x43\x44\x11\xb9\x79\x42\xd9\x0f\x7b\x1c\x95\xeb\xbb\x27\xb8\x31\xb9\xa4\x4c\xaa\xbc\xb0\x93\x4b\x87\x73\xe9\x1b\x1b\x94\xf1\xd5\x1c\xe7\x6a\x33\xfc\xe9\x51\x9e\x4b\xff\xcb\xd6\x31\x1e\x1c\xd3\xca\xc9\x56\x1d\x55\xf2\x86\x6a\xd2\xd0\x51\x66\xf5\xc5\xf1\x1e\x74\x54\xc0\x13\x09\xe0\xab\x2e\xc5\xbe\x93\x58\x0f\x84\x35\x6f\x60\x56\xce\xc9\x0b\x02\x3b\x29\xa5\x38\x1c\xd5\x5b\x71\x5d\x37\x91\x52\xb4\x4a\x69\xac\x5b\x60\xc4\x20\x12\x95\x7c\xc3\x50\x6a\x92\x4a\x7f\x3a\xd4\xde\x11\x4c\xef\x5f\xa3\xed\xb4\x0c\x26\xaa\x66\xeb\x1a\xf4\xcf\xe4\x16\xc3\x49\x99\x9b\x86\x35\x77\x6e\x89\x5e\xbc\x82\x75\xd1\x4e\x61\xd6\x61\xb7\x56\xdd\xaa\xb9\x68\x0d\xe8\x1d\xa5\xe8\x23\x2b\x38\x41\x62\xfb\x4f\xe0\xe3\x0d\x73\x75\xce\x05\xa7\xee\x40\x77\x46\x84\x81\x27\xe7\x87\x1c\xe3\xd2\xbe\x57\x90\x90\x99\xa8\x7c\x3d\xaf\xda\x7b\xa3\xb2\x88\x70\x5a\xe2\x0a\x62\xaf\x3b\xb9\x4c\xdb\xbb\x6e\x86\x10\x9e\x9e\xdd\xac\x8c\xba\x66\x86\x68\x69\x8c\x74\x3f\x1f\xa8\x72\x32\xd6\x3a\x17\xec\xda\x0b\x19\xd3\xcd\xfc\x60\xee\xf8\x67\x78\xe4\x3c\x30\x5f\x3e\xb6\x86\x3f\x37\x75\x72\x28\x57\x18\xba\x31\xbe\x27\x4f\x52\x88\xa9\xbd\xd2\xd3\x7f\x7a\xf5\xb9\xb6\x86\xb4\x45\xc1\xf6\xd5\x12\x19\x6c\xd7\xd2\x71\x1e\xdd\xa2\x74\x1c\x48\xc7\x1d\x40\x2c\x92\x70\x75\xd9\x3c\xb8\x93\x86\xe3\x49\xcc\x63\x1c\x35\xab\x0b\xb4\x78\x58\xc7\x36\x88\x8f\x13\xc3\xc8\x1c\xa2\x2d\xb2\x32\x07\x44\x34\x33\x83\xaf\xe4\x16\xa9\x0f\xc5\x09\xc5\xe2\xec\x4b\x00\xe1\x6a\x71\x0d\xdc\xc1\x84\xcd\x93\xe8\x28\x08\xd0\xbe\x2f\x3e\xe0\xb2\x98\x37\xa0\x0f\x8e\xd0\xfd\xe6\x72\x46\xd0\x60\x41\xc3\xf6\xa8\xff\xf3\x6a\xe5\x9b\x18\xa6\xdb\x61\x44\xd9\x19\xd6\xbc\xef\x9e\x2b\x31\x5c\x2d\x59\x3c\xb7\x1e\xd0\x54\xaf\xdf\x86\x61\xcd\x32\x8d\x79\xa2\x1a\x34\xcf\x3a\x6e\xa4\x5e\xa2\xe9\xac\x1a\xb2\xce\x19\x93\x2b\xfd\x69\xed\x0a\xd7\xe6\x9a\x38\x65\x99\xb6\x36\xa2\x88\x62\x49\xbc\xce\x8c\xa1\x43\x4b\x80\x14\xef\x4f\x78\x42\x0f\xa8\xcd\x0a\x95\xd4\x95\x1b\x41\x25\x5e\x40\xff\xbc\x14\xc3\x2d\xb0\x00\x21\xa7\x37\xa3\x68\xb3\x50\x85\x81\xfc\x42\x93\x52\x79\xa7\x3b\x3f\x75\x91\x1d\xb5\xa6\x7d\x9a\xaa\x85\x1c\xcc\x4b\xe3\xa6\x7a\x7a\xd2\xca\xa6\x1e\xdb\xd2\xd1\x4a\x31\x63\x2b\x6f\x26\xab\x41\x0e\xd2\x12\xe9\x40\x5c\x5e\x2e\x20\xb0\x13\xb1\x66\x52\x26\x1e\xda\xc8\x06\x8d\xd8\xad\x25\x82\x7b\x96\xb6\x84\xf8\x16\xb7\x64\x50\x3e\xd7\xde\x91\x81\x26\xf7\x66\x83\xb1\x28\x0a\xba\x92\x91\x6c\xd4\x6c\x53\xf4\x32\x83\x5c\x71\x6b\x43\x1f\xc0\x8e\x17\x37\xea\xb7\xc6\x76\x3b\x92\xa1\x9a\x66\x30\x28\xd9\x6c\x5d\x67\xbd\xff\x8c\x12\x15\x95\x59\xd6\x6d\x52\x62\xde\x6a\x12\x02\xd5\x66\x59\xb8\x89\x51\x91\x39\xfb\x96\xc8\x37\x0c\xee\x57\x92\xac\xd8\x68\x66\x4c\x3d\x15\xd3\x0f\xe5\x17\xd4\x6c\xf0\x8f\x4d\xb1\xb8\xba\x84\x6a\x59\x11\xac\x2f\x8e\x56\x4b\x30\xff\x00\x2a\x38\x30\x44\x49\xa8\x25\x1d\x26\x68\xa4\x7b\x9d\x57\xa5\xd9\x93\x1d\xac\x5d\x6d\x36\x9b\x84\x86\x65\xf0\xaa\x8b\x01\xea\x8a\x0b\xf7\x86\x5e\x0a\x19\x18\x14\x6d\x34\x89\xc7\x1a\xbf\x26\xbb\xca\xd0\xa6\xb9\x4c\x47\x92\xc9\x01\xce\x75\x60\x7f\x9d\xec\xc8\x99\x22\x8a\xdb\x61\x09\x73\x05\xdc\x4a\x1e\x1b\x78\x1a\xe1\x1a\xd9\x8e\xb1\xdd\xde\x7c\xce\x18\xa8\xbd\x94\x28\xea\xe6\x6a\x81\xa1\xa3\xe9\xc0\x31\x3a\x4a\xbf\x6e\x9f\x17\xcc\xc2\x76\x65\x95\x66\xa6\x22\xfb\xcf\xbe\x48\xd7\x29\xd2\x42\x33\xcc\xec\x7a\xae\xe5\x9f\x6f\x4a\x2a\x24\x9b\xae\xe7\x59\x95\x95\x0d\x41\xc2\x68\x15\x4f\x64\xcd\xfd\x0e\x9d\xf2\x01\x68\x5c\xe9\xd8\xae\x6f\x9b\xc4\x89\x9a\xda\xd8\x4a\xaa\xc1\x13\xbd\x64\xd8\x7b\x91\xc5\x8c\xf5\xbc\xa6\xa3\x6a\x80\x8e\x2c\xd3\x80\x09\xad\xfe\x19\xee\xc1\xae\xef\xe7\x6a\xf4\x06\xaa\x25\xbb\x1e\x4d\xad\x6c\x88\x4d\xb5\x3d\xba\x03\x19\xb6\x4e\x4f\xbb\xd9\xe8\x41\x35\x0d\xc3\x7e\xad\xd4\x73\x8e\xb8\x2b\x42\xcc\xee\x2f\x4c\xdd\x18\xe9\x6e\xe4\x27\x4a\x21\x42\xa0\x67\xc9\x10\xca\xdf\xe2\x27\xbc\x69\x5c\x12\xdd\xfb\x30\xba\xf8\x79\x54\x5a\x97\x29\x7e\x3f\x19\xbf\x17\xfd\xf7\xe7\xa7\x47\xa7\xe7\x87\xbf\x0c\xc4\xc1\xa5\x48\xc5\xd5\x25\xd6\x82\xf6\xcd\x45\x4f\x6a\x4e\xb8\xd0\xf5\x4c\xf4\xeb\xb2\x64\xee\xd1\xe4\x33\x65\x01\xcc\xc8\xc1\x50\xda\x64\x3a\xdc\x0a\x75\x19\xe2\x18\xee\x60\x27\x5a\xc1\xea\xfc\x33\xf1\xe1\x60\x7c\xf8\x7e\x74\xb4\x5e\x3b\x5c\x4e\x8b\x4b\x6d\x4e\x2e\x59\x57\xd9\xa7\x97\xe2\xb5\xda\x93\x18\x23\x78\x15\x4f\xe5\xf8\xa9\xfe\x42\x13\x9f\x9d\x8f\xf5\xe4\xdc\x95\xab\x66\xf3\x25\xb9\xaa\xa0\xf8\x59\xf2\x86\x08\x46\x09\x8e\x7c\xd9\xab\xe2\xc8\xac\x28\x76\x27\xde\x71\x61\x2a\x4e\x72\x61\x96\x16\xea\x16\x66\x77\xbe\x66\x02\xa1\xec\x6b\x26\x05\xa8\x62\xb7\xd9\x2f\xd2\xec\x10\xca\x07\x57\x67\xea\x7a\xee\x20\x33\x05\x90\x07\xf6\x45\x0f\xb1\x7f\x72\x34\x3a\x1b\x9f\x8c\xff\xfe\x87\x2a\x4e\xce\xd3\xff\xf9\xd9\x1b\xd1\x13\x2f\xc4\x3d\xfc\x7f\x4f\xb4\x1a\x71\x7c\xfc\xa6\x57\xcc\x12\x55\xa5\x44\x16\x8f\x5a\x5a\xe3\x0e\x80\x7d\x8e\xae\x3e\x9e\x9e\x1c\x22\x75\xfc\x32\xfa\xbb\x98\x05\x31\x15\x6d\x80\xba\x7f\xb4\x4c\x32\xcf\xa9\x11\x32\xb6\x87\xae\x07\x63\x60\xa6\x44\x62\xaa\xed\x30\x1d\xf3\x53\x01\x4f\xfc\xd3\x32\x07\xbb\x9d\x9b\x95\x1f\x13\x9f\xf6\xbe\x19\x0f\x57\x70\xb0\x22\xe5\xb2\xaf\x83\x3c\x1f\x97\xc5\xec\xcb\x73\x26\x06\xaa\x03\xcf\x70\x1f\x83\xeb\x95\xaa\x6f\x4d\x3f\x56\x2c\xd3\x54\xd7\x61\xa1\x23\xfc\xe2\xb2\x19\x42\x11\x88\xf8\x69\x3e\x7c\xea\x9c\xb9\xa5\x6f\x8a\xea\xb4\xbc\xb8\xbc\x42\xab\xee\xae\xae\xd0\x42\xec\x17\x74\x56\x43\x05\xd5\x60\xc0\xd5\xcb\x65\xf9\x7e\xf8\x24\xef\xdb\x4a\x8e\xc1\xa0\xde\x82\xdb\x26\x8f\x8a\x50\xea\x2c\x40\x32\x39\x34\xca\xad\xf0\x8c\x92\x54\x8e\xb2\x7b\x86\x3d\x4e\x8e\x41\x8a\x7a\x96\x40\xf5\x04\x5f\x37\xd5\xf9\x76\x3a\xe6\xec\xa7\xb2\x3f\xd8\x00\xb9\xdf\xfd\x49\x84\x22\x83\x2b\x4d\x80\x29\xb4\x46\xf9\x59\x21\xe5\x1d\x89\x9c\x4b\xe5\x29\x52\x68\x7f\x6c\x14\x9b\xe6\x6b\xe3\x28\x07\x40\x1d\xbd\x07\x65\xdf\xcb\x87\xb5\xfe\xed\xac\xa9\xd1\x7f\x1f\x9e\x5e\x1d\xf1\xd5\x9a\x2d\x8d\x2a\x6b\xbb\x96\x5e\x46\xa9\x5f\x8d\xe6\x52\x19\xa2\x00\xbd\x00\x40\xea\x8e\x5f\x8e\x18\xaa\x2d\x07\x7b\xf9\x15\x06\x44\xaf\xaa\xf4\xa0\xd2\x12\xd4\xe9\x96\x6d\x6c\xc1\xad\x48\xf9\xf0\xfc\xec\x18\x68\x79\xfc\x44\x9b\xcf\xab\xd1\x15\x05\xa3\x4e\x1c\x9d\xe3\xec\xd9\x9d\xa4\x2d\x59\x87\x0f\xa9\x7e\x44\xcb\x6a\x0c\xa3\xba\xbf\x4d\xb2\xba\x5c\xf4\xd5\xfa\x95\x6e\xc2\xf7\xe5\x4b\x15\x0e\xb5\x4d\xb2\xec\xf5\x84\x5c\xf8\x2b\xdc\x39\xdb\x5d\x84\x45\xd7\xea\x10\x1d\x20\x29\x57\x21\xb8\x73\xaf\xe9\x25\x11\xaa\x27\xd3\x6e\x1c\xd2\xa7\x27\xfe\x9a\x7d\xd0\x91\x12\xca\x26\xc2\xf9\xbe\xa2\x37\x2a\x08\x16\x7d\xc7\x76\x0a\x75\x82\xea\x0d\xb0\xf8\x5d\xc5\x6b\x4c\xbc\x05\xb5\xb0\xa0\xb7\x28\x56\xe1\x5c\x26\xba\x78\x1f\x16\x20\x52\x39\x9f\x27\x9a\x35\xfc\x54\x60\xbc\xea\x91\x90\x88\x45\xf5\x8a\x49\x8a\x81\x83\x4c\xda\x38\x0f\x2b\x84\x98\x32\x78\x2d\x9e\x3f\x77\xd3\x23\xaf\x75\xf2\x50\xcb\x45\xdd\xf5\xaf\x3f\x66\xd1\x3b\xce\x57\x88\x7f\xfe\x13\x74\x6a\x05\x18\x93\x84\xcc\x9d\x19\xbb\xfb\x1a\xdb\x84\x68\x36\x37\xec\xe0\x39\x35\xb4\x0b\x80\x16\xcf\xcd\xb9\xd3\xf2\x5d\x6e\x1d\xfd\xc9\x44\x5d\x72\xa5\xc9\xc8\x47\x13\xe0\x04\x93\xec\x91\x0d\x66\x0a\xa0\x45\xe1\x44\x52\x02\xdf\x21\x2c\x43\x1c\xa8\xa2\xb4\x55\x58\x4b\x28\xcc\x46\x4e\x66\x03\x6f\x45\x21\xd4\xff\x4d\xd4\xd4\xdd\x74\x52\xd6\xab\xb6\x52\x17\xfe\xf2\x13\x4b\xc5\x42\x84\x0f\x29\x56\x6d\xd0\x0a\x01\xc5\x1c\x5a\xe3\x55\xaa\xcf\x1c\xff\x21\xc0\x9f\xe0\x7b\xbe\x6c\x8a\xab\x59\x3e\xf3\xe5\xad\x02\x11\x02\x96\xfe\x28\x45\xe0\x9b\x46\x9a\x6e\x71\xa3\x2b\xcf\x50\xb5\xf7\xfb\x4a\xef\xf0\xb1\xcc\x1f\xb4\xa3\x88\xda\xfc\x98\x56\xd4\xdf\x26\xe1\x59\x53\xa5\xd6\x51\x87\xa4\x8e\x44\xd7\x13\x7d\xb6\xea\x27\xab\x35\x1b\x1e\x08\x8e\xb7\x6b\x6e\x91\x9f\x42\x19\x50\x3e\xc0\x08\xf7\x10\x56\x57\x5d\x4b\xe3\x09\xdf\xf2\x5e\x35\x10\xab\x10\x05\x30\x40\x17\x92\xac\x3c\x83\x36\x12\x8a\x2c\x52\xc2\x05\x6e\xdd\xab\x49\xff\x29\x4f\x40\xa7\x4d\xa8\xf8\xaa\x90\x95\x58\xc3\xbf\x7b\x35\xd5\xaf\x60\x6d\xdc\x2a\xef\xaf\x32\x29\xd2\xf3\x4c\xa1\xd6\x80\x2b\x71\x31\x9a\x1e\x84\x2b\x69\xb1\x68\x71\x27\x8c\xdd\x6c\x2f\x48\xe0\xce\xe6\xaa\xb8\xbe\x7e\x8b\xdf\xa3\xbe\xac\x6a\x53\x4e\x7d\xb1\x8e\x72\x14\xf7\xe8\x50\x50\x69\x38\xfc\xcf\xdd\x57\x6e\xf2\x76\xfb\xab\xad\x4d\x2e\x53\x09\x15\xef\x52\xf2\x99\x3b\xad\xb9\xd9\xb4\x74\x30\x78\x30\x29\x50\x53\x4c\xa4\x64\x5a\x21\x37\x9a\x93\x6c\x20\x86\x1a\xd9\x9c\x33\x5e\x5c\xda\x65\x22\x1b\x56\xda\xd0\x4d\x1a\xea\x84\xda\x0c\xfc\x02\xfb\xab\x49\x64\xe1\x1b\xa3\x82\x1f\x19\xe5\x3c\x9b\x79\x5a\xcb\xd3\x4f\x29\x68\x40\xc5\x9c\x64\x63\x52\xdb\x18\x3c\xed\x13\x56\x35\x7a\xcd\xce\x54\x85\xd3\x20\xa5\xdc\x5a\xe3\x83\x58\x85\x87\xb3\xaa\x1f\xc4\x52\xc1\x1e\xd7\x09\xb2\x03\x3e\xe5\x02\xdd\x5a\x4d\x56\x9c\x62\xda\xc0\x4d\xe9\x7f\xef\x10\xdd\xdb\x42\x1c\x6e\x80\x7e\x6f\xe5\xeb\x5d\x25\xca\xa7\x58\xe2\xbb\xa9\x7a\x7f\xe7\x72\x74\x3a\x3a\xa4\x6a\xe2\xe3\x8b\xf3\x0f\xc5\x3c\x84\xf5\x64\x4e\xc5\x9b\x92\x85\x67\x24\x73\xfe\x9d\x85\x3b\x71\x7e\x21\xc8\xcb\xcb\xd9\xbc\xc7\x32\x9d\xdc\x9a\x77\x87\x2a\x2c\x5e\x7e\x7c\x87\x77\xdd\x50\x77\xec\x98\xa6\x5b\xda\x5a\x68\xf4\x54\x19\x5a\xc8\x99\xdb\x5a\x59\xe6\xd5\x2a\x76\x20\xb8\x82\xc0\x2a\x47\xaa\xe7\x3a\x7e\x1f\x78\xdb\x22\x5d\x1e\xd5\x5c\xa6\xab\x09\x33\x7b\x9c\x98\xb3\xeb\x66\xbc\x2d\x52\x12\xbe\xae\x86\x16\x86\xf3\x9e\xb1\x16\xaf\xfc\x54\x1f\x5e\x51\xa1\x77\x10\xb9\xe2\x55\xd7\x11\x50\xd7\x60\x9b\xab\x81\xd6\x1e\xbe\xcd\xeb\x43\x6e\xd9\x08\x97\xc4\xf0\x24\x6d\xee\xf3\x95\xa2\xaa\xe3\xa7\xb9\x0c\xb7\x5d\x0e\x96\x98\xf7\x9d\x8d\x9b\x98\x27\x17\xb0\xcf\xd2\xf6\xf5\xed\xa1\x29\x9f\x52\xea\xad\x78\x08\xfb\xe2\xb9\x9f\x16\xac\x7b\x7b\x55\xbc\xa2\x77\x8f\xd9\x32\x4c\xad\x5a\x56\xb6\x87\xeb\x64\x35\x4f\x62\x64\xb3\xd9\xa2\xca\x3f\xf7\x96\x66\xc5\x0d\x45\x85\xf9\x6e\x4b\x0e\xb0\xd7\xdb\x77\xd7\x79\x0c\xc3\xf5\x2a\x9b\x99\xa2\xb0\xf1\x52\x06\x29\xce\x80\x37\x59\x10\x0d\x3b\xac\xb7\x92\x86\xb7\xda\xc7\xf2\x0e\x8e\xef\xb9\x35\x44\x95\x26\x23\xd2\x6d\x30\x00\x1d\xc4\xdb\x6a\x02\xf2\x26\x57\xa5\xe1\x92\xae\xc1\x4b\x9e\x4c\x97\x77\x5e\x46\xd1\x8d\x37\x54\x8e\x40\x7f\x8c\x47\xe5\xca\x83\xd4\x46\xe1\x66\x4a\xbb\xa0\x9d\x28\xbf\xaa\xf2\xe4\x17\xd7\x1c\x0b\xad\x14\x33\xda\x92\x62\x9c\x58\x4f\xbb\x2a\x17\x0b\x5c\x3e\x6d\x63\xa9\x5a\x23\xbe\xd2\x8d\x12\x0f\xfd\x2d\xec\xa6\x3a\x64\x4f\x00\x53\x5a\x34\x21\xe1\x89\xfd\x1a\x8d\x2d\xe7\x4c\x8a\x94\x03\x6b\xc8\xe8\xcb\xa3\x75\x20\x54\x0a\xd7\x97\x3d\xbd\xb7\xcd\xc5\x22\x57\xae\xe4\x6c\x93\xec\xda\x54\x55\xa7\x93\x4b\x71\x76\x75\x7a\xda\xab\xbe\x93\xd4\xf8\xfa\x53\xde\x66\xa3\x6b\x7a\xd5\xef\x0b\xb1\x7b\x67\x2d\xc7\xbe\x52\x88\x66\x16\x6f\x5a\x20\xfa\xc0\xfb\x48\x91\xd5\x45\xcf\x4f\x7b\xf8\x8d\x29\xdb\x4f\x95\x4d\xb6\xe5\xcd\xa9\xef\x75\x5b\xea\x69\x8f\x06\x5e\x48\xb2\x36\xb6\xb5\x1a\xd4\xb0\x56\xb7\x7b\xb2\xbe\xe2\x9a\xac\x3d\x8e\xf4\x39\x0e\x08\xcc\x4e\x41\x62\x65\x11\xcc\x02\x7a\x31\x78\xd8\x6e\xf1\x4f\x54\xfc\x35\x71\x99\x6f\xc6\x0c\x48\xe6\xed\xf8\xe1\x7c\x5c\xc9\x13\x6d\x29\x68\x57\x61\x58\x63\x29\x90\xc3\xda\x40\x69\x5b\x1a\xaa\xfc\xca\xe9\xd3\x1e\x38\xb5\x20\x55\x93\x62\xc9\xdb\xa6\xf5\xc6\xac\x86\x07\x3b\xc5\x67\x2a\xd4\xc3\xd5\x58\xe3\x87\xff\x13\x0e\x64\xd9\xa3\xc9\x7f\x13\x45\x53\x2f\x47\xc7\xea\x75\x97\xed\x0c\xd7\xfa\x57\x54\xbf\xe1\x03\xaa\x35\x0a\xb8\xb7\xc5\xcb\xa9\x75\x7a\xdc\x72\x02\xff\x2d\x9e\x4c\x55\xb2\xba\xfb\x2f\xdc\x3f\xbb\xdb\xee\x65\x00\x00")
 
 func tplObjectDbWriteGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -314,7 +361,7 @@ func tplObjectDbWriteGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectElasticGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4d\x6b\xdb\x40\x10\x3d\x6b\x7f\xc5\x54\x98\x22\x05\x77\x73\x37\xf8\xd2\x24\x85\x16\xd2\x16\x1c\x7a\x29\x25\xc8\xd2\xd8\xdd\x20\xed\x8a\xdd\x55\xa8\x59\xe6\xbf\x97\xfd\xb0\x2a\xc7\x51\xf0\xa1\x37\x79\xde\x9b\x79\x33\xf3\x66\xed\x5c\x83\x3b\x21\x11\x72\xb5\x7d\xc2\xda\x72\x6c\x2b\x63\x45\x9d\x13\x31\xe7\x16\x6a\xfb\x04\xab\x35\x70\x22\x76\x7d\xfd\x0e\x94\xee\x8e\x04\xf6\x5c\x69\x88\x0c\xfe\xb5\xea\x90\xe8\x2e\x02\x9f\x04\xb6\x8d\x81\x35\x18\xab\x87\xda\x3a\x96\x39\xf7\x01\x74\x25\xf7\x08\x8b\x9d\x07\x43\xc5\x48\x23\x8a\xb0\xd8\x25\x8c\xdf\x6d\x3e\xcb\x06\xff\xf0\xcd\x6f\x35\xb4\x4d\xf8\x8e\xa4\x84\x47\x2d\xf0\xd5\x85\xdc\xc7\x6c\x94\xcd\xb1\x50\xfc\xa4\xff\xa4\x9a\x8f\xb2\x37\xaa\x1d\x3a\x19\xc5\xf3\xe5\x9c\x2c\x9b\xdd\xca\xfd\x5e\xc3\x1a\xde\x3f\xce\x60\x8e\x18\xb3\x87\x1e\x61\x8e\x90\xb6\x09\x8e\x65\x28\xcd\xa0\xf1\xbe\xea\x7b\x21\xf7\x60\x0e\xb2\xe6\xdf\x64\x8d\x5e\x7d\x37\xc8\x1a\x8a\x0e\xae\xe6\xca\x94\x90\xf2\x8a\x12\xba\xaa\xff\x19\xb7\xf8\x4b\x48\x8b\x7a\x57\xd5\xe8\xc8\x2b\x68\xb4\x83\x96\x33\x04\xc7\xb2\x2c\xef\xb5\xea\x51\x5b\x81\x26\x5f\xbd\xc1\x7b\xd5\x84\xd0\xd8\x3f\x23\xb2\xcb\xac\xc8\xbc\x19\x70\xe6\x06\x10\xbd\xdd\x41\xa8\xbe\x30\x68\xad\x90\x7b\x13\xf4\x4f\x75\x1e\xfa\x36\xed\x64\x73\x24\x45\xbd\x69\xf3\x29\x3f\xa4\x9b\x33\xda\x18\x8b\x63\x01\xd1\x0a\xa6\xd1\x1f\x55\x3b\xf8\x4e\x97\x63\x59\x94\x4d\x4a\x8f\xc1\xc9\x39\x9d\xfe\xf0\x30\x5d\x68\x6d\x98\x67\x83\xfa\x59\xd4\x58\x94\x50\x5c\xa5\xa7\xca\xa7\xc0\x12\x50\x6b\xa5\x4b\xef\xb3\x3f\x56\xd4\x3a\x46\x58\xd6\xf1\x93\xd3\xe2\xb7\xaa\xf0\xaa\x45\xe0\x66\x8f\x21\x13\xd6\xd0\xf1\xef\x83\x1d\x57\x96\xd4\xf8\x47\xd5\x1c\xbe\x18\x25\x8b\x8e\x8f\x27\x56\xfa\x12\x25\xcb\xa8\x64\xe3\x51\xa5\x86\x6f\x5a\x81\xd2\x16\xe5\x49\x6f\x45\xb0\xd8\x0f\x77\xbb\x3d\x9a\x5b\xf2\x87\x43\x3f\x41\x52\x7e\x48\xf3\xc8\xc8\x0b\xed\x5d\xb8\xa9\x57\x06\x80\x71\x5b\x67\xe0\xe4\x49\xbc\xec\xfe\xbc\xd0\x8b\x11\x88\xe0\xf2\x11\x98\xff\xcf\x8d\xce\xff\x0d\x00\x00\xff\xff\x7a\x14\x59\xaa\x98\x05\x00\x00")
+var _tplObjectElasticGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x56\x4d\x6f\xdb\x46\x10\x3d\x4b\xbf\x62\x2c\x38\x06\x69\xa8\xeb\x9e\x15\xf8\xd0\xc4\x76\x91\x16\x76\x82\x2a\xed\x25\x08\x0c\x9a\x1c\xda\x8c\xf8\xd5\xdd\xa5\x5d\x81\xd1\x7f\xef\xcc\xee\x92\x22\x45\x53\x11\x5a\xb7\xb9\x08\xe2\xce\xec\x7c\xbc\xf7\x66\xc8\xba\x8e\x30\x4e\x72\x84\x59\x71\xf7\x05\x43\x2d\x30\x0d\x94\x4e\xc2\xd9\x66\x33\xad\xeb\x63\x3a\x84\xc5\x39\x08\x7a\x3a\x3b\x3b\x82\x42\x66\x8d\xc3\xf4\x31\x90\x60\x3d\xc4\x4d\x90\xe1\x66\x73\x69\x0d\x57\x09\xa6\x91\x82\x73\x50\x5a\x56\xa1\xae\xa7\x93\xba\xfe\x01\x64\x90\xdf\x23\x1c\xc7\x6c\x34\x11\xad\x1b\xc5\x35\xe6\x24\x76\x36\x71\xb9\x7c\x97\x47\xf8\x97\x58\x3e\x14\x55\x1a\x99\xff\xd6\xc9\xd9\x6d\x2e\xe0\xe8\x49\x7e\x6f\x6f\x63\x1e\x35\x81\xec\xdf\xcd\x0b\x65\x9d\xb5\x69\xdf\x16\x69\x95\xe5\x36\xf9\x6c\x3e\x96\x76\x3a\x8a\xca\xf5\xbd\x24\x48\x4e\x6e\x47\x6c\x35\x5d\xd5\xeb\x12\x61\xcc\xc1\xa1\x09\xd4\x18\xe6\xaa\x92\x78\x1d\x94\x25\x01\x00\x6a\x9d\x87\xe2\x7d\x1e\x22\x67\x8f\xab\x3c\x04\x2f\x83\xd3\xb1\x30\x3e\xb8\x7b\x9e\x0f\x59\x50\x7e\xb2\x28\x7e\x4e\x72\x8d\x32\x0e\x42\xac\x37\x9c\x41\xa2\xae\x64\x3e\xe2\x40\xf6\xc9\xac\x94\x45\x89\x52\x27\xa8\x66\x8b\x3d\x7e\xcf\x92\x60\x0a\xdb\x12\x31\x39\x8c\x8a\x09\x93\x01\x03\x36\x80\xe8\xd8\x5b\x81\x89\x7e\xac\x50\x6b\xb2\x2a\x93\xbf\x9f\xe7\x63\x99\x3a\x4c\x96\x8d\x93\xcd\xd7\x2d\xde\xdd\x37\xd7\xd5\xc0\xad\x3d\xb3\x6d\x91\x61\x01\xdd\xd3\x3f\x82\xb4\xe2\x4a\xe7\x6d\x58\xd2\x8b\xbb\x6e\x0f\x3b\x72\xea\x3f\xb0\x79\x73\x20\xb5\xa6\x9f\x25\xca\xc7\x24\x44\xe2\xd7\x3b\x75\xa3\x2a\xba\x86\x39\xa0\x94\x05\x79\x13\x3a\x2c\x56\x7a\xb2\x27\xd3\x09\xcd\x76\x57\x5a\xe2\xa2\xf0\x38\xab\x67\x7c\x27\xb7\xe6\x26\x89\x38\x13\x1f\x2a\xdd\x42\xe6\xb2\x89\x37\x45\xb4\xfe\x45\x15\xb9\x97\x89\x56\x62\x3e\x87\xf0\xa9\x01\x7f\xda\x8a\xca\x15\xfc\x36\x4d\x30\xd7\x74\xaf\x57\xb4\xa1\x98\x9b\xbb\xb8\x6b\xc8\xf5\xc5\x47\x9a\x8b\xad\xc5\xdd\x37\xd7\xd8\xd2\xfa\x99\xf2\x0e\x44\xea\x99\x06\xa0\x45\x6b\x60\xec\x8c\xc4\x6e\xf5\xc3\x40\x3b\x2d\xd0\xaa\x3a\xbc\x05\xae\xfe\xec\xcc\xf2\x08\x4f\x32\xd1\xa8\x80\x37\x70\xa0\x40\x3f\x20\x44\x45\x58\x65\x94\x17\x8a\x18\x12\xad\xa0\x94\x49\x16\xc8\x35\xac\x70\x2d\x0e\xd6\x87\xc7\x01\x4f\x7b\x3e\xbe\x15\x00\xb7\xa9\x3a\x22\x61\xb1\x67\x7d\x7e\x88\x4b\x1a\x54\xb6\x1d\x9d\x43\x9e\xa4\x46\x18\x0e\x1a\x46\x9f\xa4\xba\xd5\x89\x8b\x25\xde\x45\x9c\x53\xfc\x8c\xfa\x83\x2d\xf8\x57\x5c\x13\x74\xe6\xb7\xa3\x1b\xf2\x69\xf4\xd2\x89\x68\x11\xb9\x42\x1d\x3e\x80\xc4\x20\x1a\x22\x51\xae\xe6\x10\x40\x96\x28\xc5\x03\x5a\xd0\xab\x2c\x51\x10\xe4\xe6\x5d\x75\x53\xe8\xab\xa2\xca\xa3\x4b\xee\xef\x20\x8c\x4c\x26\xaf\x5c\xc1\xb6\x56\x9e\xa4\x9e\x7f\x77\x84\x24\xaa\x2a\xd5\x2d\x5e\xbb\xfa\xa0\xa6\x5f\x42\xdb\x8c\x61\xb9\x6a\x20\xb3\x20\x31\x11\xd6\xbf\xe9\xd2\xa3\x22\x7c\xf8\xfa\x15\x3c\x43\x80\x65\xe8\xe4\x04\xbc\x23\x5b\xa5\x30\x5e\xec\xe0\x9e\x97\x45\x25\x49\xde\xd6\xd3\xf7\xbb\x6c\xd2\xc1\x1c\x4e\x06\x18\xd6\xef\xcd\x57\xc2\x02\x66\x3d\x44\x66\x73\xa0\xda\x16\xd0\xd4\xb8\x31\x4a\x18\x95\x8a\x09\xee\xf4\x32\x9d\xb8\x6f\x8c\x5e\x40\xe2\x42\xdc\xe0\x53\xef\xac\xa3\x3e\x72\xff\x42\x9a\x11\xbf\xe7\xc4\x91\x7a\x08\x52\xef\xb4\xd7\xd2\x9c\xc7\xc6\x7f\xbd\x2f\x7d\x9c\x69\x61\x5a\x8a\xbd\x19\x0d\xd0\xe2\xd5\xe3\x9c\xbc\x17\xaf\x9e\xa8\x97\xa6\x0d\x53\xa4\x6f\x7a\x71\x57\x29\xec\x9c\xef\x3b\x5d\x5e\x60\x8a\x1a\x09\xce\xac\x78\xc4\xff\x5a\x9a\x36\xd9\xae\x36\xdb\xd1\xfd\x86\x12\xed\xed\xef\x2f\xc6\x9e\x16\x7b\x92\xfb\x97\x6a\x1b\x2c\x8d\x37\x55\xba\xda\x5d\xa5\x0a\x92\xdc\x10\x71\x47\x46\xe2\xed\xcf\x0a\x15\x61\xd6\x25\x4e\x41\x1c\x24\x29\x13\x16\x48\xe4\x38\x36\x30\x46\xbc\x86\x1d\x79\xd7\xd4\x41\x72\x38\x73\x6d\x25\x9e\x29\xe1\xd3\xe7\xd1\xfd\x4b\x38\xa6\x68\x76\xa1\xf2\x19\xb4\x1f\x77\x74\xdb\xcc\xd5\xed\xe8\x86\x7e\xbd\x7f\x3d\x4f\x27\xa6\xf3\x67\x04\xc2\x45\xbe\x84\x3c\xa6\x93\x98\x7a\xb9\x35\x23\xc8\x79\xec\x17\x94\x69\x9c\xcb\xe1\xf4\xe2\xa7\x88\xf4\xe1\x5e\xb7\x34\xe6\x2d\x3e\xbf\x59\x42\x3c\xff\x1b\x2f\x8d\x8b\x22\x34\xef\x8b\x66\x36\x7b\xca\x37\x19\xb6\xb2\xdc\xfb\xb2\x6a\x1e\x6d\x2d\x5c\x87\x61\xd5\x1b\x08\xce\xe6\xf0\x3b\xd2\xda\x33\xfb\xca\x0e\xff\x1e\xb1\xb9\x9d\xc0\xb1\xc8\x41\xb1\xd4\x86\x5b\xc1\x84\x61\xef\x56\x81\xff\x50\x7b\xed\xe6\x60\xed\x3d\xbb\x3c\x9c\xee\xc8\x63\x54\x76\xff\xa3\x72\xca\xd5\x56\x38\x5c\xf4\x3e\xdd\xd8\xde\x7a\xc2\x69\x97\xd3\xf7\x90\x47\x5d\xdb\x4f\xf7\xbf\x01\xa2\x2a\x9f\x8d\x59\x0f\x00\x00")
 
 func tplObjectElasticGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -334,7 +381,27 @@ func tplObjectElasticGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectFunctionsGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xd3\x30\x10\x3d\x37\x5f\x31\x8a\xf6\xd0\x20\xf0\x07\x20\xed\x69\xd1\xc2\x0a\x51\xad\xd4\x15\x17\xc4\xc1\x8d\xa7\x5d\x6f\x53\x3b\xb2\x5d\x68\x35\xf2\xbf\xa3\x89\x9d\x4d\x5b\x9a\x20\x04\xa7\xc4\xe3\xf1\x9b\x37\x6f\xde\x10\x29\x5c\x6b\x83\x50\xda\xd5\x0b\xd6\x41\xac\xf7\xa6\x0e\xda\x1a\x5f\xc6\x58\x10\xdd\xd8\xd5\x0b\xbc\xbf\x05\x91\x4e\xad\xd3\x3b\xe9\x8e\x1c\xe1\x1b\xf1\x98\xce\x9f\xf1\x78\x76\x7f\xaf\xb1\x51\x5d\x52\x0e\x88\x7b\xed\x7c\x48\xe1\x18\x0b\x2e\x02\x73\x86\x7e\x43\x24\x16\x72\x87\x31\x56\xf0\x11\x03\xff\x2e\x5b\x59\xe3\xbc\x02\x1f\x9c\x36\x1b\xa0\x62\xe6\x30\xec\x9d\x81\x92\x48\x3c\xca\x7a\x2b\x37\x18\x63\x59\xc4\x62\x1c\xe7\xae\x91\xde\xf3\x79\x0c\x27\xe5\x4e\x83\x3c\xc9\x55\x83\x53\x20\x1f\x56\x5d\xca\x1f\xc9\xd8\x66\xbf\x33\x7e\x5e\xc1\xb7\xef\x03\x4e\x9d\xa2\xac\x52\x1f\xa6\x62\x46\xf4\x0e\x9c\x34\x1b\x84\x9b\x75\x2f\xa2\xe8\x74\xf3\x31\x16\xb3\x32\x4d\x64\xa8\x2c\x88\x52\x62\x4a\xca\x6d\xbd\x4d\x40\x68\x14\x3f\x8a\xaf\xa4\x73\xcd\x49\xb6\x0b\x6b\xf0\xc1\xd4\x0e\x77\x68\xfe\x9d\xfa\x19\xda\x79\x1f\xff\x97\xf6\xe0\xc4\x79\x05\xc3\x81\xe9\xb6\x5b\xa6\xf2\x9a\xfe\x65\xe3\xc4\x02\x7f\x9e\x3e\xb8\x4e\xfe\xc4\xbc\x3d\xef\x76\x3b\x08\x9e\xd0\xe0\x16\x78\x20\x17\xd1\xb3\x46\x72\x17\xed\x76\xbc\x81\xaf\xb2\xd1\x4a\x06\x76\x1a\x3a\x67\x1d\xd3\xfe\x91\x63\x4c\x25\xff\xdb\x8e\x39\xf3\xcd\x98\x7d\x8e\x58\x06\xb7\xaf\x03\xe3\x56\x5c\x85\xab\xeb\x35\x64\xaf\xdc\x59\x13\xa4\x36\x1e\x4a\x87\x4a\x77\x9b\x3d\xaa\xe3\x83\x51\x78\xc0\xcb\x89\x6b\x75\xf8\x3b\xa3\xf6\x04\x92\x28\x9f\xa4\xef\x70\xf9\xe6\x64\xf6\x57\xc7\x7e\xd5\x01\x5a\x1d\x26\xa7\xbf\x0c\xd6\xe1\xd3\xb1\xbd\xbe\xaa\xcf\xd2\x3f\x4f\xaf\x68\x36\xc3\x6f\xcb\x9e\xac\xc3\x2a\x5e\x58\xec\x64\xaa\x22\x05\xb2\xea\x89\x3a\x51\xfa\xfe\x0a\x00\x00\xff\xff\x88\xe1\xc9\x2f\x5f\x05\x00\x00")
+var _tplObjectEnumGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb5\x95\x4d\x4f\x1b\x31\x10\x86\xcf\xd9\x5f\x31\x44\x40\x6d\x14\x0c\x6a\x6f\xb4\xe9\xa5\xe2\xd0\x4a\x05\xa4\x50\x2e\x51\x54\xed\xc7\x2c\x18\x25\x5e\x6a\x3b\xdb\xa2\xd5\xfe\xf7\x8e\xed\xdd\x8d\xa1\x49\x38\x55\x8a\x94\xd8\x1e\xbf\xf3\xcc\x3b\xb6\xd3\x34\x05\x96\x52\x21\x8c\xab\xec\x11\x73\x2b\x50\xad\x57\xe3\xb6\x4d\x9a\xe6\xb0\x94\xb8\x2c\xe0\x62\x0a\x22\x8c\xed\xf3\x13\xba\x61\x58\x10\xb7\x34\xa4\x85\xb3\x33\xe8\xd6\xda\x16\xa4\x01\xfb\x80\xe0\x44\xa0\x2a\xa1\x17\x11\xd7\xd9\xa3\xb8\x4a\x57\x14\x22\x86\xb9\x30\x9e\x80\xb1\x95\xc6\x02\x52\x03\xd2\x1a\xa8\xd3\xe5\x1a\x69\x4e\x4b\x75\x2f\x12\x9f\x32\x92\x57\xf6\xc3\xfb\x24\xc9\x2b\x65\x2c\x30\x62\x3a\x05\x9d\xaa\x7b\x84\x43\x39\x81\xc3\x3a\x82\xbb\x24\x82\x3b\x27\x65\x08\x71\x44\x0a\xb5\xf8\xe2\x76\xb5\x6d\xd3\xc8\x12\xf0\x17\x6d\x81\x73\x92\xdc\x88\x4f\x41\x56\x36\x6d\x1a\x54\x85\xaf\xf7\x14\xc2\x2f\x9e\x24\x75\xaa\xe1\xe7\x10\x19\x74\x29\x7e\xbe\x08\x9c\x4d\x4c\xb2\x9b\x62\xec\x31\xfc\xb8\x6d\xc7\x93\x28\x45\x9b\x24\xe5\x5a\xe5\x70\x93\x6a\x83\x43\x1e\x66\x3a\x1f\x38\xb0\x61\x72\x02\xa8\x75\xa5\x39\x34\xc9\xa8\xac\x34\x50\xe1\xc1\x32\xca\x1a\x08\xfe\x01\xa5\xc8\x11\xd5\x1c\xc2\xa6\x53\x08\x33\x23\x8d\x76\xad\xd5\xc6\x00\x26\xf9\x04\x94\x5c\xd2\x1a\xc1\xd2\xa7\x0b\x38\x9f\x40\xb9\xb2\xe2\xd2\xa5\x2d\xd9\x58\x2a\x12\x92\x45\x64\x9c\x17\xbe\x80\x23\x33\xa6\x66\xf2\xa1\x18\x16\x75\x8e\xc3\x57\x73\xe7\xb6\x31\x0e\x59\x55\x2d\x1d\x41\x27\x8f\xf0\x79\x0a\xe7\x70\x7c\xec\xba\xcb\x90\xc3\x27\x58\xa2\x62\xaf\xab\xd8\xa5\x3b\xf3\x0e\x91\x6c\xb0\xca\x09\x53\xad\x07\x28\x36\x09\x5d\xb5\x5d\x32\x57\xc8\xec\x89\x02\x2d\x55\xb2\xa9\xfc\xa8\xe0\xc4\xee\x4f\x17\x11\xf0\xb8\xfa\xd7\x1c\x73\x5c\xec\x20\xf9\x4e\xcd\x7b\x48\x97\xb7\xf8\xc7\x52\x52\x36\x5f\x64\xcf\x16\xe3\x76\xed\xe1\x22\xdb\xdf\x70\x99\xfc\x2d\x76\x31\x86\x54\x6c\x0b\x6a\xd7\xd1\x08\xf8\x24\x22\xfe\xa1\x56\x11\x73\xd6\xe9\xf0\x80\xec\xf0\x6a\x8f\xef\x8e\xd6\xeb\x93\x19\x5c\xcf\x1c\x87\xbb\x4e\x14\x74\x30\x75\xa9\xe2\xa2\x68\xd6\x53\x9e\xd0\xa9\x83\x3a\x89\x6a\xdd\xef\xe0\xb7\xd9\xf5\xd5\x76\x07\xb3\x81\x07\xc5\x0b\xbb\xf7\x50\x78\x6b\x7b\x94\x6e\xee\xd1\x54\xaa\x17\x88\x6b\x79\xcb\x27\x4f\xb6\xcd\x27\x7a\x1f\xfa\xcb\x3a\xa0\x10\xa5\xcf\x33\xec\x66\x84\x7f\x6c\xf8\xc7\x37\xec\xea\x87\xe2\x65\x7b\xba\x26\x1b\xbe\xeb\x26\xf8\xa6\x3b\xdf\x0a\x2d\x6b\xd4\xe1\xa1\xf9\x1f\xee\x0d\x86\xed\x3d\x5c\xb3\x3c\x55\xcc\xe8\xdc\x1d\x59\xd4\x65\x9a\x63\xd3\x46\x96\x99\xdf\xd2\xe6\x0f\xe0\x5f\x4b\x8a\x12\xcc\xed\xf3\x98\x79\x6a\xfa\x3f\x80\x8b\xc8\x9d\xed\x76\xd4\xee\x04\xfa\x1d\x61\x62\xcf\x8e\xfa\xc5\x9d\x89\x2f\x1b\x91\xbe\xb3\x60\xe8\x0b\x8e\x6e\x1d\x6f\xb5\xf1\xd5\xbd\x69\x3a\x77\x9e\xf7\xff\x0c\x7f\x01\xfb\xf4\xac\xeb\x33\x07\x00\x00")
+
+func tplObjectEnumGogoBytes() ([]byte, error) {
+	return bindataRead(
+		_tplObjectEnumGogo,
+		"tpl/object.enum.gogo",
+	)
+}
+
+func tplObjectEnumGogo() (*asset, error) {
+	bytes, err := tplObjectEnumGogoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/object.enum.gogo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplObjectFunctionsGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x55\x5d\x6f\xda\x30\x14\x7d\x26\xbf\xc2\x8a\xaa\x0a\x2a\xe6\xbe\x4f\xea\xc3\xd4\xaa\x1b\x9a\x86\x2a\xc1\xf6\xb0\x6a\x0f\x26\xb9\x80\x47\x62\x47\xb6\x33\x8a\xd2\xfc\xf7\xf9\xda\x09\x21\x90\x64\x9d\xb6\xa7\xd8\xd7\xc7\xe7\x9e\xfb\x91\xeb\xa2\x88\x61\xcd\x05\x90\x50\xae\x7e\x42\x64\xe8\x3a\x17\x91\xe1\x52\xe8\xb0\x2c\x83\xa2\xb8\xb2\x66\xf2\xfe\x8e\x50\xbf\xcb\x14\x4f\x99\x3a\xa0\x05\x4f\xe8\x93\xdf\x7f\x86\x43\xeb\xfc\x91\x43\x12\x3b\x50\x65\xa0\x8f\x5c\x69\xe3\xcd\x16\x89\x4e\xc8\x18\xa9\x6f\x8a\x82\xce\x59\x0a\x65\x39\x21\x1f\xc1\xe0\x72\x91\xb1\x08\xc6\x13\xa2\x8d\xe2\x62\x43\x8a\x60\xa4\xc0\xe4\x4a\x90\xd0\x62\x9f\x58\xb4\x63\x1b\x0b\x0f\x83\x32\xe8\xe7\xb9\x4f\x98\xd6\xb8\xef\xe3\xf1\xd8\x61\x92\x25\x5b\x25\x30\x44\xf2\xb0\x72\x90\x3f\x8a\x91\x49\x9e\x0a\x6d\x59\x9e\x7f\x34\x3c\x91\xb7\x62\x96\x6a\xb3\xb5\x16\xc5\x3b\xa2\x98\xd8\x00\xb9\x5a\xd7\x49\xa4\x2e\x6f\xda\xe6\x6d\x14\xfa\x8a\x34\x9e\xa9\x35\x38\xa0\x07\x55\x61\x4d\x3d\x11\x88\x18\x2f\x95\x47\xd1\x95\xcf\x41\xb5\x73\x29\x60\x26\x22\x05\x29\x88\x7f\x97\xde\x62\x6b\xc7\xf1\x7f\x65\x37\x9d\x68\xc5\x36\x1b\x94\x9b\xed\x50\xca\x11\xfe\x65\xa3\xe8\x1c\xf6\xa7\x17\xba\xc5\x9f\x34\x6f\xad\x3b\xdb\x35\x09\xf7\x6c\xe4\x8e\x60\x41\xce\xac\xad\x40\xaa\x28\xb2\x5d\x7f\x00\xdf\x58\xc2\x63\x66\xb0\xd3\x40\x29\xa9\x50\xf6\xaf\xca\x86\x52\xaa\xb5\x74\xca\x51\x6f\xc5\x59\x63\xe8\xc2\xa8\x3c\x32\xc8\x3b\x41\x2f\xe8\x9d\xaf\xfd\x3f\xfa\x21\x37\x72\xc9\x53\x38\x46\x11\xdc\xde\x12\x23\xf3\x68\x6b\xbb\x9a\xa5\x99\x26\x66\x0b\x84\x59\x54\x9e\x21\x97\xb1\x58\xe2\x82\xd1\x53\xc2\x44\x7c\x3c\xb6\x55\x6c\x1f\x23\x91\x36\x3c\x49\x48\x2e\x34\x18\xb2\xdf\x82\x2d\x96\x43\x11\xae\x89\x35\xd1\x9e\x78\x9d\xfb\xb1\x90\x7b\x82\x74\x14\xe5\x4d\xeb\x9b\x2b\x29\x93\x09\xe9\x69\xa8\xee\x88\x46\x75\xbc\xbe\x06\x33\x8d\x88\xaf\x2e\x1a\xc4\x21\xe2\x02\x32\xcf\x93\xc4\xff\x43\xc1\xa8\xa3\x82\xb6\xae\xc2\xa6\xfa\x28\xcf\xe6\xfc\xa6\x07\x26\xf7\x15\x3d\x24\x7a\x88\xae\xc1\xf9\xbe\xa8\xaf\xf4\xca\xb2\xf6\x2a\x27\xd7\xd7\x2e\x87\x97\xac\x96\x96\x27\xe4\xf5\xb5\xab\x09\x2d\xdb\x77\x50\x72\x3c\x71\xd9\x7c\x63\x90\x83\x51\x9e\x88\xbe\xd0\x37\x24\x60\xc8\xff\x29\xef\x49\x5e\xdc\xb2\x0c\x9a\x75\xab\xa7\x1f\x56\xf7\x52\x18\xc6\xed\x10\x0a\x15\xc4\xdc\xbd\x56\xbd\xb3\x61\x26\x62\x78\x81\xf3\x29\xc6\xe3\x97\xbf\x1b\xbe\xed\x0e\xfa\xc4\xb4\xe3\x75\xcd\x15\x9e\x05\x76\x36\xca\x3a\xa7\x9a\xf5\x3f\x38\xd1\x16\xf6\x77\x87\xe5\x21\xeb\x7e\x7e\xb6\x4c\x6f\xc3\xb7\x4c\xc4\x8b\x07\xcc\x8f\x43\xcc\xe2\xd9\xd8\x3c\x99\x54\xd4\x1b\xaa\xac\x7b\xe9\x45\xe1\xbf\xbf\x01\x20\x5b\x78\x78\x33\x08\x00\x00")
 
 func tplObjectFunctionsGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -354,7 +421,7 @@ func tplObjectFunctionsGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\xd1\x4f\xdb\x3e\x10\x7e\x8e\xff\x0a\xff\x2c\xf4\x53\x8b\xc0\x91\x26\xed\x61\x48\xbc\x0c\x04\x42\xd3\x18\x62\x8c\x57\x70\x9a\x4b\x66\x1a\xdb\xc1\x76\xba\x65\x56\xfe\xf7\xc9\x76\x9a\x36\x34\x9d\x60\x7b\xea\xf9\xbb\xfb\xbe\xbb\xeb\x9d\x1d\xe7\x72\x28\xb8\x04\x4c\x54\xf6\x04\x0b\x4b\xba\xae\x66\x8b\x25\x2b\x01\x3b\x47\x2f\xd5\x4d\x3c\x74\x1d\x72\xee\x40\x65\x4f\xf8\xe4\x14\xd3\x78\xd2\x50\x31\xcb\x95\xf4\x90\x77\xd1\xdb\x1e\xe8\x3a\x84\xb8\xa8\x95\xb6\x78\x86\x12\x52\x08\x4b\x50\x42\x2c\x17\xe0\x7f\x8d\xd5\x5c\x96\xc6\x9b\x39\xb3\x2c\x63\x06\x52\xf3\x5c\x11\x94\x38\x77\x8c\x79\x11\xb5\xce\xb3\x33\x25\x2d\xe3\xd2\x60\x02\x15\x33\x96\x2f\x48\xd7\x79\x7a\x2b\x17\x7d\x2c\xc8\xdc\x43\xfb\x68\x1a\x72\x6e\x22\x09\xb4\x56\xda\x8c\x68\x28\x21\x25\xb7\xdf\x9b\x8c\x2e\x94\x48\xe1\x57\xd6\xb4\x69\x60\x1c\x2b\x2d\x52\xa5\x85\x2f\xb0\x54\xf5\xb2\xa4\x5c\xa6\xa5\x3a\xae\x2b\xd6\x96\x5a\x35\x32\x4f\x57\xac\xe2\x39\xb3\x4a\xd3\xd5\x87\x57\xd6\xdd\xdb\x78\x23\xa9\x2a\xbe\x02\x0d\x69\xef\xa1\xab\x77\x6f\x6d\x2b\x58\x5b\x8a\xe1\x4c\x57\xef\x47\x3a\x73\xb4\x62\xda\xcf\xe1\x01\x9b\xe7\x8a\x9e\x7f\xf4\x96\x9f\x05\xbd\xe3\x02\xfc\xa1\x10\x96\x5e\x28\x2d\x98\xb5\xa0\x43\x5c\x9c\x10\xbd\x05\x96\x47\x44\x69\x41\xef\xbf\x82\xf5\xf6\xa6\xf9\xfb\x68\x01\x9a\x23\xe4\x1c\x2f\xb0\x54\x16\x0f\x6b\xe1\x2b\xb4\x6d\x1d\xf6\xe8\x9a\x09\xe8\x3a\x2f\xdc\x2c\x2c\x76\x28\xd9\xdb\x9d\x50\xb2\x54\xa1\xbb\xe4\xea\x1c\x27\x99\x51\x92\x7e\x09\x9b\x79\x95\xe3\x47\x7f\x3c\x21\x0f\x3c\x3f\x52\x82\x5b\x10\xb5\x6d\x09\x7e\x0a\x20\xcf\xc9\x63\xaf\xdb\xff\x81\xc1\xd6\x4c\x96\x80\x0f\x0a\x0e\x55\x1e\x76\xf7\xc2\x5b\xa6\xf7\x47\x7c\x5d\x1e\x1e\x80\x4b\xb0\x77\x6d\xed\xb1\x11\xc4\xca\x41\xb7\xcf\xb1\x99\x93\xd2\x78\xb6\xdb\x4d\xeb\x37\x7b\x3e\xe5\x31\xd1\xe3\x45\xfc\x80\xe2\xed\xea\x2b\x39\x53\x55\x23\xa4\xc1\xa7\xfd\x3f\xe6\xfe\xa6\x99\x38\xc5\x17\xe5\xbe\x46\x89\x0c\x52\xb1\x8e\x28\x48\x8e\x26\x3b\x5f\x5f\xa6\x30\xe9\x87\x51\x17\x9f\x4b\xbd\x35\xf1\xa9\x3e\x7d\xc4\xe1\x0e\x09\xa1\xa4\x68\xe4\x02\xcf\xc4\x84\x73\x8e\xaf\xe1\xc7\x08\x9c\xcd\xf1\xe1\x08\x08\xfb\xa5\xc1\x36\x5a\xe2\xff\x47\x1e\x17\xea\x46\x49\x9a\xfe\x87\xe3\x73\x87\x7d\x26\xbf\xad\xbe\x1b\xbf\x50\x15\xb3\xc3\x5b\x48\xd7\x4e\x43\xc2\xa2\x76\x6b\x6e\xad\xb9\x60\xba\xc5\x4b\x68\x27\x79\xbd\x9f\x2e\xa1\x8d\x4c\x7a\x13\x91\x4f\xd0\x0e\x22\x8d\xe4\xcf\x0d\x18\xb4\x3d\x0f\x7e\x84\x0f\x22\x3e\x3c\xaa\xdf\x62\x58\x7c\x11\x76\x32\xc5\x60\xb2\x66\x75\x2f\xa6\xe2\xf3\x70\x99\xc3\xcf\x89\x3c\x01\x1f\xd2\x5c\xc5\xa8\x3d\x69\x42\x2c\xe9\x39\x53\x49\x82\xee\x6e\x0e\x5d\x6e\x3e\x0e\x21\x62\x8f\x7e\xa0\x10\x1f\xff\x52\xfc\xdf\x6f\xd7\x44\xba\x3c\x1b\x06\x3a\x95\xec\x0f\x4f\xee\x54\xed\xc1\xf9\x26\xbd\xcd\x23\x37\xa1\x17\x9d\x6f\xd2\xdb\xfe\xd0\x4c\x28\xae\xdd\x13\x9a\xce\xf5\xda\x1b\xeb\x77\x00\x00\x00\xff\xff\xb2\x92\xbf\xc4\x12\x08\x00\x00")
+var _tplObjectGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x56\xdd\x6f\xd3\x30\x10\x7f\x6e\xff\x0a\x63\x4d\xa8\x45\x9b\x23\x21\xf1\xc0\xa4\xbd\x6c\x05\x34\x10\x63\x1a\x83\xd7\xcd\x89\xdd\xe0\x2d\xb1\x8b\xe3\x14\x42\x94\xff\x9d\xf3\x47\xd3\xa6\x75\xa7\x8d\x07\xf6\xb0\xc5\xbe\x8f\x9f\xcf\x77\xf7\x3b\xb7\x6d\x19\x9f\x0b\xc9\x11\x56\xe9\x1d\xcf\x0c\xee\xba\x05\xcd\xee\x69\xce\x51\xdb\x92\x0f\xea\xd2\x6f\xba\x6e\xdc\xb6\x07\x60\x82\x8e\x4f\x10\xf1\x3b\xcd\x0b\x6a\x84\x92\x56\x64\x55\xe4\x2a\x08\x40\x3d\x16\xe5\x42\x69\x83\x26\xe3\x11\xce\x94\x34\xfc\xb7\xc1\xb0\x9c\x97\xee\x63\x44\xc9\xed\xb7\x32\x5a\xc8\xbc\xb2\x4b\x46\x0d\x4d\x69\xc5\x93\xea\x67\x01\xfb\xb6\x3d\x42\x62\xee\x61\xdf\xc9\xba\x7c\x2f\x78\xc1\x2a\x00\x1e\x5a\x26\x4c\x8b\x25\xd7\xc1\x81\x4b\x66\x2d\x82\xaf\xd2\xdb\xee\x68\x42\x25\xf3\xc2\x8f\x5f\xbf\x5c\xac\x84\x4e\x30\x4b\xcf\x20\x4c\x2a\x64\x85\xb0\xe6\x4c\x54\x78\x3a\x8d\xa8\xe0\x86\x95\x11\x19\x9e\xba\x50\xb8\xcc\x14\x83\x1b\x24\x77\x95\x92\xd1\x20\xf6\x02\x38\xff\xaa\x91\xd9\xc0\x0d\x64\xb9\x30\x3f\xea\x94\x64\xaa\x4c\xf8\x9f\xb4\x6e\x12\x17\xcd\x91\xd2\x65\x02\x7f\x78\xf3\x7a\xbb\xe1\x95\x8d\x4d\x5f\x2c\xf0\xb2\xda\xa7\x59\xa8\xca\xe4\x9a\x57\x51\x25\x38\x09\xc3\xc3\x75\x1f\x0e\xcd\xd6\x23\xad\x45\xc1\xb6\xeb\x81\x73\xb5\xb8\xcf\x89\x90\x49\xae\x8e\x16\x05\x6d\x72\xad\x6a\xc9\x92\x25\x2d\x04\x14\x53\x69\xb2\x7c\x8b\x1f\x97\xb1\xb0\x46\x6b\x48\x55\xd8\x06\xe0\x49\xd0\x90\xe5\xeb\xc7\xd5\xc1\xd7\xd8\x5a\xb8\xd5\x06\xa2\xdb\x93\xe5\x9b\x5d\x1c\x4d\x25\xd0\xe2\x00\xec\xfa\x96\x3f\x77\x6d\xee\x1b\x13\x28\x01\xaa\xae\x1b\x38\x4e\xc7\x4b\xaa\x2d\x0d\x6e\x50\xe0\x01\x39\xf3\x5f\x2b\x82\x94\x91\xd9\xa9\x5d\x59\x4a\x90\x6b\xf8\x67\x37\x40\x13\xf2\x1e\x52\x4a\x8d\xe1\xda\xd9\x79\xa2\x00\xc3\x28\xf3\x12\xd0\x92\xef\x5f\xb9\x43\x59\x27\xf2\xbb\x5f\xf1\x67\xec\x12\x77\xa9\xd0\x07\xe4\xd4\x7f\x87\x09\x81\xd9\x01\x91\x49\x65\x50\x3f\x42\xac\xa3\x69\x16\x6e\xe6\x5c\xd0\x12\xc6\x8d\xbd\x72\x9d\x19\xd4\x8e\x47\x7b\x6b\x58\x2a\x99\x2b\x57\xc3\xd1\xf9\x0c\x8d\x52\x20\x21\xf9\xe2\xa6\xd8\x39\x43\xb7\x76\x7b\x8c\x6f\x04\x3b\x54\x25\x44\x57\x2e\x4c\x83\xd1\x9d\x13\x0a\x86\x6f\x03\x6e\x28\xef\x66\x7d\xe7\x76\x2e\xb8\x39\xb7\x9e\x3a\xa0\xf7\xf2\x55\x78\xa8\x17\x7c\xe0\xe6\x1a\x42\x07\xd9\x40\x44\xf3\x1e\x77\xf3\x0c\xb8\x87\x1d\x42\x13\x98\xb8\xe1\x3e\xd7\x34\x2d\x60\xfc\xda\xc4\xfe\xdf\x6a\xb9\x72\x8d\x98\xd0\xa6\x71\xed\x34\xb3\xab\x61\xc8\xdd\x33\x35\x12\xb2\x87\x5b\xe2\xf8\x47\x27\x24\xfd\x4c\x15\x75\x09\x66\x27\xa1\x39\xda\x7f\xa9\x9b\xa7\xd2\xd6\x35\x1f\x83\x84\x7b\x28\x1f\x87\x07\xc4\x87\xd1\x8c\xad\xc6\x79\x0c\x34\xf2\xa8\xb5\xad\x6d\x50\xe0\x42\xff\x0e\x13\x0e\x06\x38\x78\x75\x5b\xa8\x8e\x2a\x37\x83\xdc\x7c\xce\xf5\x06\x65\x62\xd9\xb3\x16\xaf\x76\x9c\x00\x6d\x5e\xcb\x0c\x4d\xca\x88\x72\x8a\x2e\xf8\xaf\x81\x70\x32\x45\xaf\x06\x82\x5d\x82\xf2\x39\xad\x0b\xb3\x91\x38\xcd\x4d\xad\x25\x7a\x39\xf0\xb3\x6e\xfb\xb3\xb3\x0b\xb2\x5d\xc8\xe3\x35\xdb\x82\x31\xcc\xbe\x1a\x14\x87\x2b\xe0\x15\xeb\x7a\x16\x16\x15\x7f\x20\x9e\x6e\xbb\x8e\xe3\x51\x92\xbc\x40\xbe\x1a\xc8\xe6\xc8\x0e\xaa\x68\xa9\x56\xca\x0a\xbb\xe8\xbb\x95\xef\x42\x8b\x92\xea\x06\xdd\xf3\x26\xea\x17\xf4\x04\xf4\xde\x93\x5c\x7a\xc9\x27\xde\xf4\x20\xb5\x14\x3f\x6b\x5e\x0d\x5a\x49\x1c\xa2\x03\x2f\xef\x33\xf6\xcd\x9b\xed\x69\x26\x6f\x8c\x57\x5e\xdb\xfd\x64\xcf\x11\x92\xf1\xdf\x91\x73\x9c\x7c\xfd\xde\x79\xab\x3d\xc7\x38\x5b\x1c\x7c\x62\x87\x38\xdc\xdd\x33\xf4\xfa\x41\xbd\x72\x16\x7b\xf0\x9d\x0b\xb6\xf6\x5d\x84\x67\xcf\x33\xa5\x22\x61\xb2\xb4\x6f\x84\x58\x90\x0f\xfc\x16\x89\xdd\xd9\x29\x9f\x84\xb7\x7e\x17\x23\x78\x5e\xf9\x24\xbc\xcd\x5f\x60\xb1\x49\x15\xd4\x11\xcc\xb6\x0d\xd8\xeb\xd5\x5f\x92\x16\xfc\xd4\x71\x0c\x00\x00")
 
 func tplObjectGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -374,7 +441,7 @@ func tplObjectGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectIndexGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x6f\x6b\xdb\x3e\x10\x7e\x6d\x7f\x8a\x43\xf4\x07\x56\x7f\xae\xe9\xde\x96\x65\x83\xad\x6b\xe9\xda\xae\x7f\x32\xd8\xa0\xf4\x85\x13\x9f\x3b\x05\x5b\xca\x24\x05\x16\x8c\xbf\xfb\x38\x49\x4e\x6c\x67\x4d\x3b\x18\xf8\x85\x74\xba\x7b\xee\xb9\xe7\x4e\x72\xd3\x14\x58\x0a\x89\xc0\xd4\x6c\x81\x73\x9b\x09\x59\xe0\x2f\xd6\xb6\x71\xd3\x1c\xb8\x35\x9c\x4c\x20\xf3\x7b\x35\x5b\xb8\xdd\xcd\x6c\xe1\x0d\x4b\x2d\xea\x5c\xaf\xc9\x48\x87\xd9\xad\xdf\x5f\xe2\x7a\x70\x7e\x26\xb0\x2a\x9c\x53\x30\x64\x67\x42\x1b\xeb\xcd\xde\xb3\xdc\x1a\xc8\xcf\x65\x1e\x79\xd9\xf5\x12\xa1\x63\x95\x7d\xc9\x6b\x6c\x5b\x30\x56\xaf\xe6\xb6\x89\xa3\xa6\x39\x02\x9d\xcb\x27\x84\x83\x45\x0a\x07\xe5\x0e\x14\x56\x85\x69\x5b\x72\xf4\x87\x1d\xc2\x66\x7f\x8e\xf6\xeb\x7a\x89\xde\xe7\x08\x50\x16\xb4\x54\x65\x69\xd0\x82\x90\x36\x8e\x2a\x51\x0b\xbf\x6c\xe3\xb8\x5c\xc9\x39\x24\x2b\x38\x1c\x51\xe2\x70\x89\xeb\x84\x13\x33\x21\x9f\xa0\x89\x23\x63\xb5\x21\x2a\x0f\x8f\xde\xd6\xc4\xd1\xeb\xe9\x46\x6c\x44\x98\xa5\x71\xe4\xe3\x45\x19\x02\xb3\x0b\xf3\x49\xce\x55\xe1\xb8\x47\x91\xd2\x75\xe6\xf7\x49\x59\xdb\x6c\xba\xd4\x42\xda\x64\x95\x8d\x80\x38\xdf\x20\x61\x65\x42\xec\xde\x80\xad\xbf\xd7\xa6\xbf\x6e\xe3\x48\xa3\x5d\x69\x09\x5b\x88\x32\x61\xff\x19\x96\x06\x29\x4c\xf6\x59\x09\x99\x90\x1a\x29\xb0\x13\xc6\xf9\x7e\x19\xa7\x77\x57\x67\x4a\xd7\xb9\x4d\xbc\xee\x33\xa5\xaa\xbe\xac\x73\x25\x0b\x61\x85\x92\xff\x44\x5c\x67\x0c\x23\x31\x81\xf7\x4e\xe5\x61\x79\xa2\x04\x4f\xa4\x4b\x41\xfa\xd3\xd4\x9f\xce\x3e\x2a\x69\x73\x21\x0d\xb0\xda\x98\x9f\x15\x73\xd8\x7f\x96\x03\xdc\xc7\x52\xa0\x2e\x4d\xef\xae\xbe\xfd\x40\x8d\xc9\xb6\x16\xbe\x39\xb9\xd1\x05\xea\x0f\xeb\x84\x8d\x2e\x52\x9f\x2a\x4b\xa1\xcc\x2b\x83\x21\xea\xda\x50\x9c\x9b\xd8\x2b\xa2\x9a\xac\x32\x3f\xbf\x29\xac\x32\x47\x9e\xf3\x78\xd4\xf2\x67\x69\xbe\x8a\xe3\xeb\x72\x8d\x47\xe4\x39\xdc\x17\x07\xe2\x36\xd7\x79\x6d\x12\x0e\x0f\x8f\x42\x5a\xd4\x65\x3e\xc7\xa6\xa5\x8e\x04\xe4\x81\xfd\xef\x66\x61\x67\xde\x77\x46\xe0\x25\x76\x5e\x07\x4e\x2f\x04\x51\x12\x65\xa7\x04\xbc\x83\x63\x37\x36\x81\x65\x30\xf7\x25\x39\x7a\xb3\x1f\xde\x63\x4b\xc2\xe6\x04\xd5\x21\x4f\x40\xee\x0f\xf4\x2d\x1a\x44\x86\x37\xed\xc5\xd0\x5b\x65\x5c\x63\xfa\x5d\xae\x30\x20\x25\x42\xda\x74\x03\xda\xab\xf5\xed\x64\x58\xec\x71\x0a\x15\xca\xee\x0a\x6d\xb2\xff\xdf\x13\x87\x40\x07\xf2\x74\x93\xd4\x05\xee\x1c\x74\x0a\xb6\x31\xfd\x3d\x34\x56\x39\x11\xed\x35\xf6\x1c\xed\x7d\x67\x65\xcb\x5c\x68\x06\xc3\x5b\x14\x9e\x7b\x7f\x85\x7d\xc5\x7b\xa4\xb8\x38\xfd\xde\xc1\x25\xc6\x2a\x8d\x70\x48\x43\x7c\x8f\x85\x30\x53\xda\x73\xb8\xa0\x80\x4d\xce\xf0\x4b\xa2\x37\xc2\x23\xdd\x8c\x5e\x0a\x4d\xa1\xee\xa5\x08\xc5\xf5\xea\x08\x69\x1d\xfa\xf5\x93\xf6\x19\x79\x3c\xb8\xb8\x21\x4a\x8a\xaa\xff\xbf\xa2\x9f\xa9\x5f\xfd\x0e\x00\x00\xff\xff\x6b\x86\x22\x51\xda\x07\x00\x00")
+var _tplObjectIndexGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x55\x51\x6f\xd3\x30\x10\x7e\x6e\x7f\xc5\xc9\x1a\x52\x32\xd2\x68\xbc\x4e\x14\x24\x18\x45\x83\x8d\x8e\x0d\x09\xa4\x69\x0f\x69\xe3\x14\x4f\x89\x53\x6c\x47\xa2\x8a\xfa\xdf\xb9\xb3\x9d\x34\xc9\x46\xbb\x09\xa4\x6a\xb3\x2f\xbe\xef\xbe\xfb\xee\xce\xae\xeb\x94\x67\x42\x72\x60\xe5\xe2\x9e\x2f\x4d\x2c\x64\xca\x7f\xb3\xed\x76\x5c\xd7\x47\x76\x0d\xa7\x53\x88\xdd\x1e\x8f\xd8\xdd\x7c\x71\xef\x0c\x6b\x25\x8a\x44\x6d\xc8\x48\x1f\xe3\x2b\xb7\xff\xcc\x37\xbd\xef\x33\xc1\xf3\xd4\x1e\xf2\x86\x78\x26\x94\x36\xce\xec\x4e\x66\x3b\x03\x9d\xb3\x91\x07\xa7\xcc\x66\xcd\xa1\x61\x15\x7f\x49\x0a\xbe\xdd\x82\x36\xaa\x5a\x9a\x7a\x3c\xaa\xeb\x09\xa8\x44\xae\x38\x1c\xdd\x47\x80\x78\x43\x28\xdc\x6b\x44\x19\xd9\x60\xb8\x69\x10\xda\xfd\x47\x6e\xbe\x61\x08\x77\x66\x02\x5c\xa6\xb4\x2c\xb3\x4c\x73\x03\x42\x9a\xf1\x28\x17\x85\x70\xcb\xed\x78\x9c\x55\x72\x09\x41\x05\xc7\x03\x4a\x21\x60\xfa\x41\x48\xcc\x84\x5c\x01\x52\xc3\x95\x26\x2a\xb7\x77\xce\x86\xa6\xa7\xd3\x1d\xb1\x01\x61\x16\xa1\xd1\xfa\x8b\xcc\x3b\xc6\xe7\xfa\x83\x5c\x96\xa9\xe5\x3e\x1a\x95\xaa\x88\xdd\x3e\x68\x7d\x6f\x50\x79\x69\x20\xb0\xff\x32\x60\x55\xfc\x42\x33\xe8\x00\x87\xc8\xbc\x45\xe6\xb9\xf6\x58\xcf\x02\xd8\xf9\x3b\xed\xba\x6b\xfc\x29\x6e\x2a\x25\x21\x2b\x8c\x47\xcb\x02\x86\x20\x91\x97\x4a\xc7\x9f\x4a\x21\x03\x52\x2b\x02\x76\xca\xc2\x70\xbf\xcc\x37\x5f\x2f\x66\x98\x6a\x62\x02\x57\x97\x45\x59\xe6\x5d\xd9\x97\xa5\x4c\x85\x11\xa5\xfc\x2f\xe2\x5b\xa3\x6f\x99\x29\xbc\xb5\x55\xe8\xa7\x87\xe5\x70\x44\x9a\x10\x54\x1f\x9a\x8a\xb3\xc5\xfb\x52\x9a\x44\x20\x11\x56\x68\xfd\x2b\x67\x16\xfb\x71\x39\xc0\xfe\x50\x14\xaa\x22\xa6\xf8\xfd\x27\x57\x3c\xd8\xe5\x12\xb6\x5f\xe6\x2a\xe5\xea\xdd\x26\x60\x83\x41\xeb\x52\x45\x9c\x2c\xc1\x62\x7a\xaf\x4b\x4d\x7e\xb6\xa3\x2f\x88\x6a\x50\xc5\xae\xbf\x23\xa8\x62\x4b\x1e\x45\xdf\xb5\xc0\xa3\x19\xac\x4b\x6d\x56\x8a\xeb\x03\x49\x1c\xcc\xe0\xca\xe3\x3c\x9d\xce\x3f\x06\x7c\x7a\xea\xc3\x8e\xfd\x1b\xee\xc1\xfe\xbc\x4a\x54\x52\x68\xbc\x0c\x6e\xef\x90\x29\x57\x59\xb2\xe4\xf5\x96\x1a\xc4\x23\xf7\xec\xcf\x6b\xcd\x2a\x1e\x5c\x0c\x0f\x3a\xf2\x10\x3b\xa7\x43\x48\x17\x1a\x51\xc2\x62\x7b\x25\xe0\x0d\x9c\xd8\x2e\xf6\x2c\xbd\xb9\x2b\xc9\xe4\xd5\x7e\x78\x87\x2d\x09\x3b\x24\xa8\x06\x79\x0a\x72\xbf\xa3\x2b\x51\xcf\xd3\x5f\xc1\x07\x5d\xb1\xa3\x6c\x61\xba\x55\xce\xb9\x47\x0a\xf0\x6f\xd4\x82\x76\x72\x7d\x3d\xed\x27\x7b\x12\x01\x3a\x35\x13\xdd\x46\x7f\xd9\x11\x87\x40\x7b\xf2\x34\x9d\xd4\x38\x3e\xf8\xd0\x28\x88\xfc\x91\xb6\xe2\x79\x42\x44\x3b\x85\xc5\xc7\xe7\xba\xb1\xb2\x75\x22\x14\x83\xfe\x50\xfb\xd7\xc9\xcd\xa3\xcb\x78\x8f\x14\xe7\x67\x3f\x1a\x38\xbc\x50\x4b\xc5\xe1\x98\x9a\xf8\x9a\xa7\x42\xdf\xd0\x1e\x8f\x90\x43\x1b\xd3\xbf\xa0\x34\xf0\x0e\x69\x3e\x18\x7b\x45\xae\x76\xe6\x7d\x72\x9d\x3c\x7c\x58\x8b\x7e\xb9\x52\x2e\x62\x38\xee\x0d\xae\xf7\x92\x22\xef\x3e\xaf\xf4\xf6\xbb\xd5\x1f\xff\xa7\x6b\xde\x89\x08\x00\x00")
 
 func tplObjectIndexGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -414,7 +481,7 @@ func tplObjectMongoGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectPrimaryKeyGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4d\x4f\xdb\x40\x10\x3d\x7b\x7f\xc5\x60\x01\xb2\x21\xb8\x94\x23\x34\xea\xa1\x85\xaa\x2d\xa5\xa0\x54\xea\x21\x8a\xaa\xc5\x19\xc3\xc6\xf6\x6e\x34\x5e\x07\x59\xd6\xfe\xf7\x6a\x77\x9d\x0f\x62\xda\x08\x89\x9b\x77\x3e\xde\xbc\x79\x33\xe3\xb6\x9d\x62\x26\x24\x42\xa8\xee\x67\x98\xea\x64\x4e\xa2\xe4\xd4\x24\x39\x36\xa1\x31\xac\x6d\xf7\x3b\x0b\x9c\x0f\x21\xf1\x16\x75\x3f\x73\xaf\x9f\xf7\x33\x63\x18\xd3\xcd\x1c\x61\x1d\x98\xdc\xf0\x12\x8d\x81\x4a\x53\x9d\xea\x96\x05\x6d\x7b\x02\xc4\xe5\x03\xc2\xfe\x6c\x00\xfb\x99\xc0\x62\x6a\xf3\x57\x09\x57\xd6\x52\x19\x63\x43\xbd\x7b\x89\xb1\x7a\x7f\x41\xfd\xab\x99\xa3\x8f\x39\x01\x94\x53\x63\x98\x61\x2c\xab\x65\x0a\x51\x09\x47\x7f\x3c\xaf\x2e\xf1\xc7\x03\xc5\x70\x83\x4f\xb7\xbe\xc2\x77\x6c\xa2\x18\x8e\xfa\x1c\x5b\x16\x04\x84\xba\x26\x09\x87\x3d\x6f\xbb\x51\xa1\x7e\x21\x3b\x06\x8f\x5b\x69\x12\xf2\xc1\x62\x55\x9a\x2a\xdb\xd9\x78\xe2\x6d\x16\xfe\x15\xdd\x07\xe1\x56\xff\xe1\x80\x05\x1e\x41\x64\x5d\x6a\xf2\xb5\xba\x94\xa9\x9a\x3a\x29\x82\x40\x51\x99\xf8\x77\x94\x95\x3a\x19\xcd\x49\x48\x1d\xd5\xc9\x16\x50\x1c\xaf\x90\xb0\xa8\xba\xdc\xff\x26\xac\xe3\x9d\xd4\xcf\xbe\x0d\x5b\x8a\xb6\x86\xc8\xa2\xf0\xa0\x0a\x07\x9d\x18\x55\xf2\x4d\x09\x19\x59\x3d\x06\x10\x9e\x87\x71\xbc\x4b\xca\x5b\x4e\x15\x46\x39\x36\x1d\x42\x0c\x48\xa4\xc8\xaa\xca\x89\xac\x60\x4b\xe4\xd1\xbc\x10\xda\x46\x7a\x64\x16\x88\x0c\x0a\x94\x11\x27\x8a\xe1\x00\xce\x60\x6f\x08\xa7\x9b\x93\xb5\x24\x2f\x2d\x58\x16\x85\xb6\x40\x74\x50\xc5\x90\x29\x2a\xb9\xf6\x45\xc2\x01\xe4\xd8\xc4\xae\xb1\x7c\x61\x6b\x95\x7c\x3e\xf6\xf5\x96\x93\x34\x2c\xc8\x14\x81\xb0\xde\xd3\x0b\x10\xf0\x61\x5d\xf4\x1d\x9c\x5d\x80\x38\x3e\x76\x45\xf3\xc5\x98\x13\x8d\xcf\x8e\xc4\x64\x02\x43\xe8\xbe\xe1\x18\xde\x4f\x5c\x81\x57\xec\xc3\x62\x6b\x28\x03\x50\xb9\x0d\xcd\x17\xe3\xde\xa6\x4c\x9c\x0e\x7b\x2a\xdf\xd9\xf9\x93\xd0\x8f\xaa\xd6\x10\x6d\x8f\x1c\xdc\x6b\x53\x8c\x7f\x6f\xde\x36\x35\x18\x82\x5d\xc5\xcf\xe8\x56\x71\xdb\x1b\x6f\x5e\xad\xe5\x89\x7e\xa4\x36\x65\xe4\xf4\x1d\xa5\x5c\xf6\xd2\x06\x70\xf8\xd2\x2a\x5f\xb8\xf4\xbd\x21\x48\x51\x6c\x76\x8b\x44\x2b\xd6\x5d\xa9\xce\x23\x45\xb1\x6b\xff\x46\x77\xd7\x57\x6e\x25\x9e\x1d\x74\xaa\xe4\x54\x68\xa1\xe4\x1b\x9d\xb5\x33\xae\x14\xfb\xe8\xee\xfb\xc5\xc3\x72\xca\xdc\x5d\xff\x7e\x44\xc2\x68\xcd\x62\xe7\x19\x8d\xee\xae\x6f\x39\xf1\xb2\x8a\x62\x18\x4f\x84\xd4\x48\x19\x4f\xb1\x75\xbf\xba\x0e\xfb\x99\xfd\xb5\xbd\xf4\xe6\xd1\x6b\x61\x07\xc3\x4f\xaa\xa8\x4b\xe9\xf9\xad\x85\x5e\x51\x7b\x43\x85\xfb\xea\x1a\xc6\xda\xd6\xbf\xfe\x06\x00\x00\xff\xff\x7b\x92\x7f\x46\xf9\x06\x00\x00")
+var _tplObjectPrimaryKeyGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x54\x51\x6f\xda\x30\x10\x7e\x0e\xbf\xe2\x1a\xd1\xca\x06\x9a\x75\x3c\xb6\x43\x7b\xd8\xda\x69\x5b\xd7\xb5\x62\xd2\x1e\x50\x34\xa5\xc1\xa1\x86\xc4\x41\x8e\x43\x85\x22\xfe\xfb\xce\x76\x08\x21\x61\x62\x48\x95\x90\x62\x9f\xef\xbe\xfb\xee\xbb\x3b\x8a\x62\xca\x22\x2e\x18\xb8\xe9\xf3\x9c\x85\xca\x5b\x4a\x9e\x04\x72\xed\x2d\xd8\xda\xdd\x6c\x3a\x45\xd1\x2d\x2d\x70\x3d\x02\xcf\x5a\xd0\xd5\xdc\x7e\x3e\xcf\xd1\xd0\x51\xeb\x25\x83\x9d\xa3\xf7\x10\x24\x6c\xb3\x81\x4c\xc9\x3c\x54\x45\xc7\x29\x8a\x4b\x90\x81\x98\x31\xe8\xce\x07\xd0\x8d\x38\x8b\xa7\x3a\xbe\x0a\xb8\xd3\x96\x0c\xa1\xd0\xd5\x3e\x6f\x31\xaa\xfb\x17\xa6\x7e\x61\x1a\xeb\x73\x09\x4c\x4c\xf1\x88\xb9\xa3\x5c\x84\x40\x12\xe8\xfd\xb1\xbc\xca\xc0\x1f\x33\x49\xe1\x81\xbd\x3e\xda\x0c\xdf\xd9\x9a\x50\xe8\xb5\x39\x22\x3b\x47\x32\x95\x4b\x01\x17\xad\xd7\xa2\x96\x21\x3f\x10\x4d\xc1\xe2\x62\xa1\x5c\xcc\x34\x16\x9e\x32\x5d\xd9\xc4\xb7\x36\x0d\x7f\x42\xf5\x8e\xdb\xa8\xdf\x1d\xa0\xd1\x20\xf0\xa8\x0c\xf5\xbe\x66\xb7\x22\x4c\xa7\x46\x0a\xc7\x49\x65\xe2\xd9\x3b\xa9\x62\xc7\x88\x2c\x14\x10\xf3\x89\xc0\xcd\xbd\xf3\xcc\x85\x1a\x30\x45\xee\x15\x32\x8b\xb3\x12\xeb\x24\x80\x5d\xbc\x69\xc5\xde\x19\x7f\xa5\xa8\x51\xa2\x4a\xb4\x88\xb8\x08\x32\x28\xc5\xca\xbc\x6f\x29\x17\x44\xeb\x35\x00\xf7\xda\xa5\xf4\x98\xd4\x8f\x81\xcc\x18\xc1\xa9\x2c\x11\x28\x30\x29\x53\xa9\x55\x0f\xa4\xd4\x82\x6e\x91\xc7\xcb\x98\x2b\xed\x69\x91\x3b\x0e\x6a\x17\x33\x41\xd0\x8d\xc2\x39\x0c\xe1\x6c\x04\x57\xf5\xce\x6b\x92\xb7\x1a\x0c\x39\xea\x04\xe4\x3c\xa3\x10\xa1\xb2\x81\xb2\x49\x90\x36\xda\xa9\x29\x6c\xb1\xd2\xb9\x92\x60\x39\xb1\xf9\xb6\x9d\xc6\x27\x0c\x01\xae\x5f\xaf\x6e\xf0\xfb\x61\x97\xf4\x1d\x0c\xd1\xd2\xef\x9b\xa4\x8b\xd5\x04\x8d\x93\x61\x8f\xfb\x3e\x8c\xa0\x3c\x43\x1f\xde\xfb\x26\xc1\x09\xf3\xb2\x6a\x8c\xcb\x00\xd2\x85\x76\xc5\x14\xad\x49\xf2\x8d\x0e\x67\xe8\x70\xac\xf2\x57\xae\x5e\xd2\x1c\xdb\xdf\x80\x40\x4d\xf4\xad\x2e\xc6\xbf\x27\xb3\x49\x0d\x2b\xd5\xa3\xfa\x99\x99\x51\x6d\xbe\xd2\xfa\x56\x6b\x9e\xcc\xb6\x54\x87\x8c\x8d\xbe\xe3\x30\x10\xa4\x5d\xef\x05\xc9\xbd\x26\x16\xbd\x31\xe1\xd8\x65\xc1\xe3\x7a\xb5\x68\xad\x58\x97\xa9\xca\x17\x74\x3c\x36\x7f\xe3\xa7\xfb\x3b\x33\x12\x7b\x0b\x1f\xa6\x62\xca\x15\x4f\xc5\x1b\xad\xbd\x31\x56\x8a\x7d\x34\xfb\x7f\x70\xb1\x8c\x32\x4f\xf7\xbf\x5f\x98\x64\x64\xc7\x82\xfe\x47\x19\xb8\x49\x41\x92\x61\x19\x13\x1f\xf7\x92\xc9\x28\x08\x59\x61\xfe\x0a\x4b\xec\x3d\xfb\xa9\xb5\xb4\xfa\xd1\x2a\xe1\x08\xc3\x4f\x69\x9c\x27\xc2\xf2\xdb\x09\x5d\x51\x7b\x43\x85\xdd\x43\xd4\x8a\xc2\xde\xfe\x02\x49\xee\x4d\x27\x19\x07\x00\x00")
 
 func tplObjectPrimaryKeyGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -434,7 +501,7 @@ func tplObjectPrimaryKeyGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectRangeGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x5f\x8b\xdb\x38\x10\x7f\x8e\x3f\xc5\x54\x6c\xc1\x6e\xbd\x66\x17\x4a\x1f\xca\x7a\x0b\xbd\xdb\x5d\x7a\x4d\xbb\x7f\x72\x70\x0f\xa5\x0f\x4e\x2c\xe7\x14\x1c\xd9\x27\x29\x07\x39\xd7\xdf\xfd\x18\x8d\xec\xc8\x69\xe2\xee\x3e\x2c\x85\x40\x34\x23\xcd\x6f\xfe\x8f\xe4\xa6\xc9\x79\x21\x24\x07\x56\xcd\x57\x7c\x61\x12\x95\xc9\x25\x67\x6d\x1b\x34\xcd\x89\x5a\xc2\xbb\x14\x12\x22\xaa\xf9\xca\x52\xb7\xf3\x15\x31\x6a\x25\xd6\x99\xda\x22\x13\x37\x93\x3b\xa2\x3f\xf1\xed\x60\xff\x5a\xf0\x32\xb7\x87\x1c\x23\xb9\x16\x4a\x1b\x62\xb7\x6d\x10\x98\x6d\xcd\xc1\x6a\x4b\xbe\x64\x6b\xde\xb6\xa0\x8d\xda\x2c\x4c\x13\x4c\x9a\xe6\x14\xac\x41\x70\xb2\x8a\xe1\xa4\xe8\xa1\xd4\x32\xb1\x00\xba\x6d\x83\x89\x3d\x26\x0a\x90\x1c\xc2\x2c\xcf\xe1\x64\x05\xe7\x11\x84\x25\x97\xde\xc1\xc8\x9d\x24\x90\x4e\x53\x4f\xdf\x70\xf3\xe7\xb6\xe6\x3d\x1c\x97\x39\xae\x07\x4b\x04\x9b\x66\xce\x74\x87\xf0\x81\x2f\x85\x04\x21\xcd\xdb\x37\xc7\x8e\x5c\xc9\xbc\x3b\x50\x15\x85\xe6\x06\xa9\x60\x52\x8a\xb5\x70\x4b\x21\x17\xe5\x26\xe7\x84\x35\xaf\xaa\xb2\x67\xa1\x2c\x31\x14\xff\x97\x2b\x43\x44\x1b\x14\x1b\xb9\x80\x70\x03\xaf\xfc\xb8\x45\xf0\x89\x6f\xc3\x08\xc3\x27\xe4\x12\x9a\x60\xa2\x8d\xd2\x18\xaf\xaf\xdf\x88\xd7\x38\xef\x1e\x11\xd3\x27\x04\x75\x32\x61\x7b\x71\x65\xb1\x65\x3b\x08\xb7\xf5\x51\x5f\xc9\x45\x95\x73\x27\x53\xa9\x75\x42\x8c\xb0\x58\x9b\x64\x56\x2b\x21\x4d\xb8\x49\xf6\xa0\xa2\x68\x87\xc5\x4b\xdd\x49\x8f\x8a\x78\x12\x94\xbb\x21\xe1\xaf\xd9\xe1\x9c\xa1\x03\x2d\x06\xdd\x6c\x94\x84\x9d\xb2\x22\x64\x2f\x35\x8b\x5d\x88\x75\xf2\x47\x25\x64\x88\x51\x8e\x81\xbd\x63\x51\x14\xb4\xc1\xb1\xdc\xcc\x31\xbb\xb7\xf5\x20\x3f\xa2\x80\x4d\x32\xc8\x3e\x66\xc8\x69\x65\x97\x29\xf3\xad\x60\x97\xec\x78\xe6\xb9\xcc\x9f\x84\x7d\xb1\x87\x7d\xc1\x46\x4c\x9f\xdd\x4f\xaf\x2b\xb5\xce\x4c\x48\x45\x8b\x45\xe8\xab\x5a\x54\x32\x17\x46\x54\x72\x58\x6c\xed\x33\x34\xb0\xaf\x2b\x85\xac\xae\xb9\xcc\xc3\x1d\x2f\x86\x5d\x29\x5a\x31\xd7\xe7\x29\xbc\x67\xd1\xd1\xd6\xb6\xa1\x1a\xeb\xef\x17\xe9\xd1\x03\xd8\xa1\x18\xd8\xc7\x60\x9c\x9e\xdb\xa3\x3f\xf5\x61\x50\x6f\xfb\x98\xbe\x5b\x2f\x35\xbc\x67\x31\x6c\x92\xbe\xb8\x22\xf4\xb2\x1d\xb7\x07\x4d\x7e\x56\x6b\x5c\x31\x76\xb6\x50\x84\xa9\x74\x9a\x5d\xb6\xed\xa5\xf1\xfb\xfc\xb7\x4a\x9a\x4c\x48\x0d\x6c\xad\xf5\x3f\x25\xb3\x69\x3e\xdc\x78\x60\x7f\x2c\x06\x9c\x1d\xb3\xfb\xe9\x5f\x7f\x73\xc5\x3d\x5b\xa3\x7e\xe7\x56\xe5\x5c\x7d\xd8\x8e\xda\x6b\x4d\xa5\xb1\xea\x04\x3f\x6b\x14\xb5\x33\x7a\x8a\xd6\x86\x9b\x84\x26\x36\x9e\xb4\xf6\x47\x7d\x11\x75\xa3\xe8\xd7\x58\xfa\x78\x3b\xa9\xc2\x8f\xce\xb2\x67\xb2\x72\x6c\x10\xce\xee\xa7\x77\x99\xca\xd6\x3a\x8c\xe0\xeb\x37\x21\x0d\x57\x45\xb6\xe0\x4d\x8b\xc5\x51\xdb\x1d\x1a\x23\xde\xd6\x73\x5d\x5c\x3f\xdc\x1d\xf1\xc8\x65\xf1\x0b\x26\x85\x0b\x47\xdf\x97\x44\xc7\xe3\x00\x4f\x1f\x01\x4f\x55\x73\x25\xf3\x5d\x6f\xbb\xc2\x22\x99\xf1\xbc\x53\xb5\x46\xf8\xea\xe9\xaf\x28\x9a\x0b\x97\x70\xe6\xdf\x4f\x8e\xed\xe3\x9f\x9e\x8f\x60\x13\xb0\x7d\x88\x45\x88\xd3\xc1\xa6\x20\x47\xa4\xa8\x85\x06\x62\xee\x85\xe6\xe4\x8e\x09\xde\x55\xda\x76\x88\xdf\x83\x58\x60\x16\x27\x14\xd2\xc4\x3d\xa4\xe7\xe3\x45\x3a\x74\xf2\x2c\x86\x92\xcb\x5d\x5d\x39\xdd\xaf\xbd\xa0\x20\xe8\x20\x2c\x5d\x9f\x77\x82\x3f\x6c\x74\x91\x3b\xee\xb5\xad\x11\x4a\xc2\xdb\x37\xf4\x4a\xcc\x94\xc1\x4e\x1a\xad\x2a\x6b\x24\x1d\x4d\x6d\xe5\x7c\xff\xbe\x23\xc9\x2f\x47\xc2\x59\xe7\x13\x31\xba\xdc\x8a\x02\x5e\x1c\x78\x92\xf4\x62\xf4\xff\x1a\xce\xf7\x4b\xcb\x6e\x8c\x78\x74\x25\xf3\xa1\x3f\x55\x3d\xe6\xce\x95\xcc\x9d\x75\x55\x4d\xc6\x5b\x57\x88\x70\x2d\x41\x24\x16\x5d\xef\x4a\x55\x3f\xc2\x13\x2b\x65\xff\x4e\x0f\xf9\x51\xd5\x23\x6e\x3c\xd8\xd9\x19\xce\xdd\xfb\xca\xd6\xa3\x7b\xf6\xa7\x30\x1f\x11\xfc\xe8\x19\x12\x16\xbe\xf8\xc0\xc4\x14\x8a\x9f\x83\x60\x2c\x0f\x41\xe0\xc4\x70\x00\x28\xc7\xcb\x0c\x1b\xa0\x9b\xbf\x37\xdc\x3c\x74\x2c\xf6\x9f\xe6\x86\xc1\xe0\xfb\xaf\xfb\xbe\xa2\x4b\x9f\x54\x1e\x0d\xc3\x97\x9b\x0e\x2b\xd4\xa6\x52\x1c\x5e\xe1\x2d\xf4\xc0\x73\xa1\x67\x48\x47\xf0\x80\xd7\x40\xaf\xd0\x7d\x28\xe2\x93\x42\x2d\xf1\xfb\x74\xf0\xaa\x50\x28\x67\x5f\x15\x2e\x0b\x9e\xf9\x4e\xa7\x85\xfe\xbc\x54\xa4\x2e\x0a\x06\x37\xbc\x93\x92\xa2\xf4\xdf\x8c\x36\x0c\xb4\xfc\x3f\x00\x00\xff\xff\x1e\x5d\x19\x46\x43\x0f\x00\x00")
+var _tplObjectRangeGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x57\xdd\x6f\xdb\x36\x10\x7f\xb6\xff\x0a\x96\x48\x01\xa9\x55\x84\x04\x28\xfa\x10\x44\x19\xd0\xad\x29\xba\xa5\x4d\x96\x0c\xd8\x43\xd1\x07\xd9\xa2\x3c\x05\x32\xa5\x51\xf2\x80\x4c\xd5\xff\xbe\xfb\xa0\x64\xca\xb3\x94\xf8\x21\x08\x10\xc4\xe4\xf1\xee\x77\xdf\x47\xaa\x69\x12\x95\x66\x5a\x09\x59\x2c\xee\xd5\xb2\x0e\x4d\xac\x57\x4a\xb6\xed\xbc\x69\x8e\xcc\x4a\x9c\x45\x22\xe4\x0d\x9c\xd3\xee\x7a\x71\xcf\x84\xd2\x64\xeb\xd8\x3c\x20\x11\x0f\xc3\x1b\xde\xff\xa6\x1e\x06\xe7\x97\x99\xca\x13\x62\xb2\x84\xf0\x32\x33\x55\xcd\x64\xe0\x9c\xd7\x0f\xa5\x12\xa4\x2d\xfc\x1a\xaf\x55\xdb\x8a\xaa\x36\x9b\x65\xdd\xcc\x67\x4d\x73\x2c\xc8\x20\x71\x74\x1f\x88\xa3\xb4\x87\x02\x5e\x02\xa8\x00\x60\x46\x6c\x59\x2a\xc0\x0b\x2f\x4e\x12\xe0\x15\xa7\xbe\xf0\x72\xa5\x1d\x46\xdf\x72\x32\x48\xa7\xa9\xdf\x7f\x52\xf5\x1f\x60\x47\x0f\xa7\x74\x82\xeb\xc1\x12\xc1\xae\x62\x6b\xba\x45\xf8\xa0\x56\x99\x16\x99\xae\xdf\xbf\x1b\x63\xf9\xa8\x93\x8e\xa1\x48\xd3\x4a\xd5\xb8\x9b\xcf\xf2\x6c\x9d\xd9\x65\xa6\x97\xf9\x26\x51\x8c\xb5\x28\x8a\xbc\x27\xa1\x2c\x13\x8c\xfa\x47\x99\x9a\x37\xed\x3c\xdd\xe8\xa5\xf0\x36\xe2\x8d\x1b\x37\x5f\x40\xec\x3d\x1f\xc3\x97\xe9\x95\x80\xf8\xc1\xaa\xc2\x78\x7d\xfb\xce\xb4\xc6\x7a\xf7\x84\x98\x1e\x10\xd4\xd9\x4c\xee\xc4\x55\x06\x44\xb6\x10\xf6\xe8\x73\xf5\x51\x2f\x8b\x44\x59\x99\xc2\xac\x43\x26\x78\xbd\xf4\x1d\x94\x88\xae\x85\x47\x3f\xa9\x90\x9b\xf0\x75\x25\x85\x03\x0d\x0a\xfd\x2d\xb6\xca\xab\x0e\xed\x20\x08\x07\x81\x73\x3b\xdc\xb8\x6b\xb9\x3f\xa7\xe8\x60\x8b\x49\xa9\x37\x46\x8b\x74\x5d\x5b\xbd\xa9\x27\x41\x5d\x60\x53\x50\x85\xbf\x16\x99\xf6\x30\x0b\x81\x90\x67\xd2\xf7\x21\x75\x63\xb9\x5b\x60\xf6\xaf\xcb\x41\xfe\x20\x78\x9b\x70\x50\x1d\x98\x41\xab\x55\x5e\x44\xd2\xb5\x42\x5e\xc8\xf1\xca\x00\x7f\x0e\xc2\x3e\xdf\xc1\x3e\x97\x13\xa6\xdf\xfd\x7e\x75\x09\xe9\x8c\x6b\x8f\x8b\x1a\x8b\xd4\x55\xb5\x2c\x74\x92\xd5\x59\xa1\x87\xc5\xd8\x3e\x43\x83\xbb\xba\x22\x11\x97\x25\x38\xee\x6d\x69\x90\x86\xbe\x52\x48\xcc\xce\x81\x48\xfc\x24\xfd\xd1\xd6\xa7\x50\x4d\xf5\xff\xab\x68\x94\x01\x3b\x18\x03\xfb\x14\x8c\xe3\x53\x62\x7d\xd4\x87\x41\xbd\xed\x62\xba\x6e\xbd\xae\xc0\xaf\x00\x14\xf7\xc5\xe5\xa3\x97\xed\xb4\x3d\x68\xf2\xb3\x5a\x63\x8b\xb1\xb3\x85\x23\xcc\xa5\xd3\x6c\xb3\x4d\x97\xca\x2f\x8b\x9f\x0b\x5d\xc7\x19\x28\x97\xeb\xaa\xfa\x3b\x97\x94\xe6\xfd\x8d\x27\xe8\x0f\x54\xe0\x6c\x81\xa2\xfc\xf3\x2f\x65\x94\x63\xab\xdf\x9f\x5c\x9b\x44\x99\x0f\x0f\x93\xf6\x92\xa9\x3c\x76\xad\xe0\x97\x0a\x45\x69\x86\x5f\xa1\xb5\xde\x26\xe4\x89\x8e\x9c\x64\xbf\xdf\x17\x11\x8c\xa6\xbd\x4e\x94\x45\x55\xaf\x8c\xaa\x5e\xd2\x8f\x1b\x6b\xc3\xd3\x5d\x79\x41\x63\x9f\x1e\x72\x6e\xd6\xd1\xb1\xfc\x4c\x56\xfa\xd3\x83\xf1\x26\x36\xf1\xba\x82\xc9\xfb\xed\x3b\x18\xa2\x4c\x1a\x2f\x55\xd3\x62\x9d\x97\x74\xc2\x13\xd1\x39\x7a\xae\x3b\x9a\xba\xdd\xbd\xa4\x83\x89\x7b\xef\x05\x86\x9e\x0d\x47\x3f\x62\x78\x1f\x4c\x03\x1c\x3e\xcd\x0e\x55\x03\xe2\xdb\x31\x65\x0b\x8b\x65\xa6\xf3\xce\xd5\xea\xe3\x03\xaf\xbf\x6d\x79\xc4\x5d\x88\x13\xf7\xaa\xb5\x64\x17\xff\xf8\x74\x02\x9b\x81\xe9\xcd\xe9\x23\x4e\x07\x1b\x09\x3d\x21\xc5\x2d\x34\x10\xb3\x8f\x51\x2b\x37\x26\x08\xb3\x82\x3a\xc4\xed\x41\x2c\x30\xc2\xf1\xe0\x7f\xd0\x43\x3a\x3e\x9e\x47\x43\x27\x4f\x02\x01\x42\xdb\xba\xb2\xba\xdf\x3a\x41\x41\xd0\x41\x58\xba\x3e\xef\x04\xff\x77\xd0\x45\x6e\xdc\x6b\xaa\x11\x4e\xc2\xfb\x77\xfc\x20\x8e\xe1\x11\x7d\x16\x4d\x57\x15\x19\xc9\xac\x11\x55\xce\x8f\x1f\xdb\x2d\xfb\x65\xb7\xe2\xa4\xf3\x89\x09\x5d\x6e\x81\xf0\x6a\xcf\xeb\xaa\x17\xe3\xdf\xb7\xe2\x74\xb7\xb4\xe8\x60\xc2\x23\x28\xc7\xa1\x3f\x45\x39\xe5\x0e\xb0\x5b\xeb\x80\x8f\x8c\x27\x57\x78\x63\x5b\x82\xb7\x58\x74\xbd\x2b\x40\x78\xdc\x13\x92\xa2\x9f\xe3\x7d\x7e\x14\xe5\x84\x1b\xb7\x34\x3b\xbd\x85\x7d\x2a\x52\x3d\xda\x2f\x9c\x48\x2c\x26\x04\x3f\x3b\x86\x78\xa9\x2b\x3e\x30\x31\x12\xe9\xe3\x20\x18\xcb\x7d\x10\x38\x31\x2c\x00\xca\xa9\x3c\xc6\x06\xe8\xe6\x2f\x7c\x29\xde\x76\x24\xf9\x2f\x14\xa2\x14\x83\x4f\xdd\xee\x53\x92\xaf\x7e\x56\x39\x1a\x86\xaf\x9f\x3a\x2c\xf8\x4a\x28\x8c\x12\x6f\xf0\x16\xba\x55\x49\x56\xdd\xe1\x1e\x58\xf0\x1a\xe8\x15\xda\x6f\x62\x7c\x58\x00\xcc\xf5\xce\xdb\xc2\xa0\x1c\x3d\x2c\x6c\x16\x1c\xf3\xad\x4e\x82\xfe\xb2\x32\xac\xce\x9f\x0f\x6e\x78\x2b\xa5\xb3\xdc\x7d\xfe\x52\x18\x78\xf9\x1f\xee\xe6\x6b\xcc\x2e\x10\x00\x00")
 
 func tplObjectRangeGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -474,7 +541,7 @@ func tplObjectRedisGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectRedisManagerGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x41\x6a\xc4\x30\x0c\x45\xd7\xd1\x29\x44\x28\x25\x99\x85\x0f\x30\x90\x65\x97\xd3\x45\x7b\x80\x92\x49\xe4\xe0\xa1\x96\x07\xc5\x59\x14\xa1\xbb\x97\xc4\x90\xd2\x21\x14\xba\xfd\xfe\x4f\xcf\x92\xea\x48\x3e\x30\x61\x9d\xae\x37\x1a\xb2\x13\x1a\xc3\xec\x62\xcf\xfd\x44\x52\x9b\x81\xea\x53\xba\xde\xf0\xdc\xa1\x33\x83\xfc\x75\x27\xfc\x28\x99\x7b\xed\x23\x99\xbd\xad\xc4\x65\x12\x9c\xb3\x2c\x43\x46\x85\xea\x94\x24\xba\x2d\x7f\xcf\x49\x08\x0c\xc0\x2f\x3c\x60\x13\xf1\xf4\x1b\xbe\x4c\xd2\xe2\xd6\x6c\xe6\xb5\x8a\x0f\x68\xfb\x08\xec\x36\x85\x4a\x28\x2f\xc2\x78\xf8\x5e\xc6\xb5\xbb\xfa\x8f\xd2\xbf\x9c\xc1\x63\x81\xba\x0e\x39\x7c\xae\x51\x75\xef\x39\x0c\x8d\x8f\xd9\xbd\x88\x24\xf1\x4d\x7d\x8c\x07\x0e\x19\x99\x68\xc4\xed\xca\x65\x50\xdd\xb6\x50\xd9\xbe\xcc\xf3\xb1\x5a\x7f\xbe\x77\x2e\x9c\xad\xab\xa9\x12\x8f\x66\xf0\x1d\x00\x00\xff\xff\xb0\x79\x98\x68\xc7\x01\x00\x00")
+var _tplObjectRedisManagerGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\x92\xc1\x4a\xc4\x30\x10\x86\xcf\xcd\x53\x0c\x45\xa4\xd9\x43\x1e\x60\xa1\x27\xf1\xa8\x07\x3d\x78\x94\x9a\x4e\xd6\x88\x49\x96\xe9\x14\x56\x42\xde\xdd\xb4\xd1\xaa\xa5\x2c\xeb\x2d\xf9\x67\xbe\xf9\x27\x3f\x89\xb1\x47\x63\x3d\x42\x1d\x5e\xde\x50\xb3\x22\xec\xed\xa0\x5c\xe7\xbb\x03\x52\x9d\x92\x88\xf1\x2a\x97\x60\xdf\x82\xca\x37\xfe\x38\x22\x3c\x17\x4d\xdd\x77\x0e\x53\x7a\x98\x88\xbb\x03\xc1\xc0\x34\x6a\x86\x28\xaa\x5d\x20\xa7\x66\xfd\x91\x03\xa1\x48\x42\x98\xd1\x6b\x68\x1c\xec\xfe\xc2\x99\x93\x30\x77\x36\xc3\xd4\x0a\x2b\x54\xae\x81\xc5\x2d\xdb\x10\xf2\x48\x1e\x36\xeb\x65\x9c\x5c\xac\xcf\x34\xfd\xcb\xd3\x1a\x28\x50\xdb\x82\xb7\xef\x93\x54\x1d\x3b\x6f\x75\x63\x1c\xab\x5b\xa2\x40\xa6\xa9\xb7\x71\xeb\x2d\x83\x47\xec\x61\x4e\xb9\x0c\xaa\xa5\x14\x55\x5a\x1e\x73\xbd\x6d\x1d\x7f\xd6\xdb\x17\x2e\x9d\x49\xf5\x9b\x92\xf0\x64\xf9\xf5\x26\x78\xc6\x13\x37\x9a\x4f\xa0\xcb\x59\x7d\x69\x97\xc4\x7b\xc9\x46\xbf\xe3\x53\x2b\x4f\x39\x6f\x1a\x23\xfa\x3e\x7f\xa0\x4f\x32\x25\x25\x42\x71\x02\x00\x00")
 
 func tplObjectRedisManagerGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -494,7 +561,7 @@ func tplObjectRedisManagerGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectRedisPipelineGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x4b\xc3\x40\x10\x85\xcf\x3b\xbf\xe2\x59\x44\x92\x22\xdb\x9e\x05\x3d\x08\x1e\x15\xf1\x2a\x22\x36\x99\x94\x2d\xc9\x24\x4c\x36\x07\x59\xe6\xbf\xcb\x9a\xa6\x07\x29\xd2\xeb\xbc\xf7\x7d\x3c\x26\xa5\x9a\x9b\x20\x8c\x55\xbf\x3b\x70\x15\xbd\x72\x1d\x46\x3f\x84\x81\xdb\x20\xbc\x32\xa3\x94\xae\xfb\xdd\x01\x77\xf7\xf0\x66\xb4\xd9\x5c\x61\x49\x29\x7e\x0f\x8c\xcf\xb9\xe0\x5f\xbe\x3a\x36\x7b\xcb\xfc\xeb\xb1\x80\x31\xea\x54\x45\x24\x72\xeb\x59\xbc\x24\xe4\x9e\x54\xc1\xaa\xbd\x92\x11\x35\x93\x54\x28\x3a\xac\xcf\xc8\x9e\xf7\x5a\xe2\x91\xf7\x41\x16\xb8\xc8\x03\x46\x78\xef\xff\x58\xcb\xb3\x82\xd3\x9a\x44\x2e\x34\x68\x59\x66\x41\x89\x07\x6c\xf3\xd1\x29\xc7\x49\x05\x37\xff\xc0\xe9\x17\x79\xdf\x7e\xdc\x42\x42\x6b\xe4\x8c\x2e\xc2\xba\xd3\xb8\xa2\x3c\xa2\xf9\xa5\x2c\xb5\x19\xfd\x04\x00\x00\xff\xff\x8c\xf9\xa0\xde\x7e\x01\x00\x00")
+var _tplObjectRedisPipelineGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x92\x51\x4b\xc3\x30\x14\x85\x9f\x9b\x5f\x71\x37\x44\x92\x51\xd2\x3d\x2b\xf3\xc1\xb9\x47\x45\x7c\x1d\x43\x6a\x7b\x3b\x2b\x4d\x3a\x93\x14\x26\xa5\xff\xdd\x9b\xa4\x9d\x22\x63\x08\x85\x92\x7b\xee\x3d\x39\xf9\x92\xbe\x2f\xb1\xaa\x35\xc2\xbc\x7d\xfb\xc0\xc2\x49\x83\x65\x6d\xe5\xa1\x3e\x60\x43\xe5\xf9\x30\xb0\xbe\xbf\x22\x0d\x6e\x56\x20\x69\x95\x65\x33\x98\x54\xe6\xbe\x0e\x08\xaf\xb1\x41\x3e\xe5\x0a\x87\xe1\xc5\xcf\x3f\x8f\x0d\x60\x9d\xe9\x0a\x07\x3d\x4b\x16\xd1\x78\x52\x58\xb2\x31\x06\xd0\x98\xd6\xb0\xa4\x70\x47\x28\x5a\xed\xf0\xe8\xe4\x3a\xfe\xd9\xc0\x58\xd5\xe9\x02\xb8\x82\xc5\x99\x1d\x1e\xf7\x46\xc0\x3d\xee\x6b\x3d\x39\x72\x9f\xca\x82\x94\xf2\xcf\x56\xe2\xac\xc1\x29\x22\x65\xab\x2b\x68\x50\x47\x03\x01\x77\xb0\xf4\xc5\xc4\xa0\xeb\x8c\x86\xeb\x0b\xc3\x7d\x18\xd9\x2e\x77\x29\xe8\xba\x49\x41\x4d\xf1\xb9\x18\x58\x42\xdf\x7f\x3c\xd4\x29\x29\x17\xe7\x7c\x88\x44\x96\xc1\xe6\x88\x05\x58\xd4\xa5\x05\xf7\x8e\xf0\xd9\x61\x87\x25\x51\x53\x2a\xf7\xb5\x4e\x37\x68\xa3\x34\x92\x84\xb6\x0a\x4b\xd2\xf3\x3d\x1a\xa8\x2d\x94\xad\xc6\xd4\x9b\xc5\xb6\x71\x34\x37\x08\x94\xa7\xc8\x4d\x49\x8e\x24\x69\x39\xa2\xf7\xa7\xbb\x08\x4f\x84\x58\x5c\x00\xdf\xee\x22\xf4\xb5\x2a\xd1\xa4\xf1\x62\xc5\xc8\x96\x16\xfe\xf5\x78\x37\x49\x57\x2d\xe9\xe6\xb9\xb8\x0d\xe5\xd9\xca\x1f\x38\xe0\x0e\xf2\x43\xcc\xc1\xc5\x0f\xff\x00\x84\x7a\x7f\xf3\x0c\xbd\x53\x08\x19\x33\x30\xff\x52\x89\x0f\xbd\xd1\x6f\x1e\x43\xd9\x1b\xd5\x02\x00\x00")
 
 func tplObjectRedisPipelineGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -514,7 +581,7 @@ func tplObjectRedisPipelineGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectRedisReadGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x4f\xdb\x48\x10\x7f\xb6\x3f\xc5\xd4\x82\xca\xa6\xc6\xa5\xa8\xea\x03\x5c\xee\xa1\x2d\xf4\xb8\xb6\x80\x02\x3d\x9d\x84\x10\x72\xe2\x31\xb7\xc4\xd9\xf5\xed\x3a\xbe\x46\x96\xbf\xfb\x69\x77\xfd\x37\x31\xc1\x01\xa2\xf6\xa1\x2f\x60\xaf\x67\x67\x7e\x33\xf3\x9b\x9d\xd9\x64\x59\x80\x21\xa1\x08\x16\x1b\xdd\xe1\x38\xf1\x38\x06\x44\x78\x1c\xfd\xc0\xca\x73\x33\xcb\xb6\xd8\xe8\x0e\x0e\x06\xe0\xe9\xb7\x98\x93\xa9\xcf\xe7\x72\x45\x7e\xf1\xce\xf5\xfb\x67\x9c\xb7\xbe\x1f\x13\x8c\x02\x25\x54\x2c\x78\xc7\x84\x8b\x44\x2f\xe7\xb9\x69\xbe\x7e\xfd\x02\x94\x29\x98\xb2\x00\x23\x90\x06\xcd\x70\x46\xc7\x60\x4f\x61\xe7\x46\xdb\xf5\x4e\xfd\x29\xe6\xf9\x50\xca\x7d\xbd\xe5\x0e\x1c\x13\x1a\x9c\x51\xb4\x67\x94\xfc\x3b\x43\xf8\xa6\xfe\x39\x60\xd7\x28\x5c\x40\xce\x19\x77\x20\x33\x0d\x12\x02\xc7\xc8\x4f\x08\xa3\x12\x8a\xde\xe4\x7d\xfb\x3c\x2c\x16\xed\xa9\xa7\x54\x5f\x24\x8c\xa3\x73\x58\x0b\xbf\x18\x00\x25\x91\x54\x61\x88\x84\x2b\x95\x52\x41\xf9\xdd\x6b\xc3\xf0\x3e\xe3\xdc\x76\x1c\xd3\x90\x06\xa5\x68\x63\xbb\xc1\x31\x99\x71\x2a\xdf\x95\x1a\xd3\x30\x72\xd3\x34\x8c\x78\x22\x15\xb6\xbc\xfc\x7a\xcb\xbd\x53\xfc\xaf\x76\xc5\x6e\xa8\x3c\x18\x40\x3c\xf1\xce\x7d\x2e\xd0\x16\x09\x77\x0e\x57\x18\x82\xda\x52\xb5\x1c\x4f\x5c\xf9\xc9\x94\x6b\x4d\x48\xe1\x34\xf1\x8e\x64\xc0\x42\xdb\x2a\x82\x4a\x19\xc5\xda\x55\xcb\x31\x73\xb3\x77\x62\x8e\x31\x19\xff\xb3\x94\x9d\x9d\xd6\xa6\x66\x86\xd2\x2a\xb6\xd3\x85\xa0\x3a\x66\x47\x34\x97\x82\x59\x7b\x33\xf5\xb4\xed\xb4\x3f\x5e\x9b\xd0\x00\xbf\xc3\x89\xfc\xeb\x80\x4d\x68\xf2\xee\xad\x0b\x57\xd7\xbd\xc8\xa4\xf6\x7a\x27\x1f\xff\x5e\x93\x4c\xa2\x9b\x4d\x1a\x4b\x1f\x26\xed\xb9\x6d\x32\x19\x46\xc2\x12\x3f\xd2\x98\x92\x77\x6f\xed\x08\xa9\xa4\x88\x50\x7a\xe2\x37\x2e\xc4\xfb\x35\xe0\x73\x26\x88\x34\x7a\x16\x86\x02\x93\x2f\x64\x4a\x92\xf6\x06\xf9\x00\x03\x90\xff\xae\xe2\x37\x07\xf1\xfe\xb5\xa9\x02\x2f\x66\x51\x22\x54\xa2\xfc\x09\xda\xed\x20\xed\xb9\xd0\xd2\x11\x32\x0e\x37\xae\xd4\xa1\xfc\xf4\xe9\x2d\x2a\x85\xda\x8f\xde\xd4\xef\xcf\x7d\x1d\x82\xdd\x5d\xf5\x3c\x66\x34\x21\x74\x86\xf2\x25\xd7\x81\xd3\xe0\x07\xe0\xc7\x31\xd2\xc0\x2e\x16\x5c\x88\x27\x4e\xbb\x4c\x94\x1e\x17\x2a\x81\x85\x92\x29\x63\xdf\xac\x1a\x4d\xa2\x47\x17\x8d\x66\xed\x3d\x4c\xbc\xbf\x70\x0a\xa0\xa9\x58\x28\x20\xad\x69\x55\xf1\xb4\xf8\x93\x9b\x06\x1b\xdd\xb5\x94\x48\x3c\xef\xe7\x75\x2a\x84\x9d\x0a\xc7\x5c\x08\x50\xb5\xa9\x97\x9f\x43\xc9\x00\x5b\x8c\x59\x8c\xfa\x79\xed\x6a\x53\x7b\xbd\xe1\xe9\xa7\xa7\x57\x5b\x03\x8c\x2e\x37\xb7\xd0\xfe\x1e\x6f\x09\xad\x5f\x8f\x68\xb0\xa9\x52\xd4\x06\x7e\x95\xe2\x73\x97\xa2\x76\xef\x51\xa5\xa8\x58\xa1\x6b\xf1\x1e\x9e\xae\x57\x8b\x0d\x96\xfd\x8c\xc5\x38\xc4\x14\x79\xf2\x03\x4a\x52\x6f\xd2\xd6\x13\xae\xda\xfc\xaa\x3a\x6d\xe2\x7c\xee\x6a\xfd\x55\xae\x3f\xba\x5c\x55\x72\xe1\xa9\x55\xab\x39\xf2\xdc\xb5\xdb\x64\xde\x4f\x56\xc1\xda\xd5\x78\x02\xb5\xd6\x95\x43\x76\x71\x81\xeb\xe2\x4d\x6b\xcd\x76\x4c\xd3\x88\x49\x8c\x1a\xba\xaa\xb0\x73\x12\x63\x44\x28\x4a\x5a\xc9\x4f\xde\xd1\x77\x22\x12\x61\x4f\x70\x7e\x16\x9e\xa9\x1b\xa3\xcd\x46\x77\x92\x10\xc5\xf0\x5a\x0a\xfe\xf1\xf5\x13\x26\x2b\xe4\x5c\xd3\xc8\xb2\xdd\x22\xfb\x5b\xc4\x85\xad\xb0\xba\x33\x4a\x4c\xea\xaa\x28\xf2\xdc\x34\xac\x2c\xd3\xdf\x0a\x9c\x56\xb1\x15\x69\x00\xbb\x79\xee\x98\xc6\x78\x1a\xd4\x41\x2f\x60\xe2\xd8\xee\x7b\x87\x50\x62\xa3\x4a\x81\xd4\x76\xb5\x77\xed\xd9\x3b\xfa\x2e\xfc\x9e\xb1\xe8\xc3\x34\x70\xbc\xa1\x22\xb7\x5d\x94\xd1\xa0\xd6\x49\x42\x78\x31\x5a\xbe\xef\x35\xc9\xde\x8a\x34\x94\xb7\xe8\x09\xce\x0f\xec\x6d\xe1\x00\x65\x09\xa0\x0c\xad\xd5\x08\x91\xae\x30\x89\xaf\x75\x44\x2a\x7c\x6f\x6a\x7c\x17\x11\x19\x63\x0b\x60\x6f\xbf\x7b\x66\x40\xc9\x91\xb0\x10\xf0\x4e\xc4\x29\x62\x70\xc9\x7d\x2a\x42\xc6\xa7\x4a\x62\x59\x64\x16\x45\xfe\x28\x42\xd0\x9f\x25\x22\x75\x34\x66\xd9\x16\xc9\xf3\x6b\x4f\x1e\x48\x84\xde\x3a\x32\x8e\x16\x25\x91\x55\x1c\x48\x92\xae\xde\x42\xc6\x61\xa0\xcf\x13\x79\x2e\x01\x46\x02\x4b\xd9\xd4\xe7\x90\xfa\x91\xd6\x09\xd5\xae\x4f\x98\x54\xe8\xbc\xcb\x79\x8c\x67\x9c\xdc\x12\x5a\x20\x69\x9c\x8e\xf2\xfb\x85\xc2\x71\x31\xf6\xf5\x39\xbc\x04\xd0\x85\x97\x95\x89\xae\x13\xb4\xeb\x8e\x5f\x1e\xa0\x32\x2c\x2d\x4f\xfe\xf2\xa3\x19\xea\x6a\xdc\x95\x2c\xa0\x49\x15\xb2\x16\xe8\x0f\x8c\xca\xf3\xe7\x92\x81\x5d\x48\x59\xa9\x1f\x6d\x07\x16\x6c\x11\xa7\xf4\xa3\x3b\x52\x2f\xbb\x4c\x9a\x15\x24\x55\x39\x91\xc0\x42\xc9\xa3\x22\xf8\xcc\x01\xec\x8a\x9f\x36\xd4\xed\xe1\xd3\x63\x57\x1c\x1f\x15\xb7\xeb\x80\xac\xed\x5a\x07\xc4\x2e\x27\x3b\x7c\xac\x8c\x37\x80\x34\x2b\xe8\x88\x8e\x59\x50\xc0\xea\x0e\x84\x84\xf8\x11\xa5\x94\xdd\x05\xa3\xad\xbf\xf1\x58\x80\x51\x07\xb2\x2c\xac\xde\x5d\xa7\xdd\xc9\xe2\x89\x68\x4d\x8b\x0e\xd8\xab\x7a\xad\xec\x72\x8d\x39\x68\x51\xb0\x98\x85\xe2\x89\x28\x7b\xc8\x3d\x7d\x68\x8d\x7e\x56\x0e\x54\x7a\x72\xd2\x27\x9d\x44\x2d\x73\xd2\xbb\x9d\xf5\xee\x67\xbd\x8f\xd3\xae\x8e\xb6\xd0\xd2\xf2\x27\x77\x35\x03\x39\xf7\x23\x35\xde\x5e\x5d\x6b\xbe\x66\xb9\x69\x88\x54\xae\x58\x96\x69\x30\x15\x14\x39\x85\xeb\x38\x11\xf9\xba\x77\x08\x04\x7e\xab\x32\x71\x08\xe4\xd5\xab\xb2\xc7\x2d\xb4\xc8\xfd\x1d\xb2\x4e\x93\x6c\x76\xc9\x12\x5b\x35\x58\xea\x77\xdd\x32\x2f\x74\xd5\xae\xd9\x33\xc5\x15\xb9\x6e\xe4\x6c\x79\x9a\x55\x13\xff\x72\x23\xdd\xdf\x21\xaf\x1e\x68\xa6\x5d\x97\x8a\x5e\x0e\x48\xa8\xdb\xa9\x8b\x9c\x1f\x6c\xa7\x0b\x28\x15\x0a\x3d\x1e\x94\x90\x1b\x88\x15\xd8\x75\x88\xde\x9b\x7b\x7d\x7a\xf9\x83\xcd\xbc\x7f\x37\x7f\xa0\x9d\x2f\xf4\xf3\x47\x36\x74\x43\xa4\x2e\xb0\x49\x79\xfb\x5a\x04\x55\x08\x49\xfe\xb1\x49\x65\xaa\x5f\x0a\xc7\xba\x99\xc0\x76\x0a\x09\x03\xad\x50\x9f\x69\x96\xdb\xb2\xe6\x94\x66\x5a\xc4\xab\x07\x81\x15\x9d\x25\x7d\x78\xc4\xd8\x08\xdf\x56\x80\xdd\xe4\xd8\xb2\xf6\xdc\x62\xd4\xa4\x6c\x4c\x2e\x8f\xe4\x4a\x1f\xaa\xb4\x99\x02\x00\xb0\x19\xae\x48\xcd\xed\x04\x3c\x38\xa1\xf6\xe0\xca\x66\xa8\x72\x2f\xd6\x4d\xcd\x68\xad\xd9\x68\x31\xf9\x3d\xb2\xd8\x4e\xe2\x66\x12\xd8\x0a\xc8\x43\xb3\x71\xda\x7f\x5a\xdc\x50\x0e\x97\xe1\x2e\xc5\xf8\xbe\x01\xf4\xd1\x13\xe8\xf2\xac\x5d\x3c\xab\x71\xb0\x72\x50\xff\x04\xc2\x46\x77\x7a\xf6\x21\xa1\x1a\x41\xb4\xdb\x0e\xfc\x0e\x7b\xcd\x31\xa7\xfa\xbd\x84\x71\x21\xbb\x61\x91\x77\xe1\xfd\xc9\x08\xad\x62\x75\x34\x1c\x9e\x0d\x6f\x2e\xce\xbf\x9c\x5c\x3a\x4e\xf3\xc7\x28\xbd\xbd\x18\x7c\xb3\x4c\x03\xfa\x3f\x00\x00\xff\xff\x18\x73\x5b\x30\x09\x1f\x00\x00")
+var _tplObjectRedisReadGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x59\x5b\x6f\xdb\x36\x14\x7e\x96\x7f\x05\x2b\x34\x85\x94\x2a\x6a\x5a\x0c\x7b\x48\x97\x3d\xb4\x4d\xba\xae\x6d\x12\xc4\xed\x36\x20\x08\x0a\xc5\xa2\x13\xce\xba\x4d\xa4\xdd\x04\x86\xfe\xfb\x0e\x2f\xba\x50\x17\x5b\x72\x9c\xb6\x0f\x0d\x90\xd8\xa2\xc8\x73\xbe\x73\xe1\xe1\x77\x98\xe5\xd2\xc7\x53\x12\x61\x64\xc6\x57\xff\xe2\x09\x73\x53\xec\x13\x0a\x7f\x3d\xdf\xcc\xb2\xd1\x72\xf9\x18\xc6\xd1\xc1\x21\x72\xe5\x53\x92\x92\xd0\x4b\xef\xf8\x08\x7f\xe3\x9e\xc9\xe7\xf7\xf8\x4e\x7b\x7f\x4c\x70\xe0\x8b\x49\x6a\xc0\x3d\x26\x29\x65\x72\x18\x66\x8e\x9e\x3d\x7b\x84\x84\x2a\x14\xc6\x3e\x0e\x10\x57\x38\x9a\xce\xa3\x09\xb2\x42\xb4\xfb\x45\xea\x75\x4f\xbc\x10\x67\xd9\x39\x9f\xf7\xf1\x3a\xb5\xd1\x31\x89\xfc\xd3\x08\x5b\xf3\x88\xfc\x37\xc7\xe8\xb3\xf8\xb0\x91\x55\xa2\x70\x10\x4e\xd3\x18\xa6\x2e\x47\x06\x99\x82\xd8\xc0\x63\x24\x8e\x38\x14\xb9\xc8\xfd\xfc\xfe\x5c\x0d\x5a\xa1\x2b\x44\x8f\x59\x9c\x62\xfb\x65\x39\xf9\xd1\x21\x8a\x48\xc0\x45\x18\x94\xa5\x42\x24\x17\x90\xbf\x77\x75\x18\x2e\xa8\xb5\x6c\x1b\x26\x83\x42\x3e\xb5\xb2\xdc\x48\x31\x9b\xa7\x11\x7f\x16\x62\x60\x08\x8c\x37\x8c\x64\xc6\x05\x6a\x56\x82\x81\xee\x09\xfe\x5a\x9a\x62\x55\x44\xc2\xe4\x64\xe6\x9e\x79\x29\xc5\x16\x40\x02\xb0\xdd\x8a\x50\xa9\xa9\x18\x4e\x66\x0e\x7f\x35\xe2\x63\x55\x48\xd3\x90\xb9\x47\xdc\x61\x53\xcb\x54\x4e\x8d\x62\xc8\x86\xc2\x54\xd3\x1e\x01\xe0\xbe\x81\x79\xcd\x6e\xad\x09\xbb\x45\x93\x38\x62\xf8\x96\xb9\xaf\xe5\xa7\x83\xfa\x05\x4c\x21\x0b\xdd\xbf\x09\xbb\x51\x6b\xb9\x3c\xbb\xe6\xf1\x41\x98\x8e\x31\x9b\xdc\x34\x32\x66\x57\x5b\x54\x05\xb1\x28\xe2\x1d\x36\xd4\xb6\x44\xb8\x11\xe0\xac\x62\x87\xd4\xbd\x18\x8e\xb7\xb7\x23\xbb\xed\x58\xeb\xcc\xaa\x63\xfa\x23\xb4\xe0\x17\xdf\xa2\x77\xfc\x2f\xe8\x27\x11\xfb\xf5\x17\x07\x5d\x5c\xf6\xda\x82\x62\xad\xfb\xee\xcd\x3f\x03\xb7\x20\x6d\xdf\x83\x12\x4b\x9f\xfd\xb7\xef\xe8\x5b\xd0\x30\x58\xcc\xbc\x40\x62\x02\x0b\xac\x00\x47\x7c\x63\x51\x21\x27\x79\xee\xa0\xe4\x45\x09\xf8\x2c\xa6\x84\x2b\x3d\x9d\x4e\x29\x66\x1f\x48\x48\x98\xbe\x80\x7f\x41\x87\x88\x7f\x5c\x24\xcf\x0f\x92\x17\x97\x23\x91\x1a\x74\x1e\x30\x2a\x52\xc9\x9b\x61\x4b\x77\x12\x40\xd2\x64\x4c\xe3\x14\x7d\x71\xb8\x0c\x61\xa7\x17\x5d\x63\x21\x50\xda\xd1\xbb\x60\xf4\xaf\x18\xd2\x05\x7b\x7b\xe2\x3b\x4f\x34\x12\xcd\x31\x7f\xc8\xa4\xe3\x24\xf8\x43\xe4\x25\x09\x06\x5f\xab\x01\xf0\xcc\xcc\xd6\x8b\x8b\x90\xe3\xa0\x62\x42\xad\xd0\xe4\xbe\xaf\xd6\x1a\x99\x44\x1b\x97\x9a\xce\xed\x31\x28\x39\x57\xef\x10\x99\x5c\xfd\x31\xc9\xed\xd4\x01\xa0\x7b\x9b\x2a\xe7\x2d\x68\xad\xec\xe4\xea\xbb\x4b\x8e\x96\xd3\xe0\x6e\x90\xaf\x09\xe1\x78\x5e\xdd\x95\xa6\x53\x6b\x41\xed\x51\x2d\x68\xc5\xa2\x61\x76\x0e\x0d\xc0\xa6\x65\xaa\xe2\xd4\x7e\x91\x38\xe7\xfb\xc6\xa2\x93\x38\xc1\xf2\xfb\xe0\x1a\x25\xd6\xba\xe7\x27\x6f\xef\x5f\xa3\x2a\x60\x64\x91\x72\x94\xf4\x57\xf8\x9a\x44\xe5\xe3\x11\x84\xfb\x81\x0a\x98\x54\xf0\xb3\x80\x6d\xbb\x80\x49\xf3\x36\x2a\x60\x22\x2b\x3a\x37\xd0\xa0\xd4\xed\xde\x3c\x95\xd4\x1b\x80\x4a\x6e\xb7\x0e\x08\xc3\x6a\x98\x06\xe0\x87\x2a\x62\xa5\xa5\x43\x83\xb0\x49\x15\xab\xfb\x75\x40\x34\xce\xf1\x02\xa7\xec\x3b\x14\x33\xb9\x48\x6a\x67\xa9\x20\xbe\xab\x2a\x5c\x15\xe7\xb6\xeb\xdc\xcf\x42\xf7\xbd\x0b\x9d\x08\x2e\xba\x6f\xbd\x93\x39\xf2\x4d\xaa\x5e\x35\x1d\x07\x23\xdc\x76\x05\xd4\xc1\xfc\x78\x75\xb0\x62\xf5\x37\xab\x86\x0d\x4f\xf7\x24\xd9\x62\x01\x6c\xaf\xd2\x0b\x2b\x1b\x60\x75\x71\xd5\xb6\x13\xb5\x31\xd8\x8c\x23\x23\x21\x60\xa2\x70\xb5\xa8\x59\x67\xf0\x18\x10\xe8\xfc\x6d\xf9\xca\x3d\xba\x25\x94\x51\x6b\x86\xef\x4e\xa7\xa7\xe2\xa6\xcc\x02\x09\x7c\x8b\xa9\xf6\x33\x9f\xf8\xc7\xc7\xb7\x98\xad\x98\xe7\x8c\x8c\xe5\x72\x4f\xed\xa7\xc7\xc4\x41\x8f\xa7\xc5\x5d\x19\xc7\x24\xae\xc8\x68\x06\x29\x60\x02\x4a\xf1\x4e\xe1\x34\xd5\x52\xd8\xe2\x68\x2f\xcb\x40\xe1\x24\xf4\xcb\x24\x51\x30\xf1\xc4\xea\x7b\x4f\x21\xa6\x5d\x15\x02\xb8\xb4\x8b\xfd\x4b\xd7\xda\x95\x77\x80\xaf\xe2\x38\x78\x1d\xfa\x10\x34\x51\x2e\x2c\x55\x98\x0e\x4b\x99\xb0\xfc\xd1\x55\xf3\x9e\xeb\x49\x9c\x86\xee\x49\xcc\x8e\xe3\x79\xe4\x8b\x3a\xb2\x94\xae\x38\x40\xa6\xe6\x79\xd3\x41\xe0\x94\x83\xc2\x39\x99\xac\x56\x1c\x99\x76\xdc\x08\x64\xcf\x4b\x64\xe3\x80\x4c\xb0\x06\xad\xb7\xc5\x0b\x2f\x45\x21\xa1\x94\x44\xd7\x90\xc2\xa0\x05\xbe\xf4\x8e\x08\x57\x22\x4e\x0e\xb0\x82\x64\xd9\x65\xd5\x17\x46\x2e\xb5\x28\xc3\x6a\xc0\x41\x8d\x40\x8a\xaa\x8c\x70\x40\xb1\x58\xca\xb5\x83\x64\x35\xe7\x1d\x3d\xc1\xd8\xff\x04\x70\x28\x1c\x34\xa1\xd0\xdb\x9c\x32\x0f\x02\xef\x2a\xc0\x48\xbe\xae\x03\x73\x2d\x69\x99\xcd\x11\x9a\x00\xd1\x54\x07\x09\xdf\x14\x6e\x0d\x0e\x3a\x94\xe7\x80\xa1\x81\x82\x1f\xee\xab\x85\x17\x48\x99\xa8\x58\x05\xf9\x5d\xa0\x73\x3f\xdd\x25\xf8\x34\x25\xb0\x65\x14\x92\xca\xa9\xc6\xdf\x8f\x05\x8e\xf1\xc4\x93\xe7\x67\x03\x20\x64\x4b\xa1\xa2\xed\xe4\x6b\xbb\x41\xcd\x0f\x3e\xee\x16\xcd\x92\xbf\xbc\x60\x8e\xe5\x9e\xdf\x43\x09\xc8\x67\x85\xcb\x34\xd0\x50\x92\x78\x05\xfa\x14\x23\x4b\xcd\x32\x01\xc4\x8e\x6f\x42\xf8\xed\xdc\x8e\x76\x4f\x3d\x69\x53\x39\x2a\x20\x89\xfd\x09\x2e\x54\x42\x36\xf2\xe0\x96\x1d\xd8\xe6\x3f\xa9\xa8\xdd\xc2\xfb\xfb\x4e\x15\x29\xf1\x90\x3b\x44\xcb\xde\x3f\xc7\xa7\x27\x72\x6a\x69\xeb\xbf\x14\x18\xc5\xe7\x08\x0a\x3b\xbd\xf1\x02\x60\x60\x57\x77\x0c\xb7\x9b\xcc\x6d\x6e\xc1\xde\x66\x7d\x8b\xf1\x55\x54\x75\x10\x3d\x1d\xbe\x05\xe5\x15\xf7\x54\x3d\x73\x14\x4d\x62\x5f\xc1\x6a\x0f\x0f\x87\xf8\x06\xf3\x59\x56\x1b\x8c\x9a\xfc\x6c\x54\x7d\x04\x4d\x9c\xfe\x27\x71\xca\xc6\x93\x1b\x1c\x7a\x6f\x52\x32\x65\x56\xa3\x28\xe7\xf5\xd8\xc9\x4b\xa5\x38\x4f\x0d\xfe\x3f\x9b\x2b\x4c\x19\xc2\x53\x48\x05\x20\x06\x1e\x9a\x7a\x24\xc0\x3e\x9a\x27\xd7\xa9\xe7\x43\x90\x29\x30\xe5\x29\x03\x3e\x82\xd8\x0d\xd0\x45\x38\xf7\xe5\x7f\x76\x8c\x55\x07\x6c\x25\x00\xa1\xab\x44\x59\x7c\xbe\x83\x56\x9c\xa3\x48\x3c\xe7\x00\x1b\x67\x13\x17\xfa\xa5\xed\x70\x7c\x29\x5c\x08\x25\x34\x1e\x4f\x52\x92\x30\x0b\xa6\xd8\x2a\x62\xa1\xfb\x21\xf6\x7c\x39\x4e\x25\x49\x2f\xce\xa4\x3c\x9c\x42\x2d\x2f\x98\xbd\x39\x4b\x27\xb1\xea\xcf\x65\x56\xdc\x92\x29\x56\x34\x80\x43\xe9\x3c\x32\x99\x51\x8d\x69\x03\x8e\x55\xdc\x8e\x73\xcc\x4a\x9f\x54\x9f\xa8\x7a\x25\x10\x9a\x33\xa2\x8e\xa0\x0f\x60\x67\x79\xc3\x25\x3b\x2b\x79\x4e\x73\xd4\x4b\x95\x56\xbd\xc8\x59\x6f\x76\xd6\x9b\x0c\xb4\xf1\xb3\x1a\x41\xcb\xee\xcd\xd1\xc4\x11\x02\xdf\xbd\x20\x10\x79\xfb\x11\xe8\x0e\x11\x9c\x4a\xbe\x52\xdb\x85\xb6\x86\x3c\xf7\x37\xd0\xa9\x05\x57\x6f\x9a\xe0\x77\xe1\x44\xde\xd5\x4b\xbf\x12\xfe\xb8\xff\x12\x3e\x7f\x2b\x22\x07\x4f\x4f\x9f\xe6\x0c\xaf\x46\x10\x5f\xec\x92\x21\x14\xb1\xca\x11\x0d\x65\x47\xc1\x90\xe4\xf3\x46\x84\x91\x5e\x00\x0c\x49\x1a\xed\xd6\xb6\x58\x5c\x1d\x34\x59\x24\xc0\x7f\xba\x86\x49\xb6\xdd\x4e\x74\x21\xaf\x76\xca\x90\x55\x07\x3b\x0b\x07\x5e\x1d\xec\x7c\x15\x55\xb4\x04\x29\x40\x88\x34\xac\x02\x15\x18\x87\x6c\x84\x0e\xfa\xda\x3b\x65\x57\x12\xd8\xa1\x0c\x56\x63\x8b\x3d\x38\xec\x5a\x12\xdb\x9f\xc5\xae\xa1\xb1\x35\x1e\xbb\x21\x91\x85\x4d\x03\x87\xcc\x2c\xbf\x2d\xaa\x83\x52\x93\x78\x7e\xc3\xa4\x5c\x55\xaf\x4c\x99\x48\x0e\x85\x76\x16\xfc\xa4\x94\xf2\x64\x89\x35\x1d\x4d\x99\x9d\x6b\xd1\xd2\xbb\xe4\xbf\x2b\xa8\xcb\x62\x3d\xb3\xde\x66\x56\xaf\xc0\xf8\x90\x24\x7d\x30\x4b\x37\xca\x54\xac\xf0\xf4\x0d\x33\xa4\x4f\x82\xe8\xf9\x81\xe0\xe7\x41\x32\x84\x0b\xd6\xfd\xbf\xb6\x1d\xeb\x91\x21\x5b\x4d\x90\x4e\x88\x0f\xd5\x87\x68\x4c\xb8\x1e\xf2\x1e\xb1\xd3\x43\xf7\x20\x61\xd3\xfc\xd1\x5e\x25\xcb\x66\x69\x7d\xb7\xb4\x18\xd0\x9d\xd4\xfc\xb1\x3a\x4f\x86\xc8\xcc\x1d\xbe\xe5\xf4\x69\xba\xaa\x11\xde\xae\x4e\x6a\xe3\x56\x4a\x57\x51\x6f\xde\xfa\xb7\x53\x55\x93\xb4\x96\x8a\xcb\x28\x28\x9c\x76\x18\x97\xa3\xad\x2d\x93\xf4\x40\xd9\x2e\xe5\xd3\x5b\x5b\xa6\x52\x7d\xbd\x6d\x52\xff\x1e\x10\xa4\xbe\x08\x93\xbc\x46\x86\xbf\x92\xc1\x56\x21\x56\xa2\x7d\xaf\x6e\x50\x6b\xce\x72\xe9\xeb\x1b\xb4\x66\x7f\x96\xe5\x10\x39\x77\x95\xf9\x65\xa3\xdf\xd1\x7e\x95\x4f\x17\xd7\xe2\xf0\xb2\xd6\xc9\xd1\x81\xad\x9c\xd6\x3a\xad\xe8\xeb\x06\xb5\x54\x6b\x9a\xbb\x46\xbb\x26\x5a\xbd\xe5\x52\xa6\xe1\xff\x0c\x6e\x7c\xfa\xc1\x29\x00\x00")
 
 func tplObjectRedisReadGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -554,7 +621,7 @@ func tplObjectRedisSyncGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectRedisWriteGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xdf\x6f\xdb\x36\x10\x7e\x96\xff\x0a\x56\xf0\x83\x18\x78\x6c\x07\x0c\x7b\xe8\x90\x01\x6b\x9a\x74\xdd\x8f\x24\x48\xd2\x0d\x58\x51\x04\x8a\x75\x52\x18\x53\x94\x4a\xd2\x6b\x52\x41\xff\xfb\x40\x52\x92\x4d\xd9\x96\x2d\x27\x4b\xda\xcd\x0f\x01\x22\xf2\x78\x77\xfc\xf8\x9d\xf4\xf1\x5c\x14\x11\xc4\x94\x03\xf2\xb3\xab\x1b\x18\x2b\x22\x20\xa2\x92\x7c\x12\x54\x81\x5f\x96\x83\xa2\x18\x66\x57\x37\xe8\xe5\x3e\x22\xf6\x29\x17\x34\x0d\xc5\x9d\x1e\xd1\x33\xe4\xd4\x3e\xff\x0a\x77\xce\xfc\x11\x05\x16\x19\xa3\x6a\x80\x1c\x51\x21\x95\x1d\x2e\xcb\xc1\x20\x9e\xf2\x31\x0a\x52\xb4\x77\x69\x43\x90\xe3\x30\x85\xb2\x3c\xd3\xe1\x7f\x4f\x04\x46\x07\x02\x42\x05\x81\x8e\xbe\xe7\x98\x60\x04\x42\x64\x02\x15\x03\x4f\x80\x9a\x0a\x8e\x52\x72\x1e\xfe\x6d\x4c\xf1\x60\x13\xd7\xef\xf2\xe8\xdf\x72\x6d\xb3\xfe\x93\xaa\xeb\xc3\xdb\x9c\x8a\x65\x41\x46\x08\xcc\x14\x52\x34\x05\xf2\x7a\x2a\x42\x45\x33\xbe\x2a\xb4\xeb\xaa\x5e\xdb\x67\x9f\x5f\x48\x32\xaf\x81\xc1\x3a\xd0\xf3\x89\xa6\x8c\x9e\x7a\x03\x6a\xc6\xac\x00\x0f\xbc\x9c\xe6\xa0\x27\x53\xf2\x0a\x12\xca\x4f\x69\x0e\x8c\x72\xd0\x53\xcf\x9f\x3f\x43\x53\x4e\x3f\x4e\x41\x0e\xbc\xa2\xf8\x06\x89\x90\x27\x80\x86\x74\x84\x86\x76\xbc\x61\xeb\x3b\x6b\x56\x96\xd6\x70\x28\x80\x99\x0d\x6b\x83\xa0\x32\xd6\xb1\xcf\xea\x71\x3f\x0f\xa9\xf0\x91\x2f\x95\xa0\x3c\xf1\x51\x93\x37\xd6\x3e\xa6\x93\xcb\x09\xdc\xe9\x1d\xd3\xb2\xd4\x3e\xde\x7f\xb0\x86\xc5\xc0\x9b\xcf\xe4\x66\x84\x86\xb1\xa6\xbe\xce\xa3\x8a\x62\x4a\xc1\x64\xe2\xf9\x45\x61\xa7\x2b\x44\xfc\xd1\xc0\xb3\xeb\x69\x5c\x2d\x24\x6f\xe5\x21\x1f\x67\x11\x98\x05\x5e\x26\x52\x62\x9f\x83\x38\x55\xe4\x3c\x17\x94\xab\xa0\x71\xf3\x06\xd4\x85\x08\xb9\x8c\x33\x91\xfe\x11\xb2\xa9\x2d\x6f\xe2\x97\x25\xc6\x8d\x6f\x60\xb2\xf2\xd6\xd3\xc5\xcc\x03\x8f\x8c\x83\xb9\xff\x2d\x28\x39\xcd\xe7\x40\x29\x8a\x06\xe7\x16\x2b\x02\xdc\x3a\x4d\x7d\xca\xa4\x7e\xc2\x03\x8f\xc6\x9a\x1c\xda\x89\xe3\x95\x9c\x86\x54\x9c\x41\x1a\x58\xb4\x25\xf9\x25\xa3\x3c\x70\x4e\x63\x84\xfc\x97\x3e\xc6\x3f\x98\xf5\xcf\xf6\x11\xa7\x4c\x33\xac\xe6\x33\x08\x61\x92\x9d\xa5\x6e\x79\x44\x79\x04\xb7\x4b\x78\x64\xc6\x1b\x1a\xbd\xb5\x56\x2b\x68\x64\x6c\x5d\x16\x49\x50\x2b\x49\x44\xa3\xdb\x2d\x58\x64\x83\xfc\x67\x49\xa4\x41\x79\x38\x16\x45\xb7\x97\x02\xd8\xa6\xce\x8e\xe1\xd3\xe2\xbc\x4b\x35\xf7\xcc\x2a\xae\xb5\x03\x11\xbb\xe5\x7d\x94\x4f\x48\xf5\x16\x9b\xf1\xd9\xdd\x20\x39\xd7\x6c\x49\x03\xd7\x41\x6f\xf6\x1a\xa2\x2c\x92\x57\x24\x0d\x73\xcf\x8c\xc5\x0a\xe2\x8a\xc4\x65\xed\xe7\x2e\xda\x8a\x64\x0b\xd6\x8a\x64\x9e\xb2\x35\x3b\xe1\x23\x0a\x18\xf0\xb9\x69\x8c\x82\x30\x8a\xd0\xf0\x06\x7d\x8b\xad\x69\x07\xbf\x67\x1c\x5c\x61\xd4\x59\x05\xf7\x2e\x83\x76\x0e\xdb\x14\x82\x53\x09\x1d\x65\x21\x92\x87\xab\x0a\x91\x3c\x70\x51\x38\x8c\x68\x6a\x42\x8e\x33\x01\x97\x22\x69\xc6\xab\x02\xd0\xb0\x5f\x64\x47\x2c\x0b\xd5\xf7\xdf\x69\xa4\x44\x42\x7e\x0b\x2b\x89\xd8\x05\x58\x53\x45\xab\xeb\xc2\xd9\x1a\x39\xd7\x19\xa0\x7d\xd4\xca\xa4\x6d\xd6\x55\xad\x0e\xee\xe4\xaf\xaa\x5a\x9d\xf5\xbd\x8a\x75\xe6\xd9\x9c\xca\x6b\x60\xc1\x04\xee\x4e\xe2\x13\x23\xc2\xad\xb8\xaa\xf3\xc0\x98\x1c\x0a\x11\xac\xf3\x6f\x9c\x5e\x8e\x1c\xbf\x87\xb7\x30\x5e\xbb\xb0\x7e\xe4\x94\x69\x21\xb7\x5e\xc9\x69\x25\xf8\x2a\x54\xe3\x6b\x9d\xa7\x44\xef\x3f\x6c\x26\xa2\xcd\x12\x57\x41\xca\x11\x7a\xb1\x99\x7a\xac\x55\xf8\x86\x82\xbd\x2d\x54\x6d\x94\x1e\x5b\x6b\xe5\xb9\xb0\xc9\xb5\xba\x99\xc6\x88\x01\x37\x8b\x31\xfa\x11\xbd\x30\xc0\x77\x88\x58\x2f\xce\x84\x3e\xbe\xea\xb6\x65\x5f\x9e\x26\xb4\x5e\xe8\x55\xa7\x9a\x92\x30\x8a\x2e\x32\xa7\xa6\xcd\x9a\x99\x16\xf7\xbc\x25\xf5\xe1\x21\x84\x2c\x25\x0e\x58\x26\x6d\x40\x33\x36\x4f\x05\x4f\x93\xc1\xfc\x6d\xcc\xa4\x65\x6e\x1d\x97\xe5\x12\x86\x6d\x74\x0a\xf7\xbb\xb5\xd0\xd8\x00\x39\x97\x69\x17\xf4\x7d\xc0\x5d\x82\xed\x3a\x00\xe6\xe0\xec\x46\x73\xad\xa7\xfe\x50\x2e\xee\x08\xed\xa1\x25\xc6\xb5\x89\x25\x60\x4f\xb4\x3b\xae\x6d\x5a\x95\x98\x4f\xe0\xa2\x2a\x89\x9b\x16\x81\x5e\x39\x27\x0b\xaa\xaf\x75\xc8\xa3\xd9\x17\xfb\x78\xca\x58\x78\xc5\x60\x6e\x04\x20\x6a\x3e\x11\xf6\xbb\x69\x4f\x9d\xb4\x34\x80\x83\x70\xb7\x14\x30\xf8\xff\x7c\x0e\xaa\xe3\x6d\x3c\x42\x8b\x22\x03\xdd\x43\x43\xe0\x65\x1a\x62\xeb\x44\xfa\x46\x5f\x14\x1f\xa5\xc9\xa3\x42\x6b\xeb\x3c\x7c\x4e\x99\x8f\xeb\x77\x8a\xbb\xbb\xae\x23\x78\xb2\x13\x70\x53\x7c\x54\xfc\x57\xc8\xbd\xb6\xb8\xdf\xb5\x38\xbe\x98\xdb\xe9\x43\xb6\x38\xa6\x93\x07\x96\xe1\xcb\xda\x20\xad\x30\x9d\x5a\x77\xb1\xd3\xf2\x53\x14\x05\xce\xfa\x5d\x5b\xe5\x2b\x25\xee\xff\xb0\xad\xa2\xc9\xbb\x6b\xab\xec\xda\x2a\xbb\xb6\xca\xd7\xd0\x56\xd1\xd5\xba\x6d\x5b\xc5\x38\xb6\x37\x95\xea\xc2\xdd\x5c\x4f\xab\x9b\x64\x97\x98\x6b\xae\x78\xba\xea\x7b\xde\xb2\x0e\x18\x84\x22\x70\xef\x9f\x52\x09\xd9\x9c\x4a\xaa\xc3\xc8\x40\x2b\xad\x93\xf8\x80\x85\x52\x06\xbe\xe3\x4b\x4b\xe6\x3d\x1f\x63\x72\x06\x72\xca\x54\x7d\x39\xdc\x9f\x6d\xbb\x6a\x28\x68\xb7\xb3\x86\x82\x97\x9a\xde\x91\x1e\x24\x84\xe0\xe6\x96\xb8\x22\xfe\x75\x28\xaf\x57\xc7\xb7\xbf\x00\xfb\xa3\x47\x48\x44\x82\x7a\x4a\x1c\x3e\x3f\x71\xfc\x04\xb2\xa7\x0c\xcf\xa8\x7c\x9c\xed\xbb\x65\x54\x14\xb6\x4e\xff\x09\x00\x00\xff\xff\xe3\x02\x2a\x56\x74\x20\x00\x00")
+var _tplObjectRedisWriteGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x5c\xeb\x6f\xe3\x36\x12\xff\x1c\xff\x15\x5c\xa3\xb7\x90\x53\x55\xdb\x1e\x0e\xf7\x21\x87\x1c\xb0\x9b\x6e\x5f\x77\xfb\xc0\x66\xbb\x07\x5c\xb0\x08\x14\x89\x76\xd4\xc8\x92\x2b\xc9\x9b\xb8\x86\xff\xf7\x9b\x07\x29\x91\x7a\x58\x72\xec\x36\xed\x35\x1f\xba\x8d\x44\x72\x38\x1c\xce\xfc\xe6\x41\xca\xeb\x75\x28\xa7\x51\x22\xc5\x38\xbd\xfa\x49\x06\x85\x97\xc9\x30\xca\xbd\xdb\x2c\x2a\xe4\x78\xb3\x19\xad\xd7\x9f\x41\x83\x38\x39\x15\x1e\x3f\x2d\xb2\x68\xee\x67\x2b\x7c\x83\x2d\xde\x5b\x7e\xfe\x97\x5c\x59\xed\xdf\x44\x32\x0e\xa9\x93\x7a\xe1\x7d\x13\x65\x79\xc1\xaf\xb9\xe7\x27\x99\xe5\x51\x9a\x94\x94\x3e\xf0\x33\x75\xe1\x1e\x79\x3a\x2d\x42\x19\xcb\x42\x96\x9d\xce\xe1\xd5\xd7\xf4\xca\xe8\xb7\xc8\xe4\xa7\x28\x5d\xe6\xd8\x2b\xcd\xb8\xe3\x8f\x49\xf4\xf3\x52\xe6\xfc\xf0\x7d\x12\xca\x3b\xfd\xf0\xce\x4f\x66\xf8\xb7\x9a\x1f\x48\x8c\xa6\xcb\x24\x10\xce\x5c\x1c\x5f\xf2\x7a\xbd\xd7\xfe\x5c\x6e\x36\xef\x50\x16\xaf\x66\xd9\x44\x9c\x65\xd2\x2f\xa4\x83\xa2\x38\xb6\xba\x4c\x84\xcc\x32\x98\x73\x3d\x3a\xca\x64\xb1\xcc\x12\x31\xf7\xce\xfd\x4f\xd4\x75\x32\x1a\x4e\xfa\xac\xb8\x73\x82\xe2\x4e\x04\x69\x52\xc8\xbb\xc2\x3b\xe3\xff\xbb\x62\xd8\x94\xff\x89\x8a\x6b\x35\x04\xc9\x4c\xbc\x8a\xe1\x61\x5c\xfc\xb8\x08\x7f\xad\x05\x32\xe9\x43\x2f\xb0\x62\x78\x17\x31\x23\x99\x97\x77\x8b\x28\x6b\x5b\xaa\x2b\x24\x35\x89\x22\x9a\x4b\xef\xeb\x65\xe6\x17\xa0\x1f\x5d\x02\xb0\x49\xe9\xb1\xf7\x63\x66\x07\xe1\x0c\x67\xb2\x43\x27\xf6\xe1\x9b\x85\xfe\x3b\x11\x62\x9d\x99\xdf\x46\x88\x6d\x22\xb0\xf8\x7e\xf6\x4c\x30\x40\x89\x4c\xce\xd3\x4f\x80\x34\x38\xff\x2d\x0c\x10\x51\x91\x8b\x08\xa1\x48\xc8\xa4\xc8\x22\x99\x7b\xe2\x85\x9c\xa6\x99\x54\x03\xfc\x24\x14\xcf\xa7\x85\xcc\xf8\x19\x49\xa5\x53\x1a\xee\x03\xaf\x0b\x3f\xcf\x65\x28\x7c\x91\x44\x31\xc0\xdc\xdc\xfb\xfa\x85\x2b\x08\xae\xc5\xb5\x9f\x8b\x24\x15\xc0\x99\x7f\xe5\xe7\x12\x9e\x93\x30\x96\x5e\xbf\x0c\x79\xa2\xed\x66\x1f\x4d\xf1\x6f\xc6\xd6\xb9\x67\x32\xec\x00\x27\x24\xde\xc9\x3f\xa8\xcb\x93\x53\xe2\x0d\xc6\x68\x01\xc2\xdb\xd1\xd1\x66\x74\xb4\xb8\xa1\xf1\x40\xfe\x5b\x59\x54\x2e\xc3\x99\x40\x53\xb4\x20\x78\x47\xd2\xb3\x28\x79\x0b\x8f\x31\x38\x24\x6c\xaa\x66\x9e\x7b\x2c\x4c\x05\xe4\x0e\x0e\x1a\x34\xb3\x49\x04\x07\x79\xc0\xb9\x73\x23\x57\x6f\xa6\x6f\xc8\xdf\xf1\xf6\x2d\x6e\x3c\xe2\x66\x32\xf1\x5e\x66\x99\x33\x88\xe8\xa5\x6b\xd1\x7d\x79\x27\x83\xde\x81\xfa\x11\x05\x69\x6c\x74\x25\xc7\x41\x8a\xcf\x83\x0e\x0d\xa8\x95\x2a\x10\x17\xfd\x6c\xa0\xfd\xbe\xf0\x8b\xe0\x1a\xc7\xe4\xe2\xe2\xe3\x30\xc7\x41\x43\x6c\xfb\xc9\x5d\xf1\xe5\xb0\xa5\x97\x04\xb6\xad\x7e\x18\x2f\x0d\x01\xd8\xeb\xd1\xb6\x8c\x6f\x05\x85\x43\x6c\xc8\xa0\x84\x19\x19\x72\x5e\x80\x1d\x84\x68\x7a\xd7\xae\x02\x74\x32\x60\x06\x08\xb2\xd8\xe2\x5a\x8a\x1c\x18\x40\x3a\x44\xc2\x13\x6f\x92\x78\xa5\x8c\x9e\x28\x97\x26\x4f\x4f\xca\xda\xb3\x65\xe2\x0a\x30\x69\x36\xee\xc0\x4f\x92\xb4\x10\x85\x8c\x63\xe1\x23\xa9\x80\x27\x9b\x66\xe9\x1c\xc6\x8b\x25\x4d\xe8\x12\x29\x98\x71\xd5\x05\x16\xde\x30\xf1\xee\x10\x03\xd4\x51\x70\x87\x4d\x3c\xb4\xf6\x5a\x21\xc9\x0e\x7a\x54\x53\xc4\x86\xe6\xf4\x7a\x0a\x40\x82\x58\x26\xac\x32\xe2\x9f\xe2\x4b\xb2\x7a\xd8\x5e\xc4\x07\x15\x39\x67\x18\x70\xb2\x62\x62\xa3\x05\x6b\x57\xa5\x2a\x38\xad\x68\x66\x23\xc8\x11\x62\x08\xfd\xb7\x5e\x7f\x21\x80\x4e\x19\xfa\x6e\xf0\x25\xe0\x1a\xc5\xc0\x73\xff\x46\x3a\x17\x1f\x73\xf0\x31\xc9\x0c\xb6\xc5\xad\x58\x9c\xf4\x72\x47\x44\x4e\x85\xbf\x58\xc8\x24\x44\xa8\x04\xeb\x6c\x00\x66\x0b\x92\x6b\x04\x55\x0c\x7e\xf2\x33\x81\xcc\x35\x45\x6a\x70\x5f\x85\xe0\x47\x47\xc1\x3c\x34\x99\x3f\xe6\x44\xe4\x6c\x1e\xd6\xb8\xc7\xb1\xc0\x19\x8d\x29\xc5\xf8\x1e\x56\x91\xfb\x01\xee\x8d\x62\x19\x55\xc0\x21\x1b\x15\x25\x25\xff\x2a\x96\x13\xe1\xe0\x28\xda\xbf\x09\xaf\x98\xd8\x64\x34\x47\x5a\x5a\xa4\x3c\x9a\x11\x05\x27\xb6\x77\x62\xa3\xa6\x58\x08\xc5\xa9\x76\x5c\x6d\x13\x74\xb8\xb8\x05\x91\xc5\xed\x88\x3a\xb7\xa3\x55\x56\xa4\x43\x28\xb0\x8b\xe8\x63\xc5\xb8\x1f\x86\xef\xd3\x8a\x38\xb9\x48\x5a\x1b\xf5\x32\xe3\x94\x16\x35\x23\xb1\xc6\xb9\xac\xe8\x5f\x1e\x92\xb2\xda\xb0\x86\x3e\x93\xae\xf0\x3f\xaa\x01\x06\xa2\x78\x27\x1d\x6a\xf2\xec\xd9\x13\xc2\x55\xf5\x2e\x27\xb4\x0b\xae\x65\x70\x03\x70\xc7\xd6\x44\xed\x0a\xb2\xb1\x15\xf2\xc0\xa5\x04\x35\xf2\xf9\x25\x36\x2b\x42\x79\x90\x45\x8b\x02\xa0\x3c\x02\x6c\xcd\x24\xea\x37\xe0\x6d\x3a\x87\x81\x8c\xae\x8c\xf2\x14\xbd\xa5\xcb\x02\x29\x20\x68\xb8\xe2\xf6\x3a\x0a\xae\x71\xaf\x0c\x8e\xb4\x93\x98\xd2\x53\x0a\xff\x64\x82\x93\xea\x7c\x54\x1a\xbd\x92\xcc\xd3\xa7\xe2\x09\xa2\xf2\xf7\xb9\xca\x7a\x01\xcc\xa6\x71\x04\xe6\x05\x9d\x94\xd6\xd8\xea\xa6\x4c\x0a\x9e\x72\xc2\xf3\x57\xcb\xb8\x88\x5e\xa2\x92\x8d\xfa\x54\xa8\xc2\x1b\xa5\x32\x1d\x41\x0e\xf5\xec\xe3\x8a\x8d\xee\x54\x3c\xc5\x8e\xba\x9d\xf8\x58\x33\x40\x9c\x88\xb1\x65\xee\x63\x57\x00\x32\x9c\x74\x43\x86\x2b\xd4\x6c\x27\x10\x1c\x17\x7f\xff\x1b\x9a\xba\x57\xd5\x07\xb4\x17\xd8\x54\xea\x72\x44\x52\x28\x31\x0a\x9f\x48\x57\xc9\x9c\x8e\xd0\xa5\x44\xc9\x52\x96\x8a\xa5\xd4\x08\x9d\x28\xf1\x75\xe6\x27\xe7\xab\x24\x60\x85\x6c\x9d\xec\xf3\xcf\x47\x75\xc5\xb5\xa3\xe0\xd2\x65\x6f\x09\x81\xbb\xa0\x5b\x79\x0c\xe4\xba\xf2\x18\x46\xdf\xdc\x80\xf8\xd2\x22\x6d\xf5\x69\xd7\x0f\x93\x5f\x6b\xf0\x96\x10\xbb\xdf\x55\x55\x71\x6e\x3b\x0e\x68\x01\x6c\x83\x00\x8a\x90\xcf\xe2\x34\xe7\x39\xb7\x48\x66\x58\x54\xdd\x20\xd8\x2b\x0b\xbd\xff\x26\x94\x0c\xf5\xd2\xfb\x6e\xb8\xc1\x4b\x95\x02\x20\xcc\x6d\xee\x17\xa9\xec\x16\xfb\xee\x91\xeb\x76\x87\xeb\xbb\xe4\xe9\xcd\x48\x51\xec\x1e\x63\xe1\x28\x43\xd4\x3b\x85\x50\xad\xca\xd1\x08\x9e\x48\x78\x40\xb0\x2e\xbf\x35\xfc\xd9\x1b\xd0\xb4\x06\x22\x3a\x02\x5b\xef\x10\x3e\x6d\x1e\x34\x72\x29\x25\x7e\x74\xd4\x6d\xf6\xcd\x28\x46\x07\x03\x5f\xd6\x82\x81\xe6\xd4\x93\x56\x28\x1b\x0c\x56\x87\x81\xa3\x5e\xf0\xb8\x37\x0e\x59\x1e\xa3\x35\x70\x1b\xe2\x61\x15\x3f\x0f\xe3\x61\x37\x0d\xcf\xd7\x65\x3e\x98\x6f\x96\x45\x7c\xa7\xee\x5d\x27\xda\xa8\x3a\xbc\xab\x39\x45\xbd\x2a\x62\xe3\xec\x7d\x51\xf3\xb7\xae\x0c\xf6\xd4\x33\x31\x4c\xac\xa0\x0a\x73\x7c\x08\xe4\x92\x00\x52\x75\x88\x13\x73\x78\xe5\xb6\x84\xaf\x1c\x67\xce\xfd\x15\xb4\x01\x1a\x60\xd1\x10\x02\x59\xec\x70\x9d\xa6\x37\x62\x26\x21\x5e\xe5\x1c\x3f\xbc\xea\x97\x8a\x8d\x94\xbb\x55\xfc\x7a\x7c\x5f\xad\xce\xa5\x75\x1f\xc9\x3f\x5f\x16\xe9\x07\x3f\x8e\xb0\x4a\x81\xdb\x6d\x50\xc7\x53\x1f\xd5\xe2\x0c\xa2\xa9\x34\xc6\xd4\x40\x3d\xc5\x7b\xd8\x30\x3a\x13\xca\x3b\x94\x11\x9f\x8b\x74\x89\xf5\x1d\x58\xd5\xeb\xf4\x16\x0d\xa3\xc8\x96\x88\x53\x06\x6d\x5b\xd3\x70\xd7\x2c\x98\xe1\x6c\x22\xaf\x36\x49\x15\x6d\x18\x03\x45\xc4\x2d\xaa\x38\x44\xc5\x1c\xe9\x43\x62\xb2\x12\x1a\x9c\x09\xa2\x60\x63\x65\x22\x96\xc9\x4d\x92\xde\x26\x2e\xcd\x12\x15\x82\xa7\x66\x0a\x90\x85\xcc\x71\x7d\x2a\x9d\xd0\x76\xc6\x19\x8b\xa2\x51\x66\x25\xaa\xb5\x5f\x03\x9a\x88\x29\x8e\x45\x4b\x67\xdd\x85\x57\xb5\x8b\xa1\x38\x66\xe2\x5e\xf9\x2a\x70\x81\xb8\xe3\x3b\x78\xc2\x51\xab\x9b\x86\x67\x92\x73\xcd\x7b\xb4\x65\x89\xb5\xa4\xd0\x4f\x56\xc5\x35\x78\x63\xdc\x22\x9d\x11\xd2\x56\x71\x0e\x98\x62\x61\x6e\xb6\xf4\xb3\xd0\xd8\xc1\xbc\x9e\xf9\x19\xd3\xd5\x33\x09\xc5\x59\x13\xee\x20\xc6\x6f\x85\xc1\xa6\xeb\xeb\x26\xf1\xe4\xb4\x8f\x46\x0d\xad\xc9\x52\x1f\xc4\x83\xd4\xa3\xef\x66\x11\xff\xbc\xf0\xe3\x5a\x25\xbf\xd4\xb2\xee\xf0\x8d\x56\x54\x3a\x21\x1b\x0f\xa6\x64\xf7\x46\x64\x80\x55\x59\x06\x03\x2e\x0a\xb6\x04\x1d\x0d\xb2\x06\x6c\x19\xaa\x84\x9a\xa4\xc9\xa3\xbd\x17\x60\xb7\x57\x12\x14\x29\xb4\xec\x92\x94\x6d\xb4\x2d\xd5\x64\x22\x17\xe3\x86\xd4\xc6\x1f\x21\x78\x9b\xce\x0b\xef\x7c\x01\xd1\x62\xd1\x2e\xda\xcf\xbf\xb2\x51\x6a\xe1\x47\x99\x59\x2e\x83\x81\x32\x9b\xfa\x81\x5c\x6f\xca\x82\x1f\xcf\x38\x39\xfe\x2b\x0c\xc5\x5c\x27\x01\x4a\xae\xf8\xe4\xc7\x4b\x59\x25\x3c\xdc\x89\x24\xc2\x34\xcb\xb4\x9a\x1e\x5d\x73\xd4\xa4\x81\xed\xc6\xfa\x74\x19\xa4\x3c\x84\x02\x64\xcb\x4d\x19\xb1\xb9\x05\x3e\x88\x0a\x90\x6e\xba\xcc\x11\x45\x53\x31\x4b\xc5\x95\x1f\xdc\xe0\x9f\x3e\xc4\x00\x71\x88\x65\x93\x04\xb2\xf7\x60\x1e\x6a\x17\xf4\xdd\xb9\x2c\x94\x02\x92\xc2\x78\x15\x3e\x01\xa2\xb8\xa2\x45\xa8\xee\x56\x45\x85\x10\xd8\x07\xab\x03\xc5\xc3\x45\x7a\x9e\xa7\xa5\xab\x4c\xf1\xd7\x9d\x1b\x9d\x4e\x73\xea\xca\xb5\x69\x2e\x2a\xdd\xe3\x63\x32\xef\xbb\x57\xc0\x8c\x43\xd3\xaa\xcd\x35\xc7\x56\x5b\x53\x5d\x9c\x30\x77\x06\xdf\x0a\x7e\x1d\xea\xca\x14\x15\xc8\x6e\x24\x02\x20\xa0\x9c\x72\x36\x11\xdb\xa6\x4e\xbc\x3c\xeb\x2a\x86\x01\x49\x6d\xd9\xd8\xe0\x73\xba\x0e\xbb\xa6\xc5\x0b\x93\x22\xf8\xac\xe1\xc7\x7e\x0d\x73\xd6\xb2\x3c\x20\x45\xde\x28\x24\xc8\x1e\x50\xd7\x70\x54\xa6\x40\xd1\x1f\xed\x51\x99\x06\xb5\x43\x8b\x9a\x20\x40\x4f\x49\x55\x4f\x93\x5f\x73\xf6\xaa\x91\xa6\x56\x61\x49\x85\x71\x56\xe0\xa0\x0c\x5a\x6d\x25\x76\xd2\xc7\x4a\x74\x06\x0d\x2f\xa3\x8c\xcd\x39\xef\x8f\x17\x6c\x1c\x6d\x44\x8c\xce\xdc\x5f\x5c\x70\x92\x5b\x9e\x36\x54\x3e\x1f\x39\x66\x90\xf9\x6c\x5a\x5e\x07\xc2\xe1\x3f\x9c\xbf\x79\xcd\x54\x59\xe3\xb9\x59\x51\xc5\xc6\x12\xc9\x7f\xca\xc1\x6e\x5e\xf9\x59\x7e\xed\xc7\xda\x9c\xcc\xce\x3b\x21\xbb\xe5\x2c\x4a\xec\x6c\x59\xc2\x7a\x0d\xf0\xc9\xac\x6a\x36\x27\xd6\x72\x22\xb7\xbe\xa4\x6a\x39\xe5\x4e\x33\x9f\xdf\xe7\xb8\xa0\x9a\x03\x30\x97\x40\xf0\xcf\x33\x3b\x2d\xa2\x98\x18\x41\x82\x45\xf6\xc5\x0a\x12\x84\x81\x74\xdb\x25\x67\xd1\xa5\x58\x5a\xd3\x7e\xbd\x8c\x63\x2c\x39\x18\x6f\xa4\x0c\xa9\xb6\x01\x8e\x64\xae\xd4\xbf\x85\xaa\x65\xe0\x75\x41\xbc\x4c\x82\x34\xe4\x48\x67\x1b\xcf\x08\xba\xdc\xb5\x92\x07\xbb\x46\xe1\xa8\x47\x88\x51\x4a\x66\x3e\x90\x3f\xc3\xeb\x6b\xde\x78\xc2\xeb\xb2\xa3\xaa\x6d\x73\xdd\x63\x82\x5a\x7e\xac\x00\x6b\xdd\x33\xd1\x18\xa4\x32\x1e\x59\x75\xdd\x0e\xd9\xfc\xba\xa2\x31\x25\x73\x78\xc1\xd4\xfd\x98\x95\x51\xe9\x28\x4a\x65\x56\x2d\xd1\x3d\xa2\x5a\x79\x97\x0f\xf3\x26\x2b\x99\x62\xcc\xd2\xc0\x66\xc3\x9c\x75\xab\x46\xe1\x9d\xca\xb4\x30\xf2\xa1\x03\x19\xe5\xf0\x30\x36\x53\x14\x57\xb2\xe8\x87\x40\xbb\xa8\x66\x97\xe4\x3a\xaf\x1b\x38\x2d\x55\xd8\x12\x17\x75\x85\x8b\x08\x7a\x66\x81\xab\xfd\x0c\xf4\x1c\xc2\x77\x49\xf9\x54\xed\x24\x77\x6b\x01\x9b\x68\x95\xc1\x1c\x3e\xb9\x42\x05\x11\xdf\x72\x10\x31\x34\x13\x73\xd5\xce\x76\x82\xdf\x5b\x25\x22\x03\x04\x1b\x4a\xe5\x56\xea\x21\xbe\x00\x11\xb1\x57\xdc\xf5\x1e\x8d\x89\xe9\x23\x2e\x7f\x9a\xf2\xaa\x89\xbc\x2e\x2b\xe0\x5c\x05\x76\xbc\x14\x12\x11\x52\x07\x98\xcc\xd5\xf9\x18\x16\x24\xa8\x96\x47\x69\xe6\x09\x59\x42\x45\x13\x34\xc2\x7b\x2d\x6f\xad\x77\xd4\x5b\x69\x14\xf4\xa7\xc0\xf2\x1e\x12\x03\x51\x20\x1b\x17\x40\x3b\xda\x6c\x3e\x5a\x48\xaa\xa8\x9f\x52\xe4\xd8\x06\xad\x0d\x78\x6e\xeb\xa3\x41\xbd\xac\x44\x9a\xf3\x79\x0e\xfb\x8a\x89\x38\x55\x68\xa5\xcf\x6e\x54\x46\x6a\xc1\xfc\xa9\x3a\xa6\x35\xe1\x8f\x4b\xe4\x60\xa5\x4c\xb1\x82\x10\x13\x33\xbc\xf7\xab\x85\x7c\x93\x45\xb3\xc8\x38\xc9\x36\x8a\x4d\xe7\xc4\xc4\x79\xe0\x27\x4e\x2b\x77\x90\xd4\x96\x33\xb4\x9d\xc0\xb4\x44\x95\xfa\xd0\xb0\xb6\x86\x0f\x3a\x0f\x42\x39\x11\xc8\x95\xb2\xb2\x18\x86\xfc\x19\x62\xb6\xe2\x7d\x2a\x1c\xd5\x6b\x0c\x1c\xfc\x25\x1c\xc3\xbe\x2a\x77\xd0\x25\xa3\xa7\x6d\x53\x8e\x0c\x86\xcc\x23\xf7\xfb\x08\xef\xb0\xb2\x6b\x11\xdd\x66\xd4\xbd\xba\x03\xc8\xad\x51\x2f\xaf\xc4\xb1\xf3\xd2\xda\xb8\xdc\x65\x95\xad\xb5\xfb\x96\xd0\xa5\x4b\x1c\x74\xc9\x4a\x92\x6f\x6e\x65\xa5\x3e\x47\x4b\x9d\x44\x99\x79\x75\xb4\x73\x11\xa1\x2f\xc6\x3f\xcb\xaa\x87\x62\x5f\x9d\xfc\x18\x55\xca\x66\x6d\xc5\x2c\x55\x52\xab\x1f\x77\xfa\x4b\x42\xbb\xdb\xeb\x34\xc7\x6c\x70\xc5\x57\xdd\x82\x6b\xba\x29\xdf\xef\x22\x3b\xca\x3a\xad\x03\xfa\x2b\x8b\x46\x21\x7a\x7b\x3e\x6b\xb0\xef\xb7\x25\xb7\x94\xdb\x32\x6f\xa1\xf0\x63\x0c\x28\x56\x23\xb3\xc8\xb6\x35\xa9\x35\xaf\xa3\xd8\xf1\x0c\xce\xbf\xe4\xcf\x0b\x1a\x59\x01\xbf\x2f\x71\x5e\x7d\x85\xa0\x03\xa2\xcf\x32\x19\x53\x9d\x14\x3b\x38\xaa\x33\x9a\xcd\x3b\xfd\x7e\x8c\x55\x81\xb1\x18\xb3\x5e\x8f\x45\x29\x17\x32\x99\xe5\xcd\x25\xf2\x7e\xa9\x40\xe2\x44\x07\xf8\xb9\xf7\x43\x1a\x19\x07\x8e\xb6\xfb\xf9\x49\xbb\x1f\xe4\x4a\xcd\xd9\xe7\xaa\xb7\x5a\xc0\xfd\xc2\x50\x92\x3a\xc5\xa1\x6e\xd3\xdc\xef\x43\xc7\xad\x9b\x94\x19\x96\xbb\x62\x7c\x32\x9e\x90\xc8\x40\xa1\xff\x98\x12\x2b\x03\xf7\xfd\x04\xa6\xc9\x0c\x92\x17\xac\xa0\xa6\x65\x60\x13\xb6\x10\x51\x58\xd8\x27\x5a\x18\x62\x85\xbf\xb4\x72\xd7\xf0\x01\xe2\xc8\xda\x81\xb1\x59\x45\x9b\x58\xf5\x23\x8b\xac\xf7\x16\x6c\xe1\x9d\x9c\x3b\x36\x43\x03\x4e\xf8\x37\x76\x59\x8c\x8e\x57\x74\x4d\xab\x66\xb0\x8c\x84\xda\x5e\x15\x7c\x75\xd8\x2b\xf5\xb5\xcd\x35\x97\x45\xa7\xb5\x46\xe1\xdd\xde\xe6\xca\x53\xfe\x69\xac\x15\x45\xb6\xa7\xb9\x3e\x98\xc4\x1e\xc8\x5a\xeb\x5a\x06\x76\x51\x93\x22\x95\x68\xb1\xd7\xe1\x0c\x16\xa8\xc1\xe0\xa1\xd4\x38\x63\xaa\xb5\x3b\x35\xc6\x9b\x74\x3d\x96\xc8\x69\x77\x6a\x6a\x61\x87\xbd\x44\xef\x1c\xcd\x74\xee\xd8\x24\xef\x87\x1d\xa4\x66\x39\x5e\x20\xe5\x90\x28\x14\x79\x40\x47\x8a\x71\x9a\x48\x3c\x4f\xcc\x64\x79\x34\xb4\xa2\xd0\xc4\x0f\xc3\x06\xd2\x64\xb3\x12\x66\xf8\x13\x44\xe3\x24\x7b\x06\x2a\x41\x35\xc7\x6c\xa6\x54\x77\x22\xbe\xea\x40\x21\xe8\x62\x41\xd0\x2f\xdb\x30\x28\x9b\xed\x0d\x41\x25\x4b\x56\x68\x2c\x7f\x6e\x72\xec\xc0\xb2\x61\xb0\xf8\x4a\xc7\xf7\xdd\xa6\x67\x5c\x35\x6e\xef\xb4\xd5\x40\x0f\x83\x69\x75\x46\xee\x8d\x6a\x2d\x79\xcc\x16\x9b\x85\x2d\xd9\x13\xe2\xfe\x7f\x76\xc4\xc6\xcc\x3d\x36\xc4\x42\xcd\xdd\xf6\x03\x96\x54\xb3\x12\x80\x07\x7b\x93\x28\x1f\x98\x1d\x10\x40\x81\xd8\xde\xf8\x69\x33\xdd\xa0\xba\x23\x7a\x5a\xcb\xf3\xfe\xab\xd0\xd3\xa2\xb8\x2b\x78\x76\xde\xaa\x31\xf0\x95\x72\xa9\xc5\x2c\xf3\xc3\xf6\x9b\x35\x46\xa9\x77\x1e\xe5\x39\xde\xdf\xa0\x9b\xf9\xe5\xd9\x16\x5e\x2c\x69\x1c\x6c\x41\xb4\x87\xcb\xee\xcf\x5c\xd5\xd4\x43\xd2\x55\x9c\x48\x1f\x10\xb5\x5d\x87\xd1\xec\x69\x03\x36\xd2\xd8\x3d\xae\x27\x94\x1f\x50\x0e\x38\xef\x57\x1c\x54\x07\xfe\x97\x7c\x7a\x5f\x15\x3c\x35\x8f\x7d\xa7\xfd\xea\x64\x00\x1f\x3e\x72\xa1\x56\x9f\x84\xbf\x62\x0a\xad\x27\xe1\xc6\x81\xf6\x8e\xd7\xf5\x6a\x07\xb2\x3d\x3b\x31\xa4\x64\x30\xf4\x67\x00\x36\xdb\x3f\x4e\xfd\xbd\x64\xfd\xb6\xc3\x78\xcc\x5a\xab\xeb\xf4\x87\x4c\x44\x81\xd6\xde\xa8\x6c\x39\x73\x6b\xe7\xd8\xdf\xd4\xa7\x29\x61\x5a\x7f\x83\x3c\xda\x9a\x0e\x3f\x0f\x43\xc7\x1a\xbf\xc3\xb5\xc9\x07\x4d\x84\x77\xd7\xe0\x3f\x45\x22\x37\x3a\x6c\x66\x76\x90\xc4\xcc\x52\x61\x7b\xef\x4a\x1d\xee\xc8\xd4\xda\x94\xb8\x99\x97\xa1\x12\xf7\xe5\x65\x3d\x5a\xcc\x29\xd9\xf0\x1c\x6b\xcf\x1c\x6a\x77\xed\x7d\x8c\xd1\x0f\x11\xa3\x8f\x0e\x1a\x76\x1f\x22\xea\xb6\x8c\xc3\xd2\x8c\xd2\x36\xa8\x38\x70\x09\x6d\xfa\xbd\x71\x90\xf5\x3e\xfd\x26\x4e\x7d\xbc\x1c\x88\xb4\x67\xde\xbf\x7d\xf5\xa3\x48\x9d\x02\xdb\x7e\xc9\xa9\xfc\x61\x09\x2b\xf4\x3f\xa7\xf2\xc4\xa9\xa8\x71\x32\xea\xc8\x10\xda\xac\xb6\x99\x0f\xa0\xd5\xf6\xe4\x03\xdd\x37\xf6\x77\x0c\x08\x9b\xb7\x08\x1f\x63\xc2\xc7\x98\xf0\x21\x62\xc2\xbe\xb3\x89\xde\x78\xef\x31\x3e\x7b\x8c\xcf\xfe\x60\xf1\xd9\x90\xba\xf9\x63\x7c\xf6\x18\x9f\x3d\xc6\x67\xbf\xa7\xf8\x6c\x40\xbd\xf6\x60\xf1\xd9\x59\x2c\x7d\xd8\x83\x2a\xc4\x32\x3f\xcb\xa6\x46\xfc\x12\xf6\x6d\x96\xce\x32\x99\xe7\xf8\xdd\x68\x55\x14\xc4\xd9\x55\xe5\xb7\xd1\x53\xdd\xe0\xe1\x22\x30\x5f\x44\x9a\x5a\xb7\x77\xd5\x8f\x5c\x45\x99\xd0\x1b\x9f\xe3\x99\xdb\xf9\xd9\xf3\xd7\xae\xfa\x0c\x02\x0b\x9c\xdc\x25\x48\x97\x49\x31\x70\x31\x16\xbf\x0b\xcd\x0e\x7d\x28\x7f\x4c\xd7\xd9\xb0\x93\xee\x30\x01\x54\xa0\x4f\x7c\xcc\x6b\xbd\xd5\x4f\x63\x05\x7e\x82\x3f\x33\xa7\xa9\xb8\xaa\xda\xfa\x66\x7a\x16\xfb\x40\xbd\xf1\xed\xdd\xf8\x78\x4c\xc6\x8b\x65\xe1\xee\x4e\x2c\x02\xa3\x3b\x60\x62\x2f\xc9\x5f\x86\x74\x9a\xc9\xb4\xb7\x4f\x1c\xe5\xbd\x84\x26\xc3\x75\xc7\x14\x77\xe7\xe7\xd9\xfb\x6d\x43\xf3\xf7\x2f\x3b\xf7\x79\x07\xc6\x3b\x98\x1d\xf6\x03\x9c\x6c\x35\x7c\xfb\x9d\x0d\xef\x7f\xaa\x81\xd8\x71\x80\x57\x00\x00")
 
 func tplObjectRedisWriteGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -594,7 +661,7 @@ func tplObjectRelationGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplObjectUnqiueGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4d\x6b\x1b\x31\x10\x3d\xaf\x7e\xc5\x20\x5c\x90\x82\xb3\xd0\x6b\x20\xf4\xd0\x36\xa1\x8d\xdb\x7c\xb8\xa1\x87\x90\x83\xbc\x9e\x75\x65\x76\x25\x57\xd2\x1e\x8c\xd0\x7f\x2f\x5a\x69\x6d\xaf\x53\xe2\x06\x7a\xd3\x8c\xe6\xbd\x99\xf7\x84\xc6\xfb\x25\xd6\x52\x21\x50\xbd\x58\x63\xe5\xca\x4e\xc9\xdf\x1d\xd2\x10\x88\xf7\x93\x14\xc0\xc5\x25\x94\x29\xa1\x17\xeb\x3e\xba\x5d\xac\x53\x62\x63\x64\x2b\xcc\xf6\x4a\x62\xb3\x1c\x6e\xca\xbb\x83\x64\x08\x84\xb8\xed\x06\x61\x47\x57\x7e\x17\x2d\x86\x00\xd6\x99\xae\x72\x9e\x14\xde\x9f\x83\x11\x6a\x85\x30\x59\x4f\x61\x52\x0f\x5c\x43\x7d\xcf\x63\x43\x88\x95\xe9\x76\xa0\xd8\xc5\xd7\xe8\x7e\x6c\x37\x98\x6a\xce\x01\x55\xec\x1b\x08\xa9\x3b\x55\x01\xeb\xe0\xec\xb8\x3b\x87\x1b\xdc\x32\x1e\x87\x90\x6a\x05\x9e\x14\xd6\x19\x1b\xbb\x3e\x3d\xa7\x9c\x27\xc5\x1b\x26\x2b\xe8\xd1\x6c\x74\x4a\x8a\x44\x20\xeb\x8c\x2c\xbf\xd8\xcf\xaa\xd2\xcb\x7e\xcc\xa2\xd0\xa6\x2d\x53\xcc\xea\xd6\x95\xf3\x8d\x91\xca\xb1\xae\x3c\x22\xe2\x7c\xc7\x84\x8d\xcd\xd8\x57\x01\xfb\xfa\xde\x86\xd1\x39\x90\xc2\xa0\xeb\x8c\x82\x3d\x45\xcd\xe8\x3b\x4b\xa7\xd9\x0b\x5b\x7e\xd5\x52\xb1\x68\xc7\x14\xe8\x05\xe5\xfc\x84\x91\xf3\xfb\xd9\x95\x36\xad\x70\xac\x91\xad\x74\xb0\xd0\xba\x39\x34\xb6\xd2\x6a\x29\x9d\xd4\xea\xff\xd8\xdb\x27\xf3\xfb\x5f\xc2\x87\xde\xe7\xbf\x0a\x8c\xfe\xce\xef\x67\x3f\x7f\xa1\x41\xb6\x1f\xe2\x1f\xe4\xdc\x09\x23\x5a\xcb\x38\x3c\x3d\x4b\xe5\xd0\xd4\xa2\x42\x1f\xa2\x96\x4c\x3d\xca\xbf\x51\xc9\x8b\xf7\x7a\x21\xe0\xe4\x7c\xb3\xe8\x33\xe3\x20\x95\x3b\x18\xea\xfd\x09\x60\x42\xa9\x88\xe2\xe0\x4f\x14\xdf\xd6\xb5\xc5\x71\xb5\xf7\x13\x83\x8d\x88\x26\x1e\x4a\xbb\x46\xf7\x30\xa4\xe9\x46\x48\x43\x61\xb4\x15\x86\xbf\x09\x71\x79\x64\xfe\xd7\x3a\x3f\xde\x0c\x74\xcc\x3a\x6d\x10\xce\xe2\x43\x3e\xe0\x52\xda\x79\x8c\x39\x3c\xf6\x80\x5d\xd3\xbc\x41\xe2\x37\xcb\x54\x71\x07\x7d\x5a\x7c\xd4\xca\x09\xa9\x2c\x50\x13\xc1\x71\xa5\x0d\x56\x1d\x48\xc9\x7d\x7b\xfe\x6f\x2b\x93\x7a\x72\x32\xfa\x6f\x19\xa5\x64\x73\xb4\x5e\xbc\x4f\xc7\x3f\x01\x00\x00\xff\xff\xab\xa7\x08\x70\x46\x05\x00\x00")
+var _tplObjectUnqiueGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x54\x4d\x6f\xdb\x30\x0c\x3d\x27\xbf\x82\x10\x32\xc0\x2e\x52\x03\xbb\x16\x28\x76\xd8\xd6\x61\x6b\xb7\x76\xcd\x86\x1d\x8a\x1e\x1c\x9b\xca\x14\xd8\x52\x26\xc9\x87\x40\xc8\x7f\x1f\xf5\x61\xc7\xc9\x86\x66\x01\x06\x18\xb0\x48\x93\x8f\x8f\xcf\x14\x9d\xab\x91\x0b\x89\xc0\xd4\x72\x8d\x95\x2d\x3a\x29\x7e\x75\xc8\x76\xbb\xa9\x73\xb3\x68\xc0\xd5\x35\x14\xd1\x41\x41\xc1\xba\x5f\xae\xa3\x63\xa3\x45\x5b\xea\xed\x8d\xc0\xa6\xee\xbf\x14\x0f\x23\x27\x85\x4d\xed\x76\x83\x30\xc0\x15\x5f\xca\x16\x77\x3b\x30\x56\x77\x95\x75\xd3\x89\x73\x97\xa0\x4b\xb9\x42\x98\xad\xe7\x30\xe3\x3d\x56\x1f\x1f\x70\x0c\x01\x51\x64\xfc\xda\x43\x0c\xf6\x07\xb4\xdf\xa8\x48\x8c\xb9\x04\x94\xbe\x2e\x55\xe6\x9d\xac\x20\xeb\xe0\xe2\xb8\x7a\x0e\xb7\xb8\xcd\x72\x4f\x42\xc8\x15\x10\x0b\x3a\x19\x5f\xf5\xe9\x39\xfa\xc8\x75\x06\xb3\x09\x3b\xe2\xc6\xe6\xe4\x0c\x00\x82\xa7\xcc\xe2\xa3\x79\x2f\x2b\x55\x07\x9a\x93\x89\xd2\x6d\x11\xed\x6c\xc8\x5d\x90\x9e\xd2\x42\x16\x5e\x1c\x58\x57\xbc\x32\x0c\x46\xc0\x39\x51\x1f\x90\xb1\x31\x09\xeb\x2c\x80\x7d\x7e\x90\xe9\xe0\x4c\x8f\x46\xdb\x69\x09\xbc\xb5\x09\x8d\x67\x8c\x40\xe6\x49\x2b\x53\x7c\x52\x42\x66\x5e\xae\x39\xb0\x2b\x96\xe7\x27\x84\x5e\x7c\xbd\xbb\xa1\x5e\x4b\x9b\x35\xa2\x15\x16\x96\x4a\x35\x63\xe1\x2b\x25\x6b\x61\x85\x92\xff\x47\xfe\xe0\x4c\xf3\x71\x0d\x6f\xc2\x7f\xf8\x6b\x83\x5e\x7f\xe2\xf6\xe3\x27\x6a\xcc\xf6\x24\xfe\xa1\x9d\x87\x52\x97\xad\xa1\xe9\x79\x7a\x26\x79\x50\xf3\xb2\x42\xb7\xf3\xbd\x24\xe8\x03\xff\x99\x9d\x74\xc5\xd1\x24\xfd\xd1\xc0\x49\x7e\x77\x5e\x67\xa2\xe7\x27\x61\x4f\xea\xf5\x89\xc4\x98\x25\x7d\x56\x4e\x69\x2f\x07\xdf\x73\x6e\xf0\x30\x9a\x82\x34\x36\xa5\x17\x71\xdc\x1a\x5d\xcd\xc7\xde\xcd\x36\xa5\xd0\x34\x8e\xe3\xad\xd1\xdf\x5d\xf0\xcb\x25\xe1\xbf\x54\xf9\xfb\x6d\x0f\x47\x33\xa8\x34\xc2\x85\xff\x91\x8f\x58\x0b\xb3\xf0\x36\x45\x84\x84\xa1\x68\xda\x30\xfe\x1a\x26\x28\xbf\xa3\xde\x2d\xdf\x2a\x69\x4b\x41\x53\xc7\xb4\x4f\xf6\x2b\xaf\x97\x6a\xd4\x4a\xaa\x1b\xf0\x3f\xaf\x74\xac\x99\x4f\x0f\xee\x5f\xca\x92\xa2\x39\x5a\x3f\xce\xc5\xe3\x6f\xea\xf7\xba\x14\x66\x05\x00\x00")
 
 func tplObjectUnqiueGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -614,7 +681,7 @@ func tplObjectUnqiueGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplQueryGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\xc1\x6a\xeb\x30\x10\x3c\x5b\x5f\xb1\x98\xf0\x48\x02\x91\xef\x0f\x7a\x29\x25\x39\x35\x94\x36\xf4\x1a\x64\x7b\xed\x2a\xb5\x2c\x47\x5e\xb7\xb8\x42\xff\x5e\xd6\x4a\x4a\x4d\x7a\xf2\xec\xec\xec\x78\x47\x92\xf7\x25\x56\xba\x45\x48\xcf\x03\xba\x31\x0d\xa1\x53\xc5\xbb\xaa\x11\xbc\x97\x3b\xfb\x14\x8b\x10\x84\xf7\x0b\x9b\x9f\xe0\xff\x1d\xc8\x10\x84\xd0\xa6\xb3\x8e\x60\x29\x92\xb4\x32\x94\x8a\x24\x25\x6d\x90\xbf\x3d\x39\xdd\xd6\x3d\xc3\x52\x91\xca\x55\x8f\x59\x7f\x6e\xb8\xae\x35\xbd\x0d\xb9\x2c\xac\xc9\xf0\x2b\x1f\xc6\xcc\x61\xa9\xfb\x8d\x75\x26\xb3\xce\xa4\x62\x25\xc4\x87\x72\x6c\x7a\x84\xfe\xdc\xc8\x87\x7b\x46\x6c\x2c\x0f\xda\x20\x17\x95\x21\xb9\xb5\xce\x28\x22\x74\x93\x2e\xfe\x4e\x3e\xa3\x2a\x23\x63\x9d\x91\xaf\x2f\x48\x6c\x97\xd0\xd8\x4d\x51\xf6\xca\x60\x08\xac\x1e\x0a\x02\x2f\x92\xc4\xfb\x0d\x38\xd5\xd6\x08\x8b\x4a\x63\x53\x4e\xd1\xb6\x8c\xfa\x10\xa6\x7e\xe4\xaf\xa3\xf0\x43\xec\x90\x0e\x63\xc7\xdc\x8c\x52\xf5\x65\x6e\x03\xd8\x96\x8c\xc3\x75\x81\x63\x3c\xbd\x8b\xd5\x63\xed\x7e\x2d\x12\x44\xc2\xa1\x6f\x14\xeb\x9b\x21\x21\x92\x6a\x68\x0b\x58\x9a\x3f\x9a\x2b\xd8\xe3\xe7\x8c\x5c\xae\x60\x3d\x23\xa6\xd8\x0e\x69\x70\x2d\xfc\x9b\x75\xfc\x65\x59\xef\x09\x4d\xd7\x28\x42\x48\x6d\x7e\xc2\x82\x64\x99\xcb\xf8\x32\x80\xf5\x7c\xf5\xde\xc7\x78\xdf\x01\x00\x00\xff\xff\xe4\xb9\x96\xeb\x3b\x02\x00\x00")
+var _tplQueryGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6d\x52\x4d\x4f\xc3\x30\x0c\x3d\xb7\xbf\xc2\xaa\x26\xb4\x4d\x5a\x7a\x47\xe2\x02\x68\x3b\x31\x21\x98\xb8\x4e\x69\xeb\x96\xc2\xd2\x76\xa9\x0b\x8c\xa8\xff\x1d\xbb\xe9\x10\xd5\x38\x24\xb6\x9f\x3f\x9f\x65\xe7\x32\xcc\xcb\x0a\x21\x3a\x76\x68\x4f\x51\xdf\x37\x3a\x7d\xd7\x05\x82\x73\x6a\x53\x3f\x7a\xa3\xef\x43\xe7\x66\x75\xf2\x06\xd7\x37\xa0\xd8\x0a\x4b\xd3\xd4\x96\x60\x1e\x06\x51\x5a\x57\x84\x5f\x14\xb1\x9a\x9b\x41\x50\x69\x50\x64\x4b\xb6\xac\x8a\x56\xd4\x4c\x93\x4e\x74\x8b\x71\x7b\x3c\x88\x5d\x94\xf4\xda\x25\x2a\xad\x4d\x8c\xdf\x49\x77\x8a\x2d\x66\x65\xbb\xaa\xad\x89\xf9\x45\xe1\x22\x0c\x3f\xb4\x95\xfa\x7b\x18\x1b\xa8\x3b\x2f\x05\xe2\x2a\xea\xfe\x56\x34\xe9\xa5\x76\xfc\x89\xc1\xfd\xd5\x9a\xf3\x35\x11\xda\x21\xce\x4f\xa0\x9e\x50\x67\x1e\x61\xaf\x7a\x79\x46\x92\x0e\x01\x9d\x9a\x81\xe8\x56\x1b\xe6\x28\xd1\x5d\x4a\xe0\xc2\x20\x70\x6e\x05\x56\x57\xbc\x87\x59\x5e\xe2\x21\x1b\x88\xaf\x45\x6b\x99\xbe\xf8\x3d\x7e\x4e\x85\x5f\x60\x83\xb4\xe3\xb2\x8c\x4d\x20\x5d\x8c\x79\x2b\xc0\x2a\x13\xbd\x3f\x0f\xb0\xf7\xbb\x1d\x4b\x3d\x14\xf6\xcf\x20\x1c\x27\x7b\xb8\x88\x58\x5e\x24\x71\xb5\xbc\xab\x52\x98\x9b\x7f\x9c\x0b\xd8\xe2\xe7\x04\x9c\x2f\x60\x39\x01\x06\xda\x16\xa9\xb3\x15\x5c\x4d\x3c\x6e\x1c\xd6\x39\x42\xd3\x1c\x34\xf1\xb5\xb0\x17\x53\x52\x59\xa2\xfc\xdd\x80\xc4\xcb\x61\x38\xe7\xe9\xfd\x00\x34\x8a\x45\x39\x59\x02\x00\x00")
 
 func tplQueryGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -634,7 +701,7 @@ func tplQueryGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationDbReadGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\xdd\x6e\xf3\x36\x0c\xbd\x96\x9e\x82\x0b\xd2\xc2\x1e\x52\x3d\x40\x86\xdc\xf4\x6f\x18\xd6\x75\x2d\x5a\xf4\x66\x18\x06\x39\xa2\x53\x0d\x8e\x9c\xd2\x4a\xda\xc0\xf0\xbb\x0f\xb2\x64\xc7\x71\xec\xb5\xc5\x77\x61\xc0\x92\xc8\x73\xc8\xc3\x03\xa9\x2c\x15\xa6\xda\x20\x4c\x08\x33\x69\x75\x6e\x84\x4a\x04\xa1\x54\x93\xaa\xe2\x65\x39\xcd\x93\x7f\x61\xbe\x00\xe1\x57\x1b\xd2\x6b\x49\x7b\xb7\xe3\x4e\xc4\x83\x5f\xff\x8e\xfb\xa3\xf3\x5b\x8d\x99\xaa\x83\xc2\x86\xb8\xd5\x54\x58\xbf\x5d\x55\x9c\xdb\xfd\x06\xe1\x1f\x0f\x2f\xee\xe5\x1a\xab\xea\xfa\xf2\x8f\x15\x41\x61\x69\xbb\xb4\x50\x72\xa6\x12\xc8\x69\x2d\xae\x2f\x79\xc5\x79\xba\x35\x4b\x38\x0d\x8f\xda\xa0\x18\x7e\x1e\x82\x2b\x39\xd3\x29\xa8\x04\x16\x0b\x30\x3a\x73\x6b\xb6\x91\x46\x2f\xa3\x74\x6d\xc5\x0d\x51\x4e\x69\x34\x19\x48\xd4\x46\x5b\x30\x88\x0a\x54\x32\x89\x63\xce\x2a\xce\x08\xed\x96\x0c\x9c\x0f\x10\x95\x2a\x99\x83\x4a\xaa\xb6\xd6\x68\x3d\x58\x50\x0c\xb7\x68\x97\xaf\x97\xfb\xa7\xc7\xbb\xe8\xcd\x75\xab\xcd\x6a\x06\x92\x56\x05\x08\x21\x40\x1b\x8b\x94\xca\x25\x96\x55\x0c\x11\x61\xb1\xcd\x6c\x01\x7f\xfd\xdd\xd9\x9f\x01\x12\xb9\x2f\xa7\xd8\xf5\x43\xf9\x7b\xe1\xf7\xe6\x0b\x58\xbb\xe9\x3d\x6e\x91\xf6\xd1\x9b\x87\x15\x42\xc4\xb5\x08\x2e\xe2\xa7\x83\x0a\xa1\x19\xa3\xb3\x19\x8c\x6a\x01\xa9\xab\xd6\x93\xcd\xe1\x6c\x37\xa9\x89\xbc\x1a\x0a\x53\x24\x70\xec\xe2\x2a\xcb\x0b\x8c\x62\xce\x59\x59\x92\x34\x2b\x84\xa9\x36\x0a\x3f\x66\x30\x4d\x5b\x27\x38\xd4\xda\x00\x45\x55\x71\xc6\xca\xf2\x02\x74\x1a\x02\xc4\x6f\xc5\xfd\x36\xcb\x64\x92\x21\xd4\xa7\x6c\x27\xc9\xcd\xdb\x9f\x86\x62\x8a\xb7\x4c\xb4\x7b\xbf\xa2\x75\x29\x4f\x8f\x77\xcf\xfb\x0d\xb6\x90\x98\x15\x78\x8c\x8b\xa8\x9e\x49\x9a\x22\xcd\x69\xfd\x3f\xe0\x5d\xe0\x36\x5e\x38\xec\x3f\x49\xaf\xb4\x39\x30\x18\x05\x17\x6e\xd5\x2c\x9c\x9f\x59\x9a\x07\x2d\xee\xf1\xc3\x46\xf5\x60\x6a\x1a\x3f\xc2\x63\xeb\x72\xc6\xdc\x30\x16\x3e\xe1\x69\x29\x4d\x14\xb0\xbf\x20\x9e\xe7\x6e\xe4\xcb\x69\x40\xc1\x91\xde\x7d\x22\x3b\xef\x75\x3e\xeb\x2a\xd7\xc4\x04\xeb\x89\x5e\x6c\x3c\xeb\x88\x70\xaa\x08\x8b\x39\x1b\xb0\xda\x91\xd7\x90\x88\x33\xe6\x24\xfb\x86\x59\x4e\xdd\x32\x30\xd5\x71\x4b\x85\xae\xea\xda\x7a\x1d\x89\x17\x99\x69\xe5\xcb\x0c\x10\xef\xda\xbe\xc2\x74\xe7\xea\x88\x36\xa4\x8d\x4d\x61\x72\x56\xbc\xc8\x6c\x8b\x13\xe8\x24\xc7\x0d\x2a\xeb\x61\xd6\xa1\x2e\xbd\xcf\x75\x58\x1f\x9c\x5b\x07\x8f\x21\x3d\xe4\xda\x58\x8f\x74\x01\xa1\x96\x21\x9b\x5e\xe5\x66\x87\x64\x9f\x73\x98\xee\x5a\xac\xe1\x11\xc2\x02\xfa\x0e\xa8\x59\x3a\x02\xa0\x51\x0d\x48\xe5\x6d\x51\x7e\x06\x69\x74\x16\x12\xda\x49\x74\xfd\x34\x9e\xf8\xf5\xc6\xba\x89\xbc\x53\x6a\xc3\xd1\x72\x7e\xd9\x0d\x63\x45\x71\x76\x9a\xdf\x99\x98\xf3\xde\x95\x2c\xec\x01\xe8\xe8\xee\xa8\x6f\xa3\xe8\x1b\xa3\x8f\x0f\x7c\x47\x9a\xf5\xcd\xf0\xb9\x7d\x7a\x8a\x0c\x2a\xd4\xcc\xb6\x2f\xcf\x8d\x59\xe6\x2a\x20\x8d\x4e\xab\x7e\x6b\xd1\x05\x8e\x5d\x10\x7d\x9e\xb2\xf4\x7f\xbc\x41\x2d\x60\x01\x72\xb3\x41\xa3\x9a\xe7\x6d\x06\xe7\xfe\xcf\xbf\x2b\xe1\xfe\x08\xb7\xe3\x0d\x51\x14\xc3\x2f\x3f\xf0\x78\x85\x1b\x78\xf0\x0d\xf3\x38\xee\xd1\x6e\xea\xfc\x2f\x00\x00\xff\xff\xd7\x2e\x5b\x5f\x10\x09\x00\x00")
+var _tplRelationDbReadGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x56\xd1\x6e\x9b\x30\x14\x7d\x86\xaf\xb8\x43\x69\x04\x53\xea\x0f\xe8\x94\x97\xa4\xed\x34\x6d\xeb\x5a\x35\xda\xcb\x34\x4d\x0e\x98\xd4\x1b\x98\xd4\x36\x6d\x23\xc4\xbf\xef\x1a\x03\x21\x04\xb6\x46\xdb\x43\x94\xd8\x3e\xf7\x9c\xeb\x7b\x8f\xed\x14\x45\xc4\x62\x2e\x18\x78\x92\x25\x54\xf3\x4c\x90\x68\x4d\x24\xa3\x91\x57\x96\x6e\x51\x4c\xb2\xf5\x4f\xb8\x98\x03\xb1\xa3\xad\xe4\x29\x95\x3b\x33\x63\x56\xc8\xad\x1d\x7f\x64\xbb\x83\xf5\x6b\xce\x92\xa8\x02\xd5\x13\xe4\x9a\x4b\xa5\xed\x34\x22\x5d\xbd\xdb\x32\xf8\x61\xe9\xc9\x0d\x4d\x59\x59\x5e\x2e\x3e\x6f\x24\x28\x2d\xf3\x50\x43\xe1\x3a\xd1\x1a\x32\x99\x92\xcb\x85\x8b\xf8\x38\x17\x21\x1c\xc3\xfd\x16\x14\xc0\xdb\x21\x3a\xe4\xe1\x31\x20\x6a\x3e\x07\xc1\x13\x33\x76\xb6\x54\xf0\xd0\x8f\x53\x4d\xae\xa4\xcc\x64\xec\x7b\x03\x81\x5c\x70\x0d\x82\xb1\x08\x83\xbd\x20\x70\x9d\xd2\x75\x24\xd3\xb9\x14\x30\x1d\x10\x2a\xa2\xf5\x05\x22\xcb\x36\x57\x3f\x1d\x4c\x28\x80\x6b\xa6\xc3\x87\xc5\xee\xfe\xee\x93\xff\x68\x76\xcb\xc5\x66\x06\x54\x6e\x14\x10\x42\x50\x56\x33\x19\xd3\x90\x15\x65\x00\xbe\x64\x2a\x4f\xb4\x82\x6f\xdf\x3b\xf3\x33\x60\x52\x9a\x4f\x86\x6c\x45\x9b\x55\x4a\xf6\xcc\x4b\xfd\xe2\x87\x19\x86\xbc\x68\xb2\xa0\xe1\xaf\x8d\xcc\x72\x11\xf9\xc1\x0c\x1e\xad\x16\x4a\x05\xa7\xa4\x5a\x11\xea\x17\x68\x48\x97\xf6\x1b\xf9\xfe\xcf\x16\xb2\x67\x65\xe7\xd0\x32\xa9\x31\xe0\x5d\xce\xe4\xae\x56\x31\xca\x87\x99\x9b\x9e\x1a\xf4\x9b\x7d\x53\xeb\x2a\xe0\x70\x06\xa3\xad\x85\xd8\xec\xc8\x0a\x5f\xc0\xd9\xb3\x57\x89\xda\xe6\xe2\x31\x60\x12\x4c\x26\x64\x99\x64\x8a\xf9\x81\xeb\x3a\x45\x21\xa9\xd8\x30\x98\x70\x11\x31\x4c\x62\x12\xb7\xc6\x36\xac\x95\x9f\x15\xfa\xd9\x41\xe4\x39\x60\x56\x16\x40\x3e\xa8\x9b\x3c\x49\xe8\x3a\x61\x50\xad\x3a\x4f\x54\x1a\xfb\xda\xd5\x3a\x19\xf5\x98\x90\x76\xee\x3d\xd3\x26\x04\x6b\xbd\xc2\xa3\xd1\x52\xb2\x44\xb1\x43\x5e\xb4\xe4\x0a\x93\x52\x31\xfa\xfe\x0f\xe4\x5d\xe2\x16\x4f\x0c\xf7\x17\xc9\x37\x5c\xec\x15\x44\x04\xe7\x66\xd4\x0c\xcc\xf1\x74\x10\x6d\x6b\x71\x63\x1a\x50\x35\xa9\x92\xb1\xed\x3c\x3c\x89\xb8\x64\x9a\x31\xb7\x01\xf7\x21\x15\x7e\xcd\xfd\x8a\xe2\x59\xed\xa6\x7c\xa8\x7a\x5c\xc1\x91\xbd\xdb\x40\x67\xda\xdb\xf9\xac\x5b\xb9\x06\x53\xdb\x90\xf4\xb0\xc1\xac\x53\x84\xe3\x8a\x38\xe8\x8c\x01\xab\x1d\x78\x0d\xd7\x70\xca\x94\xec\x04\xb3\x1c\xbb\x65\xa0\xab\xe3\x96\xaa\x77\x55\xe5\xd6\xdb\x11\xf9\x4a\x13\x1e\xd9\x34\x6b\x8a\x67\xae\x1f\x60\xf2\x64\xf2\xf0\xf1\x36\x16\x3a\x06\xef\x4c\x21\x2e\x67\x1e\x74\x82\x83\x86\xd5\xe9\x71\x56\x50\x13\xde\xd7\xda\x8f\xf7\xce\xad\xc0\x63\x4c\xb7\x19\xca\x5b\xa6\x73\xa8\x73\x19\xb2\x29\x1e\xfc\x27\x26\xf5\x2a\xc3\xbc\x5b\xae\xe1\x16\xa2\xed\xa6\x43\x2a\x9d\x02\x60\x3f\x1b\x92\xd2\xda\xa2\xf8\x1b\x25\xb6\xb6\x0e\x68\x3b\xd1\xf5\xd3\x78\xe0\xeb\x37\xd6\x0d\x74\x3b\xa9\x36\x1a\xad\xe6\xab\xdd\x30\x96\x94\xeb\x1c\xc7\x77\x3a\x66\xbc\xb7\xa4\x4a\xef\x89\x0e\xee\x8e\xea\x36\xf2\x4f\x68\x7d\xb0\xd7\x3b\xa8\x99\x73\xb2\x7d\x7a\x15\x19\xac\x50\xd3\xdb\x7e\x79\xae\x44\x98\x45\x35\xd3\x68\xb7\xaa\xbf\x0e\xcc\x00\xc7\x2e\x88\xbe\x4e\x51\xd8\x5f\x6e\xc3\xaa\x90\x86\x6e\xb7\x38\xdb\x3c\x75\x33\x98\xda\x5f\xf6\x5d\xa9\xef\x8f\xfa\x76\xc4\x67\x09\x6f\xd3\x77\xff\xf0\x78\xd5\x37\xf0\xe0\x1b\x66\x79\xcc\xc3\xde\xe4\xf9\x1b\x7b\x04\x4c\x72\xdf\x09\x00\x00")
 
 func tplRelationDbReadGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -674,7 +741,7 @@ func tplRelationFunctionsGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationGeoGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x5d\x6b\xdc\x38\x14\x7d\x96\x7e\xc5\x8d\x59\x82\x35\x78\x95\x7d\x58\xf6\x61\x96\x29\x94\xb4\x0d\x25\x69\xd2\x26\xa1\x0f\x2d\x25\xa8\xe3\xeb\x41\x8d\x2c\x4f\x25\x7b\xca\x60\xfc\xdf\x8b\x64\x5b\x56\x32\x13\xc8\x47\xfd\xe6\xfb\x79\x8e\xee\xbd\xa7\x6d\x73\x2c\xa4\x46\x48\x0c\x2a\x51\xcb\x4a\xf3\x15\x56\x49\xd7\xd1\xb6\xfd\x6b\x34\xc1\x7c\x01\xbc\x37\xad\x8d\x2c\x85\xd9\xbe\x93\xa8\x72\x67\x0e\x31\xfc\x63\xe4\xe9\x3a\x7a\x74\x74\x00\x06\x73\x69\x21\x54\x59\x0b\x69\x68\xd1\xe8\x25\xa4\x25\xcc\x6e\xa2\x06\xfc\x5c\x94\xd8\x75\x97\x2e\xfe\xc3\xca\x30\x38\xab\x96\xde\xf1\x3a\xcf\xd3\x90\x3f\xdb\xcd\x60\x80\xc6\x54\x06\x5a\x4a\x0c\xd6\x8d\xd1\x50\xf2\x13\xac\x5c\xda\x0a\xab\x8b\xe2\x58\x09\x6b\xd3\x24\x4e\xbc\xf8\xfe\x63\x48\x4e\x32\x48\x76\x4b\x26\x59\x40\xcc\x4f\x71\xcb\x32\x38\xf4\x3c\x5c\xdd\x11\x56\x4b\x09\x39\xab\xf4\x4a\xd6\x4d\x8e\xf3\x29\x3e\xd8\x32\x17\x20\xea\xc1\x1f\x05\x0c\x36\xe7\x77\xed\xe6\xe0\xbf\xa2\xac\xf9\xd5\xda\x48\x5d\x07\xb6\xfc\xb3\x50\x0d\xb2\x8c\x92\x8e\xf1\xb7\xc6\xa4\x8c\x76\xf4\x89\xaf\x77\x29\x72\xd9\xd8\xf4\x16\xb7\x60\x6b\x23\xf5\x2a\x03\x35\x22\x84\x42\x55\xa2\xfe\xef\xdf\x0c\xd4\x80\x69\xb2\xfc\x6c\xd0\x6c\x61\x16\x68\xf7\x75\x3e\x39\x2b\x83\xf4\xeb\xb7\x3d\x83\xc8\xfa\x41\x30\x37\x09\x35\xb4\xb7\xde\xe8\xb6\xa4\x9c\xaa\xbc\x64\x2e\xb7\x7e\x1c\x81\xc2\x04\x7d\x80\xcc\xf8\x25\xda\x46\xd5\x29\xa3\x44\x16\xbe\xf9\xc1\x02\xb4\x54\x0e\xd5\xb8\x20\x5a\x2a\x8f\x8b\x92\x8e\xba\xad\xe9\x9b\x58\x07\x73\x2f\xb3\xb6\xa3\xa4\xa8\x0c\xdc\xb8\xce\xcb\x70\x0f\x46\xe8\x15\x06\x8b\x1d\x3a\x4c\xf7\x52\xf2\x73\xfc\xb5\x5b\xcd\x0d\x83\x45\xa1\xd3\xca\xc0\x22\x54\x9b\x8c\x77\x22\xc7\x39\xc5\x81\x83\x8d\x12\xd2\xb6\x7f\x83\x2c\xa2\x83\xf4\x0b\xe4\xcf\x91\xbf\xb7\xe7\x88\xf9\xb5\x11\xda\x16\x95\x29\xbb\x8e\x12\x42\x36\xc2\xc0\x46\x28\x88\x41\x46\x39\x27\x58\x87\x04\x7e\xbd\x5d\xe3\x85\x91\x2b\xa9\xfb\xdc\xe1\x75\xe7\x0b\x70\xde\x2b\xbf\x5c\x57\x4b\xa1\xd3\x80\xcc\xd1\xcd\xe0\x70\x23\x14\xfb\xff\xfe\x24\xf6\xcc\x82\x10\x5f\x37\x00\x79\x00\x54\xff\x88\xb0\x00\xc7\xd6\x1f\xcc\x03\x8c\xef\xa0\x3f\xae\xf4\x06\x4d\x7d\x5d\x41\xb2\x11\x2a\xf1\x14\x5c\x01\x54\x16\xfd\xcf\xa3\xe9\xdc\xbb\xce\x5d\x66\xbb\xc4\x42\x33\x9d\xfb\x5e\xd3\xc6\x2d\x40\xac\xd7\xa8\x27\x85\xb3\x93\xf4\x30\xb7\x9e\x63\xb5\xc8\xaf\xa5\x7a\x86\x0e\x60\xf9\x64\x15\xfd\xe2\x92\xfe\x9c\x86\x3e\xac\x70\xcf\x56\xb7\x37\xa8\x22\x69\xdb\xc7\xc1\x45\xbc\x50\x6e\x9e\x8c\xee\x58\xa1\x30\x69\x84\xc6\xd6\x26\x16\xc2\x53\xdc\xbe\x48\x03\x93\x59\xc2\x1e\xa5\x73\x83\xc4\xb9\x00\x85\x3a\x75\x30\x18\xbc\x82\x7f\xe2\x98\xfe\x89\x9c\x8b\x73\x3e\x52\x8d\x16\x6f\xd8\xb6\xb6\xed\x97\x97\xfe\x0e\x00\x00\xff\xff\x24\x40\xc0\xee\x2e\x08\x00\x00")
+var _tplRelationGeoGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x55\x4d\x6f\xd4\x30\x10\x3d\x27\xbf\xc2\x8d\x2a\x14\xaf\x82\x7b\x41\x1c\x8a\xf6\x80\x0a\x54\x88\xd2\x96\xb6\x02\x09\x84\x2a\xb3\x99\x04\xd3\x7c\x2c\x76\xd2\xb2\x8a\xf2\xdf\x99\x71\x12\x27\xcb\x6e\x11\xfd\x10\x39\x25\x6f\xc6\x33\xef\xcd\x8c\x27\x4d\x13\x43\xa2\x0a\x60\x81\x86\x4c\x56\xaa\x2c\x44\x0a\x65\xd0\xb6\x7e\xd3\xec\x0e\x10\xdb\x9f\x33\xd1\x41\x4b\xad\x72\xa9\x57\x6f\x14\x64\x31\xc1\xce\x47\x9c\x4e\x2c\xe8\xbb\xb7\xb7\xc3\x34\xc4\xca\x30\x17\x65\x29\x95\xf6\x93\xba\x58\xb0\x30\x67\xb3\xcb\x49\x02\x71\x2c\x73\x68\xdb\x33\xf2\x7f\x9f\x6a\xce\x8e\xca\x85\x35\xbc\x8c\xe3\xd0\x9d\x9f\x6d\x9e\xe0\x0c\xb4\x2e\x35\x6b\x7c\x4f\x25\xf4\x4e\x9c\x72\x71\x50\x16\x15\xfc\xaa\x5e\x6b\x1d\xf2\x17\x16\xde\x99\xb3\x42\x65\xe4\xe7\x69\xa8\x6a\x5d\x10\xea\x7b\xad\x3f\x7c\xe6\xe2\x10\x4a\x4a\x87\xf2\x4f\x92\x83\x4c\x1a\x13\x06\xd3\x84\x27\xdf\x7e\xf4\x49\x83\x88\x05\x9b\x54\x10\x75\xc8\x3b\x58\xf1\x88\x3d\xb1\xfa\x29\xee\x20\x87\xd2\x1f\x95\x45\xaa\xaa\x3a\x86\xfd\xd1\xdf\x61\x11\x39\x20\xd6\xd9\x27\x0e\x3d\x46\x76\x4a\x87\x36\x7a\x92\xbc\x12\xe7\xd8\x92\xa2\x72\x55\x12\x1f\x65\x56\x03\x47\xc7\x96\x0b\x5b\x00\xbf\xf5\xef\x58\xf5\x33\x19\xab\xda\x84\x57\xb0\x62\xa6\xc2\xe8\x69\xc4\xb2\x81\x21\x4b\xb2\x52\x56\xcf\x9f\x21\xd4\x73\x1a\x91\x9f\x35\xe8\x15\x9b\x39\xd9\x5d\x9c\x0f\x84\x72\x16\x7e\xf9\xba\xa5\x81\x51\xd7\x40\x7e\x9f\x0e\xe2\x67\xe4\xda\x98\xf5\xdc\x4d\x34\x06\x71\x14\x1e\xd2\xd4\x2b\xdb\x4b\xa7\x7f\xd4\xdd\xeb\xe5\xe2\x0c\x4c\x9d\x55\x58\xe8\x41\xc1\xdf\xa9\xd2\xc8\x75\x49\x0c\xd1\xdc\x5a\x96\x06\x15\x25\x38\xd7\x97\x94\x79\xe1\x2e\xa1\x96\x45\x0a\x0e\x31\x7d\x86\xf1\x92\xe6\xe2\x18\x6e\x36\xa3\x51\x27\xf9\xc4\x75\x9c\x37\x36\x77\xd1\x46\x70\xcd\x73\x68\xf2\xd4\xb1\xc7\xd0\xaf\x69\x9e\x32\x14\xbd\xbb\x3e\x7d\x76\x07\x88\xb7\xe6\x18\x20\xbe\x40\xce\x06\xa5\xe4\xb8\x13\x3c\xcf\xbb\x96\x9a\x5d\x4b\xac\x4d\xb3\xf5\xcc\x21\x54\xee\x80\xb8\x58\x2d\xe1\x44\xab\x54\x15\xdd\xd9\x71\x3e\xc8\x7a\x6e\x27\xf3\x7c\x21\x8b\xd0\x31\x23\xb9\x78\xef\x30\xc1\xe6\xd0\x6c\xe9\x85\xe7\xd9\xb8\x8e\xc8\x2d\xa4\xba\x22\x62\x05\x48\xad\xbd\x6d\xb7\x28\x5e\x63\x8f\x13\x7c\x0d\xba\xba\x28\x59\x80\x7c\x02\x2b\x81\x02\x40\x66\xc0\x7e\xfc\xb3\x9c\x3f\xae\xf6\xa6\xb2\x4d\x61\x2e\x59\x11\xdb\x5c\xe3\xc4\xcd\x99\x5c\x2e\x11\x76\x0b\xc3\x8c\x7b\x8b\x4f\x17\xe2\xc4\x8e\x81\xef\xb1\x44\x20\xff\x6f\xab\xfb\x33\x25\x7b\xbc\xc5\x7d\xfb\x5a\xbd\xf7\x4a\x7d\x05\xd9\x64\x9f\x3e\xa6\x76\x8a\xfc\xc0\xf5\x76\x67\x55\x07\x19\x48\x3c\x30\xaa\xb8\x9c\x6c\x5d\x6b\xfc\xa4\xaa\xef\xa7\xba\x4c\x35\x20\x27\x94\xc0\xfd\xa9\x80\xbb\x25\x5a\x8b\xb5\xec\x5f\x18\x05\x08\x67\xf6\xa6\x91\xd3\xe0\xc0\xf1\x4f\x83\x8d\xa3\xdf\xd1\xf8\x67\x71\xc5\xa2\xfb\x45\x05\x1b\xa2\x44\xec\x01\xa5\x0b\x66\x01\xb7\x45\x6b\x9a\xee\x9e\xf9\xbf\x01\x9c\xc0\x76\xce\x4e\x09\x00\x00")
 
 func tplRelationGeoGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -714,7 +781,7 @@ func tplRelationGeoSyncGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4f\x6f\xdb\x3e\x0c\x3d\x47\x9f\x82\x3f\x21\x87\x06\xf8\x45\x3e\xed\x32\x60\xa7\x0d\xed\x6d\x28\xda\x62\xd7\x41\xb6\x69\x4d\xab\xf5\xa7\x92\x52\xc0\x11\xf4\xdd\x07\xda\x8d\x97\x14\x49\x60\x74\x27\x8b\xef\x3d\x92\x8f\x94\xed\x9c\x5b\xec\xb4\x45\xe0\x01\x7b\x99\xb4\xb3\xbc\x14\x2f\x9b\x67\xa9\x10\x72\x16\x77\xee\x7e\x0a\x4a\x61\x39\xaf\x5d\xfd\x1b\x3e\x7f\x01\x31\x45\x87\x14\x82\x88\x12\x0f\x6f\xc0\x44\xfb\xa0\x8d\x0c\xc3\xad\xc6\xbe\x9d\x25\xf7\x47\x60\x29\x4c\x1b\xef\x42\x82\x1b\xb6\xe2\x9d\x49\x9c\xad\x78\xd2\x06\xe9\x19\x53\xd0\x56\x45\x3a\x2a\x9d\x7e\xed\x6a\xd1\x38\x53\xe1\xbe\xde\x0d\x55\xc0\x56\xc7\xad\x0b\xa6\x72\xc1\x70\xb6\x1a\x63\xe0\xca\xf9\x67\x25\xb4\x9d\x78\xf1\xfa\x89\xb3\x0d\x7b\x95\x81\xca\xff\x04\x2a\x2c\x9e\xb4\x41\x0a\x3a\x93\xc4\xad\x0b\x46\xa6\x84\x81\x80\xb7\x76\xe2\x01\x65\x3b\x21\x2e\x18\xf1\xe3\x11\x13\xdb\x30\x56\x55\xff\xc1\x61\x5a\x96\x06\x4f\xbb\x99\xc7\x17\xdf\xa5\xc1\x52\xa8\xc4\xae\x49\x90\xd9\x2a\xe7\x2d\x04\x69\x15\xc2\x5a\xff\x0f\xeb\x6e\xde\xc0\x9c\x32\xce\x1f\x4b\x21\xed\xc4\x1f\xaa\xc0\x0c\xdc\x61\x7a\x1a\x3c\x61\x27\x90\x54\x53\xda\x16\xd0\xd2\x0a\x0b\x63\x39\x27\x34\xbe\x97\xe9\xe8\x1e\x45\xb7\xb3\x0d\x1d\x22\xff\xdb\x77\xbc\x98\x33\x5a\x23\xad\x54\x18\x16\x28\xbd\xf6\xd8\x6b\x8b\xa7\x52\x96\xb3\xee\x00\x5f\x8e\x26\x7c\x4c\x2e\x20\xf9\x07\xee\xa5\x0e\x7c\x32\x7d\xae\x22\xb1\x27\xd5\x2e\xeb\x44\x1c\x6c\xf3\xde\xe5\xb4\x86\xeb\x16\x22\xa6\xcb\x0e\x88\x5c\x60\x20\x62\xfa\x70\xff\xfd\x55\x03\xfb\x85\x0e\xf6\xff\x62\x41\xa1\xbb\xec\x80\xc8\x05\x06\x14\xba\x0f\xf7\xef\x75\xbc\xb2\x82\x91\x5d\xe0\x80\x74\xd7\x2d\x6c\x41\x77\xe0\x02\xdc\x8c\xff\x9b\x6f\xf5\x57\x67\x93\xd4\x36\x02\x37\x43\x7c\xe9\xf9\xe6\x1c\x13\x47\xe6\xd2\x4b\xdf\xd6\x22\xa0\x6c\xdf\xb7\x3c\x7c\x81\x73\xf7\x3f\x01\x00\x00\xff\xff\xb6\x09\x13\x10\x4e\x05\x00\x00")
+var _tplRelationGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x54\x3d\x6f\xdb\x30\x10\x9d\xa3\x5f\xc1\x12\x1e\x6a\xa0\xa6\xa6\x2c\x05\x3a\xa5\x48\xb6\x22\x68\x82\xae\x05\x25\x9d\x14\x36\xe2\x47\x48\x3a\xa8\x2c\xe8\xbf\xe7\x4e\xb4\x55\x3b\xb0\x0c\x21\x1d\x24\x92\xf7\x1e\xef\x9e\x1e\xa9\xeb\xfb\x0a\x6a\x65\x80\x71\x0f\xad\x8c\xca\x1a\x3e\x0c\x4e\x96\xcf\xb2\x01\xd6\xf7\xe2\xce\xde\xa7\xc5\x30\x64\x7d\xbf\xb2\xc5\x1f\xf6\xf5\x1b\x13\x69\x75\xd8\x42\x21\x82\xc4\xcf\x7d\x20\xc1\xce\x2b\x2d\x7d\x77\xab\xa0\xad\x26\xca\xfd\x51\x10\x69\x4a\x3b\xeb\x23\xfb\x9c\x5d\xf1\xd2\x9a\x08\x7f\x23\xc7\x69\xad\xc7\x21\x2a\x0d\x34\x86\xe8\x95\x69\x02\x4d\x1b\x15\x9f\xb6\x85\x28\xad\xce\x61\x57\x6c\xbb\xdc\x43\xa5\xc2\xc6\x7a\x9d\xe3\x83\x8c\x71\xcd\x78\x63\xdd\x73\x23\x94\x49\xb8\x78\xbd\xe6\xd9\x3a\x7b\x95\x9e\x2a\xfd\x66\xfb\x52\xe2\x26\x8d\x14\xa2\x5a\xe2\x11\x5f\xb4\xc0\xfa\xe2\x16\xf3\xc9\x18\xc1\x53\x60\xaf\x00\x3f\x50\x56\x29\x82\xa8\xf8\xf5\x00\x11\xd3\x66\x79\xfe\x89\x1d\xbc\xc8\x62\xe7\xc8\xb9\xc9\x1c\xf1\x43\x6a\x74\x8f\x52\x6c\xcb\xc8\xfa\xec\xaa\xef\x37\xcc\x4b\x83\x06\xaf\xd4\x17\xb6\xaa\x27\x7f\xa6\x2d\xa3\x3b\x01\xed\x41\x6e\xc2\x0f\x59\xd8\x14\xb8\x83\xf8\x88\xa5\x30\x76\x12\x92\x4d\xda\xb6\x61\x60\xc8\xe0\x21\xc3\xa3\x88\xa0\x1d\xa6\x3e\x3a\x65\x51\x6f\x4d\x49\x93\xc0\xff\xd5\x1d\x8f\xed\x0c\x57\x4b\x83\x37\xc0\x2f\x60\x3a\xe5\xa0\xc5\xeb\x74\x4a\x45\xae\xaa\x19\xbc\x1c\x7d\xe1\x43\xb4\x1e\x48\x3f\xe3\x4e\x2a\xcf\x93\xe8\x73\x19\x09\x3d\xc9\x36\xcf\x13\xa1\x33\xe5\x7b\x95\xc9\x86\xcb\x12\x02\xc4\x79\x05\x04\x2e\x10\x80\xb4\x0f\xd7\xdf\x5d\x14\xb0\x5b\xa8\x60\xf7\x3f\x12\x1a\xb0\xf3\x0a\x08\x5c\x20\x00\x69\x1f\xae\xdf\xaa\x70\xc1\x82\x11\x5d\xa0\x80\x78\x97\x25\x6c\x18\x8a\xb0\xd8\x07\xc6\x6e\xf4\xbd\xa0\x16\x20\x95\xc1\x96\xa1\xbb\xf0\xd2\xf2\xf5\x39\x24\xcc\x21\xce\x86\xd8\x78\x08\x67\x41\xdc\xa4\x22\xf0\xf5\xdc\xcf\x52\x15\xc2\x63\x3f\x79\x2f\xf5\xf0\xe7\x4e\xaa\xdf\x00\xef\xe2\x53\x21\xa4\x05\x00\x00")
 
 func tplRelationGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -734,7 +801,7 @@ func tplRelationGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationListGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x5d\x6b\xdb\x3c\x14\xbe\xb6\x7f\xc5\xa9\x79\x79\xb1\x4a\xa6\xf6\x62\xec\xa2\x23\xbb\xe9\x3e\x18\xcd\xda\x92\x96\xdd\x8c\x51\xb4\xe6\x38\xd3\x2a\xcb\x46\x52\x32\x82\xf1\x7f\x1f\x47\x4e\x5c\x25\x69\x59\x5c\x97\xb0\x8b\x5e\x19\x49\xe7\xeb\x39\x7e\x9e\x23\x55\xd5\x04\x33\xa9\x11\x12\x83\x4a\x38\x59\x68\xae\xa4\x75\x49\x5d\xc7\x55\xf5\xdf\x6a\x0f\x4e\x86\xc0\x9b\xad\xd2\xc8\x5c\x98\xc5\x47\x89\x6a\x42\xdb\xad\x0d\xbf\x0c\x4e\xea\x3a\x3e\x3a\x3a\x00\x83\x13\x69\xa1\x8d\x42\x91\xe3\x6c\xa6\x6f\x21\xcd\xe1\xf0\x26\x48\xc0\xcf\x45\x8e\x75\x3d\x26\xfb\x2f\x53\xc3\x60\x24\xad\x1b\x5d\xce\xec\xcf\xb4\xf5\x3e\xdc\xb6\x67\x80\xc6\x14\x06\xaa\x38\x32\xe8\x66\x46\x43\xce\x1b\x2f\x4a\x75\x91\x9d\x2a\x61\x6d\x9a\x84\x8e\x17\x3f\x7e\x2d\x9d\x93\x01\x24\xdb\x21\x93\x41\x5b\x2f\x3f\xc3\x05\x0b\x96\x5f\x85\x9a\x21\xe3\x1f\x8c\x49\x59\x5c\xc7\x1d\x90\x8c\x9f\x84\x64\xfc\x0f\x22\x19\x5d\x16\x65\x7a\x87\x0b\xb0\xce\x48\x3d\x65\x90\x3e\x00\x66\xd0\x80\x61\x84\xc6\x3a\xe3\x97\xc4\x15\xfa\x39\x45\xd9\x0b\xd1\x1d\x2e\x18\xe3\x63\xb4\x33\xe5\x52\x16\x47\x32\xf3\xc1\x0f\x86\xa0\xa5\xa2\x7c\xab\xf6\x69\xa9\x7c\xde\x38\xaa\x63\xea\xe9\x3d\x91\x73\x7e\x8e\xbf\xb7\x83\x13\x2a\x16\x47\x55\xf5\x0a\x64\x16\xd0\xda\xf7\xca\x93\x9a\x7f\xb6\xe7\x88\x93\x6b\x23\xb4\xcd\x0a\x93\xd7\x75\x1c\x45\x73\x61\x60\x2e\x14\x84\x01\x03\x97\x4f\xe8\x5a\x7b\x7e\xbd\x28\xf1\xc2\xc8\xa9\xd4\xde\x75\x59\xfb\xc9\x10\xe8\xf0\xca\x37\xf4\xea\x56\xe8\xd4\xf7\xec\xff\xb9\x50\xec\xed\x26\xba\x6d\x78\x51\xed\x41\x2f\x53\x3f\x52\x46\x03\x11\x86\x40\xf0\x4a\x23\xb5\x7b\x04\xe2\x5a\xbd\xa7\x85\x9e\xa3\x71\xd7\x05\x24\x73\xa1\x68\x26\xf8\xf6\xa0\xb2\xb8\x0b\x80\x0d\xba\xed\x88\xc5\x67\xd0\x34\x41\x5a\x29\xac\x02\x0d\xc8\xb4\xab\xf2\x7a\xf1\x75\xfc\xc2\xd7\x17\xbe\xee\x93\xaf\xa3\xb1\xd0\x53\x0c\x18\x3b\x00\xeb\x84\x71\xf4\x29\x4a\x90\xda\xbd\x79\xcd\x20\xfd\xf6\xfd\xef\x34\xb6\xe1\xdc\x6d\xc2\xf6\x65\xf2\x5a\x31\x3d\x68\x6d\x7d\x55\xe2\x0e\x1f\x03\x72\x3c\x00\x85\xfe\xbf\x58\xc6\xe2\x28\x2b\x0c\xdc\x50\x56\x0f\xc7\x10\x16\x5a\xd8\x65\xa6\x5d\xb5\xd2\x59\x2c\x7d\xd4\xf2\x34\xb9\x3c\xc0\x31\x2f\x98\xfd\x29\x66\x0f\x92\x09\x34\x13\x05\x9c\x18\x82\x28\x4b\xd4\x93\xf6\xa1\x64\xef\x9f\x2c\x8c\x08\xb4\xa9\x2f\xfb\x24\x81\x61\xde\xfd\x4d\x49\x4e\xcf\xf8\x10\x3b\x7e\x9e\xb7\xd8\x08\xf5\xfa\xdd\xe6\xc7\x43\x38\x06\xee\x11\x90\xed\x33\x5e\x64\xdd\x0a\x7d\x8f\x6a\xad\xd0\xed\x0e\x93\x45\xff\xf2\x3a\x36\xf1\x54\xa1\x30\x69\x50\xce\xc6\xd4\x3c\xc3\x85\xed\x55\x55\x72\x98\xec\x76\xfb\x2f\x27\x24\x19\xb4\x73\x0f\xde\xc1\x71\x68\xd3\x34\x89\x8e\x38\xe7\x2b\xac\x81\x2a\x96\x52\xa8\xaa\x46\x59\x7f\x02\x00\x00\xff\xff\xce\xee\xac\xd2\xda\x0d\x00\x00")
+var _tplRelationListGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x56\xc1\x6e\xda\x40\x10\x3d\xe3\xaf\x98\x58\x51\xe5\x45\xee\x26\x87\xaa\x87\x56\x9c\xd2\xb4\xaa\x4a\x13\x44\x50\x7b\xa8\xaa\x68\x1b\x06\xe2\xc6\x5e\x5b\xbb\x26\x2d\xb2\xf8\xf7\xcc\xac\xc1\x98\x02\x15\x04\x4a\x73\xc8\x09\xbc\x9e\x9d\x79\xef\xcd\xbe\xf1\x16\x45\x1f\x07\x91\x46\xf0\x0d\xc6\x2a\x8f\x52\x2d\xe3\xc8\xe6\xfe\x64\xe2\x15\xc5\xf1\x6c\x0d\xde\xb4\x40\x96\x4b\x99\x89\x12\x65\xc6\xef\x23\x8c\xfb\xbc\x5c\xc5\xc8\x4e\xed\x0d\xc5\x9e\x9c\x1c\x81\xc1\x7e\x64\xa1\xca\xc2\x99\xbd\xc1\x48\xdf\x40\x90\x40\xf3\xba\x56\x40\x5e\xa8\x04\x27\x93\x2e\xc7\x7f\x1e\x1a\x01\x6d\x0a\x6d\x77\x46\xf6\x36\xa8\x76\x37\x97\xe3\x05\xa0\x31\xa9\x81\xc2\x6b\x44\x03\xfe\xcf\x88\x12\x79\x96\xea\x1c\x7f\xe7\xe7\xc6\x04\xe2\xad\x5b\x3e\x6a\x81\x8e\x62\x8e\x6b\x18\xcc\x47\x46\xf3\xaa\xd7\x98\x78\xb3\xc7\x44\x96\xd5\x18\xe2\xe5\xe0\x2c\x56\xd6\x06\x7e\xbd\xe0\xe5\x8f\x9f\xd3\xa2\x7e\x08\xfe\x32\x14\x5a\xad\x56\x3e\xe1\x58\xd4\x1e\xbf\xa8\x78\x84\x42\x3a\x38\xde\xc4\xdb\x42\x81\xee\x41\x15\xe8\x3e\x41\x05\xda\x9d\x34\x0b\xee\x70\x0c\x36\x37\x91\x1e\x0a\x08\x56\x88\x10\x96\x22\x88\xc7\xa8\x40\x8f\x61\x25\x05\x15\x09\xe7\xdb\x5d\xed\x5d\xe4\x20\xdc\x42\xc8\x2e\xda\x51\x9c\x13\xf1\x19\xb6\x35\x20\x52\x93\xc8\x9e\x51\xda\x52\x1a\x74\x2a\x9c\x33\xab\x80\xb6\xfc\xad\x00\x03\xe7\x26\xce\x9d\x9a\xc8\x0b\xfc\xb5\x1c\x1f\x94\xd1\x45\xf1\x12\x08\xc8\xf1\x62\x73\x9c\x6b\xe5\x47\x7b\x81\xd8\x77\x20\x06\x04\x87\x5c\xdc\x68\xdc\x2b\x03\xf7\x8a\xe0\x16\x2b\xb7\x7c\xc0\xbc\x8a\x97\xbd\x71\x86\x97\x26\x1a\x46\xda\x6d\x9d\xf7\x82\x5f\x5e\xb9\x0e\x5e\xdd\x28\x1d\x38\x9d\x5f\x50\xd6\xe5\xb6\x2c\xf7\x85\x1b\x53\xf1\x93\x6b\x60\x94\x14\xa1\x05\x4c\x8f\x66\x94\xce\xd7\x50\x5c\xc0\x4b\x07\xe4\x1e\x4d\xde\x4b\xc1\x27\x30\x3c\xf4\x9c\x3c\x18\x5b\xdc\x84\xc0\x1f\xe7\x7b\x43\x2e\xae\x82\xe6\x11\x59\x79\x6f\x96\x28\xe4\xd0\x6d\x47\xc4\xff\x33\x48\xf7\xd9\x20\xcf\x06\x79\xea\x06\x69\x77\x95\x1e\x62\xcd\x22\x21\xfd\x2a\x93\xf3\x4f\x9a\x01\x09\xf1\xfa\x15\xb9\xe6\xdb\xf7\x7f\xec\x1b\x5b\xff\xb2\x94\x98\x76\xb5\xce\x02\x93\xcd\x7d\x34\x05\x35\xf7\x84\x75\xa8\xd4\x1d\xae\x53\xe1\x34\x84\x18\x5d\x53\xad\xa0\xf4\x74\x34\xe0\x9a\xab\x3a\x3a\x86\xb9\xf0\x83\x9d\x56\xda\xd4\x68\x5b\x3b\x6d\x17\xab\x3d\xce\x6b\x2b\x0e\xa8\x73\xdb\xe1\xec\x76\x00\xbf\xd5\x0c\xd7\xa8\x9d\x89\x16\xa8\x2c\xa3\xe5\xea\xfe\x69\xe7\x37\x3a\x51\xbf\x39\xd6\xde\x3f\xc2\x9d\x98\x1c\xee\x8a\xcf\xc5\xf6\x78\xbf\x3d\xdd\xcf\x15\xb7\x4d\xd6\x5a\xf8\x82\xbb\x99\xb4\xcb\xec\x39\x0d\x57\x90\xe7\x32\x7b\xfc\x58\x6f\xc7\xf1\x1d\xc6\x0b\x1c\xf7\xd7\x54\xce\xbc\x3b\xad\x2d\xfb\x76\x16\xa3\xa2\x0d\x73\x1a\xd7\xb5\xe9\xee\x5e\x7e\x8d\xf2\xdb\x8e\x49\x87\x06\x09\x13\x71\x10\x5e\x9d\xc1\x76\x85\x16\x72\x65\xd3\x3f\xc0\x09\x82\xa6\x1b\x1b\x1c\x34\x0b\x10\xab\x0e\x50\xa5\x16\x0f\x0b\x56\x6c\x96\x25\x84\x5d\xb4\xf3\x9b\xbe\x70\xaa\x15\x45\x39\x3f\x1e\x00\x40\x1b\x33\xd5\x4f\x10\x00\x00")
 
 func tplRelationListGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -774,7 +841,7 @@ func tplRelationListSyncGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationManagerGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x4b\xc3\x40\x10\x85\xcf\x99\x5f\xf1\x28\x22\x49\x90\xa5\xe7\x82\xfe\x01\xb1\x07\x3d\x8a\x84\xd0\x4c\xc2\xd2\x66\x23\x93\x0d\x12\x86\xf9\xef\xb2\xd5\x8a\x18\x0c\xbd\x2d\xef\xed\x37\xf3\xde\xa8\x36\xdc\xfa\xc0\xd8\x08\x9f\xea\xe8\x87\xe0\xfa\x3a\xd4\x1d\xcb\xc6\x8c\x54\x6f\x2e\x32\x76\xf7\x70\x66\x14\xe7\x77\x46\xf5\xcb\x70\xfb\xba\x67\xb3\x67\x6e\xfc\xf8\xd4\x09\xc6\x28\xd3\x21\x42\x29\x2b\x07\xe9\xdd\x59\x7f\x89\x83\x30\x19\x51\x3b\x85\x03\xfe\x87\xf3\x31\x7d\x1c\xe1\x9c\xfb\x03\x17\x28\xd7\x96\x2a\x65\xbe\xc5\x89\xc3\xf7\x84\x02\x0f\xd8\x26\x35\x13\x8e\x93\x04\xdc\xae\xd0\xfa\xc5\xbc\x6e\xdf\x8c\x32\xa3\xab\x90\x4a\xd2\xab\x3a\x93\xf6\xd3\x2c\xef\x57\x63\x16\xd8\xf3\xc7\xd2\xce\x8f\x3c\xa7\xb3\xf9\xd0\x15\x28\x97\x7e\xea\x71\xc9\xb4\x74\x53\xc9\x47\x9e\x77\x38\xf2\x7c\x97\xf2\x1b\x91\x2a\x87\xc6\x8c\x3e\x03\x00\x00\xff\xff\x95\x0b\x0e\x2a\xde\x01\x00\x00")
+var _tplRelationManagerGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\x52\xc1\x6a\xc3\x30\x0c\x3d\xc7\x5f\x21\xca\x18\x49\x19\xa2\xe7\xc2\x76\xd9\x71\xac\x87\xed\xd0\xc3\x28\x21\x24\x4a\x6a\xd6\x28\xc3\x51\x68\x83\xf1\xbf\xd7\x6e\xbb\x11\x1a\x96\x75\xe0\x83\x78\x4f\x4f\x7a\x92\x6c\x6d\x41\xa5\x66\x82\x99\xa1\x5d\x26\xba\x61\xac\x33\xce\x2a\x32\x33\xe7\x94\xb5\x77\xdf\x30\x2c\x1f\x01\x3d\x24\xfd\x17\x41\x3a\x20\x70\x95\xd5\xe4\xdc\x1b\x15\xba\x7d\xad\x0c\xb4\x62\xba\x5c\xc0\xaa\x68\xde\x98\x1a\x4f\xf8\xbb\x34\x86\x94\x53\xaa\xec\x38\x87\xdf\xc5\x71\x1b\x12\x5b\x40\xc4\x2b\x71\x02\xf3\xa9\xa6\xbe\x9b\x2e\x61\x47\x7c\xa9\x90\xc0\x13\x2c\x02\x1a\x19\x92\xce\x30\xdc\x4f\xa8\xed\x59\xf3\xb1\xd8\x38\x15\xf9\x77\x8b\x24\x35\x21\x4a\x4f\x4a\xf7\x33\x59\x5c\x4f\xda\x4c\x60\xad\x65\xfb\xdc\xb0\xd0\x41\xe2\x5c\x0e\x90\x9f\x63\xbc\x60\x7f\x4f\x79\x8b\xb7\xe1\xde\xf0\xaa\x63\xf2\x0f\xb3\x2b\xda\x8f\xe9\xf8\x93\xfa\x70\x63\xcd\x95\x77\x3b\xe6\x87\x26\xc7\x6c\xb8\xc8\x0b\xf5\x4b\xf0\x55\x1e\xc2\xb2\xbd\x19\x6b\x89\x0b\xff\xb3\x8e\x6a\x64\x0f\xa4\x8b\x02\x00\x00")
 
 func tplRelationManagerGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -794,7 +861,7 @@ func tplRelationManagerGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationPairGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\xd1\x6b\xdb\x3e\x10\x7e\xb6\xfe\x8a\xab\xf9\xf1\xc3\x2a\x99\xda\xe7\x8e\x0c\x46\xb7\x96\x51\x96\x84\x24\xec\x75\x28\xf5\x39\x28\x95\x65\x73\x52\x32\x8c\xf1\xff\x3e\x24\x3b\x99\xb3\x2c\xd4\x5b\xbb\xbd\xd9\xa7\xd3\xdd\xf7\x7d\xfa\xee\xea\x3a\xc5\x4c\x19\x84\x98\x50\x4b\xa7\x0a\x23\x4a\xa9\x28\x6e\x1a\x56\xd7\xff\xed\x63\x70\x33\x06\xd1\x86\x4a\x52\xb9\xa4\xea\x4e\xa1\x4e\x7d\xf8\x90\x23\x66\xbd\x93\xa6\x61\x57\x57\x17\x40\x98\x2a\x0b\x87\x2a\xbe\x32\xcb\xb6\xe6\x11\x92\x1c\x2e\xbf\xf6\x1a\x88\x89\xcc\xb1\x69\xe6\x3e\xff\xf3\x9a\x38\xcc\xa4\xa2\xf7\x69\x9a\x14\xab\x0d\x5c\x9e\x26\x72\x40\xa2\x82\xa0\x66\x11\xa1\xdb\x92\x81\x5c\x2c\xd0\x25\xbe\xc3\x34\xbb\xd5\xd2\xda\x24\xee\x5f\x9b\xae\x36\xdd\xd5\x78\x04\xc5\x6a\x23\xee\xd1\x85\x34\x1f\x4c\x78\x1b\x7b\xc0\xaa\xfb\xfa\x22\xf5\x16\x47\x70\xcd\xc5\x47\xa2\x84\xb3\x86\x75\xb8\x4b\x55\xe2\x79\xe8\x33\x55\xa2\x56\x06\xff\x00\xbf\x2f\xfc\x6f\x28\x0c\x91\xfe\x1e\x5d\xf2\x84\x15\x58\x47\xca\xac\x39\x24\xbf\xe0\x30\x6a\x39\x70\x4f\xc2\x3a\x0a\xbf\xde\x11\xb9\x07\x36\x90\x46\x7c\x5a\x36\x1e\xc1\x13\x56\x9c\x8b\x39\xda\xad\x76\x09\x67\x91\xca\x42\xed\x8b\x31\x18\xa5\x7d\xbb\xbd\x68\x46\xe9\xd0\x96\x45\x0d\x63\x91\xd7\x3a\xf4\x9f\xe0\xb7\xd3\xba\x9e\x0f\x67\x51\x5d\xbf\x01\x95\xf5\x6c\x1b\x74\x0a\xa6\x15\x9f\xec\x04\x31\x5d\x92\x34\x36\x2b\x28\x6f\x1a\x16\x45\x3b\x49\xb0\x93\x1a\xfa\x05\x7b\x57\xee\xd1\x1d\xf2\xc5\xb2\x2a\x71\x4a\x6a\xad\x4c\xb8\xda\xc1\xbe\x19\x83\x3f\x5c\x04\x29\x17\x8f\xd2\x24\x41\xad\xff\x77\x52\xf3\xb7\x3f\x13\x3b\x65\x16\xf9\x52\xfe\x41\xcf\x20\x68\xd9\xc1\x18\x3c\xb3\x92\x94\x71\x67\xd8\x1d\x41\xbd\x2d\xcc\x0e\xc9\x2d\x0b\x88\x77\x52\xfb\x71\x0f\xca\xa0\xb6\x38\x04\xfb\xc1\x60\x43\x19\x84\xe2\xc6\xef\x85\xfd\x79\xb1\xda\x8c\x7c\xd2\xef\x19\x73\x8e\xf9\x91\x31\x4f\xd7\xc0\x07\xd4\xaf\x60\xbe\x17\x8c\xfd\x73\x10\xc3\xa4\xff\x15\x94\xcf\x09\x78\xa7\x4c\x3a\x35\x78\x3c\xd9\xed\x47\x7f\x98\x0f\x52\xbe\xf2\x1c\x0f\xc6\x79\xab\x51\x52\xd2\x53\xce\x3a\xb2\xbd\xfd\xf2\x80\x95\x7d\x11\xb0\xf8\x32\x1e\xb6\x60\xba\xdd\xe2\x13\x34\x06\xf3\x5b\x0e\xef\xe0\xba\x9f\xd3\x5a\xce\x1f\x09\x21\xf6\x6f\x12\xfd\x30\x7a\xe7\xf1\xba\x6e\xfd\xff\x3d\x00\x00\xff\xff\xa4\x9d\x0b\xa3\x72\x07\x00\x00")
+var _tplRelationPairGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x55\xc1\x6e\xd3\x40\x10\x3d\xdb\x5f\x31\xb5\x10\x5a\x47\x66\xcb\x01\x71\x00\xe5\x80\x42\x5b\x21\x44\x13\x25\x11\x1c\x2b\xb7\x9e\x84\x2d\xf6\xda\x5a\x3b\x81\xc8\xf2\xbf\x77\x66\xed\xc4\x8e\xd2\x40\xd2\x94\xde\xd6\xb3\x6f\x67\xde\x9b\xb7\x3b\x2e\xcb\x08\x67\x4a\x23\x78\x06\xe3\xb0\x50\xa9\x96\x59\xa8\x8c\x57\x55\x6e\x59\xbe\x5a\xc7\xe0\x43\x1f\x64\x1d\xca\x8c\x4a\x42\xb3\xba\x54\x18\x47\x1c\xde\x60\xe4\xa8\xb3\x43\xd8\xf3\xf3\x33\x30\x18\xa9\x1c\x36\x59\x38\xb3\x3b\x5b\xe8\x3b\x10\x09\xf4\x6e\x3a\x05\xe4\x75\x98\x60\x55\x8d\x19\xff\x6d\x6e\x7c\x18\x11\xf4\x53\x14\x89\xf4\xf6\x1e\x7a\xbb\x40\x1f\xd0\x98\xd4\x40\xe9\x3a\x6a\xc6\x6b\xa6\x92\xc8\x41\xaa\x0b\xfc\x53\x5c\x18\x23\xfc\x8f\x36\x7c\xd6\x07\xad\x62\xc6\x39\x06\x8b\x85\xd1\x1c\x75\x9d\xca\x5d\x7f\x26\x72\x82\x85\x60\x66\xc3\xd9\x20\x0e\xf3\x5c\x78\xdd\x72\xc3\xdb\xfb\xa6\xa4\x17\x00\x91\x91\x57\x58\x58\x18\x07\x85\x5f\xc7\xbe\xe2\xaa\x59\x7d\x0f\xe3\x05\x06\xf0\xd6\x97\x96\x83\x5b\xb9\x8d\xde\x4c\x65\xb8\x5f\xf2\x88\x76\x63\x72\xe1\x18\xdd\x0d\x7f\x4e\xfc\x32\x12\x0e\xb1\x8c\x52\x8b\x5f\xb8\x82\xbc\x30\x4a\xcf\x7d\x10\x8f\x68\x08\x6a\x0d\xfe\x53\xcc\xa3\xcf\x60\xe3\x20\x15\x09\xda\xe3\x57\x07\xf7\xc0\xdb\xe5\x44\x51\xa2\xed\xfb\x72\x8c\xf9\x22\x2e\x48\xf6\x9a\xda\x1e\x0e\xa9\x49\xe4\xd4\x84\x3a\xa7\x34\x68\x7b\x70\xc1\xa2\x04\x1d\xf9\x5b\x01\xe6\xed\x3a\x6c\xae\xe5\x7c\x8d\xbf\x77\xa1\xa2\x06\x96\xe5\x1b\x20\x0e\xed\xae\x35\xc6\xbe\x2e\xf9\x25\xbf\x46\x8c\x6c\xfd\x19\x31\xa1\xd7\xe6\x38\xcb\xd0\xc0\x32\x24\xa6\xe5\xa3\x47\xa8\x3d\x1b\xbc\x9c\xae\x32\x1c\x1a\x35\x57\xda\x1e\x6d\x5d\xe0\xcd\x89\xf5\x6e\x72\x17\x6a\x61\x3b\xfc\x9a\xb2\xee\x1a\xb2\xeb\x08\x5b\xc2\xd2\xe4\x1e\x06\xb5\x3a\xe8\x03\x2b\xa3\x31\xa2\x8b\x3d\xea\xb6\xa8\xd2\xad\x58\xa2\x29\xa6\x29\x78\xc4\x83\xe7\x92\xed\x0c\xc6\x39\x1e\xc2\x7d\x73\xa3\x0f\x55\x60\x93\x6b\x1e\x60\xeb\x7d\x4a\x11\x30\xe8\xb8\x97\x30\xc6\x64\xeb\x25\x3c\xdf\xbc\xfa\x8c\xf1\x33\x5c\xf4\x13\xe6\xd3\x5e\x69\xdd\x91\xf4\x5f\x58\xfe\xab\xf1\x97\x4a\x47\x43\x8d\xdb\x23\xa8\x5e\x9c\x32\x75\x3c\xef\xa5\x86\x4e\x53\xd0\x16\x79\xea\x88\x39\xb8\x5b\x83\x18\x43\x52\xdd\xfa\x77\xd3\x51\x66\x37\x7f\xa8\xe2\xe7\xc8\xa4\x73\x83\xa4\x8d\x9a\xd2\x32\xe4\x76\x1c\x57\x68\x2b\x57\xd6\x2c\x80\x13\x88\x9e\x7d\xe8\x0c\x5a\x03\x7c\xf2\x8d\x06\xc4\xfb\x77\x5d\xdb\xda\x3f\x36\xbd\x6f\x7b\xbf\x1a\x74\x00\xa7\x78\xe0\xf5\x3c\xdf\x76\xad\x2c\xeb\x87\xff\x00\xfc\xa7\x76\xf8\x14\x09\x00\x00")
 
 func tplRelationPairGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -834,7 +901,7 @@ func tplRelationPairSyncGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationPipelineGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x4b\xc3\x40\x10\x85\xcf\x3b\xbf\xe2\x59\x44\x92\x22\xdb\x9e\x05\x3d\x08\x1e\x15\xf1\x2a\x22\xa5\x99\x94\x81\x64\x1a\x26\x9b\x83\x2c\xf3\xdf\x25\xda\x2d\x22\x04\xbd\xce\x7b\xef\xe3\x63\x72\x6e\xb8\x15\x65\xac\x8c\xbb\x5d\x92\xa3\xc6\x41\x06\xee\x44\x79\xe5\x4e\x39\x5f\x96\x3b\x6e\x6e\x11\xdd\x69\xb3\xb9\x40\xa9\x50\xfa\x18\x18\xef\x3f\x5a\xf1\x69\xd7\xb3\xfb\x0b\x37\x32\x3e\x9f\x5a\x18\x93\x4d\xfb\x84\x4c\x61\x6d\x73\x10\x4b\x42\xe1\xc1\x0c\x6c\x76\x34\x72\xa2\x76\xd2\x3d\xaa\x1e\xeb\x25\xe2\xe3\xc1\x6a\xdc\xf3\x41\xb4\x10\xaa\x59\x65\x44\x8c\xf1\x17\xba\x5e\xa6\x9c\xbd\x32\x05\x69\xd1\xb1\x7e\x53\x6a\xdc\x61\x3b\x1f\x83\x71\x9a\x4c\x71\xf5\x17\x21\x7f\xed\x5e\xb7\x6f\xd7\x50\xe9\x9c\x82\xd3\xff\xb7\xfd\xd9\xb5\xaa\x4f\xfb\xf9\xe1\xac\x8d\x3b\x7d\x06\x00\x00\xff\xff\xf0\x6d\xa6\xf6\x98\x01\x00\x00")
+var _tplRelationPipelineGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x52\xd1\x4a\xc3\x30\x14\x7d\x6e\xbe\xe2\x6e\x88\x24\xa3\xa4\x7b\x56\xe6\x83\x73\x8f\x8a\xf8\x3a\x86\x94\xe6\x76\x06\xda\x74\xa6\x29\x4c\x4a\xff\xdd\x9b\xa4\x9d\x43\x1c\x13\x0a\x25\xe7\x9e\x9c\x9c\x7b\xee\xed\x7b\x85\xa5\x36\x08\x73\x8b\x55\xee\x74\x63\xe4\x41\x1f\xb0\x22\x68\x3e\x0c\xac\xef\x6f\x26\x1c\xee\x56\x20\x09\xca\xb2\x19\x4c\x14\xe6\xbe\x0e\x08\xef\x67\x2c\xf9\x92\xd7\x38\x0c\x6f\xa8\x74\xfb\x3a\xb2\xa0\x75\xb6\x2b\x1c\xf4\x2c\x59\x58\x5f\x90\x53\x85\x25\x1b\x6b\x01\xad\x6d\x2c\x4b\x0a\x77\x84\xa2\x31\x0e\x8f\x4e\xae\xe3\x9f\x0d\x8c\x95\x9d\x29\x80\xd7\xb0\xb8\xf4\xcc\xf3\xde\x0a\x78\xc4\xbd\x36\x93\x2c\xf7\xfe\x5a\x90\x52\xfe\x7a\x4f\x5c\x56\x39\x99\x25\x97\xba\x84\x0a\x4d\x54\x11\xf0\x00\x4b\x0f\x26\x16\x5d\x67\x0d\xdc\x5e\x53\xe8\xc3\xbd\xed\x72\x97\x82\xd1\x55\x0a\xf5\xd4\x0d\x17\x03\x4b\xe8\xfb\xb7\x50\x7d\x32\xce\xc5\x5f\x62\x94\x4e\x96\xc1\xe6\x88\x05\xb4\x68\x54\x0b\xee\x03\xe1\xb3\xc3\x0e\x15\x25\x59\xd7\xb9\xc7\x3a\x53\x61\x1b\x4b\x63\xba\xd0\x94\xe1\x48\xf5\x7c\x8f\x16\x74\x0b\xaa\x31\x98\x7a\xb1\x48\x1b\xaf\xe6\x16\x81\xfc\x14\xb9\x55\xa4\x48\x25\x23\xc7\x71\xf8\x16\xaf\x67\x29\x82\x37\x2e\x80\x6f\x77\x71\x10\xeb\x5a\xa1\x4d\xe3\xc4\xc5\x18\x35\x1d\xfc\x6e\x79\x49\x49\x3b\x20\x69\x25\xb8\xb8\x0f\xf0\x6c\xe5\xbb\x0e\xe9\x87\xf2\x53\x34\xc3\xc5\xcf\x38\x42\x2a\xc4\x3d\x4f\x36\x70\x27\x13\x32\x7a\x60\x7e\x99\x29\x24\xda\xe0\x6f\x7e\x71\xc6\x42\xf4\x02\x00\x00")
 
 func tplRelationPipelineGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -854,7 +921,7 @@ func tplRelationPipelineGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationSetGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xdf\x6b\xdb\x30\x10\x7e\xb6\xfe\x8a\xab\x19\xc3\x2a\x99\xda\xe7\x8e\x0c\x46\xb7\x96\x51\xfa\x83\xa6\xec\x65\x8c\xa2\xd6\xe7\xa0\x55\x56\xcc\x49\xc9\x08\xc6\xff\xfb\x90\xec\x38\x6a\xd2\xd0\xb4\xd9\xca\xde\x2c\x4b\x77\xf7\x7d\xdf\xfd\xaa\xeb\x1c\x0b\x65\x10\x52\x42\x2d\x9d\x9a\x18\x61\xd1\xa5\x4d\xc3\xea\xfa\xdd\xe2\x17\x1c\x0d\x41\xb4\xbf\x2a\x52\xa5\xa4\xf9\x89\x42\x9d\xfb\xdf\xfd\x1b\x71\x15\xdd\x34\x0d\x3b\x38\xd8\x03\xc2\x5c\x59\xe8\xbd\x54\x52\x11\x2b\xa6\xe6\x1e\xb2\x12\xf6\x6f\xa3\x00\xe2\x42\x96\xd8\x34\xd7\xfe\xfd\xf9\x98\x38\x8c\xd0\x7d\xce\xf3\xac\x37\xdd\x5f\x7f\xcc\x01\x89\x26\x04\x35\x4b\x08\xdd\x94\x0c\x94\x62\xe4\x8d\x2c\xba\xcb\xe2\x58\x4b\x6b\xb3\x34\x36\xbb\xbc\xfb\xd5\x99\xa6\x03\x48\xd7\x1d\xa6\x83\x1e\xaa\x38\xc3\x39\x8f\x8e\xdf\xa5\x9e\x22\x17\x5f\x89\x32\xce\x1a\xd6\x91\xa8\x54\x85\x9b\x79\x5c\xa9\x0a\xb5\x32\xf8\x4a\x32\xde\xf9\x1b\xf3\xd9\x22\x29\xa7\xe8\xb2\x07\x9c\x83\x75\xa4\xcc\x98\x43\xf6\xe3\xe7\x13\x6c\x06\x2d\x1b\xee\xe9\x58\x47\x36\x9c\x7d\xb5\x94\x62\x74\x8e\xe5\x1d\x92\xdd\x85\xd5\x03\xce\x39\x17\xd7\x68\xa7\xda\x65\x9c\x25\xaa\x08\xfe\xf7\x86\x60\x94\xf6\x31\x17\x1a\x1a\xa5\x43\x68\x96\x34\xcc\x0b\xdb\x3a\xb2\x01\x89\x7c\xc0\x4d\xe0\x0f\x07\xa0\xd1\x64\x1e\x39\xe7\x2c\x29\x26\x04\xb7\x03\x4f\xd9\x1b\x92\x34\x63\xf4\x07\xdb\x45\x5a\xb6\x48\x29\x2e\xf0\xf7\xba\x43\x2f\x18\x67\x49\x52\xd7\x1f\x40\x15\x51\xc7\x84\x2c\x84\x7e\x11\xdf\xec\x05\x62\x7e\x43\xd2\xd8\x62\x42\x65\xd3\xb0\x24\x49\x66\x92\x60\x26\x35\xc4\x2e\x23\x9b\x53\x74\xbd\x81\xb8\x99\x57\x78\x49\x6a\xac\x4c\x6b\xdb\x69\x72\x34\x04\x7f\x3b\x0a\xe9\x1a\xdd\xcb\xc0\x6a\x00\xef\x67\x52\xf3\x8f\xab\xaa\x3d\xa1\x5b\x92\x04\x6f\x7d\xf8\x0d\x50\x5a\xa2\x30\x04\xcf\xb1\x22\x65\xdc\x06\x9e\x8f\x30\x1f\x4f\xcc\x0c\xc9\xdd\x4c\x20\x9d\x49\x9d\x06\xe0\xde\x01\x6a\x8b\xe1\xf0\x0c\x89\x95\x72\x5e\xe7\xb3\x4e\xa7\x0f\x61\xf2\x10\x61\x59\x13\x43\x90\x55\x85\x66\xd9\xa6\x76\xd9\x2f\xdc\x17\xd0\xc2\x5b\x74\x6f\x94\x7e\x51\xf7\x5c\x63\xf9\xf2\x91\xe6\x8d\xfe\xc7\x91\xf6\x72\x32\xed\x48\x7b\x53\x3e\x5b\x24\xe5\x0b\xea\x47\x23\x6d\x3d\x05\xfe\xc5\xce\xe3\xea\xf5\x42\x3f\x07\x30\xc8\xfa\x2f\x30\x3e\x27\xde\x89\x32\xf9\xea\x36\x68\x3f\xe3\x05\xb0\xac\xe4\xbf\x3f\xfa\xb7\x86\x7a\xac\x51\x52\x16\x69\xb7\xb2\x96\xce\x70\xbe\x13\xae\x74\x3f\xdd\x6e\x25\x75\xdb\xc8\x3f\xe8\x77\x0c\x7c\x82\xc3\xf8\x4d\x57\x71\x8e\xac\x10\x62\x91\x95\x68\x02\x75\x63\xa7\xae\xdb\x29\xf6\x27\x00\x00\xff\xff\x30\xb3\xe5\x93\xc3\x09\x00\x00")
+var _tplRelationSetGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x55\xc1\x8e\xda\x30\x10\x3d\x27\x5f\xe1\x8d\x56\x55\x8c\xd2\x6c\x0f\x55\x0f\xad\x38\x54\xb4\x5b\x55\xd5\x02\x02\xd4\x1e\xaa\x0a\x79\x37\x03\x75\x37\x31\x91\x6d\x68\x51\x94\x7f\xdf\xb1\x03\x49\xd8\x40\x17\x58\x8a\x7a\x8b\xc7\xf6\x9b\xf7\xe6\x65\xc6\x59\x16\xc1\x84\x0b\x20\x9e\x84\x98\x69\x3e\x13\xa1\x02\xed\xe5\xb9\x9b\x65\x97\xeb\x10\x79\xdb\x26\x61\x11\x4a\x25\x4f\x98\x5c\x5e\x73\x88\x23\x13\x2e\xcf\x84\xfd\xda\x0e\x9e\xbd\xba\xba\x20\x12\x22\xae\x48\x89\x92\x32\x2e\xdd\xc9\x5c\xdc\x11\x3f\x21\xad\x71\x2d\x41\xd8\x65\x09\xe4\xf9\xc0\x9c\xbf\x99\x4a\x4a\x86\xa0\xdf\x47\x91\x5f\x5e\x6d\x35\x0f\x53\x02\x52\xce\x24\xc9\x5c\x87\x4f\xcc\xb7\xa1\x93\x84\x9d\x99\xd0\xf0\x47\x7f\x94\xd2\xa7\xef\x6c\xf8\xa2\x4d\x04\x8f\xcd\x39\x47\x82\x9e\x4b\x61\xa2\xae\x93\xbb\xeb\x65\x12\x0e\x4d\x32\xd4\xdd\x9b\x74\x62\xa6\x94\xef\xd5\xd3\xf5\x6e\x7f\xad\x52\x7a\x01\xf1\x9a\x44\x30\x5a\x46\xbe\xc0\x92\xd6\x96\x5f\x59\x3c\x07\x1a\x5a\x32\x6e\xee\xae\xc4\xa7\x3c\x85\xdd\xfa\xfb\xb8\x1b\xa3\x23\x87\x16\x61\x25\xc6\x80\x9f\x59\xcf\x1e\x66\x7e\x02\xed\xdf\xc3\x92\x28\x2d\xb9\x98\x52\xe2\x7f\xff\xb1\x45\x4d\x50\xa8\xa1\xc7\x78\x8a\xcb\xa0\x34\x16\xd3\xa8\xa0\xba\x3f\xbc\x81\xe4\x16\xa4\x7a\x4e\x49\x90\x3d\xa5\xe1\x00\xd4\x3c\xd6\x28\x7e\xcd\xef\xef\x44\x8c\x2b\x05\x90\xb2\x4c\xd8\x3d\xec\x52\xfe\x2a\x20\x31\x08\xdf\x30\xa7\x08\x3f\x41\x53\xc7\x81\xa9\x97\xb9\x28\x99\x98\x82\x59\xa8\x55\xa6\xaa\x2f\x93\xb0\x0b\xbf\x9b\x80\xa6\xda\x08\xe3\x64\xd9\x4b\x82\x54\x2f\x37\x2d\xb4\x4d\x1a\x7e\x56\x5d\x80\x68\x84\xe0\x0a\xd3\x25\xd8\xb4\x8e\xe3\x2c\x98\x24\x0b\x86\x8a\xb2\xad\x77\xd0\xc8\xf2\x42\x38\x5a\xa6\xd0\x93\x7c\xca\x45\x71\xb7\xf2\xcc\xec\x0e\xad\xd7\xc3\x3b\x66\x55\x05\xe4\x05\xc2\x36\xed\xdb\x52\x37\xc7\xb1\x68\x65\xfa\x1d\x54\x0a\xa1\xa4\x4d\x8c\x46\x9c\x4b\x42\xef\xd0\xb9\xc1\x19\xff\xa5\x05\x48\x3d\x9a\x11\x0f\xf9\x78\x96\xb8\x01\x80\x58\x81\x5d\x3c\x21\xe2\x51\x2f\x34\xf5\x34\xe5\x94\x29\x44\x64\x33\x54\xff\x44\x9b\xb0\x34\xc5\x70\xd9\xe3\xaa\x6a\x36\x5a\x1f\x51\xb5\x7d\x04\x3e\xa8\xf5\x06\x90\x9c\x6f\x8e\x9a\x64\xff\xe3\x1c\x3d\xa0\x08\x1b\x73\xf4\xac\x7a\xf6\x30\xf3\x03\xc4\x1b\x73\xf4\x74\xd6\x19\xe4\x67\x8f\xc7\xe3\x0d\xda\x29\xac\x6e\xc7\xbf\xe0\xf8\x54\xd1\xaf\x39\xb6\xe7\xa3\xa7\xab\xf8\x3c\xd9\x6b\x55\xb5\xcf\xe9\xdf\xa9\xbd\x75\x76\x62\x60\x48\xb8\x2a\xfc\xb8\xf6\x80\xda\xcd\x6f\x5c\xff\xec\xcb\xd9\x54\x02\xd2\x42\x01\xd4\xad\xff\x4c\x87\x25\xda\xc0\x4a\x57\x1f\xc4\x00\xf8\x2d\x3b\xa5\xcd\xa1\xf5\x01\x8a\x45\xc7\xe9\xfe\xe6\x75\xbd\xe2\x55\xd1\x70\x36\x9b\x1f\x63\x8d\x82\x8f\xe6\xf1\xd5\xf3\x5a\x1e\xb5\x45\xcb\xb2\x62\x5a\x3f\x00\x9e\x94\xb6\x44\x20\x0b\x00\x00")
 
 func tplRelationSetGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -894,7 +961,7 @@ func tplRelationSetSyncGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplRelationZsetGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x5b\x6b\xdb\x4c\x10\x7d\x96\x7e\xc5\x44\x7c\x7c\x68\x83\xba\xc9\x43\xe9\x83\x8b\x0b\x25\xbd\x50\x42\x2e\xc4\xa1\x0f\x2e\x25\x6c\xe2\x91\xd9\x66\xb5\x12\xb3\xb2\x5b\x57\xec\x7f\x2f\xbb\xb2\x65\x25\xb6\x6b\x3b\x6e\x4a\x4b\xf3\xb8\x97\xb9\x9c\x33\x33\x7b\xb6\xaa\x06\x98\x4a\x8d\x10\x11\x2a\x51\xca\x5c\xf3\xef\x06\xcb\xc8\xda\xb0\xaa\xfe\x9b\xed\x41\xa7\x0b\xbc\xde\x2a\x48\x66\x82\x26\xef\x24\xaa\x81\xdb\x6e\xee\xf0\xf3\xd6\x89\xb5\xe1\xc1\xc1\x1e\x10\x0e\xa4\x81\xc6\x8b\xf3\x1c\xa6\x23\x7d\x03\x71\x06\xfb\x57\xad\x00\xfc\x54\x64\x68\xed\x85\xbb\x7f\x32\x24\x06\xfd\x1e\x96\xaf\x07\x83\xb8\xb1\xdd\x5f\xbc\xcd\x00\x89\x72\x82\x2a\x0c\x08\xcb\x11\x69\xc8\x78\xdf\x19\xb9\x38\x67\xe9\x91\x12\xc6\xc4\x51\xdb\xee\xec\xfa\xcb\xd4\x36\x4a\x20\x5a\xf4\x18\x25\x4d\xb2\xfc\x18\x27\x2c\xa9\x11\xf0\x7e\xd5\xbb\xc9\x09\x3b\xf3\x53\xbf\x4e\xe0\x04\xb3\x6b\xa4\x0e\xb4\x5d\x7d\x14\x6a\x84\x9e\x05\xfe\x1e\xcb\x4b\x12\xda\xa4\x39\x65\x7e\xbb\x45\x73\x64\xad\x65\xfc\x2d\x51\xcc\x42\x1b\x4e\x69\x29\x64\x81\xab\x99\x39\x97\x05\x2a\xa9\xf1\xa1\xf4\x38\xef\x7f\x3b\x43\x9b\x34\xce\x85\xd0\x43\x8c\x6f\x71\x02\xa6\x24\xa9\x87\x09\x64\x52\x27\x90\x89\x6f\x20\x75\xf9\xe2\x39\x83\xf8\xd3\xe7\x25\x8c\x25\x35\x63\xcc\x51\x66\x4a\x32\x7e\xed\x9a\x3c\xe3\xfd\xda\xe7\x4a\xde\x1a\x76\x6e\x3d\x29\xb3\x78\x8c\x5f\xa0\x19\xa9\x32\x66\x61\x20\x53\xef\x6e\xaf\x0b\x5a\x2a\x17\x62\x56\x16\x2d\x95\x8f\x14\x06\x36\x74\xb5\xaa\x5d\x1a\x1f\x58\xdc\xe2\xaa\x5c\x0f\x13\x50\xa8\x63\x97\x28\x63\x61\x90\xe6\x04\x57\x89\x43\xec\x0c\xc9\xa5\xeb\x16\x66\x1a\x69\x3e\xc8\x19\x3f\xc5\xaf\x8b\x0e\x1d\x5f\x2c\x0c\x82\xaa\x7a\x06\x32\x85\xa5\xd5\xfa\x60\x4e\x11\x07\x4d\xc1\xac\x0d\x83\x20\x18\x0b\x82\xb1\x50\x9b\x54\x98\x5f\x4e\x0a\x3c\x23\x39\x94\xba\xb6\x9d\x72\xd2\xe9\x82\x3b\xed\xf9\x6a\xf5\x6e\x84\x47\x95\xc0\xff\x63\xa1\xd8\xcb\xfb\xac\x2d\xe1\x2d\x08\xbc\xb7\x26\xfc\x8a\x54\x6a\xa0\xd0\x05\x87\xb1\x20\xa9\xcb\x15\x38\xef\xe4\x7c\x94\xeb\x31\x52\x79\x99\x43\x34\x16\x2a\xf2\x89\x3b\x07\xa8\x0c\xfa\xc5\x1a\x10\x77\x03\x2c\xc1\xb3\x08\xa7\x09\xa1\x07\x3e\xc2\xbc\x27\xba\x20\x8a\x02\xf5\x7c\xf2\xcd\x7c\x20\x99\x6b\xa0\x99\xb7\xd6\xb9\x96\x6a\xcb\xf9\x41\x07\xf8\x51\xa6\x08\xc7\x4f\x83\xf4\x34\x48\xff\xce\x20\x65\xdb\xff\x60\x9c\xd1\x2f\xd4\xe7\x87\x09\xef\x4e\x3f\x93\xed\x61\xd7\x3f\x93\x3f\x13\xf9\x26\x85\x7e\x83\xaa\xf5\x52\x2e\x2b\xab\xbb\xb1\x03\x34\xf7\xa6\xec\x54\x93\x75\x19\xfa\x0a\x3c\x46\x92\xeb\xe8\xdb\x48\x66\x66\x47\x73\x65\x69\xcd\xcb\xcf\x05\x65\xc3\xbc\x97\x4a\xcd\x76\x20\x6a\xd9\xdc\x09\xca\x5a\x79\xfc\x2d\x68\x8e\x14\x0a\x8a\x5b\x3d\x72\x4f\xc6\x8f\x71\x62\x76\x4a\x31\xda\x8f\xd8\x46\x8a\x3e\x15\x73\x77\xa1\x91\x68\x78\x05\x87\xed\x3b\xd3\xd9\x2a\xc9\x70\xce\x67\xed\xd7\x7a\xc0\xeb\x57\xbb\xaa\x6a\x0d\x08\x7f\x04\x00\x00\xff\xff\xb8\x22\xa2\x4e\xf2\x0e\x00\x00")
+var _tplRelationZsetGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x56\xdb\x6e\xd3\x40\x10\x7d\xb6\xbf\x62\x6a\x21\x64\x47\xc6\xe5\x01\xf1\x10\x94\x07\x54\x2e\x42\xa8\x17\x35\x11\x48\x41\xa8\xda\xd6\x93\xb0\xd4\x5e\x5b\xeb\x4d\x68\x6a\xf9\xdf\xd9\x59\xc7\x97\x28\x49\x9b\x34\xa5\x14\xd1\x97\x28\x3b\xbb\x9e\x39\xe7\xcc\xcc\xee\xe4\x79\x88\x23\x2e\x10\x1c\x89\x11\x53\x3c\x11\xc1\x75\x86\xca\x29\x0a\x3b\xcf\x9f\x55\x36\xe8\xf6\x20\x28\x4d\xa9\xe4\x31\x93\xb3\x0f\x1c\xa3\x90\xcc\xf5\x99\xe0\xa4\xb5\xa3\xcf\xee\xef\xef\x81\xc4\x90\x67\x50\x7b\x21\xcf\xf6\x68\x22\x2e\xc0\x8d\xa1\x73\xd6\x0a\x10\x1c\xb1\x18\x8b\xe2\x94\xce\x1f\x8e\xa5\x07\xc3\x3e\xaa\xb7\x61\xe8\xd6\xdf\x76\x96\x4f\x7b\x80\x52\x26\x12\x72\xdb\xe2\x23\xfa\x4f\x78\xe2\xe0\x20\x11\x0a\xaf\xd4\x7b\x29\x5d\xef\x8d\x31\xef\xf5\x40\xf0\x88\xce\x59\x12\xd5\x44\x0a\xb2\xda\x56\x61\x57\xcb\x38\x18\x52\x30\xc2\x77\x3c\x3a\x88\x58\x96\xb9\x4e\x3b\xde\xf1\xf9\xcf\x79\x4c\xc7\x07\x67\x19\x89\xb6\xd6\x96\xcf\x38\xf3\xfc\x92\x79\x30\xcc\xfb\x17\x89\xc4\x6e\xb3\x6b\xd6\x3e\x1c\x62\x7c\x8e\xb2\x0b\x6d\x57\x5f\x58\x34\x41\xa3\x5e\xf0\x11\xd5\x40\x32\x91\x8d\x12\x19\x1b\x73\x2b\x3d\x3a\x35\x85\x17\x18\x76\x76\x61\xcf\xe5\x4c\x79\x8a\xeb\x15\x3d\xd1\xbb\x91\x4e\xf2\xd6\xb2\xce\xe5\x21\xef\xff\xba\x42\x9b\x14\xdc\x29\x13\x63\x74\x2f\x71\x06\x99\x92\x5c\x8c\x7d\x88\xb9\xd0\x3f\xec\x0a\xb8\x50\xaf\x5f\x79\xe0\x7e\xfb\xbe\x42\x31\xbf\x54\xcc\xbb\x4b\x25\xea\xa5\x5f\x97\xa3\x0e\x9b\xf9\xcd\xf7\xc3\x12\xd0\x5a\xd1\x6b\x69\x2f\x8d\xa2\x15\x58\x2f\x38\xc5\x6c\x12\x29\x4d\xbf\x82\x73\x73\x5c\x4a\x74\xe9\x32\x33\x81\xd9\x25\xae\x23\xfa\xd2\x87\x08\x85\x4b\x40\x3d\xed\x5e\xcb\x0f\x67\x3e\xc9\x45\x1f\x4a\x82\x4b\x8b\x6c\x1e\xa9\xb9\x3d\xe2\xe0\x08\x7f\x2d\x3b\x24\xb1\xb5\x1b\x2b\xcf\x5f\x80\x86\xba\x32\xd5\x9f\xb2\x23\xc4\xb0\xce\xb6\xbe\x5a\x2c\xcb\x9a\x32\x09\x53\x16\x6d\x52\x1e\xc1\x60\x96\xe2\xb1\xe4\x63\x2e\xca\x6f\x9b\x14\xd1\x6e\xdf\xa4\xba\x7f\xc1\x0c\x2b\x1f\x9e\x6b\xb7\xcb\xd9\x5a\xa1\x9b\x65\x19\x6f\x75\xf8\x35\x50\x4a\xa2\xd0\x03\xe2\xa8\x6f\x4f\xa1\xd6\xf0\x5c\xc0\xac\x4b\x67\x8a\x52\x0d\x12\x70\x34\x1e\xc7\x00\x27\x07\x18\x65\x68\x16\xb7\x90\x58\x0c\xb0\x82\xcf\x32\x9d\x3a\x84\x08\x4d\x84\xa6\x26\x7a\xc0\xd2\x54\x9b\xeb\x6b\x23\x6b\xba\xd9\x6b\xdf\xa3\xad\x7d\xed\x78\xcb\xe6\x43\x22\xfc\xf8\x5a\x10\xa7\x4f\x5d\xf8\xd4\x85\xff\x4f\x17\xc6\x0f\x37\x73\x51\xb0\x7b\x9c\x28\xee\x36\x2a\xec\x34\x4b\x6d\x21\xd7\xc2\x2c\xf5\x38\x99\x6f\x52\x20\xef\x30\x6a\x5d\xcf\xf7\x59\x0e\xe4\x79\x07\x49\xe8\x0e\xdb\x29\x97\x6b\x99\xb5\x33\xf7\x27\x40\xde\x26\xfb\x46\x6f\x62\xb5\x75\x4f\xcf\x60\xab\x49\x6f\x7e\xfd\x36\x24\xbd\xf2\x5d\xdc\x4e\x81\x72\x40\xf8\x7b\x3a\xdc\x3a\x08\x3c\x88\x14\x07\x11\x32\x0d\xbe\xa9\xce\xb3\xd6\xb4\x62\x36\xbf\x72\xf5\xe3\x44\x26\x63\x89\x1a\xa0\x26\xe3\xd9\xed\x96\xdb\x2e\xd0\x82\xaf\x74\xfe\x07\xc8\x81\xdb\x31\x0f\x24\x1d\xaa\x0e\x78\x3a\x01\x26\x11\x6d\xf5\x6b\x01\xe9\x59\xa4\xee\xa9\xbc\xf8\xb0\x8b\x90\x4e\xc7\xf1\x48\xb5\x3c\x2f\x1f\x4a\xfb\x37\xf4\x5c\x95\x70\xc9\x10\x00\x00")
 
 func tplRelationZsetGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -934,6 +1001,26 @@ func tplRelationZsetSyncGogo() (*asset, error) {
 	return a, nil
 }
 
+var _tplScriptMssqlSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xdf\x8f\x9a\x40\x10\x7e\x96\xbf\x62\x62\x4c\x90\x44\x49\xda\xc7\x36\x7d\xf0\x90\xeb\xd1\x72\xcb\x9d\xe0\xf5\x12\x63\x0c\xde\x8e\xcd\x36\xb0\x28\x60\x7a\x86\xee\xff\xde\xec\x02\x8a\xe8\x99\x8b\xe9\x63\x9f\xd8\x9d\xfd\xe6\xc7\x37\xf3\x4d\x28\x8a\x21\x50\x5c\x31\x8e\xd0\xcd\x5e\x52\xb6\xce\xcd\x38\xcb\x36\x51\x57\x08\xf9\xd4\x4b\x96\xbf\xe0\xd3\x17\x30\x61\x28\x84\x26\x2d\x6c\x05\x1c\x95\xdd\x1c\x2f\x83\x70\x19\x21\x74\xbb\x42\x68\xd6\xc4\x1e\x05\x36\x04\xa3\x1b\xd7\x86\x19\x5d\x26\x73\x73\x56\x14\x4d\x9c\x10\x73\xe8\x6b\x1d\x19\x24\x0d\xf9\x4f\x84\x1e\x1b\x40\x6f\xc5\x30\xa2\x32\x85\x82\xde\xca\x5b\x26\x84\x84\x95\x4f\xa6\xff\xe8\x5a\x49\xb4\x8d\x39\x74\xeb\xca\x06\xf2\x79\x08\xc8\x69\x85\x94\xae\x0f\x29\x8b\xc3\x74\xf7\x1d\x77\xe7\x5c\x4e\xf2\x6e\x39\xdb\x6c\x71\x9f\x78\xaa\xae\x55\xe6\x92\x65\x92\xd7\x28\xf3\x2e\xcc\x0e\xe1\x55\x7e\xcb\x23\x7e\x30\x19\x39\x24\x80\x99\x04\x2d\x8a\xa2\x06\x93\x30\x46\xf8\x03\x2f\x61\x8c\xd1\x47\x1e\xc6\x8a\xf8\x94\x38\x8f\x53\x5b\xf2\x3f\x69\x80\xaa\xa1\xf2\x2d\xf9\xab\x66\x77\x3a\x75\x25\xb8\x81\x7e\x48\x29\xf4\x18\x7c\x30\xa0\x1f\x21\x6f\xe1\x8d\xda\x41\x79\xf4\x56\xb2\x01\xaa\x8a\x8a\x7e\x33\x1e\x46\x19\xbe\x03\x3e\xd8\xe3\x39\xad\xe0\x47\x37\xa3\x35\x82\xfa\x68\x7c\xd6\xbe\x7a\x9a\xfd\x6c\x5b\x90\xad\x17\x21\xa5\xf8\x9a\x23\xa7\x48\xd7\x69\xb2\xc6\x34\xdf\x01\xd1\xef\xfd\xc5\x18\x4b\xb1\xb1\x84\xeb\x03\x20\x7a\x35\x43\x2b\x89\x63\xe4\xb9\x10\xca\xe8\x5b\x77\xf6\xfd\x48\x1d\xe9\x32\x51\x5f\x25\xaf\xa6\xc7\x5e\x5b\xba\x4a\xfc\x3e\x71\xd5\x13\xc6\x0a\x51\xe7\x2d\x95\x7c\x4d\xf1\x47\x61\xae\x2a\x5f\x1a\x2d\xcf\x9d\xde\x93\x76\x4c\x29\x64\x39\xcd\x26\xc7\xb2\xd9\x87\x53\x9b\x38\xe3\x14\x5f\xf7\xc4\x1d\x79\xc3\x26\x73\xa9\x6d\x85\x69\x4b\xbb\xde\x63\x87\x8c\xed\x67\x90\x1b\x5c\xc2\xce\x8a\xda\x23\x6f\x6e\xfa\x99\x45\x57\xe5\x94\xd1\x8e\x64\x7e\x41\xe5\x4d\xf4\x5e\xe4\x17\x35\xde\x96\xf8\x65\x85\x57\x0d\x84\x61\x43\xc4\xea\x62\x5c\xd9\xe9\x89\x7c\xf8\xdf\xe8\x7f\xd1\xe8\xc3\xe9\xf4\xbf\xf3\xc4\xf0\x77\xb9\xac\xce\x2d\x78\x37\xdf\x6c\x2b\x58\x38\xe3\x3e\xd1\xdb\x5d\x92\x40\x21\xe6\x6a\xa5\x9e\x74\x03\x1c\x1f\x88\x17\x00\x99\xba\xae\xd6\x19\x4f\xbc\x07\x78\x72\xec\x1f\xf0\x86\x9b\x12\x41\x35\xa7\x4b\x38\x18\xf9\x50\xd9\x9c\x78\x9d\xa4\xb9\xff\xe8\x0a\xd1\x62\xa6\x15\x05\x72\x2a\x84\xf6\x77\x00\xb1\x1e\x36\x9b\x73\x07\x00\x00")
+
+func tplScriptMssqlSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_tplScriptMssqlSql,
+		"tpl/script.mssql.sql",
+	)
+}
+
+func tplScriptMssqlSql() (*asset, error) {
+	bytes, err := tplScriptMssqlSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/script.mssql.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _tplScriptMysqlSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x41\x6f\x9b\x30\x14\xc7\xcf\xe1\x53\x3c\x45\x91\x1a\xa4\x14\x69\xd3\x0e\xd3\xa2\x1c\x28\x38\xab\xd5\x94\x34\x81\x74\xdd\x69\x90\x62\x26\x4f\xd8\x34\x40\xb4\x45\xcc\xdf\x7d\xb2\xc1\x09\x90\xa8\xdd\x61\xc7\xdd\xfc\xec\xff\x7b\xcf\xfe\xff\x9e\x5c\x55\xd7\x10\x93\x84\x72\x02\xc3\xe2\x39\xa7\x2f\xa5\xc5\x0e\xc5\x2e\x1d\x0a\x21\x8f\x46\xd9\xf6\x07\x7c\x9a\x81\x05\xd7\x42\x18\x72\x87\x26\xc0\x89\xda\xb7\xdc\x6d\x10\x6d\x53\x02\xc3\xa1\x10\x86\xb3\x46\x76\x80\x20\xb0\x6f\x16\x08\xc2\xaa\x6a\x2b\x84\x08\x61\x6c\x0c\x64\x7a\x1e\xf1\xef\x04\x46\x74\x02\xa3\x84\x92\x34\x96\xc5\x95\x74\x2e\xa3\x42\x08\x29\xab\x8f\x2c\x7f\xb5\x70\xb2\x74\xcf\x38\x0c\xf5\x9d\x26\x75\x15\xc2\xe3\x46\x29\x53\x1f\x72\xca\xa2\xfc\x70\x47\x0e\x97\x52\xce\xfa\xee\x39\xdd\xed\xc9\xb1\xf1\x46\x85\x85\x16\xca\xf7\x65\xa5\x56\x59\xb7\x51\x71\x2a\xaf\xfa\x6f\x3c\xbc\xda\x20\xb8\x43\x5f\x21\x94\xa2\x6f\x55\xa5\xc5\x5e\xc4\x08\xfc\x86\xe7\x88\x91\xf4\x3d\x8f\x98\x7e\xf8\xd9\xcb\x55\xf3\x26\xa9\x7e\xb8\xf2\x77\x30\xd0\x57\x20\x3b\x18\x47\x71\x0c\x23\x0a\xef\x4c\x18\xa7\x84\xf7\xf4\xa6\x4e\x18\x84\x8a\x53\x72\xde\x5c\x2a\x42\x5d\x93\xa4\x05\xf9\xcb\x94\xc9\x31\x87\xc7\x4d\x4a\x27\x32\x7b\x0c\xf4\xd2\x04\xe4\x7d\xc6\x1e\x9a\x61\xce\x33\xf7\x06\x5c\x34\xb7\x37\x8b\x00\x9c\x5b\x7b\xed\xa3\x60\xb6\x2f\x93\x8f\x6c\xfb\x01\x9c\xe5\xfd\x3d\xf2\x02\xb8\x6a\xf0\x39\x19\x63\x84\x97\x42\x5c\x4d\x0d\xa3\xe7\x14\xe5\x31\xf9\x75\x44\x85\x65\xa4\x50\xb5\x49\x29\x4d\x1f\x94\x9e\x47\xec\xb9\xe8\x49\xcd\x63\x2d\xbb\x88\x68\xe9\x5d\x98\xd8\x0b\x03\xab\x2e\x52\xd7\xe9\x50\x7b\x05\x5a\x5b\x7d\x64\xf6\x26\xb2\x3e\xb1\xb7\x81\x75\x08\xb5\x03\x73\x6a\x9c\x10\x9d\x56\xaf\x1b\xbd\x96\x07\xff\x7d\xfe\x07\x3e\xf7\x1c\xef\xfc\x9e\x8f\x94\xfc\xac\x3f\x4f\x77\xbd\x7c\x80\x47\x8c\xbe\x00\x9e\x03\x7a\xc2\x7e\xe0\xb7\x9c\x92\x3a\x21\xc2\xa9\xf6\x5a\x09\xcf\x8e\xc1\xf6\xa1\xd9\xc3\xec\x25\xcb\x4b\x7f\xb5\x10\x62\xda\xbd\x41\xbd\xf8\x13\x00\x00\xff\xff\x43\xe2\xee\x26\xf5\x05\x00\x00")
 
 func tplScriptMysqlSqlBytes() ([]byte, error) {
@@ -954,6 +1041,46 @@ func tplScriptMysqlSql() (*asset, error) {
 	return a, nil
 }
 
+var _tplScriptPostgresSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x4d\x6f\x9b\x40\x10\x3d\x9b\x5f\x31\x42\x48\x31\x92\x8d\xd4\x1e\x6b\xf5\xe0\x62\xa2\xa2\xda\x10\x1b\x9c\xe6\x56\xe1\x30\x8e\xb6\x82\xc5\x01\xac\x36\xa2\xf3\xdf\xab\x5d\x58\xc0\xd8\x69\xac\xf6\xda\xdb\x7e\xbc\x37\xf3\xe6\xf1\x96\xaa\x9a\x42\x8c\x7b\xc6\x11\xf4\xe2\x31\x67\x87\xd2\x3a\x64\x45\xf9\x94\x63\xa1\x13\x89\x5b\x23\xdb\x7d\x87\x0f\x1f\xc1\x82\x29\x91\x26\x4e\xd8\x1e\x38\xca\x73\x6b\xb1\x0b\xa3\x5d\x82\xa0\xeb\x44\x9a\xbd\x71\xe6\xa1\x03\xe1\xfc\xd3\xd2\x01\xbd\xaa\xfa\x08\x22\x1d\xc6\xda\x48\xd0\xf3\x88\x3f\x21\x18\x6c\x02\xc6\x9e\x61\x12\x8b\xe2\x12\x7a\x2b\x76\x05\x91\x80\xd5\x57\x56\xb0\x5e\xda\x59\x72\x4c\x39\xe8\x3d\x59\x13\x81\x98\x02\xf2\xb8\x01\x0b\xf6\x5d\xce\xd2\x28\x7f\xf9\x82\x2f\xaf\xb0\xce\xba\x1f\x39\x7b\x3e\x62\xdb\x7e\x2b\xb7\x4d\xff\x7a\xca\xac\x54\x28\xeb\x73\x54\x74\x1d\x88\x26\xda\xc8\xf6\xbd\x20\xdc\xcc\x5d\x2f\x04\x5d\x80\xbe\x55\x95\x02\x7b\x51\x8a\xf0\x0b\x1e\xa3\x14\x93\xf7\x3c\x4a\xe5\xf8\x5b\xcf\x5d\x6f\x1d\xe1\xc2\x99\x0d\x52\x43\xc3\xad\x5d\x90\x66\x8f\x46\x4a\x09\x3e\xc3\x38\x8a\x63\x30\x18\xbc\x33\x61\x9c\x20\x1f\xe0\x4d\x45\x90\x0c\x63\x2f\x3c\x90\x2a\x3a\x07\xfa\x25\x31\x29\xf0\x3a\xc6\xa4\xa5\xf0\xb8\x61\x9c\xec\xcc\xc1\xb7\x50\x4b\x73\xa6\xd9\xfe\x6a\xe5\x78\x21\xf8\xde\xeb\x99\x70\x03\xb8\x69\x4e\xed\x2c\x4d\x91\x97\x44\x37\x33\xed\xba\x9c\xa8\xcf\x84\x0d\x42\x95\x68\xe2\xd8\xb5\xb7\xfd\xe5\x76\xe5\x5d\xe8\x6f\xf5\x93\x36\x98\x9e\x48\xa9\x3b\x29\xde\xea\xab\xe7\xec\x56\x43\xd1\x8c\xc7\xf8\xb3\x15\xed\x8a\x1d\xf6\x55\x8b\x70\x49\xcc\x30\x5b\xea\x21\xb9\xde\xc2\x79\x90\xa2\x6b\xd8\xc5\x54\xf9\x97\xc6\xba\xf0\xd2\xa4\x90\xba\xce\x49\xc2\xfe\x10\xb0\x3e\xba\xcd\xd7\x5b\xf1\x1a\xa6\xeb\xcd\x70\x35\x06\xd6\xf8\xfe\xc6\xfc\x1b\x9b\x37\xe2\xe2\xbf\xcb\xff\xec\x72\xb7\x3a\xff\xe5\xdf\x33\xfc\x51\x3f\xb1\xc5\xc6\xbf\x83\x7b\xd7\xf9\x0a\xee\x2d\x38\x0f\x6e\x10\x06\x3d\x9f\x04\x8e\x48\x9f\x29\xa7\x25\xf0\xec\x1a\xe6\x01\x34\x67\x6e\x7a\xc8\xf2\x32\x58\x2f\x89\xfa\xaa\xb4\xaa\x42\x1e\x13\x69\xbf\x07\x00\xa4\x66\xa3\x28\xad\x06\x00\x00")
+
+func tplScriptPostgresSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_tplScriptPostgresSql,
+		"tpl/script.postgres.sql",
+	)
+}
+
+func tplScriptPostgresSql() (*asset, error) {
+	bytes, err := tplScriptPostgresSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/script.postgres.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplScriptSqliteSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xc1\x8e\x9b\x30\x10\x86\xcf\xe1\x29\x46\x88\xc3\x22\x05\xa4\xf6\xd8\xa8\x07\x9a\xb0\x2a\x6a\x44\x36\x81\x6c\xf7\x56\x91\x30\x54\xae\xc0\x6c\x80\xa8\x5d\xb9\xf3\xee\x95\xed\x40\xbc\x24\x4a\x57\xea\xb5\x37\x8f\xe7\x9f\xe1\x9f\x2f\x13\x0b\xe1\x41\x8e\x05\xe3\x08\x76\xbb\x6f\xd8\x73\xe7\xb7\x87\x92\x75\x68\x13\xc9\x9c\x53\xef\x7e\xc0\x87\x8f\xe0\x83\x47\x64\xc9\x1b\x56\x00\x47\x75\xef\x2f\x76\x69\xb6\x2b\x11\x6c\xfb\x32\x37\xaf\xab\x0a\x79\xa7\x73\x9e\x07\x42\x98\xd7\x27\x3d\xf2\x9c\xc8\x9a\x6f\xc2\x20\x0d\x21\x0d\x3e\x2d\x43\xb0\x85\x30\x7b\x13\xd9\x70\x67\x4d\xe4\x87\x9b\x8c\x7f\x47\x70\xd8\x14\x9c\x82\x61\x99\x4b\x5b\x4a\x7a\x2f\xa3\x96\x48\xcb\x58\x01\x0e\x23\x9a\x0a\xa1\xbb\x4f\x84\xd0\x7a\x3f\x59\x2f\xe7\x75\x79\xac\x38\xd8\xc3\x8c\xba\x66\x50\xaa\x72\x5e\x77\x7a\x86\x87\x86\x55\x59\xf3\xf2\x05\x5f\xfc\xa8\x0d\x8e\x5d\xbd\x6f\x50\xbb\x9f\xca\xba\xb1\xe6\x4d\xfd\x8d\x29\x8e\x9c\x1d\x8e\x38\x8c\xb1\x55\xa1\x39\x87\x32\xa2\x55\xfe\xe7\xac\x3d\x7f\x4a\x19\x98\xaf\xe2\x24\xdd\x04\x51\x9c\x82\x2d\x45\xdf\x84\xe8\xc5\x71\x56\x21\xfc\x86\x7d\x56\x61\xf9\x9e\x67\x95\xc2\xb8\x8d\xa3\xf5\x36\x94\x34\x2f\x70\x2a\x0f\xa7\x5a\x4d\x53\xfd\xdc\x93\x49\xef\x04\x0f\x70\x97\xe5\x39\x38\x0c\xde\xb9\x70\x57\x22\x1f\xe9\xdd\xbe\x40\x55\x38\x85\x84\xad\x5c\xf4\x28\xcc\x86\x58\xb6\xf8\x16\xfd\x74\x28\xe0\xf9\x49\xff\x2a\x72\x2f\xe8\xea\xa3\x3b\xb3\xac\xd1\x88\x8c\xe7\xf8\x6b\x40\x1d\xc9\x08\x5b\x63\x6b\x25\x69\xa5\x19\x83\xee\xb7\x33\x8a\x17\xe1\x93\xda\x4e\x2d\xbb\x8a\x78\x15\x5f\xd9\xdf\x2b\xeb\xab\x8c\xe8\x3e\xaf\x70\xdf\xa0\x6d\xaa\x07\xd8\xb7\x59\x8f\x51\xff\x85\xf4\x89\x1f\x78\x06\x4c\x15\xb8\x33\xe3\xcf\x7a\x3e\xdd\x46\xbc\x91\x89\xff\x84\xff\x89\xf0\xf9\x34\x7e\x5d\x17\xbb\x47\x86\x3f\xf5\xe3\xba\xd8\xac\x1e\xe0\x31\x0a\xbf\x42\x74\x0f\xe1\x53\x94\xa4\x89\xc1\x48\xea\x88\xec\x59\x4f\x59\x09\x2f\xd2\x10\x24\xfd\x0b\x1d\x55\xcf\x75\xd3\x25\xeb\x25\x91\xe9\xca\x12\x02\x79\x4e\x64\xfd\x19\x00\xf4\x1a\x66\x25\x32\x06\x00\x00")
+
+func tplScriptSqliteSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_tplScriptSqliteSql,
+		"tpl/script.sqlite.sql",
+	)
+}
+
+func tplScriptSqliteSql() (*asset, error) {
+	bytes, err := tplScriptSqliteSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/script.sqlite.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _tplUtilElasticGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x2a\x2d\xc9\xcc\xd1\x4b\xcd\x49\x2c\x2e\xc9\x4c\x56\xaa\xad\x2d\x48\x4c\xce\x4e\x4c\x4f\x55\xa8\xae\xd6\x73\xcf\x0f\x80\x70\x6a\x6b\xb9\xaa\xab\x53\xf3\x52\x6a\x6b\xb9\xb8\x00\x01\x00\x00\xff\xff\xa0\xfc\xdc\xc6\x39\x00\x00\x00")
 
 func tplUtilElasticGogoBytes() ([]byte, error) {
@@ -994,7 +1121,7 @@ func tplUtilMssqlGogo() (*asset, error) {
 	return a, nil
 }
 
-var _tplUtilMysqlGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcd\x41\xaa\xc3\x30\x0c\x04\xd0\xf5\xd7\x29\x84\x57\x09\x7c\x7c\x89\x92\x96\x6e\x4a\xaf\x90\x26\x4a\x30\x4d\xa4\x54\x96\x17\x45\xf8\xee\xc5\xb4\xcb\x81\x37\x33\xee\x33\x2d\x89\x09\x43\xb1\xb4\xc5\xfd\x9d\x5f\x5b\xa8\xf5\x18\xa7\xe7\xb8\x12\xba\xc7\x8b\xdc\xbf\xa1\x56\x80\xb4\x1f\xa2\x86\x21\x9b\x26\x5e\x73\x00\x58\x0a\x4f\x78\xcd\x83\xea\x4d\xec\x2c\x85\xe7\x8e\x54\x91\x54\x45\x7b\x7c\x88\x6c\xe8\xf0\xa7\x64\x45\x19\x7f\xb5\x78\x12\xb6\x31\x71\x6e\x34\x0e\x8d\x76\xfd\x3f\x06\x16\xc3\xa5\x4d\x84\x1e\x2a\x80\x3b\xf1\xdc\x5e\x3f\x01\x00\x00\xff\xff\x34\x08\x40\x35\xa5\x00\x00\x00")
+var _tplUtilMysqlGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4d\x8e\x31\x0e\x83\x30\x0c\x45\x67\x7c\x8a\x28\x13\x48\x6d\x38\x45\x5b\xb1\x54\xbd\x02\x10\x43\xa3\x92\x98\x3a\xc9\x40\xa3\xdc\xbd\xa1\x2c\x1d\x2c\xd9\x5f\xdf\x4f\x2f\x25\x8d\x93\x71\x28\x64\x0c\x66\x51\x76\xf3\xef\x45\xe6\xbc\xf6\xe3\xab\x9f\x51\xa4\xa4\x6e\xf4\x38\x8e\x9c\x01\x8c\x5d\x89\x83\xa8\xa1\x92\xc8\x4c\xec\x25\x94\x75\x36\xe1\x19\x07\x35\x92\x6d\xf1\x33\xc4\xad\x65\xd4\xc6\x9f\x89\x6d\x5b\x46\x42\x03\x30\x45\x37\x8a\xce\x5f\x98\xef\x14\xae\x14\x9d\xae\x0b\x40\xfc\x20\x8d\x18\x88\x16\x91\xa0\x62\x0c\x91\xdd\x91\x7a\xd5\xf9\xbd\x73\x12\x85\xa1\xfe\x1e\x1b\x28\x22\x29\xa1\xd3\xbb\xd1\x17\xab\xe8\xe8\x1d\xc1\x00\x00\x00")
 
 func tplUtilMysqlGogoBytes() ([]byte, error) {
 	return bindataRead(
@@ -1014,6 +1141,26 @@ func tplUtilMysqlGogo() (*asset, error) {
 	return a, nil
 }
 
+var _tplUtilPostgresGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x39\x00\xc6\xff\x7b\x7b\x64\x65\x66\x69\x6e\x65\x20\x22\x75\x74\x69\x6c\x2e\x70\x6f\x73\x74\x67\x72\x65\x73\x22\x7d\x7d\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x47\x6f\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x03\x00\x1a\xae\x6f\x9c\x39\x00\x00\x00")
+
+func tplUtilPostgresGogoBytes() ([]byte, error) {
+	return bindataRead(
+		_tplUtilPostgresGogo,
+		"tpl/util.postgres.gogo",
+	)
+}
+
+func tplUtilPostgresGogo() (*asset, error) {
+	bytes, err := tplUtilPostgresGogoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/util.postgres.gogo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _tplUtilRedisGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x2a\x2d\xc9\xcc\xd1\x2b\x4a\x4d\xc9\x2c\x56\xaa\xad\x2d\x48\x4c\xce\x4e\x4c\x4f\x55\xa8\xae\xd6\x73\xcf\x0f\x80\x70\x6a\x6b\xb9\xaa\xab\x53\xf3\x52\x6a\x6b\xb9\xb8\xb8\x00\x01\x00\x00\xff\xff\x1a\xe3\x3d\x2e\x38\x00\x00\x00")
 
 func tplUtilRedisGogoBytes() ([]byte, error) {
@@ -1034,6 +1181,26 @@ func tplUtilRedisGogo() (*asset, error) {
 	return a, nil
 }
 
+var _tplUtilSqliteGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x37\x00\xc8\xff\x7b\x7b\x64\x65\x66\x69\x6e\x65\x20\x22\x75\x74\x69\x6c\x2e\x73\x71\x6c\x69\x74\x65\x22\x7d\x7d\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x47\x6f\x50\x61\x63\x6b\x61\x67\x65\x7d\x7d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0a\x03\x00\xbb\xd7\xef\xe7\x37\x00\x00\x00")
+
+func tplUtilSqliteGogoBytes() ([]byte, error) {
+	return bindataRead(
+		_tplUtilSqliteGogo,
+		"tpl/util.sqlite.gogo",
+	)
+}
+
+func tplUtilSqliteGogo() (*asset, error) {
+	bytes, err := tplUtilSqliteGogoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/util.sqlite.gogo", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _tplViewGogo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x2a\xcb\x4c\x2d\x57\xaa\xad\xe5\xaa\xae\x2e\x49\xcd\x2d\xc8\x49\x2c\x49\x55\x50\xca\x4f\xca\x4a\x4d\x2e\x51\x52\xd0\x03\x8b\xa7\xe6\xa5\xd4\xd6\x72\x01\x02\x00\x00\xff\xff\xb3\xec\x14\xe9\x32\x00\x00\x00")
 
 func tplViewGogoBytes() ([]byte, error) {
@@ -1111,12 +1278,15 @@ var _bindata = map[string]func() (*asset, error){
 	"tpl/conf.mssql.gogo": tplConfMssqlGogo,
 	"tpl/conf.mysql.gogo": tplConfMysqlGogo,
 	"tpl/conf.orm.gogo": tplConfOrmGogo,
+	"tpl/conf.postgres.gogo": tplConfPostgresGogo,
 	"tpl/conf.redis.gogo": tplConfRedisGogo,
+	"tpl/conf.sqlite.gogo": tplConfSqliteGogo,
 	"tpl/object.db.gogo": tplObjectDbGogo,
 	"tpl/object.db.query.gogo": tplObjectDbQueryGogo,
 	"tpl/object.db.read.gogo": tplObjectDbReadGogo,
 	"tpl/object.db.write.gogo": tplObjectDbWriteGogo,
 	"tpl/object.elastic.gogo": tplObjectElasticGogo,
+	"tpl/object.enum.gogo": tplObjectEnumGogo,
 	"tpl/object.functions.gogo": tplObjectFunctionsGogo,
 	"tpl/object.gogo": tplObjectGogo,
 	"tpl/object.index.gogo": tplObjectIndexGogo,
@@ -1147,11 +1317,16 @@ var _bindata = map[string]func() (*asset, error){
 	"tpl/relation.set.sync.gogo": tplRelationSetSyncGogo,
 	"tpl/relation.zset.gogo": tplRelationZsetGogo,
 	"tpl/relation.zset.sync.gogo": tplRelationZsetSyncGogo,
+	"tpl/script.mssql.sql": tplScriptMssqlSql,
 	"tpl/script.mysql.sql": tplScriptMysqlSql,
+	"tpl/script.postgres.sql": tplScriptPostgresSql,
+	"tpl/script.sqlite.sql": tplScriptSqliteSql,
 	"tpl/util.elastic.gogo": tplUtilElasticGogo,
 	"tpl/util.mssql.gogo": tplUtilMssqlGogo,
 	"tpl/util.mysql.gogo": tplUtilMysqlGogo,
+	"tpl/util.postgres.gogo": tplUtilPostgresGogo,
 	"tpl/util.redis.gogo": tplUtilRedisGogo,
+	"tpl/util.sqlite.gogo": tplUtilSqliteGogo,
 	"tpl/view.gogo": tplViewGogo,
 }
 
@@ -1201,12 +1376,15 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"conf.mssql.gogo": &bintree{tplConfMssqlGogo, map[string]*bintree{}},
 		"conf.mysql.gogo": &bintree{tplConfMysqlGogo, map[string]*bintree{}},
 		"conf.orm.gogo": &bintree{tplConfOrmGogo, map[string]*bintree{}},
+		"conf.postgres.gogo": &bintree{tplConfPostgresGogo, map[string]*bintree{}},
 		"conf.redis.gogo": &bintree{tplConfRedisGogo, map[string]*bintree{}},
+		"conf.sqlite.gogo": &bintree{tplConfSqliteGogo, map[string]*bintree{}},
 		"object.db.gogo": &bintree{tplObjectDbGogo, map[string]*bintree{}},
 		"object.db.query.gogo": &bintree{tplObjectDbQueryGogo, map[string]*bintree{}},
 		"object.db.read.gogo": &bintree{tplObjectDbReadGogo, map[string]*bintree{}},
 		"object.db.write.gogo": &bintree{tplObjectDbWriteGogo, map[string]*bintree{}},
 		"object.elastic.gogo": &bintree{tplObjectElasticGogo, map[string]*bintree{}},
+		"object.enum.gogo": &bintree{tplObjectEnumGogo, map[string]*bintree{}},
 		"object.functions.gogo": &bintree{tplObjectFunctionsGogo, map[string]*bintree{}},
 		"object.gogo": &bintree{tplObjectGogo, map[string]*bintree{}},
 		"object.index.gogo": &bintree{tplObjectIndexGogo, map[string]*bintree{}},
@@ -1237,11 +1415,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"relation.set.sync.gogo": &bintree{tplRelationSetSyncGogo, map[string]*bintree{}},
 		"relation.zset.gogo": &bintree{tplRelationZsetGogo, map[string]*bintree{}},
 		"relation.zset.sync.gogo": &bintree{tplRelationZsetSyncGogo, map[string]*bintree{}},
+		"script.mssql.sql": &bintree{tplScriptMssqlSql, map[string]*bintree{}},
 		"script.mysql.sql": &bintree{tplScriptMysqlSql, map[string]*bintree{}},
+		"script.postgres.sql": &bintree{tplScriptPostgresSql, map[string]*bintree{}},
+		"script.sqlite.sql": &bintree{tplScriptSqliteSql, map[string]*bintree{}},
 		"util.elastic.gogo": &bintree{tplUtilElasticGogo, map[string]*bintree{}},
 		"util.mssql.gogo": &bintree{tplUtilMssqlGogo, map[string]*bintree{}},
 		"util.mysql.gogo": &bintree{tplUtilMysqlGogo, map[string]*bintree{}},
+		"util.postgres.gogo": &bintree{tplUtilPostgresGogo, map[string]*bintree{}},
 		"util.redis.gogo": &bintree{tplUtilRedisGogo, map[string]*bintree{}},
+		"util.sqlite.gogo": &bintree{tplUtilSqliteGogo, map[string]*bintree{}},
 		"view.gogo": &bintree{tplViewGogo, map[string]*bintree{}},
 	}},
 }}
@@ -0,0 +1,71 @@
+{{- define "script.mssql"}}{{- $obj := . -}}
+{{- if ne $obj.DbTable ""}}
+CREATE TABLE [dbo].[{{$obj.DbTable}}] (
+	{{- range $i, $field := $obj.Fields}}
+	{{$field.SQLColumn "mssql"}},
+	{{- end}}
+	{{$obj.PrimaryKey.SQLColumn "mssql"}}
+	{{- range $i, $unique := $obj.Uniques}}
+	{{- if not $unique.HasPrimaryKey}},
+	CONSTRAINT [uniq_{{$unique.Name | camel2name}}] UNIQUE (
+		{{- range $i, $f := $unique.Fields -}}
+			{{- if eq (add $i 1) (len $unique.Fields) -}}
+				{{- $f.SQLName "mssql" -}}
+			{{- else -}}
+				{{- $f.SQLName "mssql" -}},
+			{{- end -}}
+		{{- end -}}
+	)
+	{{- end}}
+	{{- end}}
+);
+GO
+EXEC sp_addextendedproperty N'MS_Description', N'{{$obj.Comment}}', N'SCHEMA', N'dbo', N'TABLE', N'{{$obj.DbTable}}';
+GO
+{{- range $i, $field := $obj.Fields}}
+{{- if ne $field.Comment ""}}
+EXEC sp_addextendedproperty N'MS_Description', N'{{$field.Comment}}', N'SCHEMA', N'dbo', N'TABLE', N'{{$obj.DbTable}}', N'COLUMN', N'{{$field.ColumnName}}';
+GO
+{{- end}}
+{{- end}}
+
+{{- range $i, $index := $obj.Indexes}}
+{{- if not $index.HasPrimaryKey}}
+CREATE INDEX [{{$index.Name | camel2name}}] ON [dbo].[{{$obj.DbTable}}](
+	{{- range $i, $f := $index.Fields -}}
+		{{- if eq (add $i 1) (len $index.Fields) -}}
+			{{- $f.SQLName "mssql" -}}
+		{{- else -}}
+			{{- $f.SQLName "mssql" -}},
+		{{- end -}}
+	{{- end -}}
+);
+GO
+{{- end}}
+{{- end}}
+
+{{- range $i, $index := $obj.Ranges}}
+{{- if not $index.HasPrimaryKey}}
+CREATE INDEX [{{$index.Name | camel2name}}] ON [dbo].[{{$obj.DbTable}}](
+	{{- range $i, $f := $index.Fields -}}
+		{{- if eq (add $i 1) (len $index.Fields) -}}
+			{{- $f.SQLName "mssql" -}}
+		{{- else -}}
+			{{- $f.SQLName "mssql" -}},
+		{{- end -}}
+	{{- end -}}
+);
+GO
+{{- end}}
+{{- end}}
+{{- end}}
+
+{{- if ne $obj.DbView ""}}
+IF OBJECT_ID(N'[dbo].[{{$obj.DbView}}]', N'V') IS NOT NULL
+	DROP VIEW [dbo].[{{$obj.DbView}}];
+GO
+CREATE VIEW [dbo].[{{$obj.DbView}}] AS {{$obj.ImportSQL}};
+GO
+{{- end}}
+
+{{end}}
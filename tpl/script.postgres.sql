@@ -0,0 +1,63 @@
+{{- define "script.postgres"}}{{- $obj := . -}}
+{{- if ne $obj.DbTable ""}}
+CREATE TABLE "{{$obj.DbTable}}" (
+	{{- range $i, $field := $obj.Fields}}
+	{{$field.SQLColumn "postgres"}},
+	{{- end}}
+	{{$obj.PrimaryKey.SQLColumn "postgres"}}
+	{{- range $i, $unique := $obj.Uniques}}
+	{{- if not $unique.HasPrimaryKey}},
+	CONSTRAINT "uniq_{{$unique.Name | camel2name}}" UNIQUE (
+		{{- range $i, $f := $unique.Fields -}}
+			{{- if eq (add $i 1) (len $unique.Fields) -}}
+				{{- $f.SQLName "postgres" -}}
+			{{- else -}}
+				{{- $f.SQLName "postgres" -}},
+			{{- end -}}
+		{{- end -}}
+	)
+	{{- end}}
+	{{- end}}
+);
+COMMENT ON TABLE "{{$obj.DbTable}}" IS '{{$obj.Comment}}';
+{{- range $i, $field := $obj.Fields}}
+{{- if ne $field.Comment ""}}
+COMMENT ON COLUMN "{{$obj.DbTable}}".{{$field.SQLName "postgres"}} IS '{{$field.Comment}}';
+{{- end}}
+{{- end}}
+
+{{- range $i, $index := $obj.Indexes}}
+{{- if not $index.HasPrimaryKey}}
+CREATE INDEX "{{$index.Name | camel2name}}" ON "{{$obj.DbTable}}"(
+	{{- range $i, $f := $index.Fields -}}
+		{{- if eq (add $i 1) (len $index.Fields) -}}
+			{{- $f.SQLName "postgres" -}}
+		{{- else -}}
+			{{- $f.SQLName "postgres" -}},
+		{{- end -}}
+	{{- end -}}
+);
+{{- end}}
+{{- end}}
+
+{{- range $i, $index := $obj.Ranges}}
+{{- if not $index.HasPrimaryKey}}
+CREATE INDEX "{{$index.Name | camel2name}}" ON "{{$obj.DbTable}}"(
+	{{- range $i, $f := $index.Fields -}}
+		{{- if eq (add $i 1) (len $index.Fields) -}}
+			{{- $f.SQLName "postgres" -}}
+		{{- else -}}
+			{{- $f.SQLName "postgres" -}},
+		{{- end -}}
+	{{- end -}}
+);
+{{- end}}
+{{- end}}
+{{- end}}
+
+{{- if ne $obj.DbView ""}}
+DROP VIEW IF EXISTS "{{$obj.DbView}}";
+CREATE VIEW "{{$obj.DbView}}" AS {{$obj.ImportSQL}};
+{{- end}}
+
+{{end}}
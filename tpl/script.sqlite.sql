@@ -0,0 +1,63 @@
+{{- define "script.sqlite"}}{{- $obj := . -}}
+{{- if ne $obj.DbTable ""}}
+{{- if ne $obj.Comment ""}}
+-- {{$obj.Comment}}
+{{- end}}
+CREATE TABLE "{{$obj.DbTable}}" (
+	{{- range $i, $field := $obj.Fields}}
+	{{- if $i}},{{end}}
+	{{$field.SQLColumn "sqlite"}}
+	{{- end}}
+	{{- if not $obj.PrimaryKey.IsAutocrement}},
+	{{$obj.PrimaryKey.SQLColumn "sqlite"}}
+	{{- end}}
+	{{- range $i, $unique := $obj.Uniques}}
+	{{- if not $unique.HasPrimaryKey}},
+	CONSTRAINT "uniq_{{$unique.Name | camel2name}}" UNIQUE (
+		{{- range $i, $f := $unique.Fields -}}
+			{{- if eq (add $i 1) (len $unique.Fields) -}}
+				{{- $f.SQLName "sqlite" -}}
+			{{- else -}}
+				{{- $f.SQLName "sqlite" -}},
+			{{- end -}}
+		{{- end -}}
+	)
+	{{- end}}
+	{{- end}}
+);
+
+{{- range $i, $index := $obj.Indexes}}
+{{- if not $index.HasPrimaryKey}}
+CREATE INDEX "{{$index.Name | camel2name}}" ON "{{$obj.DbTable}}"(
+	{{- range $i, $f := $index.Fields -}}
+		{{- if eq (add $i 1) (len $index.Fields) -}}
+			{{- $f.SQLName "sqlite" -}}
+		{{- else -}}
+			{{- $f.SQLName "sqlite" -}},
+		{{- end -}}
+	{{- end -}}
+);
+{{- end}}
+{{- end}}
+
+{{- range $i, $index := $obj.Ranges}}
+{{- if not $index.HasPrimaryKey}}
+CREATE INDEX "{{$index.Name | camel2name}}" ON "{{$obj.DbTable}}"(
+	{{- range $i, $f := $index.Fields -}}
+		{{- if eq (add $i 1) (len $index.Fields) -}}
+			{{- $f.SQLName "sqlite" -}}
+		{{- else -}}
+			{{- $f.SQLName "sqlite" -}},
+		{{- end -}}
+	{{- end -}}
+);
+{{- end}}
+{{- end}}
+{{- end}}
+
+{{- if ne $obj.DbView ""}}
+DROP VIEW IF EXISTS "{{$obj.DbView}}";
+CREATE VIEW "{{$obj.DbView}}" AS {{$obj.ImportSQL}};
+{{- end}}
+
+{{end}}